@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+var backupDestinations = map[string]storepb.InstanceBackupSetting_Destination{
+	"local": storepb.InstanceBackupSetting_LOCAL,
+	"s3":    storepb.InstanceBackupSetting_S3,
+}
+
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Back up the database and the local attachments of the instance as a zip archive",
+	Long: `Back up the database and the local attachments of the instance as a zip archive, while the server keeps running.
+The archive holds a consistent snapshot of the database: a copy of the SQLite database file, or a SQL dump of the
+data of a MySQL or Postgres database, which restores into a database with the schema of the same version.
+The backup is written to the destination of the backup setting, the backups folder of the data directory unless set
+to S3, and the oldest backups beyond the retention count of the setting are deleted. With --output, the archive is
+written to the file instead.`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		output, _ := cmd.Flags().GetString("output")
+		destinationName, _ := cmd.Flags().GetString("destination")
+		destination := storepb.InstanceBackupSetting_DESTINATION_UNSPECIFIED
+		if destinationName != "" {
+			var ok bool
+			if destination, ok = backupDestinations[destinationName]; !ok {
+				return errors.Errorf("unsupported destination %q, expected local or s3", destinationName)
+			}
+		}
+
+		ctx := cmd.Context()
+		instanceProfile := newProfile()
+		if err := instanceProfile.Validate(); err != nil {
+			return errors.Wrap(err, "failed to validate profile")
+		}
+		storeInstance, err := openStore(ctx, instanceProfile)
+		if err != nil {
+			return err
+		}
+		defer storeInstance.Close()
+
+		if output != "" {
+			return writeBackupFile(ctx, storeInstance, output)
+		}
+		service := apiv1.NewAPIV1Service("", instanceProfile, storeInstance)
+		backup, err := service.RunBackup(ctx, destination, time.Now())
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Backed up the instance to %s (%d bytes, %d attachment files)\n", backup.Location, backup.Size, backup.FileCount)
+		for _, name := range backup.DeletedBackups {
+			fmt.Printf("Deleted the old backup %s\n", name)
+		}
+		return nil
+	},
+}
+
+func init() {
+	backupCmd.Flags().String("output", "", "path of the archive to write, instead of the destination of the backup setting")
+	backupCmd.Flags().String("destination", "", "destination of the backup, local or s3, defaults to the backup setting")
+	backupCmd.MarkFlagsMutuallyExclusive("output", "destination")
+	rootCmd.AddCommand(backupCmd)
+}
+
+func writeBackupFile(ctx context.Context, storeInstance *store.Store, output string) error {
+	file, err := os.Create(output)
+	if err != nil {
+		return errors.Wrap(err, "failed to create archive")
+	}
+	manifest, err := storeInstance.WriteBackup(ctx, file)
+	if err != nil {
+		file.Close()
+		os.Remove(output)
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return errors.Wrap(err, "failed to write archive")
+	}
+	for _, reference := range manifest.MissingFiles {
+		fmt.Printf("Warning: the file of the attachment %s was not found\n", reference)
+	}
+	fmt.Printf("Backed up the %s database and %d attachment files to %s\n", manifest.Driver, len(manifest.Files), output)
+	return nil
+}
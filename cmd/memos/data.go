@@ -0,0 +1,177 @@
+package main
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/usememos/memos/plugin/importer"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+var (
+	exportCmd = &cobra.Command{
+		Use:          "export",
+		Short:        "Export the memos and attachments of a user as a zip archive",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			username, _ := cmd.Flags().GetString("user")
+			output, _ := cmd.Flags().GetString("output")
+			if output == "" {
+				output = username + ".zip"
+			}
+
+			ctx := cmd.Context()
+			service, user, err := newDataService(ctx, username)
+			if err != nil {
+				return err
+			}
+			file, err := os.Create(output)
+			if err != nil {
+				return errors.Wrap(err, "failed to create archive")
+			}
+			if err := service.WriteUserDataArchive(ctx, user.ID, file); err != nil {
+				file.Close()
+				return err
+			}
+			if err := file.Close(); err != nil {
+				return errors.Wrap(err, "failed to write archive")
+			}
+			fmt.Printf("Exported the memos of %s to %s\n", user.Username, output)
+			return nil
+		},
+	}
+
+	importCmd = &cobra.Command{
+		Use:          "import <path>",
+		Short:        "Import a zip archive made by export, or the notes exported from another tool, into the memos of a user",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			username, _ := cmd.Flags().GetString("user")
+			format, _ := cmd.Flags().GetString("format")
+			dryRun, _ := cmd.Flags().GetBool("dry-run")
+			if format != memosFormat && !slices.Contains(importer.Formats, importer.Format(format)) {
+				return errors.Errorf("unsupported format %q", format)
+			}
+			if format == memosFormat && dryRun {
+				return errors.New("--dry-run is only supported for the exports of other tools")
+			}
+
+			ctx := cmd.Context()
+			service, user, err := newDataService(ctx, username)
+			if err != nil {
+				return err
+			}
+			if format != memosFormat {
+				return importNotes(ctx, service, user, importer.Format(format), args[0], dryRun)
+			}
+			archive, err := zip.OpenReader(args[0])
+			if err != nil {
+				return errors.Wrap(err, "failed to open archive")
+			}
+			defer archive.Close()
+			response, err := service.ImportUserDataArchive(ctx, user.ID, &archive.Reader)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Imported %d new and %d updated memos with %d new attachments for %s\n",
+				response.CreatedMemoCount, response.UpdatedMemoCount, response.CreatedAttachmentCount, user.Username)
+			return nil
+		},
+	}
+)
+
+func init() {
+	for _, cmd := range []*cobra.Command{exportCmd, importCmd} {
+		cmd.Flags().String("user", "", "username of the user")
+		if err := cmd.MarkFlagRequired("user"); err != nil {
+			panic(err)
+		}
+		rootCmd.AddCommand(cmd)
+	}
+	exportCmd.Flags().StringP("output", "o", "", "path of the archive, defaults to <user>.zip")
+	importCmd.Flags().String("format", memosFormat, fmt.Sprintf("format of the export, one of %s or %s", memosFormat, formatList()))
+	importCmd.Flags().Bool("dry-run", false, "report what would be imported without importing it")
+}
+
+// memosFormat is the format of the archives made by export.
+const memosFormat = "memos"
+
+func formatList() string {
+	formats := []string{}
+	for _, format := range importer.Formats {
+		formats = append(formats, string(format))
+	}
+	return strings.Join(formats, ", ")
+}
+
+// importNotes imports the notes exported from another tool, either a folder, a zip archive or a single file.
+func importNotes(ctx context.Context, service *apiv1.APIV1Service, user *store.User, format importer.Format, filePath string, dryRun bool) error {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return errors.Wrap(err, "failed to read export")
+	}
+	var files []*importer.File
+	if info.IsDir() {
+		files, err = importer.ReadDir(filePath)
+	} else {
+		var data []byte
+		data, err = os.ReadFile(filePath)
+		if err == nil && strings.EqualFold(filepath.Ext(filePath), ".zip") {
+			files, err = importer.ReadZip(data, importer.MaxExportSize)
+		} else if err == nil {
+			files = []*importer.File{{Path: filepath.Base(filePath), ModTime: info.ModTime(), Data: data}}
+		}
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to read export")
+	}
+
+	response, err := service.ImportNoteFiles(ctx, user.ID, format, files, dryRun)
+	if err != nil {
+		return err
+	}
+	for _, warning := range response.Warnings {
+		fmt.Printf("warning: %s\n", warning)
+	}
+	verb := "Imported"
+	if dryRun {
+		verb = "Would import"
+	}
+	fmt.Printf("%s %d memos with %d attachments and %d relations for %s\n",
+		verb, response.MemoCount, response.AttachmentCount, response.RelationCount, user.Username)
+	if len(response.Tags) > 0 {
+		fmt.Printf("Tags: %s\n", strings.Join(response.Tags, ", "))
+	}
+	return nil
+}
+
+// newDataService opens the store of the instance for the commands working on the data of a user, without a server.
+func newDataService(ctx context.Context, username string) (*apiv1.APIV1Service, *store.User, error) {
+	instanceProfile := newProfile()
+	if err := instanceProfile.Validate(); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to validate profile")
+	}
+	storeInstance, err := openStore(ctx, instanceProfile)
+	if err != nil {
+		return nil, nil, err
+	}
+	user, err := storeInstance.GetUser(ctx, &store.FindUser{Username: &username})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get user")
+	}
+	if user == nil {
+		return nil, nil, errors.Errorf("user %q not found", username)
+	}
+	return apiv1.NewAPIV1Service("", instanceProfile, storeInstance), user, nil
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"syscall"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -25,35 +26,17 @@ var (
 		Use:   "memos",
 		Short: `An open source, lightweight note-taking service. Easily capture and share your great thoughts.`,
 		Run: func(_ *cobra.Command, _ []string) {
-			instanceProfile := &profile.Profile{
-				Demo:        viper.GetBool("demo"),
-				Addr:        viper.GetString("addr"),
-				Port:        viper.GetInt("port"),
-				UNIXSock:    viper.GetString("unix-sock"),
-				Data:        viper.GetString("data"),
-				Driver:      viper.GetString("driver"),
-				DSN:         viper.GetString("dsn"),
-				InstanceURL: viper.GetString("instance-url"),
-			}
-			instanceProfile.Version = version.GetCurrentVersion()
-
+			instanceProfile := newProfile()
 			if err := instanceProfile.Validate(); err != nil {
 				slog.Error("failed to validate profile", "error", err)
 				return
 			}
 
 			ctx, cancel := context.WithCancel(context.Background())
-			dbDriver, err := db.NewDBDriver(instanceProfile)
+			storeInstance, err := openStore(ctx, instanceProfile)
 			if err != nil {
 				cancel()
-				slog.Error("failed to create db driver", "error", err)
-				return
-			}
-
-			storeInstance := store.New(dbDriver, instanceProfile)
-			if err := storeInstance.Migrate(ctx); err != nil {
-				cancel()
-				slog.Error("failed to migrate", "error", err)
+				slog.Error("failed to open store", "error", err)
 				return
 			}
 
@@ -136,6 +119,34 @@ func init() {
 	viper.AutomaticEnv()
 }
 
+// newProfile returns the profile of the instance from the flags and environment variables.
+func newProfile() *profile.Profile {
+	return &profile.Profile{
+		Demo:        viper.GetBool("demo"),
+		Addr:        viper.GetString("addr"),
+		Port:        viper.GetInt("port"),
+		UNIXSock:    viper.GetString("unix-sock"),
+		Data:        viper.GetString("data"),
+		Driver:      viper.GetString("driver"),
+		DSN:         viper.GetString("dsn"),
+		InstanceURL: viper.GetString("instance-url"),
+		Version:     version.GetCurrentVersion(),
+	}
+}
+
+// openStore connects to the database of the instance and migrates it to the current version.
+func openStore(ctx context.Context, instanceProfile *profile.Profile) (*store.Store, error) {
+	dbDriver, err := db.NewDBDriver(instanceProfile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create db driver")
+	}
+	storeInstance := store.New(dbDriver, instanceProfile)
+	if err := storeInstance.Migrate(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to migrate")
+	}
+	return storeInstance, nil
+}
+
 func printGreetings(profile *profile.Profile) {
 	fmt.Printf("Memos %s started successfully!\n", profile.Version)
 
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/store"
+)
+
+var migrateDBCmd = &cobra.Command{
+	Use:   "migrate-db",
+	Short: "Copy all the data of the instance to an empty database, of the same or another driver",
+	Long: `Copy all the data of the instance to an empty database, of the same or another driver, keeping the IDs.
+The source defaults to the database of the instance, set by --driver, --dsn and --data.
+Attachments stored on the local file system or S3 are not moved, only their references.`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ctx := cmd.Context()
+		sourceProfile := newProfile()
+		if driver, _ := cmd.Flags().GetString("from-driver"); driver != "" {
+			sourceProfile.Driver = driver
+		}
+		if dsn, _ := cmd.Flags().GetString("from-dsn"); dsn != "" {
+			sourceProfile.DSN = dsn
+		}
+		targetProfile := newProfile()
+		targetProfile.Driver, _ = cmd.Flags().GetString("to-driver")
+		targetProfile.DSN, _ = cmd.Flags().GetString("to-dsn")
+		for _, instanceProfile := range []*profile.Profile{sourceProfile, targetProfile} {
+			if err := instanceProfile.Validate(); err != nil {
+				return errors.Wrap(err, "failed to validate profile")
+			}
+		}
+		if sourceProfile.Driver == targetProfile.Driver && sourceProfile.DSN == targetProfile.DSN {
+			return errors.New("the source and target databases are the same")
+		}
+
+		source, err := openStore(ctx, sourceProfile)
+		if err != nil {
+			return errors.Wrap(err, "failed to open the source database")
+		}
+		defer source.Close()
+		target, err := openStore(ctx, targetProfile)
+		if err != nil {
+			return errors.Wrap(err, "failed to open the target database")
+		}
+		defer target.Close()
+
+		counts, err := source.CopyTo(ctx, target)
+		if counts != nil {
+			printTableCounts(counts)
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to copy the database")
+		}
+		fmt.Printf("Copied the %s database to the %s database\n", sourceProfile.Driver, targetProfile.Driver)
+		return nil
+	},
+}
+
+func init() {
+	migrateDBCmd.Flags().String("from-driver", "", "database driver of the source, defaults to --driver")
+	migrateDBCmd.Flags().String("from-dsn", "", "database source name of the source, defaults to --dsn")
+	migrateDBCmd.Flags().String("to-driver", "", "database driver of the target")
+	migrateDBCmd.Flags().String("to-dsn", "", "database source name of the target")
+	for _, name := range []string{"to-driver", "to-dsn"} {
+		if err := migrateDBCmd.MarkFlagRequired(name); err != nil {
+			panic(err)
+		}
+	}
+	rootCmd.AddCommand(migrateDBCmd)
+}
+
+func printTableCounts(counts []*store.TableCount) {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "TABLE\tSOURCE\tTARGET\t")
+	for _, count := range counts {
+		fmt.Fprintf(writer, "%s\t%d\t%d\t\n", count.Table, count.Source, count.Target)
+	}
+	writer.Flush()
+}
@@ -0,0 +1,177 @@
+package eventbus
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+	"runtime/debug"
+	"sync"
+)
+
+// Handler handles the events of type T published on the bus.
+type Handler[T any] func(ctx context.Context, event T)
+
+// Bus delivers the events published in process to the subscribers of their type.
+//
+// Synchronous subscribers run in Publish, in the order they subscribed, so that their side effects are done when
+// Publish returns. Asynchronous subscribers run in the background, each one handling its events in the order they
+// were published.
+type Bus struct {
+	mu          sync.RWMutex
+	nextID      int
+	subscribers map[reflect.Type][]*subscriber
+
+	pendingMu   sync.Mutex
+	pendingCond *sync.Cond
+	// pending is the number of events queued to asynchronous subscribers and not handled yet.
+	pending int
+}
+
+type subscriber struct {
+	id     int
+	handle func(ctx context.Context, event any)
+	async  bool
+
+	mu      sync.Mutex
+	queue   []queuedEvent
+	running bool
+	closed  bool
+}
+
+type queuedEvent struct {
+	ctx   context.Context
+	event any
+}
+
+// New creates a bus without subscribers.
+func New() *Bus {
+	bus := &Bus{
+		subscribers: map[reflect.Type][]*subscriber{},
+	}
+	bus.pendingCond = sync.NewCond(&bus.pendingMu)
+	return bus
+}
+
+// Subscribe registers the handler of the events of type T, run synchronously by Publish.
+// It returns a function that unsubscribes the handler.
+func Subscribe[T any](bus *Bus, handler Handler[T]) func() {
+	return bus.subscribe(reflect.TypeFor[T](), func(ctx context.Context, event any) {
+		handler(ctx, event.(T))
+	}, false)
+}
+
+// SubscribeAsync registers the handler of the events of type T, run in the background.
+// The handler gets the context of the publisher without its cancellation, as it may run after the publisher returned.
+// It returns a function that unsubscribes the handler and drops the events it did not handle yet.
+func SubscribeAsync[T any](bus *Bus, handler Handler[T]) func() {
+	return bus.subscribe(reflect.TypeFor[T](), func(ctx context.Context, event any) {
+		handler(ctx, event.(T))
+	}, true)
+}
+
+// Publish delivers the event to the subscribers of its type.
+func Publish[T any](ctx context.Context, bus *Bus, event T) {
+	bus.mu.RLock()
+	subscribers := bus.subscribers[reflect.TypeFor[T]()]
+	bus.mu.RUnlock()
+	for _, s := range subscribers {
+		if s.async {
+			bus.enqueue(s, queuedEvent{ctx: context.WithoutCancel(ctx), event: event})
+			continue
+		}
+		s.handle(ctx, event)
+	}
+}
+
+// Wait blocks until the asynchronous subscribers handled the events published before.
+func (b *Bus) Wait() {
+	b.pendingMu.Lock()
+	defer b.pendingMu.Unlock()
+	for b.pending > 0 {
+		b.pendingCond.Wait()
+	}
+}
+
+func (b *Bus) subscribe(eventType reflect.Type, handle func(ctx context.Context, event any), async bool) func() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.nextID++
+	s := &subscriber{id: b.nextID, handle: handle, async: async}
+	b.subscribers[eventType] = append(b.subscribers[eventType], s)
+
+	return func() {
+		b.mu.Lock()
+		subscribers := b.subscribers[eventType]
+		for i, subscriber := range subscribers {
+			if subscriber.id == s.id {
+				// The slice is copied, so that the events being published still reach the subscribers.
+				b.subscribers[eventType] = append(subscribers[:i:i], subscribers[i+1:]...)
+				break
+			}
+		}
+		b.mu.Unlock()
+
+		s.mu.Lock()
+		dropped := len(s.queue)
+		s.queue = nil
+		s.closed = true
+		s.mu.Unlock()
+		b.done(dropped)
+	}
+}
+
+func (b *Bus) enqueue(s *subscriber, event queuedEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	b.pendingMu.Lock()
+	b.pending++
+	b.pendingMu.Unlock()
+	s.queue = append(s.queue, event)
+	if !s.running {
+		s.running = true
+		go b.run(s)
+	}
+}
+
+// run handles the queued events of the asynchronous subscriber until its queue is empty.
+func (b *Bus) run(s *subscriber) {
+	for {
+		s.mu.Lock()
+		if len(s.queue) == 0 {
+			s.running = false
+			s.mu.Unlock()
+			return
+		}
+		event := s.queue[0]
+		s.queue = s.queue[1:]
+		s.mu.Unlock()
+
+		b.handle(s, event)
+		b.done(1)
+	}
+}
+
+// handle runs the asynchronous handler, recovering from its panics so that they do not take the server down.
+func (*Bus) handle(s *subscriber, event queuedEvent) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error("panic recovered in event handler", "event", reflect.TypeOf(event.event).String(), "panic", r, "stacktrace", string(debug.Stack()))
+		}
+	}()
+	s.handle(event.ctx, event.event)
+}
+
+func (b *Bus) done(count int) {
+	if count == 0 {
+		return
+	}
+	b.pendingMu.Lock()
+	b.pending -= count
+	if b.pending == 0 {
+		b.pendingCond.Broadcast()
+	}
+	b.pendingMu.Unlock()
+}
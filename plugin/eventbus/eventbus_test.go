@@ -0,0 +1,135 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type created struct {
+	name string
+}
+
+type deleted struct {
+	name string
+}
+
+func TestSubscribe(t *testing.T) {
+	ctx := context.Background()
+	bus := New()
+	received := []string{}
+	unsubscribeFirst := Subscribe(bus, func(_ context.Context, event created) {
+		received = append(received, "first:"+event.name)
+	})
+	unsubscribeSecond := Subscribe(bus, func(_ context.Context, event created) {
+		received = append(received, "second:"+event.name)
+	})
+	Subscribe(bus, func(_ context.Context, event deleted) {
+		received = append(received, "deleted:"+event.name)
+	})
+
+	Publish(ctx, bus, created{name: "a"})
+	require.Equal(t, []string{"first:a", "second:a"}, received)
+
+	Publish(ctx, bus, deleted{name: "a"})
+	require.Equal(t, []string{"first:a", "second:a", "deleted:a"}, received)
+
+	unsubscribeFirst()
+	Publish(ctx, bus, created{name: "b"})
+	require.Equal(t, []string{"first:a", "second:a", "deleted:a", "second:b"}, received)
+
+	unsubscribeSecond()
+	unsubscribeSecond()
+	Publish(ctx, bus, created{name: "c"})
+	require.Len(t, received, 4)
+}
+
+func TestUnsubscribeWhilePublishing(t *testing.T) {
+	ctx := context.Background()
+	bus := New()
+	count := 0
+	var unsubscribe func()
+	unsubscribe = Subscribe(bus, func(context.Context, created) {
+		count++
+		unsubscribe()
+	})
+	Subscribe(bus, func(context.Context, created) {
+		count++
+	})
+
+	Publish(ctx, bus, created{})
+	Publish(ctx, bus, created{})
+	require.Equal(t, 3, count)
+}
+
+func TestSubscribeAsync(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	bus := New()
+	var mu sync.Mutex
+	received := []string{}
+	release := make(chan struct{})
+	SubscribeAsync(bus, func(ctx context.Context, event created) {
+		<-release
+		require.NoError(t, ctx.Err())
+		mu.Lock()
+		received = append(received, event.name)
+		mu.Unlock()
+	})
+
+	for _, name := range []string{"a", "b", "c"} {
+		Publish(ctx, bus, created{name: name})
+	}
+	// The handlers do not see the cancellation of the publisher.
+	cancel()
+	mu.Lock()
+	require.Empty(t, received)
+	mu.Unlock()
+
+	close(release)
+	bus.Wait()
+	require.Equal(t, []string{"a", "b", "c"}, received)
+}
+
+func TestSubscribeAsyncRecoversFromPanics(t *testing.T) {
+	ctx := context.Background()
+	bus := New()
+	count := 0
+	SubscribeAsync(bus, func(_ context.Context, event created) {
+		count++
+		if event.name == "panic" {
+			panic("handler failed")
+		}
+	})
+
+	Publish(ctx, bus, created{name: "panic"})
+	Publish(ctx, bus, created{name: "ok"})
+	bus.Wait()
+	require.Equal(t, 2, count)
+}
+
+func TestUnsubscribeAsyncDropsPendingEvents(t *testing.T) {
+	ctx := context.Background()
+	bus := New()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	count := 0
+	unsubscribe := SubscribeAsync(bus, func(context.Context, created) {
+		count++
+		if count == 1 {
+			close(started)
+			<-release
+		}
+	})
+
+	Publish(ctx, bus, created{})
+	<-started
+	Publish(ctx, bus, created{})
+	Publish(ctx, bus, created{})
+	unsubscribe()
+	Publish(ctx, bus, created{})
+	close(release)
+	bus.Wait()
+	require.Equal(t, 1, count)
+}
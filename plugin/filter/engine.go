@@ -95,6 +95,24 @@ func (p *Program) Render(opts RenderOptions) (Statement, error) {
 	return renderer.Render(p.condition)
 }
 
+// RenderRelevance converts the full-text conditions of the program into a dialect-specific
+// relevance expression, higher is better. SQL is empty when the program has no full-text condition.
+func (p *Program) RenderRelevance(opts RenderOptions) (Statement, error) {
+	renderer := newRenderer(p.schema, opts)
+	sql, err := renderer.renderRelevance(p.condition)
+	if err != nil {
+		return Statement{}, err
+	}
+	args := renderer.args
+	if args == nil {
+		args = []any{}
+	}
+	return Statement{
+		SQL:  sql,
+		Args: args,
+	}, nil
+}
+
 var (
 	defaultOnce           sync.Once
 	defaultInst           *Engine
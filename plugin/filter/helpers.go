@@ -3,6 +3,7 @@ package filter
 import (
 	"context"
 	"fmt"
+	"strings"
 )
 
 // AppendConditions compiles the provided filters and appends the resulting SQL fragments and args.
@@ -23,3 +24,32 @@ func AppendConditions(ctx context.Context, engine *Engine, filters []string, dia
 	}
 	return nil
 }
+
+// AppendRelevance compiles the provided filters and appends an ORDER BY term ranking rows
+// by how well they match the full-text conditions of the filters, best match first.
+// Nothing is appended when the filters have no full-text condition.
+func AppendRelevance(ctx context.Context, engine *Engine, filters []string, dialect DialectName, orderBy *[]string, args *[]any) error {
+	scores := []string{}
+	for _, filterStr := range filters {
+		program, err := engine.Compile(ctx, filterStr)
+		if err != nil {
+			return err
+		}
+		stmt, err := program.RenderRelevance(RenderOptions{
+			Dialect:           dialect,
+			PlaceholderOffset: len(*args),
+		})
+		if err != nil {
+			return err
+		}
+		if stmt.SQL == "" {
+			continue
+		}
+		scores = append(scores, stmt.SQL)
+		*args = append(*args, stmt.Args...)
+	}
+	if len(scores) > 0 {
+		*orderBy = append(*orderBy, fmt.Sprintf("(%s) DESC", strings.Join(scores, " + ")))
+	}
+	return nil
+}
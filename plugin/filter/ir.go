@@ -81,6 +81,23 @@ type ContainsCondition struct {
 
 func (*ContainsCondition) isCondition() {}
 
+// MatchesCondition models the <field>.matches(<query>) full-text search call.
+// All terms must match.
+type MatchesCondition struct {
+	Field string
+	Terms []MatchTerm
+}
+
+func (*MatchesCondition) isCondition() {}
+
+// MatchTerm is a single term of a full-text query.
+// A term with several words is a phrase: the words must appear next to each other.
+type MatchTerm struct {
+	Words []string
+	// Prefix makes the last word match any word starting with it.
+	Prefix bool
+}
+
 // ConstantCondition captures a literal boolean outcome.
 type ConstantCondition struct {
 	Value bool
@@ -1,7 +1,9 @@
 package filter
 
 import (
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/pkg/errors"
 	exprv1 "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
@@ -94,6 +96,8 @@ func buildCallCondition(call *exprv1.Expr_Call, schema Schema) (Condition, error
 		return buildInCondition(call, schema)
 	case "contains":
 		return buildContainsCondition(call, schema)
+	case "matches":
+		return buildMatchesCondition(call, schema)
 	default:
 		val, ok, err := evaluateBool(call)
 		if err != nil {
@@ -232,6 +236,85 @@ func buildContainsCondition(call *exprv1.Expr_Call, schema Schema) (Condition, e
 	}, nil
 }
 
+func buildMatchesCondition(call *exprv1.Expr_Call, schema Schema) (Condition, error) {
+	if call.Target == nil {
+		return nil, errors.New("matches requires a target")
+	}
+	targetName, err := getIdentName(call.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	field, ok := schema.Field(targetName)
+	if !ok {
+		return nil, errors.Errorf("unknown identifier %q", targetName)
+	}
+	if !field.SupportsMatches {
+		return nil, errors.Errorf("identifier %q does not support matches()", targetName)
+	}
+	if len(call.Args) != 1 {
+		return nil, errors.New("matches expects exactly one argument")
+	}
+	value, err := getConstValue(call.Args[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "matches only supports literal arguments")
+	}
+	str, ok := value.(string)
+	if !ok {
+		return nil, errors.New("matches argument must be a string")
+	}
+	terms := parseMatchQuery(str)
+	if len(terms) == 0 {
+		return nil, errors.New("matches query must contain at least one word")
+	}
+	return &MatchesCondition{
+		Field: targetName,
+		Terms: terms,
+	}, nil
+}
+
+// parseMatchQuery splits a full-text query into terms.
+// Whitespace separates terms, "double quotes" group words into a phrase and a trailing * makes a prefix term.
+// Words are runs of letters and digits; other characters only separate words,
+// so that the query cannot inject dialect-specific search operators.
+func parseMatchQuery(query string) []MatchTerm {
+	var terms []MatchTerm
+	addTerm := func(text string, allowPrefix bool) {
+		prefix := allowPrefix && strings.HasSuffix(text, "*")
+		words := strings.FieldsFunc(text, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		})
+		if len(words) == 0 {
+			return
+		}
+		terms = append(terms, MatchTerm{Words: words, Prefix: prefix})
+	}
+
+	for query != "" {
+		query = strings.TrimLeftFunc(query, unicode.IsSpace)
+		if query == "" {
+			break
+		}
+		if query[0] == '"' {
+			end := strings.IndexByte(query[1:], '"')
+			if end < 0 {
+				addTerm(query[1:], false)
+				break
+			}
+			addTerm(query[1:end+1], false)
+			query = query[end+2:]
+			continue
+		}
+		end := strings.IndexFunc(query, unicode.IsSpace)
+		if end < 0 {
+			end = len(query)
+		}
+		addTerm(query[:end], true)
+		query = query[end:]
+	}
+	return terms
+}
+
 func buildValueExpr(expr *exprv1.Expr, schema Schema) (ValueExpr, error) {
 	if identName, err := getIdentName(expr); err == nil {
 		if _, ok := schema.Field(identName); !ok {
@@ -74,6 +74,8 @@ func (r *renderer) renderCondition(cond Condition) (renderResult, error) {
 		return r.renderElementInCondition(c)
 	case *ContainsCondition:
 		return r.renderContainsCondition(c)
+	case *MatchesCondition:
+		return r.renderMatchesCondition(c)
 	case *ListComprehensionCondition:
 		return r.renderListComprehension(c)
 	case *ConstantCondition:
@@ -468,6 +470,78 @@ func (r *renderer) renderContainsCondition(cond *ContainsCondition) (renderResul
 	}
 }
 
+// renderMatchesCondition renders a full-text search against the index of the field:
+// an FTS5 table named <table>_fts on SQLite, a FULLTEXT index on MySQL and a
+// tsvector column named <column>_tsv on PostgreSQL.
+func (r *renderer) renderMatchesCondition(cond *MatchesCondition) (renderResult, error) {
+	field, ok := r.schema.Field(cond.Field)
+	if !ok {
+		return renderResult{}, errors.Errorf("unknown field %q", cond.Field)
+	}
+	switch r.dialect {
+	case DialectSQLite:
+		table := fullTextTable(field.Column)
+		sql := fmt.Sprintf("`%s`.`id` IN (SELECT `rowid` FROM `%s` WHERE `%s` MATCH %s)", field.Column.Table, table, table, r.addArg(sqliteMatchQuery(cond.Terms)))
+		return renderResult{sql: sql}, nil
+	case DialectMySQL:
+		sql := fmt.Sprintf("MATCH(%s) AGAINST (%s IN BOOLEAN MODE)", qualifyColumn(r.dialect, field.Column), r.addArg(mysqlMatchQuery(cond.Terms)))
+		return renderResult{sql: sql}, nil
+	case DialectPostgres:
+		sql := fmt.Sprintf("%s @@ to_tsquery('simple', %s)", tsvectorColumn(field.Column), r.addArg(postgresMatchQuery(cond.Terms)))
+		return renderResult{sql: sql}, nil
+	default:
+		return renderResult{}, errors.Errorf("matches is not supported for dialect %s", r.dialect)
+	}
+}
+
+// renderRelevance renders an expression scoring how well a row matches the full-text conditions,
+// higher is better. Conditions under a negation are ignored.
+func (r *renderer) renderRelevance(cond Condition) (string, error) {
+	var scores []string
+	var collect func(cond Condition) error
+	collect = func(cond Condition) error {
+		switch c := cond.(type) {
+		case *LogicalCondition:
+			if err := collect(c.Left); err != nil {
+				return err
+			}
+			return collect(c.Right)
+		case *MatchesCondition:
+			score, err := r.renderMatchScore(c)
+			if err != nil {
+				return err
+			}
+			scores = append(scores, score)
+		default:
+			// Other conditions do not contribute to relevance.
+		}
+		return nil
+	}
+	if err := collect(cond); err != nil {
+		return "", err
+	}
+	return strings.Join(scores, " + "), nil
+}
+
+func (r *renderer) renderMatchScore(cond *MatchesCondition) (string, error) {
+	field, ok := r.schema.Field(cond.Field)
+	if !ok {
+		return "", errors.Errorf("unknown field %q", cond.Field)
+	}
+	switch r.dialect {
+	case DialectSQLite:
+		// bm25() is negative, the better the match the lower the value.
+		table := fullTextTable(field.Column)
+		return fmt.Sprintf("COALESCE((SELECT -bm25(`%s`) FROM `%s` WHERE `%s` MATCH %s AND `%s`.`rowid` = `%s`.`id`), 0)", table, table, table, r.addArg(sqliteMatchQuery(cond.Terms)), table, field.Column.Table), nil
+	case DialectMySQL:
+		return fmt.Sprintf("MATCH(%s) AGAINST (%s IN BOOLEAN MODE)", qualifyColumn(r.dialect, field.Column), r.addArg(mysqlMatchQuery(cond.Terms))), nil
+	case DialectPostgres:
+		return fmt.Sprintf("ts_rank(%s, to_tsquery('simple', %s))", tsvectorColumn(field.Column), r.addArg(postgresMatchQuery(cond.Terms))), nil
+	default:
+		return "", errors.Errorf("matches is not supported for dialect %s", r.dialect)
+	}
+}
+
 func (r *renderer) renderListComprehension(cond *ListComprehensionCondition) (renderResult, error) {
 	field, ok := r.schema.Field(cond.Field)
 	if !ok {
@@ -693,6 +767,64 @@ func qualifyColumn(d DialectName, col Column) string {
 	}
 }
 
+func fullTextTable(col Column) string {
+	return col.Table + "_fts"
+}
+
+func tsvectorColumn(col Column) string {
+	return fmt.Sprintf("%s.%s_tsv", col.Table, col.Name)
+}
+
+// sqliteMatchQuery builds an FTS5 query: every term is a quoted string, optionally a prefix.
+func sqliteMatchQuery(terms []MatchTerm) string {
+	parts := make([]string, 0, len(terms))
+	for _, term := range terms {
+		part := `"` + strings.Join(term.Words, " ") + `"`
+		if term.Prefix {
+			part += "*"
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, " ")
+}
+
+// mysqlMatchQuery builds a boolean mode query with every term required.
+// MySQL does not support prefix phrases, so the prefix is dropped for them.
+func mysqlMatchQuery(terms []MatchTerm) string {
+	parts := make([]string, 0, len(terms))
+	for _, term := range terms {
+		switch {
+		case len(term.Words) > 1:
+			parts = append(parts, `+"`+strings.Join(term.Words, " ")+`"`)
+		case term.Prefix:
+			parts = append(parts, "+"+term.Words[0]+"*")
+		default:
+			parts = append(parts, "+"+term.Words[0])
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// postgresMatchQuery builds a to_tsquery expression; phrases use the followed-by operator.
+func postgresMatchQuery(terms []MatchTerm) string {
+	parts := make([]string, 0, len(terms))
+	for _, term := range terms {
+		lexemes := make([]string, 0, len(term.Words))
+		for _, word := range term.Words {
+			lexemes = append(lexemes, "'"+word+"'")
+		}
+		if term.Prefix {
+			lexemes[len(lexemes)-1] += ":*"
+		}
+		if len(lexemes) > 1 {
+			parts = append(parts, "("+strings.Join(lexemes, " <-> ")+")")
+		} else {
+			parts = append(parts, lexemes[0])
+		}
+	}
+	return strings.Join(parts, " & ")
+}
+
 func jsonPath(field Field) string {
 	return "$." + strings.Join(field.JSONPath, ".")
 }
@@ -54,6 +54,7 @@ type Field struct {
 	JSONPath             []string
 	AliasFor             string
 	SupportsContains     bool
+	SupportsMatches      bool
 	Expressions          map[DialectName]string
 	AllowedComparisonOps map[ComparisonOperator]bool
 }
@@ -106,6 +107,7 @@ func NewSchema() Schema {
 			Type:             FieldTypeString,
 			Column:           Column{Table: "memo", Name: "content"},
 			SupportsContains: true,
+			SupportsMatches:  true,
 			Expressions:      map[DialectName]string{},
 		},
 		"creator_id": {
@@ -180,6 +182,13 @@ func NewSchema() Schema {
 			Type:     FieldTypeString,
 			AliasFor: "tags",
 		},
+		"mentions": {
+			Name:     "mentions",
+			Kind:     FieldKindJSONList,
+			Type:     FieldTypeString,
+			Column:   Column{Table: "memo", Name: "payload"},
+			JSONPath: []string{"mentions"},
+		},
 		"has_task_list": {
 			Name:     "has_task_list",
 			Kind:     FieldKindJSONBool,
@@ -224,6 +233,60 @@ func NewSchema() Schema {
 				CompareNeq: true,
 			},
 		},
+		"category": {
+			Name:   "category",
+			Kind:   FieldKindScalar,
+			Type:   FieldTypeString,
+			Column: Column{Table: "memo", Name: "payload"},
+			Expressions: map[DialectName]string{
+				DialectSQLite:   "JSON_EXTRACT(%s, '$.enrichment.category')",
+				DialectMySQL:    "JSON_UNQUOTE(JSON_EXTRACT(%s, '$.enrichment.category'))",
+				DialectPostgres: "%s->'enrichment'->>'category'",
+			},
+			AllowedComparisonOps: map[ComparisonOperator]bool{
+				CompareEq:  true,
+				CompareNeq: true,
+			},
+		},
+		"remind_ts": {
+			Name:   "remind_ts",
+			Kind:   FieldKindScalar,
+			Type:   FieldTypeTimestamp,
+			Column: Column{Table: "memo", Name: "payload"},
+			Expressions: map[DialectName]string{
+				// The payload stores int64 values as JSON strings.
+				DialectSQLite:   "CAST(JSON_EXTRACT(%s, '$.remindTs') AS INTEGER)",
+				DialectMySQL:    "CAST(JSON_UNQUOTE(JSON_EXTRACT(%s, '$.remindTs')) AS SIGNED)",
+				DialectPostgres: "CAST(%s->>'remindTs' AS BIGINT)",
+			},
+		},
+		"publish_ts": {
+			Name:   "publish_ts",
+			Kind:   FieldKindScalar,
+			Type:   FieldTypeTimestamp,
+			Column: Column{Table: "memo", Name: "payload"},
+			Expressions: map[DialectName]string{
+				// The payload stores int64 values as JSON strings.
+				DialectSQLite:   "CAST(JSON_EXTRACT(%s, '$.publishTs') AS INTEGER)",
+				DialectMySQL:    "CAST(JSON_UNQUOTE(JSON_EXTRACT(%s, '$.publishTs')) AS SIGNED)",
+				DialectPostgres: "CAST(%s->>'publishTs' AS BIGINT)",
+			},
+		},
+		"content_hash": {
+			Name:   "content_hash",
+			Kind:   FieldKindScalar,
+			Type:   FieldTypeString,
+			Column: Column{Table: "memo", Name: "payload"},
+			Expressions: map[DialectName]string{
+				DialectSQLite:   "JSON_EXTRACT(%s, '$.property.contentHash')",
+				DialectMySQL:    "JSON_UNQUOTE(JSON_EXTRACT(%s, '$.property.contentHash'))",
+				DialectPostgres: "%s->'property'->>'contentHash'",
+			},
+			AllowedComparisonOps: map[ComparisonOperator]bool{
+				CompareEq:  true,
+				CompareNeq: true,
+			},
+		},
 	}
 
 	envOptions := []cel.EnvOption{
@@ -234,11 +297,16 @@ func NewSchema() Schema {
 		cel.Variable("pinned", cel.BoolType),
 		cel.Variable("tag", cel.StringType),
 		cel.Variable("tags", cel.ListType(cel.StringType)),
+		cel.Variable("mentions", cel.ListType(cel.StringType)),
 		cel.Variable("visibility", cel.StringType),
 		cel.Variable("has_task_list", cel.BoolType),
 		cel.Variable("has_link", cel.BoolType),
 		cel.Variable("has_code", cel.BoolType),
 		cel.Variable("has_incomplete_tasks", cel.BoolType),
+		cel.Variable("category", cel.StringType),
+		cel.Variable("content_hash", cel.StringType),
+		cel.Variable("remind_ts", cel.IntType),
+		cel.Variable("publish_ts", cel.IntType),
 		nowFunction,
 	}
 
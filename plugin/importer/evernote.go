@@ -0,0 +1,110 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// evernoteTimeLayout is the layout of the times in ENEX files, in UTC.
+const evernoteTimeLayout = "20060102T150405Z"
+
+// evernoteNote is a note of an ENEX file.
+type evernoteNote struct {
+	Title     string   `xml:"title"`
+	Content   string   `xml:"content"`
+	Created   string   `xml:"created"`
+	Updated   string   `xml:"updated"`
+	Tags      []string `xml:"tag"`
+	Resources []struct {
+		Data               string `xml:"data"`
+		Mime               string `xml:"mime"`
+		ResourceAttributes struct {
+			FileName string `xml:"file-name"`
+		} `xml:"resource-attributes"`
+	} `xml:"resource"`
+}
+
+// parseEvernote parses the ENEX files of an Evernote export. The content of the notes is ENML, a subset of XHTML,
+// and their resources are attached to them.
+func parseEvernote(files []*File) (*Result, error) {
+	result := &Result{}
+	for _, file := range files {
+		if path.Ext(file.Path) != ".enex" || isHiddenPath(file.Path) {
+			continue
+		}
+		decoder := xml.NewDecoder(bytes.NewReader(file.Data))
+		// ENEX files declare the DTD of Evernote, with entities the decoder does not know.
+		decoder.Strict = false
+		decoder.Entity = xml.HTMLEntity
+		for {
+			token, err := decoder.Token()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return nil, errors.Wrapf(err, "failed to parse %s", file.Path)
+			}
+			start, ok := token.(xml.StartElement)
+			if !ok || start.Name.Local != "note" {
+				continue
+			}
+			evernote := &evernoteNote{}
+			if err := decoder.DecodeElement(evernote, &start); err != nil {
+				return nil, errors.Wrapf(err, "failed to parse %s", file.Path)
+			}
+			note, warnings := convertEvernoteNote(evernote)
+			for _, warning := range warnings {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("%s: %s: %s", file.Path, evernote.Title, warning))
+			}
+			result.Notes = append(result.Notes, note)
+		}
+	}
+	return result, nil
+}
+
+func convertEvernoteNote(evernote *evernoteNote) (*Note, []string) {
+	warnings := []string{}
+	content, err := htmlToMarkdown(evernote.Content)
+	if err != nil {
+		warnings = append(warnings, err.Error())
+	}
+	note := &Note{
+		Title:   evernote.Title,
+		Content: appendTags(prependTitle(content, evernote.Title), evernote.Tags),
+	}
+	note.CreateTime, _ = time.Parse(evernoteTimeLayout, evernote.Created)
+	note.UpdateTime, err = time.Parse(evernoteTimeLayout, evernote.Updated)
+	if err != nil {
+		note.UpdateTime = note.CreateTime
+	}
+
+	for i, resource := range evernote.Resources {
+		data, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(resource.Data), ""))
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("invalid resource %d", i+1))
+			continue
+		}
+		filename := path.Base(resource.ResourceAttributes.FileName)
+		if filename == "." || filename == "/" {
+			filename = fmt.Sprintf("attachment-%d", i+1)
+			if extensions, _ := mime.ExtensionsByType(resource.Mime); len(extensions) > 0 {
+				filename += extensions[0]
+			}
+		}
+		mimeType := resource.Mime
+		if mimeType == "" {
+			mimeType = attachmentType(filename, data)
+		}
+		note.Attachments = append(note.Attachments, &Attachment{Filename: filename, Type: mimeType, Data: data})
+	}
+	return note, warnings
+}
@@ -0,0 +1,88 @@
+package importer
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+)
+
+// flomoTimeLayout is the layout of the time of the memos in the flomo export, in the local time of the user.
+const flomoTimeLayout = "2006-01-02 15:04:05"
+
+// parseFlomo parses the HTML export of flomo: each memo is a div.memo with its time, its content and its files,
+// which are linked relative to the HTML file. The tags of flomo are already #tag in the content.
+func parseFlomo(files []*File) (*Result, error) {
+	result := &Result{}
+	filesByPath := indexFiles(files)
+	for _, file := range files {
+		if path.Ext(file.Path) != ".html" || isHiddenPath(file.Path) {
+			continue
+		}
+		document, err := html.Parse(strings.NewReader(string(file.Data)))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s", file.Path)
+		}
+		for _, memo := range findElements(document, "div", "memo") {
+			note := &Note{}
+			if times := findElements(memo, "div", "time"); len(times) > 0 {
+				createTime, err := time.ParseInLocation(flomoTimeLayout, strings.TrimSpace(textContent(times[0])), time.Local)
+				if err != nil {
+					result.Warnings = append(result.Warnings, fmt.Sprintf("%s: invalid time %q", file.Path, textContent(times[0])))
+				}
+				note.CreateTime, note.UpdateTime = createTime, createTime
+			}
+			if contents := findElements(memo, "div", "content"); len(contents) > 0 {
+				note.Content = renderChildren(contents[0])
+			}
+			for _, filesElement := range findElements(memo, "div", "files") {
+				for _, source := range flomoFileSources(filesElement) {
+					if unescaped, err := url.PathUnescape(source); err == nil {
+						source = unescaped
+					}
+					attached, ok := filesByPath[path.Join(path.Dir(file.Path), source)]
+					if !ok {
+						result.Warnings = append(result.Warnings, fmt.Sprintf("%s: file %s not found", file.Path, source))
+						continue
+					}
+					name := path.Base(source)
+					note.Attachments = append(note.Attachments, &Attachment{
+						Filename: name,
+						Type:     attachmentType(name, attached.Data),
+						Data:     attached.Data,
+					})
+				}
+			}
+			if note.Content == "" && len(note.Attachments) == 0 {
+				continue
+			}
+			result.Notes = append(result.Notes, note)
+		}
+	}
+	return result, nil
+}
+
+// flomoFileSources returns the paths of the images and other files of a memo.
+func flomoFileSources(node *html.Node) []string {
+	sources := []string{}
+	for _, image := range findElements(node, "img", "") {
+		if source := attribute(image, "src"); source != "" {
+			sources = append(sources, source)
+		}
+	}
+	for _, audio := range findElements(node, "audio", "") {
+		if source := attribute(audio, "src"); source != "" {
+			sources = append(sources, source)
+		}
+	}
+	for _, link := range findElements(node, "a", "") {
+		if href := attribute(link, "href"); href != "" && !strings.Contains(href, "://") {
+			sources = append(sources, href)
+		}
+	}
+	return sources
+}
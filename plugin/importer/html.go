@@ -0,0 +1,284 @@
+package importer
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// listIndent is the indentation of the items of nested lists.
+const listIndent = "  "
+
+var (
+	whitespacePattern = regexp.MustCompile(`[\s\x{00a0}]+`)
+	blankLinesPattern = regexp.MustCompile(`\n{3,}`)
+	// listMarkerPattern matches a line with only the marker of a list item.
+	listMarkerPattern = regexp.MustCompile(`^ *(- |\d+\. )(\[[ x]\] )?$`)
+)
+
+// htmlToMarkdown converts the HTML content of a note to Markdown.
+// It keeps what memos renders: paragraphs, headings, emphasis, links, lists, checkboxes, quotes and code.
+// Images and other media are left out, as they are imported as attachments.
+func htmlToMarkdown(source string) (string, error) {
+	nodes, err := html.ParseFragment(strings.NewReader(source), &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse html")
+	}
+	w := &markdownWriter{}
+	for _, node := range nodes {
+		w.render(node)
+	}
+	return w.markdown(), nil
+}
+
+type markdownWriter struct {
+	builder strings.Builder
+	// lists are the lists the writer is in, with the number of their last item, or -1 for unordered lists.
+	lists []int
+}
+
+func (w *markdownWriter) markdown() string {
+	lines := strings.Split(w.builder.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return strings.Trim(blankLinesPattern.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"), "\n")
+}
+
+func (w *markdownWriter) atLineStart() bool {
+	content := w.builder.String()
+	return content == "" || strings.HasSuffix(content, "\n")
+}
+
+func (w *markdownWriter) atListMarker() bool {
+	content := w.builder.String()
+	return listMarkerPattern.MatchString(content[strings.LastIndex(content, "\n")+1:])
+}
+
+// block separates a block from the content around it.
+// The blocks of a list item, such as its paragraphs, stay on the line of the item.
+func (w *markdownWriter) block() {
+	if w.atListMarker() {
+		return
+	}
+	if !w.atLineStart() {
+		w.builder.WriteString("\n")
+	}
+	if len(w.lists) == 0 {
+		w.builder.WriteString("\n")
+	}
+}
+
+func (w *markdownWriter) children(node *html.Node) {
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		w.render(child)
+	}
+}
+
+// wrap renders the children of the node between the marker, as for emphasis.
+func (w *markdownWriter) wrap(node *html.Node, marker string) {
+	inner := &markdownWriter{lists: w.lists}
+	inner.children(node)
+	text := strings.TrimSpace(inner.builder.String())
+	if text == "" {
+		return
+	}
+	w.text(marker + text + marker)
+}
+
+func (w *markdownWriter) text(text string) {
+	if w.atLineStart() || w.atListMarker() {
+		text = strings.TrimLeft(text, " ")
+	}
+	w.builder.WriteString(text)
+}
+
+func (w *markdownWriter) render(node *html.Node) {
+	switch node.Type {
+	case html.TextNode:
+		w.text(whitespacePattern.ReplaceAllString(node.Data, " "))
+		return
+	case html.ElementNode:
+	default:
+		w.children(node)
+		return
+	}
+
+	switch node.Data {
+	case "script", "style", "head", "title", "img", "audio", "video", "object":
+	case "br":
+		w.builder.WriteString("\n")
+	case "hr":
+		w.block()
+		w.builder.WriteString("---")
+		w.block()
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		level, _ := strconv.Atoi(node.Data[1:])
+		w.block()
+		w.builder.WriteString(strings.Repeat("#", level) + " ")
+		w.children(node)
+		w.block()
+	case "strong", "b":
+		w.wrap(node, "**")
+	case "em", "i":
+		w.wrap(node, "*")
+	case "s", "del", "strike":
+		w.wrap(node, "~~")
+	case "code":
+		w.wrap(node, "`")
+	case "pre":
+		w.block()
+		w.builder.WriteString("```\n" + strings.Trim(textContent(node), "\n") + "\n```")
+		w.block()
+	case "a":
+		inner := &markdownWriter{lists: w.lists}
+		inner.children(node)
+		text := strings.TrimSpace(inner.builder.String())
+		href := attribute(node, "href")
+		switch {
+		case href == "" || strings.HasPrefix(href, "evernote:"):
+			w.text(text)
+		case text == "" || text == href:
+			w.text(href)
+		default:
+			w.text("[" + text + "](" + href + ")")
+		}
+	case "ul", "ol":
+		index := -1
+		if node.Data == "ol" {
+			index = 0
+		}
+		if len(w.lists) == 0 {
+			w.block()
+		} else if !w.atLineStart() {
+			w.builder.WriteString("\n")
+		}
+		w.lists = append(w.lists, index)
+		w.children(node)
+		w.lists = w.lists[:len(w.lists)-1]
+		w.block()
+	case "li":
+		if !w.atLineStart() {
+			w.builder.WriteString("\n")
+		}
+		marker := "- "
+		if depth := len(w.lists); depth > 0 {
+			w.builder.WriteString(strings.Repeat(listIndent, depth-1))
+			if w.lists[depth-1] >= 0 {
+				w.lists[depth-1]++
+				marker = strconv.Itoa(w.lists[depth-1]) + ". "
+			}
+		}
+		w.builder.WriteString(marker)
+		w.children(node)
+	case "en-todo":
+		// The self-closing tags of ENML are parsed as start tags, so the text after a checkbox is within it.
+		w.checkbox(attribute(node, "checked") == "true")
+		w.children(node)
+	case "en-media":
+		w.children(node)
+	case "input":
+		if attribute(node, "type") == "checkbox" {
+			_, checked := attributeValue(node, "checked")
+			w.checkbox(checked)
+		}
+	case "blockquote":
+		inner := &markdownWriter{}
+		inner.children(node)
+		w.block()
+		for i, line := range strings.Split(inner.markdown(), "\n") {
+			if i > 0 {
+				w.builder.WriteString("\n")
+			}
+			w.builder.WriteString(strings.TrimRight("> "+line, " "))
+		}
+		w.block()
+	case "p", "div", "section", "article", "en-note", "table", "tr", "header", "footer":
+		w.block()
+		w.children(node)
+		w.block()
+	case "td", "th":
+		if !w.atLineStart() {
+			w.builder.WriteString(" ")
+		}
+		w.children(node)
+	default:
+		w.children(node)
+	}
+}
+
+// checkbox writes a task list item, or only its box within a list item.
+func (w *markdownWriter) checkbox(checked bool) {
+	box := "[ ] "
+	if checked {
+		box = "[x] "
+	}
+	if w.atLineStart() {
+		box = "- " + box
+	} else if !w.atListMarker() {
+		box = " " + box
+	}
+	w.builder.WriteString(box)
+}
+
+func attribute(node *html.Node, key string) string {
+	value, _ := attributeValue(node, key)
+	return value
+}
+
+func attributeValue(node *html.Node, key string) (string, bool) {
+	for _, attr := range node.Attr {
+		if attr.Key == key {
+			return attr.Val, true
+		}
+	}
+	return "", false
+}
+
+func textContent(node *html.Node) string {
+	if node.Type == html.TextNode {
+		return node.Data
+	}
+	var builder strings.Builder
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		builder.WriteString(textContent(child))
+	}
+	return builder.String()
+}
+
+// findElements returns the elements under the node with the tag and class, or any class when class is empty.
+func findElements(node *html.Node, tag, class string) []*html.Node {
+	elements := []*html.Node{}
+	var visit func(*html.Node)
+	visit = func(node *html.Node) {
+		if node.Type == html.ElementNode && node.Data == tag && (class == "" || hasClass(node, class)) {
+			elements = append(elements, node)
+			return
+		}
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			visit(child)
+		}
+	}
+	visit(node)
+	return elements
+}
+
+func hasClass(node *html.Node, class string) bool {
+	for _, name := range strings.Fields(attribute(node, "class")) {
+		if name == class {
+			return true
+		}
+	}
+	return false
+}
+
+// renderChildren renders the children of the node as Markdown.
+func renderChildren(node *html.Node) string {
+	w := &markdownWriter{}
+	w.children(node)
+	return w.markdown()
+}
@@ -0,0 +1,242 @@
+// Package importer parses the exports of other note tools into notes that can be imported as memos.
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/markdown/parser"
+)
+
+// Format is the note tool an export comes from.
+type Format string
+
+const (
+	// FormatObsidian is an Obsidian vault, with a Markdown file per note.
+	FormatObsidian Format = "obsidian"
+	// FormatFlomo is the HTML export of flomo.
+	FormatFlomo Format = "flomo"
+	// FormatGoogleKeep is the Google Keep export of Google Takeout, with a JSON file per note.
+	FormatGoogleKeep Format = "keep"
+	// FormatEvernote is an Evernote ENEX export.
+	FormatEvernote Format = "evernote"
+)
+
+// Formats are the supported formats.
+var Formats = []Format{FormatObsidian, FormatFlomo, FormatGoogleKeep, FormatEvernote}
+
+// MaxExportSize is the maximum total size in bytes of the files of an export.
+const MaxExportSize int64 = 1 << 30
+
+// File is a file of an export.
+type File struct {
+	// Path is the slash separated path of the file in the export.
+	Path    string
+	ModTime time.Time
+	Data    []byte
+}
+
+// Note is a note of an export, with its content converted to the Markdown of memos.
+type Note struct {
+	// Title identifies the note in the links of the other notes, when the format has links.
+	Title string
+	// Content is the Markdown content, with the tags of the note as #tag.
+	Content     string
+	CreateTime  time.Time
+	UpdateTime  time.Time
+	Pinned      bool
+	Archived    bool
+	Attachments []*Attachment
+	// Links are the titles of the notes the note links to.
+	Links []string
+}
+
+// Attachment is a file attached to a note.
+type Attachment struct {
+	Filename string
+	Type     string
+	Data     []byte
+}
+
+// Result is the notes parsed from an export, with the problems found on the way.
+type Result struct {
+	Notes    []*Note
+	Warnings []string
+}
+
+type parseFunc func(files []*File) (*Result, error)
+
+var parsers = map[Format]parseFunc{
+	FormatObsidian:   parseObsidian,
+	FormatFlomo:      parseFlomo,
+	FormatGoogleKeep: parseGoogleKeep,
+	FormatEvernote:   parseEvernote,
+}
+
+// Parse parses the files of an export of the format.
+func Parse(format Format, files []*File) (*Result, error) {
+	parse, ok := parsers[format]
+	if !ok {
+		return nil, errors.Errorf("unsupported format %q", format)
+	}
+	return parse(files)
+}
+
+// ReadZip reads the files of a zip archive, failing when their total size exceeds maxSize bytes.
+func ReadZip(data []byte, maxSize int64) ([]*File, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid zip archive")
+	}
+	files := []*File{}
+	for _, file := range archive.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		if int64(file.UncompressedSize64) > maxSize {
+			return nil, errors.New("archive too large")
+		}
+		reader, err := file.Open()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to open %s", file.Name)
+		}
+		fileData, err := io.ReadAll(io.LimitReader(reader, maxSize+1))
+		reader.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s", file.Name)
+		}
+		maxSize -= int64(len(fileData))
+		if maxSize < 0 {
+			return nil, errors.New("archive too large")
+		}
+		files = append(files, &File{Path: file.Name, ModTime: file.Modified, Data: fileData})
+	}
+	return files, nil
+}
+
+// ReadDir reads the files of a directory and its subdirectories.
+func ReadDir(dir string) ([]*File, error) {
+	files := []*File{}
+	err := fs.WalkDir(os.DirFS(dir), ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			return err
+		}
+		files = append(files, &File{Path: name, ModTime: info.ModTime(), Data: data})
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read directory")
+	}
+	return files, nil
+}
+
+// FormatTag returns the tag as a #tag of memos, replacing the characters not allowed in tags.
+// It returns an empty string when nothing is left of the tag.
+func FormatTag(tag string) string {
+	tag = strings.TrimPrefix(strings.TrimSpace(tag), "#")
+	var builder strings.Builder
+	for _, r := range tag {
+		switch {
+		case parser.IsValidTagRune(r):
+			builder.WriteRune(r)
+		case unicode.IsSpace(r) || r == '.':
+			builder.WriteRune('-')
+		}
+	}
+	tag = strings.Trim(builder.String(), "-/")
+	if tag == "" {
+		return ""
+	}
+	return "#" + tag
+}
+
+// appendTags appends the tags missing from the content on a last line.
+func appendTags(content string, tags []string) string {
+	missing := []string{}
+	for _, tag := range tags {
+		tag = FormatTag(tag)
+		if tag == "" || slices.Contains(missing, tag) || containsTag(content, tag) {
+			continue
+		}
+		missing = append(missing, tag)
+	}
+	if len(missing) == 0 {
+		return content
+	}
+	content = strings.TrimRight(content, "\n")
+	if content != "" {
+		content += "\n\n"
+	}
+	return content + strings.Join(missing, " ")
+}
+
+func containsTag(content, tag string) bool {
+	for offset := 0; ; {
+		index := strings.Index(content[offset:], tag)
+		if index < 0 {
+			return false
+		}
+		end := offset + index + len(tag)
+		if next, _ := utf8.DecodeRuneInString(content[end:]); end == len(content) || !parser.IsValidTagRune(next) {
+			return true
+		}
+		offset = end
+	}
+}
+
+// prependTitle starts the content with the title as a heading, unless it already does.
+func prependTitle(content, title string) string {
+	title = strings.TrimSpace(title)
+	if title == "" || strings.HasPrefix(content, "# "+title) {
+		return content
+	}
+	if strings.TrimSpace(content) == "" {
+		return "# " + title
+	}
+	return "# " + title + "\n\n" + content
+}
+
+// attachmentType returns the MIME type of an attached file, from its extension or else its content.
+func attachmentType(filename string, data []byte) string {
+	if mimeType := mime.TypeByExtension(path.Ext(filename)); mimeType != "" {
+		if mediaType, _, err := mime.ParseMediaType(mimeType); err == nil {
+			return mediaType
+		}
+	}
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(data))
+	if err != nil {
+		return "application/octet-stream"
+	}
+	return mediaType
+}
+
+// indexFiles returns the files by path.
+func indexFiles(files []*File) map[string]*File {
+	index := make(map[string]*File, len(files))
+	for _, file := range files {
+		index[file.Path] = file
+	}
+	return index
+}
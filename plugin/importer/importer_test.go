@@ -0,0 +1,140 @@
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func parseTestdata(t *testing.T, format Format, dir string) *Result {
+	files, err := ReadDir("testdata/" + dir)
+	require.NoError(t, err)
+	result, err := Parse(format, files)
+	require.NoError(t, err)
+	return result
+}
+
+func TestParseObsidian(t *testing.T) {
+	result := parseTestdata(t, FormatObsidian, "obsidian")
+	require.Equal(t, []string{"Ideas.md: embedded file missing.png not found"}, result.Warnings)
+	require.Len(t, result.Notes, 3)
+
+	ideas := result.Notes[0]
+	require.Equal(t, "Ideas", ideas.Title)
+	require.Equal(t, "# Ideas\n\nWrite about Lisbon and Missing note.", ideas.Content)
+	require.Equal(t, []string{"Lisbon", "Missing note"}, ideas.Links)
+
+	lisbon := result.Notes[2]
+	require.Equal(t, "Lisbon", lisbon.Title)
+	require.Equal(t, "# Lisbon\n\nFlights are booked, see the packing list and Budget.\n\nBack to Flights.\n\n#travel #trip-plan", lisbon.Content)
+	require.Equal(t, []string{"Packing", "Budget"}, lisbon.Links)
+	require.Equal(t, time.Date(2024, 3, 1, 9, 30, 0, 0, time.Local), lisbon.CreateTime)
+	require.Equal(t, time.Date(2024, 3, 4, 0, 0, 0, 0, time.Local), lisbon.UpdateTime)
+	require.Len(t, lisbon.Attachments, 1)
+	require.Equal(t, "itinerary.txt", lisbon.Attachments[0].Filename)
+	require.Equal(t, "text/plain", lisbon.Attachments[0].Type)
+
+	// Tags already in the content are not repeated.
+	require.Equal(t, "# Packing\n\n- [x] Passport\n- [ ] Charger #travel", result.Notes[1].Content)
+}
+
+func TestParseFlomo(t *testing.T) {
+	result := parseTestdata(t, FormatFlomo, "flomo")
+	require.Empty(t, result.Warnings)
+	require.Len(t, result.Notes, 2)
+
+	run := result.Notes[0]
+	require.Equal(t, "Morning run, **5 km** #health/running\n\n- Stretch\n- Hydrate", run.Content)
+	require.Equal(t, time.Date(2024, 3, 2, 8, 15, 0, 0, time.Local), run.CreateTime)
+	require.Len(t, run.Attachments, 1)
+	require.Equal(t, "route.png", run.Attachments[0].Filename)
+	require.Equal(t, "image/png", run.Attachments[0].Type)
+	require.Equal(t, []byte("fake png data"), run.Attachments[0].Data)
+
+	require.Equal(t, "Read [an essay](https://example.com/essay) on habits #reading\n\n1. Start small\n2. Stack habits", result.Notes[1].Content)
+}
+
+func TestParseGoogleKeep(t *testing.T) {
+	result := parseTestdata(t, FormatGoogleKeep, "keep")
+	require.Equal(t, []string{"Takeout/Keep/Old.json: trashed note skipped"}, result.Warnings)
+	require.Len(t, result.Notes, 2)
+
+	groceries := result.Notes[0]
+	require.Equal(t, "# Groceries\n\n- [x] Milk\n- [ ] Bread\n\n#Home #Weekly-shopping", groceries.Content)
+	require.True(t, groceries.Pinned)
+	require.False(t, groceries.Archived)
+	require.Equal(t, time.UnixMicro(1709280000000000), groceries.CreateTime)
+	require.Equal(t, time.UnixMicro(1709370000000000), groceries.UpdateTime)
+
+	whiteboard := result.Notes[1]
+	require.Equal(t, "Sketch from the planning meeting", whiteboard.Content)
+	require.True(t, whiteboard.Archived)
+	require.Len(t, whiteboard.Attachments, 1)
+	require.Equal(t, "whiteboard.jpeg", whiteboard.Attachments[0].Filename)
+	require.Equal(t, "image/jpeg", whiteboard.Attachments[0].Type)
+}
+
+func TestParseEvernote(t *testing.T) {
+	result := parseTestdata(t, FormatEvernote, "evernote")
+	require.Empty(t, result.Warnings)
+	require.Len(t, result.Notes, 2)
+
+	office := result.Notes[0]
+	require.Equal(t, "Home office", office.Title)
+	require.Equal(t, "# Home office\n\nBuy a **standing desk** before April.\n\n- [x] Measure the room\n\n- [ ] Compare prices\n\n#work #home-office", office.Content)
+	require.Equal(t, time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC), office.CreateTime)
+	require.Equal(t, time.Date(2024, 3, 2, 8, 30, 0, 0, time.UTC), office.UpdateTime)
+	require.Len(t, office.Attachments, 1)
+	require.Equal(t, "receipt.txt", office.Attachments[0].Filename)
+	require.Equal(t, []byte("Receipt #42"), office.Attachments[0].Data)
+
+	quotes := result.Notes[1]
+	require.Equal(t, "# Quotes\n\n> Simplicity is prerequisite for reliability.", quotes.Content)
+	require.Equal(t, quotes.CreateTime, quotes.UpdateTime)
+}
+
+func TestParseUnsupportedFormat(t *testing.T) {
+	_, err := Parse("notion", nil)
+	require.Error(t, err)
+}
+
+func TestReadZip(t *testing.T) {
+	var buffer bytes.Buffer
+	archive := zip.NewWriter(&buffer)
+	for name, content := range map[string]string{"vault/Note.md": "Hello", "vault/Other.md": "World"} {
+		file, err := archive.Create(name)
+		require.NoError(t, err)
+		_, err = file.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, archive.Close())
+
+	files, err := ReadZip(buffer.Bytes(), 10)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	_, err = ReadZip(buffer.Bytes(), 9)
+	require.Error(t, err)
+	_, err = ReadZip([]byte("not a zip"), 10)
+	require.Error(t, err)
+}
+
+func TestFormatTag(t *testing.T) {
+	tests := map[string]string{
+		"travel":          "#travel",
+		"#travel":         "#travel",
+		"trip plan":       "#trip-plan",
+		"work/projects":   "#work/projects",
+		"v1.2":            "#v1-2",
+		"  ":              "",
+		"what?":           "#what",
+		"旅行":              "#旅行",
+		"science & tech":  "#science-&-tech",
+		"/leading/slash/": "#leading/slash",
+	}
+	for tag, want := range tests {
+		require.Equal(t, want, FormatTag(tag), tag)
+	}
+}
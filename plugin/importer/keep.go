@@ -0,0 +1,115 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// keepNote is a note of the Google Keep export of Google Takeout.
+type keepNote struct {
+	Title                   string `json:"title"`
+	TextContent             string `json:"textContent"`
+	IsPinned                bool   `json:"isPinned"`
+	IsArchived              bool   `json:"isArchived"`
+	IsTrashed               bool   `json:"isTrashed"`
+	CreatedTimestampUsec    int64  `json:"createdTimestampUsec"`
+	UserEditedTimestampUsec int64  `json:"userEditedTimestampUsec"`
+	ListContent             []struct {
+		Text      string `json:"text"`
+		IsChecked bool   `json:"isChecked"`
+	} `json:"listContent"`
+	Labels []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+	Attachments []struct {
+		FilePath string `json:"filePath"`
+		Mimetype string `json:"mimetype"`
+	} `json:"attachments"`
+}
+
+// parseGoogleKeep parses the Google Keep export: each note is a JSON file, with its attachments next to it.
+// The labels become tags, the checklists task lists, and the trashed notes are left out.
+func parseGoogleKeep(files []*File) (*Result, error) {
+	result := &Result{}
+	filesByPath := indexFiles(files)
+	noteFiles := []*File{}
+	for _, file := range files {
+		if path.Ext(file.Path) == ".json" && !isHiddenPath(file.Path) {
+			noteFiles = append(noteFiles, file)
+		}
+	}
+	sort.Slice(noteFiles, func(i, j int) bool { return noteFiles[i].Path < noteFiles[j].Path })
+	for _, file := range noteFiles {
+		keep := &keepNote{}
+		if err := json.Unmarshal(file.Data, keep); err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("%s: invalid note: %v", file.Path, err))
+			continue
+		}
+		// Takeout has other JSON files, which are not notes.
+		if keep.CreatedTimestampUsec == 0 && keep.UserEditedTimestampUsec == 0 {
+			continue
+		}
+		if keep.IsTrashed {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("%s: trashed note skipped", file.Path))
+			continue
+		}
+
+		lines := []string{}
+		if text := strings.TrimSpace(keep.TextContent); text != "" {
+			lines = append(lines, text)
+		}
+		if len(keep.ListContent) > 0 {
+			items := []string{}
+			for _, item := range keep.ListContent {
+				box := "[ ]"
+				if item.IsChecked {
+					box = "[x]"
+				}
+				items = append(items, fmt.Sprintf("- %s %s", box, strings.TrimSpace(item.Text)))
+			}
+			lines = append(lines, strings.Join(items, "\n"))
+		}
+		tags := []string{}
+		for _, label := range keep.Labels {
+			tags = append(tags, label.Name)
+		}
+		note := &Note{
+			Title:      keep.Title,
+			Content:    appendTags(prependTitle(strings.Join(lines, "\n\n"), keep.Title), tags),
+			CreateTime: time.UnixMicro(keep.CreatedTimestampUsec),
+			UpdateTime: time.UnixMicro(keep.UserEditedTimestampUsec),
+			Pinned:     keep.IsPinned,
+			Archived:   keep.IsArchived,
+		}
+		if keep.CreatedTimestampUsec == 0 {
+			note.CreateTime = note.UpdateTime
+		}
+		if keep.UserEditedTimestampUsec == 0 {
+			note.UpdateTime = note.CreateTime
+		}
+		for _, attachment := range keep.Attachments {
+			attachedPath := path.Join(path.Dir(file.Path), attachment.FilePath)
+			attached, ok := filesByPath[attachedPath]
+			if !ok && path.Ext(attachedPath) == ".jpeg" {
+				// Takeout lists some images as .jpeg while their files are .jpg.
+				attached, ok = filesByPath[strings.TrimSuffix(attachedPath, ".jpeg")+".jpg"]
+			}
+			if !ok {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("%s: attachment %s not found", file.Path, attachment.FilePath))
+				continue
+			}
+			name := path.Base(attachment.FilePath)
+			mimeType := attachment.Mimetype
+			if mimeType == "" {
+				mimeType = attachmentType(name, attached.Data)
+			}
+			note.Attachments = append(note.Attachments, &Attachment{Filename: name, Type: mimeType, Data: attached.Data})
+		}
+		result.Notes = append(result.Notes, note)
+	}
+	return result, nil
+}
@@ -0,0 +1,148 @@
+package importer
+
+import (
+	"cmp"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// wikiLinkPattern matches the [[target#heading|alias]] links of Obsidian, with a leading ! for embeds.
+var wikiLinkPattern = regexp.MustCompile(`(!?)\[\[([^\[\]|#]*)(#[^\[\]|]*)?(\|[^\[\]]*)?\]\]`)
+
+// obsidianTimeLayouts are the layouts of the dates in the front matter of Obsidian notes.
+var obsidianTimeLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"}
+
+type obsidianFrontMatter struct {
+	Tags    any    `yaml:"tags"`
+	Created string `yaml:"created"`
+	Date    string `yaml:"date"`
+	Updated string `yaml:"updated"`
+}
+
+// parseObsidian parses a vault: the Markdown files are the notes, titled after their filename, and the other files
+// are attached to the notes embedding them.
+func parseObsidian(files []*File) (*Result, error) {
+	result := &Result{}
+	// The embedded files are found by name, as Obsidian links to them without their folder.
+	filesByName := map[string]*File{}
+	notes := []*File{}
+	for _, file := range files {
+		if isHiddenPath(file.Path) {
+			continue
+		}
+		if path.Ext(file.Path) == ".md" {
+			notes = append(notes, file)
+			continue
+		}
+		filesByName[path.Base(file.Path)] = file
+	}
+	sort.Slice(notes, func(i, j int) bool { return notes[i].Path < notes[j].Path })
+
+	for _, file := range notes {
+		title := strings.TrimSuffix(path.Base(file.Path), ".md")
+		content, frontMatter, err := splitFrontMatter(string(file.Data))
+		if err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("%s: invalid front matter: %v", file.Path, err))
+		}
+		note := &Note{
+			Title:      title,
+			CreateTime: parseObsidianTime(frontMatter.Created, parseObsidianTime(frontMatter.Date, file.ModTime)),
+			UpdateTime: parseObsidianTime(frontMatter.Updated, file.ModTime),
+		}
+
+		content = wikiLinkPattern.ReplaceAllStringFunc(content, func(link string) string {
+			match := wikiLinkPattern.FindStringSubmatch(link)
+			embed, target, alias := match[1] == "!", strings.TrimSpace(match[2]), strings.TrimPrefix(match[4], "|")
+			if target == "" {
+				// A link to a heading of the note itself.
+				return cmp.Or(alias, strings.TrimPrefix(match[3], "#"))
+			}
+			name := path.Base(target)
+			if ext := path.Ext(name); embed && ext != "" && ext != ".md" {
+				attached, ok := filesByName[name]
+				if !ok {
+					result.Warnings = append(result.Warnings, fmt.Sprintf("%s: embedded file %s not found", file.Path, name))
+					return ""
+				}
+				note.Attachments = append(note.Attachments, &Attachment{
+					Filename: name,
+					Type:     attachmentType(name, attached.Data),
+					Data:     attached.Data,
+				})
+				return ""
+			}
+			linkedTitle := strings.TrimSuffix(name, ".md")
+			note.Links = append(note.Links, linkedTitle)
+			if alias != "" {
+				return alias
+			}
+			return linkedTitle
+		})
+		// The embeds taken out of the content leave blank lines behind.
+		content = strings.TrimSpace(blankLinesPattern.ReplaceAllString(content, "\n\n"))
+		note.Content = appendTags(prependTitle(content, title), obsidianTags(frontMatter.Tags))
+		result.Notes = append(result.Notes, note)
+	}
+	return result, nil
+}
+
+// splitFrontMatter returns the content without its YAML front matter, and the front matter.
+func splitFrontMatter(text string) (string, obsidianFrontMatter, error) {
+	frontMatter := obsidianFrontMatter{}
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	if !strings.HasPrefix(text, "---\n") {
+		return text, frontMatter, nil
+	}
+	header, content, ok := strings.Cut(text[len("---\n"):], "\n---")
+	if !ok {
+		return text, frontMatter, nil
+	}
+	content = strings.TrimPrefix(content, "\n")
+	if err := yaml.Unmarshal([]byte(header), &frontMatter); err != nil {
+		return content, obsidianFrontMatter{}, err
+	}
+	return content, frontMatter, nil
+}
+
+// obsidianTags returns the tags of the front matter, which are either a list or a comma or space separated string.
+func obsidianTags(value any) []string {
+	switch value := value.(type) {
+	case string:
+		return strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' })
+	case []any:
+		tags := []string{}
+		for _, tag := range value {
+			if tag, ok := tag.(string); ok {
+				tags = append(tags, tag)
+			}
+		}
+		return tags
+	default:
+		return nil
+	}
+}
+
+func parseObsidianTime(value string, fallback time.Time) time.Time {
+	for _, layout := range obsidianTimeLayouts {
+		if parsed, err := time.ParseInLocation(layout, strings.TrimSpace(value), time.Local); err == nil {
+			return parsed
+		}
+	}
+	return fallback
+}
+
+// isHiddenPath reports whether the path is in a hidden folder, such as the settings and trash of the vault.
+func isHiddenPath(filePath string) bool {
+	for _, part := range strings.Split(filePath, "/") {
+		if strings.HasPrefix(part, ".") || part == "__MACOSX" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+package extensions
+
+import (
+	"github.com/yuin/goldmark"
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+	mparser "github.com/usememos/memos/plugin/markdown/parser"
+)
+
+type mentionExtension struct{}
+
+// MentionExtension is a goldmark extension for @username syntax.
+var MentionExtension = &mentionExtension{}
+
+// Extend extends the goldmark parser with mention support.
+func (*mentionExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithInlineParsers(
+			// Priority 200 - run before standard link parser (500)
+			util.Prioritized(mparser.NewMentionParser(), 200),
+		),
+	)
+	m.Renderer().AddOptions(
+		renderer.WithNodeRenderers(
+			util.Prioritized(&mentionHTMLRenderer{}, 500),
+		),
+	)
+}
+
+// mentionHTMLRenderer renders mentions as their plain @username text.
+type mentionHTMLRenderer struct{}
+
+// RegisterFuncs registers the mention render function.
+func (*mentionHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(mast.KindMention, func(w util.BufWriter, _ []byte, n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if entering {
+			_ = w.WriteByte('@')
+			_, _ = w.Write(n.(*mast.MentionNode).Username)
+		}
+		return gast.WalkContinue, nil
+	})
+}
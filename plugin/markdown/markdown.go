@@ -2,8 +2,14 @@ package markdown
 
 import (
 	"bytes"
+	"regexp"
+	"slices"
 	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/pkg/errors"
 	"github.com/yuin/goldmark"
 	gast "github.com/yuin/goldmark/ast"
 	"github.com/yuin/goldmark/extension"
@@ -17,9 +23,33 @@ import (
 	storepb "github.com/usememos/memos/proto/gen/store"
 )
 
+// Chunk is a passage of markdown content, given as byte offsets into the content.
+type Chunk struct {
+	Start int
+	End   int
+}
+
+// Task is a checklist item of markdown content.
+type Task struct {
+	// Text is the plain text of the item without its due date.
+	Text    string
+	Checked bool
+	// Offset is the byte offset of the checkbox in the content.
+	Offset int
+	// Line is the 1-based line number of the checkbox.
+	Line int
+	// DueDate is the due date of the item in YYYY-MM-DD format, written as "📅 2026-11-01" or "due:2026-11-01".
+	DueDate string
+}
+
+// taskDueDatePattern matches the due date of a checklist item.
+var taskDueDatePattern = regexp.MustCompile(`(?:📅|\bdue:)\s*(\d{4}-\d{2}-\d{2})\b`)
+
 // ExtractedData contains all metadata extracted from markdown in a single pass.
 type ExtractedData struct {
 	Tags     []string
+	Mentions []string
+	Tasks    []Task
 	Property *storepb.MemoPayload_Property
 }
 
@@ -28,7 +58,7 @@ type ExtractedData struct {
 // HTML rendering is primarily done on frontend using markdown-it, but backend provides
 // RenderHTML for RSS feeds and other server-side rendering needs.
 type Service interface {
-	// ExtractAll extracts tags, properties, and references in a single parse (most efficient)
+	// ExtractAll extracts tags, mentions, properties, and references in a single parse (most efficient)
 	ExtractAll(content []byte) (*ExtractedData, error)
 
 	// ExtractTags returns all #tags found in content
@@ -46,11 +76,20 @@ type Service interface {
 	// GenerateSnippet creates plain text summary
 	GenerateSnippet(content []byte, maxLength int) (string, error)
 
+	// GenerateMatchSnippet creates plain text excerpt around the first matched term
+	GenerateMatchSnippet(content []byte, terms []string, maxLength int) (string, error)
+
+	// SplitChunks splits content into passages along its block structure
+	SplitChunks(content []byte, maxLength int) ([]Chunk, error)
+
 	// ValidateContent checks for syntax errors
 	ValidateContent(content []byte) error
 
 	// RenameTag renames all occurrences of oldTag to newTag in content
 	RenameTag(content []byte, oldTag, newTag string) (string, error)
+
+	// ToggleTask flips the checkbox of the index-th checklist item in content
+	ToggleTask(content []byte, index int) (string, error)
 }
 
 // service implements the Service interface.
@@ -62,7 +101,8 @@ type service struct {
 type Option func(*config)
 
 type config struct {
-	enableTags bool
+	enableTags     bool
+	enableMentions bool
 }
 
 // WithTagExtension enables #tag parsing.
@@ -72,6 +112,13 @@ func WithTagExtension() Option {
 	}
 }
 
+// WithMentionExtension enables @username parsing.
+func WithMentionExtension() Option {
+	return func(c *config) {
+		c.enableMentions = true
+	}
+}
+
 // NewService creates a new markdown service with the given options.
 func NewService(opts ...Option) Service {
 	cfg := &config{}
@@ -87,6 +134,9 @@ func NewService(opts ...Option) Service {
 	if cfg.enableTags {
 		exts = append(exts, extensions.TagExtension)
 	}
+	if cfg.enableMentions {
+		exts = append(exts, extensions.MentionExtension)
+	}
 
 	md := goldmark.New(
 		goldmark.WithExtensions(exts...),
@@ -202,6 +252,64 @@ func (s *service) RenderHTML(content []byte) (string, error) {
 
 // GenerateSnippet creates a plain text summary from markdown content.
 func (s *service) GenerateSnippet(content []byte, maxLength int) (string, error) {
+	// Stop collecting text at double the max length (we'll truncate precisely later)
+	snippet, err := s.plainText(content, maxLength*2)
+	if err != nil {
+		return "", err
+	}
+
+	// Truncate at word boundary if needed
+	if len(snippet) > maxLength {
+		snippet = truncateAtWord(snippet, maxLength)
+	}
+
+	return strings.TrimSpace(snippet), nil
+}
+
+// GenerateMatchSnippet creates a plain text excerpt from markdown content centered on
+// the first case-insensitive occurrence of any of the terms.
+// It falls back to GenerateSnippet when none of the terms appear in the text.
+func (s *service) GenerateMatchSnippet(content []byte, terms []string, maxLength int) (string, error) {
+	text, err := s.plainText(content, 0)
+	if err != nil {
+		return "", err
+	}
+
+	runes := []rune(text)
+	lowerRunes := []rune(strings.ToLower(text))
+	matchStart := -1
+	for _, term := range terms {
+		term = strings.ToLower(strings.TrimSpace(term))
+		if term == "" {
+			continue
+		}
+		if index := indexRunes(lowerRunes, []rune(term)); index >= 0 && (matchStart < 0 || index < matchStart) {
+			matchStart = index
+		}
+	}
+	// ToLower may change the rune count of some scripts; fall back rather than cut at a wrong offset.
+	if matchStart < 0 || len(lowerRunes) != len(runes) {
+		return s.GenerateSnippet(content, maxLength)
+	}
+
+	// Keep a third of the excerpt as leading context and start at a word boundary.
+	start := max(matchStart-maxLength/3, 0)
+	if start > 0 && !unicode.IsSpace(runes[start-1]) {
+		if space := indexSpace(runes, start, matchStart); space >= 0 {
+			start = space + 1
+		}
+	}
+	snippet := truncateAtWord(string(runes[start:]), maxLength)
+	snippet = strings.TrimSpace(snippet)
+	if start > 0 {
+		snippet = "... " + snippet
+	}
+	return snippet, nil
+}
+
+// plainText extracts the text of markdown content, skipping code.
+// Extraction stops once the text exceeds limit bytes; a limit of zero means no limit.
+func (s *service) plainText(content []byte, limit int) (string, error) {
 	root, err := s.parse(content)
 	if err != nil {
 		return "", err
@@ -244,6 +352,12 @@ func (s *service) GenerateSnippet(content []byte, maxLength int) (string, error)
 
 		lastNodeWasBlock = false
 
+		// Mentions are part of the sentence they are in
+		if mentionNode, ok := n.(*mast.MentionNode); ok {
+			buf.WriteByte('@')
+			buf.Write(mentionNode.Username)
+		}
+
 		// Only extract plain text nodes
 		if textNode, ok := n.(*gast.Text); ok {
 			segment := textNode.Segment
@@ -255,9 +369,8 @@ func (s *service) GenerateSnippet(content []byte, maxLength int) (string, error)
 			}
 		}
 
-		// Stop walking if we've exceeded double the max length
-		// (we'll truncate precisely later)
-		if buf.Len() > maxLength*2 {
+		// Stop walking once we've collected enough text
+		if limit > 0 && buf.Len() > limit {
 			return gast.WalkStop, nil
 		}
 
@@ -268,14 +381,63 @@ func (s *service) GenerateSnippet(content []byte, maxLength int) (string, error)
 		return "", err
 	}
 
-	snippet := buf.String()
+	return buf.String(), nil
+}
 
-	// Truncate at word boundary if needed
-	if len(snippet) > maxLength {
-		snippet = truncateAtWord(snippet, maxLength)
+// SplitChunks splits markdown content into passages of at most maxLength characters.
+// Passages follow the top-level block structure: a heading always starts a new passage,
+// and lists, quotes and code blocks are only split when they are longer than maxLength.
+func (s *service) SplitChunks(content []byte, maxLength int) ([]Chunk, error) {
+	root, err := s.parse(content)
+	if err != nil {
+		return nil, err
+	}
+	if maxLength <= 0 {
+		return appendChunk(nil, content, 0, len(content)), nil
 	}
 
-	return strings.TrimSpace(snippet), nil
+	// Each top-level block spans from its first line to the start of the next block,
+	// so markers such as list bullets and code fences stay with their block.
+	type block struct {
+		start   int
+		heading bool
+	}
+	var blocks []block
+	for child := root.FirstChild(); child != nil; child = child.NextSibling() {
+		start, ok := blockStart(child, content)
+		if !ok || (len(blocks) > 0 && start <= blocks[len(blocks)-1].start) {
+			continue
+		}
+		blocks = append(blocks, block{start: start, heading: child.Kind() == gast.KindHeading})
+	}
+	if len(blocks) == 0 {
+		return appendChunk(nil, content, 0, len(content)), nil
+	}
+	blocks[0].start = 0
+
+	var chunks []Chunk
+	chunkStart := -1
+	for i, b := range blocks {
+		end := len(content)
+		if i+1 < len(blocks) {
+			end = blocks[i+1].start
+		}
+		if chunkStart >= 0 && (b.heading || passageLength(content, chunkStart, end) > maxLength) {
+			chunks = appendChunk(chunks, content, chunkStart, b.start)
+			chunkStart = -1
+		}
+		if chunkStart < 0 {
+			chunkStart = b.start
+		}
+		if passageLength(content, chunkStart, end) > maxLength {
+			chunks = splitLongChunk(chunks, content, chunkStart, end, maxLength)
+			chunkStart = -1
+		}
+	}
+	if chunkStart >= 0 {
+		chunks = appendChunk(chunks, content, chunkStart, len(content))
+	}
+	return chunks, nil
 }
 
 // ValidateContent checks if the markdown content is valid.
@@ -285,7 +447,7 @@ func (s *service) ValidateContent(content []byte) error {
 	return err
 }
 
-// ExtractAll extracts tags, properties, and references in a single parse for efficiency.
+// ExtractAll extracts tags, mentions, properties, and references in a single parse for efficiency.
 func (s *service) ExtractAll(content []byte) (*ExtractedData, error) {
 	root, err := s.parse(content)
 	if err != nil {
@@ -294,6 +456,8 @@ func (s *service) ExtractAll(content []byte) (*ExtractedData, error) {
 
 	data := &ExtractedData{
 		Tags:     []string{},
+		Mentions: []string{},
+		Tasks:    []Task{},
 		Property: &storepb.MemoPayload_Property{},
 	}
 
@@ -308,6 +472,11 @@ func (s *service) ExtractAll(content []byte) (*ExtractedData, error) {
 			data.Tags = append(data.Tags, string(tagNode.Tag))
 		}
 
+		// Extract mentions
+		if mentionNode, ok := n.(*mast.MentionNode); ok {
+			data.Mentions = append(data.Mentions, string(mentionNode.Username))
+		}
+
 		// Extract properties based on node kind
 		switch n.Kind() {
 		case gast.KindLink:
@@ -322,6 +491,9 @@ func (s *service) ExtractAll(content []byte) (*ExtractedData, error) {
 				if !checkBox.IsChecked {
 					data.Property.HasIncompleteTasks = true
 				}
+				if task, ok := newTask(checkBox, content); ok {
+					data.Tasks = append(data.Tasks, task)
+				}
 			}
 		default:
 			// No special handling for other node types
@@ -336,6 +508,7 @@ func (s *service) ExtractAll(content []byte) (*ExtractedData, error) {
 
 	// Deduplicate tags while preserving original case
 	data.Tags = uniquePreserveCase(data.Tags)
+	data.Mentions = uniquePreserveCase(data.Mentions)
 
 	return data, nil
 }
@@ -372,6 +545,112 @@ func (s *service) RenameTag(content []byte, oldTag, newTag string) (string, erro
 	return mdRenderer.Render(root, content), nil
 }
 
+// ToggleTask flips the checkbox of the index-th checklist item in content.
+// Only the checkbox is rewritten, the rest of the content is kept as written.
+func (s *service) ToggleTask(content []byte, index int) (string, error) {
+	root, err := s.parse(content)
+	if err != nil {
+		return "", err
+	}
+
+	var checkBox *east.TaskCheckBox
+	var offset int
+	count := 0
+	err = gast.Walk(root, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		if node, ok := n.(*east.TaskCheckBox); ok {
+			task, ok := newTask(node, content)
+			if !ok {
+				return gast.WalkContinue, nil
+			}
+			if count == index {
+				checkBox, offset = node, task.Offset
+				return gast.WalkStop, nil
+			}
+			count++
+		}
+		return gast.WalkContinue, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if checkBox == nil {
+		return "", errors.Errorf("task %d not found", index)
+	}
+
+	// Render the flipped checkbox over the "[ ]" or "[x]" it was parsed from.
+	checkBox.IsChecked = !checkBox.IsChecked
+	mdRenderer := renderer.NewMarkdownRenderer()
+	marker := strings.TrimSpace(mdRenderer.Render(checkBox, content))
+	var buf bytes.Buffer
+	buf.Write(content[:offset])
+	buf.WriteString(marker)
+	buf.Write(content[offset+len("[ ]"):])
+	return buf.String(), nil
+}
+
+// newTask builds the checklist item of the checkbox.
+func newTask(checkBox *east.TaskCheckBox, content []byte) (Task, bool) {
+	lines := checkBox.Parent().Lines()
+	if lines == nil || lines.Len() == 0 {
+		return Task{}, false
+	}
+	offset := lines.At(0).Start
+	if offset+len("[ ]") > len(content) || content[offset] != '[' {
+		return Task{}, false
+	}
+
+	var buf strings.Builder
+	for n := checkBox.NextSibling(); n != nil; n = n.NextSibling() {
+		writeInlineText(&buf, n, content)
+	}
+	taskText := buf.String()
+	var dueDate string
+	if match := taskDueDatePattern.FindStringSubmatchIndex(taskText); match != nil {
+		if _, err := time.Parse("2006-01-02", taskText[match[2]:match[3]]); err == nil {
+			dueDate = taskText[match[2]:match[3]]
+			taskText = taskText[:match[0]] + taskText[match[1]:]
+		}
+	}
+
+	return Task{
+		Text:    strings.Join(strings.Fields(taskText), " "),
+		Checked: checkBox.IsChecked,
+		Offset:  offset,
+		Line:    bytes.Count(content[:offset], []byte("\n")) + 1,
+		DueDate: dueDate,
+	}, true
+}
+
+// writeInlineText writes the text of an inline node, including code, tags and mentions.
+func writeInlineText(buf *strings.Builder, node gast.Node, content []byte) {
+	_ = gast.Walk(node, func(n gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering {
+			return gast.WalkContinue, nil
+		}
+		switch n := n.(type) {
+		case *gast.Text:
+			buf.Write(n.Segment.Value(content))
+			if n.SoftLineBreak() || n.HardLineBreak() {
+				buf.WriteByte(' ')
+			}
+		case *mast.TagNode:
+			buf.WriteByte('#')
+			buf.Write(n.Tag)
+		case *mast.MentionNode:
+			buf.WriteByte('@')
+			buf.Write(n.Username)
+		case *gast.AutoLink:
+			buf.Write(n.URL(content))
+		default:
+			// Other nodes are written through their text children
+		}
+		return gast.WalkContinue, nil
+	})
+}
+
 // uniquePreserveCase returns unique strings from input while preserving case.
 func uniquePreserveCase(strs []string) []string {
 	seen := make(map[string]struct{})
@@ -407,3 +686,98 @@ func truncateAtWord(s string, maxLength int) string {
 
 	return truncated + " ..."
 }
+
+// indexRunes returns the index of the first occurrence of sub in s, or -1.
+func indexRunes(s, sub []rune) int {
+	for i := 0; i+len(sub) <= len(s); i++ {
+		if slices.Equal(s[i:i+len(sub)], sub) {
+			return i
+		}
+	}
+	return -1
+}
+
+// indexSpace returns the index of the first whitespace rune in runes[from:to], or -1.
+func indexSpace(runes []rune, from, to int) int {
+	for i := from; i < to; i++ {
+		if unicode.IsSpace(runes[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// blockStart returns the offset of the line a top-level block starts on.
+func blockStart(n gast.Node, content []byte) (int, bool) {
+	start := -1
+	_ = gast.Walk(n, func(child gast.Node, entering bool) (gast.WalkStatus, error) {
+		if !entering || child.Type() != gast.TypeBlock {
+			return gast.WalkContinue, nil
+		}
+		if lines := child.Lines(); lines != nil && lines.Len() > 0 {
+			start = lines.At(0).Start
+			return gast.WalkStop, nil
+		}
+		return gast.WalkContinue, nil
+	})
+	if start < 0 {
+		return 0, false
+	}
+	start = lineStart(content, start)
+	// The lines of a fenced code block exclude the opening fence.
+	if n.Kind() == gast.KindFencedCodeBlock && start > 0 {
+		start = lineStart(content, start-1)
+	}
+	return start, true
+}
+
+// lineStart returns the offset of the line containing pos.
+func lineStart(content []byte, pos int) int {
+	return bytes.LastIndexByte(content[:pos], '\n') + 1
+}
+
+// appendChunk appends content[start:end] without surrounding whitespace, skipping blank passages.
+func appendChunk(chunks []Chunk, content []byte, start, end int) []Chunk {
+	passage := content[start:end]
+	start += len(passage) - len(bytes.TrimLeftFunc(passage, unicode.IsSpace))
+	end -= len(passage) - len(bytes.TrimRightFunc(passage, unicode.IsSpace))
+	if start >= end {
+		return chunks
+	}
+	return append(chunks, Chunk{Start: start, End: end})
+}
+
+// passageLength returns the number of characters of content[start:end] without surrounding whitespace.
+func passageLength(content []byte, start, end int) int {
+	return utf8.RuneCount(bytes.TrimSpace(content[start:end]))
+}
+
+// splitLongChunk splits content[start:end] into passages of at most maxLength characters,
+// breaking between lines where possible.
+func splitLongChunk(chunks []Chunk, content []byte, start, end, maxLength int) []Chunk {
+	pieceStart := start
+	for pos := start; pos < end; {
+		lineEnd := bytes.IndexByte(content[pos:end], '\n')
+		if lineEnd < 0 {
+			lineEnd = end
+		} else {
+			lineEnd += pos + 1
+		}
+		if pos > pieceStart && utf8.RuneCount(content[pieceStart:lineEnd]) > maxLength {
+			chunks = appendChunk(chunks, content, pieceStart, pos)
+			pieceStart = pos
+		}
+		// A single line longer than maxLength is cut at character boundaries.
+		for utf8.RuneCount(content[pieceStart:lineEnd]) > maxLength {
+			cut := pieceStart
+			for i := 0; i < maxLength; i++ {
+				_, size := utf8.DecodeRune(content[cut:])
+				cut += size
+			}
+			chunks = appendChunk(chunks, content, pieceStart, cut)
+			pieceStart = cut
+		}
+		pos = lineEnd
+	}
+	return appendChunk(chunks, content, pieceStart, end)
+}
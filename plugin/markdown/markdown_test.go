@@ -1,6 +1,7 @@
 package markdown
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -105,6 +106,135 @@ func TestGenerateSnippet(t *testing.T) {
 	}
 }
 
+func TestGenerateMatchSnippet(t *testing.T) {
+	svc := NewService()
+
+	tests := []struct {
+		name      string
+		content   string
+		terms     []string
+		maxLength int
+		expected  string
+	}{
+		{
+			name:      "match at start",
+			content:   "Kubernetes upgrade notes for the staging cluster.",
+			terms:     []string{"kubernetes"},
+			maxLength: 100,
+			expected:  "Kubernetes upgrade notes for the staging cluster.",
+		},
+		{
+			name:      "match far from start",
+			content:   "This memo starts with a long introduction that has nothing to do with the search before it finally mentions pgvector and its index.",
+			terms:     []string{"PGVECTOR"},
+			maxLength: 30,
+			expected:  "... mentions pgvector and its ...",
+		},
+		{
+			name:      "earliest term wins",
+			content:   "Alpha beta gamma delta epsilon.",
+			terms:     []string{"delta", "beta"},
+			maxLength: 100,
+			expected:  "Alpha beta gamma delta epsilon.",
+		},
+		{
+			name:      "code is ignored",
+			content:   "Text before\n\n```go\nneedle\n```\n\nText after",
+			terms:     []string{"needle"},
+			maxLength: 100,
+			expected:  "Text before Text after",
+		},
+		{
+			name:      "multi-byte text",
+			content:   "今天学习了向量检索和关键词检索的融合方法",
+			terms:     []string{"融合"},
+			maxLength: 8,
+			expected:  "... 索的融合方法",
+		},
+		{
+			name:      "no match falls back to summary",
+			content:   "This is **bold** and *italic* text.",
+			terms:     []string{"missing"},
+			maxLength: 100,
+			expected:  "This is bold and italic text.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snippet, err := svc.GenerateMatchSnippet([]byte(tt.content), tt.terms, tt.maxLength)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, snippet)
+		})
+	}
+}
+
+func TestSplitChunks(t *testing.T) {
+	svc := NewService()
+
+	tests := []struct {
+		name      string
+		content   string
+		maxLength int
+		expected  []string
+	}{
+		{
+			name:      "short memo is a single chunk",
+			content:   "Hello world\n\nSecond paragraph",
+			maxLength: 100,
+			expected:  []string{"Hello world\n\nSecond paragraph"},
+		},
+		{
+			name:      "headings start new chunks",
+			content:   "Intro\n\n# First\n\nBody one\n\n## Second\n\nBody two\n",
+			maxLength: 100,
+			expected:  []string{"Intro", "# First\n\nBody one", "## Second\n\nBody two"},
+		},
+		{
+			name:      "blocks are grouped up to the max length",
+			content:   "Paragraph one.\n\nParagraph two.\n\nParagraph three.",
+			maxLength: 32,
+			expected:  []string{"Paragraph one.\n\nParagraph two.", "Paragraph three."},
+		},
+		{
+			name:      "code fence and list stay whole",
+			content:   "Setup notes\n\n```sh\nmake build\nmake test\n```\n\n- step one\n- step two",
+			maxLength: 30,
+			expected:  []string{"Setup notes", "```sh\nmake build\nmake test\n```", "- step one\n- step two"},
+		},
+		{
+			name:      "long block splits between lines",
+			content:   "- item number one\n- item number two\n- item number three",
+			maxLength: 40,
+			expected:  []string{"- item number one\n- item number two", "- item number three"},
+		},
+		{
+			name:      "long line splits at character boundaries",
+			content:   "一二三四五六七八九十",
+			maxLength: 4,
+			expected:  []string{"一二三四", "五六七八", "九十"},
+		},
+		{
+			name:      "blank content has no chunks",
+			content:   "  \n\n ",
+			maxLength: 100,
+			expected:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunks, err := svc.SplitChunks([]byte(tt.content), tt.maxLength)
+			require.NoError(t, err)
+			var texts []string
+			for _, chunk := range chunks {
+				texts = append(texts, tt.content[chunk.Start:chunk.End])
+			}
+			assert.Equal(t, tt.expected, texts)
+		})
+	}
+}
+
 func TestExtractProperties(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -315,6 +445,100 @@ func TestExtractTags(t *testing.T) {
 	}
 }
 
+func TestExtractMentions(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name:     "no mentions",
+			content:  "Just plain text",
+			expected: []string{},
+		},
+		{
+			name:     "multiple mentions",
+			content:  "Thanks @alice and @bob-2, cc @alice",
+			expected: []string{"alice", "bob-2"},
+		},
+		{
+			name:     "emails are not mentions",
+			content:  "Mail alice@example.com",
+			expected: []string{},
+		},
+		{
+			name:     "mentions in code are ignored",
+			content:  "`@alice` and\n\n```\n@bob\n```",
+			expected: []string{},
+		},
+		{
+			name:     "mention in list",
+			content:  "- [ ] @carol to review",
+			expected: []string{"carol"},
+		},
+	}
+
+	svc := NewService(WithTagExtension(), WithMentionExtension())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := svc.ExtractAll([]byte(tt.content))
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.expected, data.Mentions)
+		})
+	}
+
+	t.Run("mentions are kept in snippets and HTML", func(t *testing.T) {
+		snippet, err := svc.GenerateSnippet([]byte("Thanks @alice for the review"), 100)
+		require.NoError(t, err)
+		assert.Equal(t, "Thanks @alice for the review", snippet)
+
+		html, err := svc.RenderHTML([]byte("Thanks @alice"))
+		require.NoError(t, err)
+		assert.Contains(t, html, "Thanks @alice")
+
+		markdown, err := svc.RenderMarkdown([]byte("Thanks @alice"))
+		require.NoError(t, err)
+		assert.Equal(t, "Thanks @alice", markdown)
+	})
+}
+
+func TestExtractTasks(t *testing.T) {
+	svc := NewService(WithTagExtension(), WithMentionExtension())
+	content := "# Plan\n\n- [ ] Pay **rent** 📅 2026-11-01\n- [x] Call @alice #home\n  - [ ] Book `flight` due:2026-12-24\n\nNot a task [ ] here\n\n```\n- [ ] in code\n```"
+
+	data, err := svc.ExtractAll([]byte(content))
+	require.NoError(t, err)
+	require.Equal(t, []Task{
+		{Text: "Pay rent", Checked: false, Offset: 10, Line: 3, DueDate: "2026-11-01"},
+		{Text: "Call @alice #home", Checked: true, Offset: 45, Line: 4},
+		{Text: "Book flight", Checked: false, Offset: 71, Line: 5, DueDate: "2026-12-24"},
+	}, data.Tasks)
+	for _, task := range data.Tasks {
+		assert.Equal(t, "[", content[task.Offset:task.Offset+1])
+	}
+
+	t.Run("invalid due dates are kept in the text", func(t *testing.T) {
+		data, err := svc.ExtractAll([]byte("- [ ] Renew due:2026-13-45"))
+		require.NoError(t, err)
+		require.Len(t, data.Tasks, 1)
+		assert.Equal(t, "Renew due:2026-13-45", data.Tasks[0].Text)
+		assert.Empty(t, data.Tasks[0].DueDate)
+	})
+
+	t.Run("toggle rewrites only the checkbox", func(t *testing.T) {
+		toggled, err := svc.ToggleTask([]byte(content), 1)
+		require.NoError(t, err)
+		assert.Equal(t, strings.Replace(content, "- [x] Call", "- [ ] Call", 1), toggled)
+
+		toggled, err = svc.ToggleTask([]byte(content), 2)
+		require.NoError(t, err)
+		assert.Equal(t, strings.Replace(content, "  - [ ] Book", "  - [x] Book", 1), toggled)
+
+		_, err = svc.ToggleTask([]byte(content), 3)
+		require.Error(t, err)
+	})
+}
+
 func TestUniquePreserveCase(t *testing.T) {
 	tests := []struct {
 		name     string
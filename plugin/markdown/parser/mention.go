@@ -0,0 +1,80 @@
+package parser
+
+import (
+	"unicode"
+
+	gast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+const (
+	// MaxMentionLength defines the maximum length of a mentioned username.
+	MaxMentionLength = 32
+)
+
+type mentionParser struct{}
+
+// NewMentionParser creates a new inline parser for @username syntax.
+func NewMentionParser() parser.InlineParser {
+	return &mentionParser{}
+}
+
+// Trigger returns the characters that trigger this parser.
+func (*mentionParser) Trigger() []byte {
+	return []byte{'@'}
+}
+
+// isValidMentionByte checks if a byte is valid in a username.
+// Usernames are ASCII letters, digits and hyphens.
+func isValidMentionByte(b byte) bool {
+	return (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9') || b == '-'
+}
+
+// Parse parses @username syntax.
+// Mentions follow these rules:
+//   - Must not directly follow a letter, digit or one of "_.-@", so that emails are not mentions
+//   - Valid characters: ASCII letters, digits and hyphen (-), not starting or ending with a hyphen
+//   - Maximum length: 32 characters
+func (*mentionParser) Parse(_ gast.Node, block text.Reader, _ parser.Context) gast.Node {
+	line, _ := block.PeekLine()
+
+	// Must start with @
+	if len(line) < 2 || line[0] != '@' {
+		return nil
+	}
+
+	// An @ inside a word, such as in an email address, is not a mention
+	if r := block.PrecendingCharacter(); unicode.IsLetter(r) || unicode.IsNumber(r) || r == '_' || r == '.' || r == '-' || r == '@' {
+		return nil
+	}
+
+	pos := 1
+	for pos < len(line) && pos <= MaxMentionLength && isValidMentionByte(line[pos]) {
+		pos++
+	}
+	// A longer run of username characters is not a valid username
+	if pos < len(line) && isValidMentionByte(line[pos]) {
+		return nil
+	}
+	// Usernames do not end with a hyphen
+	for pos > 1 && line[pos-1] == '-' {
+		pos--
+	}
+	if pos <= 1 || line[1] == '-' {
+		return nil
+	}
+
+	// Make a copy of the username
+	username := make([]byte, pos-1)
+	copy(username, line[1:pos])
+
+	// Advance reader
+	block.Advance(pos)
+
+	return &mast.MentionNode{
+		Username: username,
+	}
+}
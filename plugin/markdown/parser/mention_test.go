@@ -0,0 +1,105 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+
+	mast "github.com/usememos/memos/plugin/markdown/ast"
+)
+
+func TestMentionParser(t *testing.T) {
+	tests := []struct {
+		name             string
+		prefix           string
+		input            string
+		expectedUsername string
+		shouldParse      bool
+	}{
+		{
+			name:             "basic mention",
+			input:            "@steven",
+			expectedUsername: "steven",
+			shouldParse:      true,
+		},
+		{
+			name:             "mention with hyphen and digits",
+			input:            "@team-2024",
+			expectedUsername: "team-2024",
+			shouldParse:      true,
+		},
+		{
+			name:             "mention followed by punctuation",
+			input:            "@steven, thanks",
+			expectedUsername: "steven",
+			shouldParse:      true,
+		},
+		{
+			name:             "trailing hyphen is not part of the username",
+			input:            "@steven- hi",
+			expectedUsername: "steven",
+			shouldParse:      true,
+		},
+		{
+			name:             "mention after a space",
+			prefix:           "hi ",
+			input:            "@steven",
+			expectedUsername: "steven",
+			shouldParse:      true,
+		},
+		{
+			name:             "mention in parentheses",
+			prefix:           "(",
+			input:            "@steven)",
+			expectedUsername: "steven",
+			shouldParse:      true,
+		},
+		{
+			name:        "lone @",
+			input:       "@",
+			shouldParse: false,
+		},
+		{
+			name:        "@ followed by space",
+			input:       "@ steven",
+			shouldParse: false,
+		},
+		{
+			name:        "leading hyphen",
+			input:       "@-steven",
+			shouldParse: false,
+		},
+		{
+			name:        "email address",
+			prefix:      "steven",
+			input:       "@example.com",
+			shouldParse: false,
+		},
+		{
+			name:        "username too long",
+			input:       "@abcdefghijklmnopqrstuvwxyz0123456789",
+			shouldParse: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewMentionParser()
+			reader := text.NewReader([]byte(tt.prefix + tt.input))
+			reader.Advance(len(tt.prefix))
+			ctx := parser.NewContext()
+			node := p.Parse(nil, reader, ctx)
+			if tt.shouldParse {
+				require.NotNil(t, node, "Expected mention to be parsed")
+				mentionNode, ok := node.(*mast.MentionNode)
+				require.True(t, ok, "Expected node to be *mast.MentionNode")
+				assert.Equal(t, tt.expectedUsername, string(mentionNode.Username))
+			} else {
+				assert.Nil(t, node, "Expected mention NOT to be parsed")
+			}
+		})
+	}
+}
@@ -28,9 +28,9 @@ func (*tagParser) Trigger() []byte {
 	return []byte{'#'}
 }
 
-// isValidTagRune checks if a Unicode rune is valid in a tag.
+// IsValidTagRune checks if a Unicode rune is valid in a tag.
 // Uses Unicode categories for proper international character support.
-func isValidTagRune(r rune) bool {
+func IsValidTagRune(r rune) bool {
 	// Allow Unicode letters (any script: Latin, CJK, Arabic, Cyrillic, etc.)
 	if unicode.IsLetter(r) {
 		return true
@@ -102,7 +102,7 @@ func (*tagParser) Parse(_ gast.Node, block text.Reader, _ parser.Context) gast.N
 		}
 
 		// Validate character using Unicode categories
-		if !isValidTagRune(r) {
+		if !IsValidTagRune(r) {
 			break
 		}
 
@@ -0,0 +1,173 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// activityTitles are the human readable titles of the activity types used by the chat formats.
+var activityTitles = map[string]string{
+	ActivityTypeMemoCreated:       "Memo created",
+	ActivityTypeMemoUpdated:       "Memo updated",
+	ActivityTypeMemoDeleted:       "Memo deleted",
+	ActivityTypeMemoArchived:      "Memo archived",
+	ActivityTypeMemoRestored:      "Memo restored",
+	ActivityTypeMemoPublished:     "Memo published",
+	ActivityTypeMemoReminded:      "Memo reminder",
+	ActivityTypeCommentCreated:    "New comment",
+	ActivityTypeReactionUpserted:  "New reaction",
+	ActivityTypeReactionDeleted:   "Reaction removed",
+	ActivityTypeRelationsUpdated:  "Memo relations updated",
+	ActivityTypeAttachmentCreated: "Attachment uploaded",
+	ActivityTypeDigestCreated:     "Digest",
+}
+
+// RenderOptions are the options to render the request body of a webhook.
+type RenderOptions struct {
+	Format storepb.WebhooksUserSetting_Webhook_Format
+	// Template is the Go text/template of the TEMPLATE format.
+	Template string
+	// Snippet is the plain text snippet of the memo, or of the comment for comment activities.
+	// It is the Markdown of the digest for digest activities.
+	Snippet string
+	// Link is the URL of the memo. Omitted from the messages when empty.
+	Link string
+}
+
+// TemplateData is the data the template of the TEMPLATE format is executed with.
+type TemplateData struct {
+	*WebhookRequestPayload
+	Title   string
+	Snippet string
+	Link    string
+}
+
+// ParseTemplate parses the template of the TEMPLATE format.
+// The template can use the `json` function to encode a value as JSON, e.g. `{"text": {{json .Snippet}}}`.
+func ParseTemplate(text string) (*template.Template, error) {
+	return template.New("webhook").Funcs(template.FuncMap{
+		"json": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}).Option("missingkey=error").Parse(text)
+}
+
+// Render renders the request body of the payload in the format of the options.
+func Render(payload *WebhookRequestPayload, options RenderOptions) ([]byte, error) {
+	title := activityTitles[payload.ActivityType]
+	if payload.Digest != nil {
+		title = payload.Digest.Title
+	}
+	if title == "" {
+		title = payload.ActivityType
+	}
+	text := options.Snippet
+	if payload.Reaction != nil {
+		text = strings.TrimSpace(payload.Reaction.ReactionType + " " + text)
+	}
+
+	var body any
+	switch options.Format {
+	case storepb.WebhooksUserSetting_Webhook_FORMAT_UNSPECIFIED, storepb.WebhooksUserSetting_Webhook_MEMOS:
+		body = payload
+	case storepb.WebhooksUserSetting_Webhook_SLACK:
+		blocks := []map[string]any{
+			{"type": "section", "text": map[string]any{"type": "mrkdwn", "text": "*" + title + "*\n" + text}},
+		}
+		if options.Link != "" {
+			blocks = append(blocks, map[string]any{
+				"type":     "context",
+				"elements": []map[string]any{{"type": "mrkdwn", "text": "<" + options.Link + "|Open in memos>"}},
+			})
+		}
+		body = map[string]any{"text": title + ": " + text, "blocks": blocks}
+	case storepb.WebhooksUserSetting_Webhook_DISCORD:
+		embed := map[string]any{"title": title, "description": text}
+		if options.Link != "" {
+			embed["url"] = options.Link
+		}
+		body = map[string]any{"embeds": []map[string]any{embed}}
+	case storepb.WebhooksUserSetting_Webhook_TELEGRAM:
+		// The Bot API takes the chat from the body, so the chat_id query parameter of the URL is copied into it.
+		endpoint, err := url.Parse(payload.URL)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid webhook URL")
+		}
+		body = map[string]any{
+			"chat_id": endpoint.Query().Get("chat_id"),
+			"text":    joinLines(title, text, options.Link),
+		}
+	case storepb.WebhooksUserSetting_Webhook_FEISHU:
+		elements := []map[string]any{
+			{"tag": "div", "text": map[string]any{"tag": "plain_text", "content": text}},
+		}
+		if options.Link != "" {
+			elements = append(elements, map[string]any{
+				"tag": "action",
+				"actions": []map[string]any{{
+					"tag":  "button",
+					"text": map[string]any{"tag": "plain_text", "content": "Open in memos"},
+					"url":  options.Link,
+					"type": "default",
+				}},
+			})
+		}
+		body = map[string]any{
+			"msg_type": "interactive",
+			"card": map[string]any{
+				"header":   map[string]any{"title": map[string]any{"tag": "plain_text", "content": title}},
+				"elements": elements,
+			},
+		}
+	case storepb.WebhooksUserSetting_Webhook_DINGTALK:
+		markdown := "### " + title + "\n\n" + text
+		if options.Link != "" {
+			markdown += "\n\n[Open in memos](" + options.Link + ")"
+		}
+		body = map[string]any{
+			"msgtype":  "markdown",
+			"markdown": map[string]any{"title": title, "text": markdown},
+		}
+	case storepb.WebhooksUserSetting_Webhook_TEMPLATE:
+		tmpl, err := ParseTemplate(options.Template)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse webhook template")
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, &TemplateData{
+			WebhookRequestPayload: payload,
+			Title:                 title,
+			Snippet:               options.Snippet,
+			Link:                  options.Link,
+		}); err != nil {
+			return nil, errors.Wrap(err, "failed to execute webhook template")
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, errors.Errorf("unsupported webhook format %s", options.Format)
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal webhook request")
+	}
+	return b, nil
+}
+
+func joinLines(lines ...string) string {
+	nonEmpty := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if line != "" {
+			nonEmpty = append(nonEmpty, line)
+		}
+	}
+	return strings.Join(nonEmpty, "\n\n")
+}
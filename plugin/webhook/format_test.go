@@ -0,0 +1,91 @@
+package webhook
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestRender(t *testing.T) {
+	payload := &WebhookRequestPayload{
+		URL:          "https://api.telegram.org/bot123/sendMessage?chat_id=-42",
+		ActivityType: ActivityTypeMemoCreated,
+		Creator:      "users/1",
+		Memo:         &v1pb.Memo{Name: "memos/abc", Content: "**Hello** world"},
+	}
+	options := RenderOptions{Snippet: "Hello world", Link: "https://memos.example.com/memos/abc"}
+
+	tests := []struct {
+		format   storepb.WebhooksUserSetting_Webhook_Format
+		expected string
+	}{
+		{
+			format:   storepb.WebhooksUserSetting_Webhook_SLACK,
+			expected: `{"blocks":[{"text":{"text":"*Memo created*\nHello world","type":"mrkdwn"},"type":"section"},{"elements":[{"text":"<https://memos.example.com/memos/abc|Open in memos>","type":"mrkdwn"}],"type":"context"}],"text":"Memo created: Hello world"}`,
+		},
+		{
+			format:   storepb.WebhooksUserSetting_Webhook_DISCORD,
+			expected: `{"embeds":[{"description":"Hello world","title":"Memo created","url":"https://memos.example.com/memos/abc"}]}`,
+		},
+		{
+			format:   storepb.WebhooksUserSetting_Webhook_TELEGRAM,
+			expected: `{"chat_id":"-42","text":"Memo created\n\nHello world\n\nhttps://memos.example.com/memos/abc"}`,
+		},
+		{
+			format:   storepb.WebhooksUserSetting_Webhook_DINGTALK,
+			expected: `{"markdown":{"text":"### Memo created\n\nHello world\n\n[Open in memos](https://memos.example.com/memos/abc)","title":"Memo created"},"msgtype":"markdown"}`,
+		},
+	}
+	for _, test := range tests {
+		options.Format = test.format
+		body, err := Render(payload, options)
+		require.NoError(t, err)
+		require.JSONEq(t, test.expected, string(body), test.format.String())
+	}
+
+	t.Run("Memos format is the payload", func(t *testing.T) {
+		options.Format = storepb.WebhooksUserSetting_Webhook_FORMAT_UNSPECIFIED
+		body, err := Render(payload, options)
+		require.NoError(t, err)
+		expected, err := json.Marshal(payload)
+		require.NoError(t, err)
+		require.Equal(t, expected, body)
+	})
+
+	t.Run("Feishu card", func(t *testing.T) {
+		options.Format = storepb.WebhooksUserSetting_Webhook_FEISHU
+		body, err := Render(payload, options)
+		require.NoError(t, err)
+		card := struct {
+			MsgType string `json:"msg_type"`
+			Card    struct {
+				Header struct {
+					Title struct {
+						Content string `json:"content"`
+					} `json:"title"`
+				} `json:"header"`
+				Elements []json.RawMessage `json:"elements"`
+			} `json:"card"`
+		}{}
+		require.NoError(t, json.Unmarshal(body, &card))
+		require.Equal(t, "interactive", card.MsgType)
+		require.Equal(t, "Memo created", card.Card.Header.Title.Content)
+		require.Len(t, card.Card.Elements, 2)
+	})
+
+	t.Run("Template", func(t *testing.T) {
+		options.Format = storepb.WebhooksUserSetting_Webhook_TEMPLATE
+		options.Template = `{"msg": {{json .Snippet}}, "type": "{{.ActivityType}}", "memo": "{{.Memo.Name}}"}`
+		body, err := Render(payload, options)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"msg": "Hello world", "type": "memos.memo.created", "memo": "memos/abc"}`, string(body))
+
+		options.Template = `{{.Unknown}}`
+		_, err = Render(payload, options)
+		require.Error(t, err)
+	})
+}
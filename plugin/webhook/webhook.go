@@ -2,10 +2,14 @@ package webhook
 
 import (
 	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"io"
-	"log/slog"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
@@ -18,6 +22,49 @@ var (
 	timeout = 30 * time.Second
 )
 
+const (
+	// SignatureHeader is the header of the request signature, formatted as "sha256={signature}".
+	SignatureHeader = "X-Memos-Signature"
+	// TimestampHeader is the header of the unix timestamp the request was signed at.
+	TimestampHeader = "X-Memos-Timestamp"
+	// DeliveryHeader is the header of the delivery id.
+	DeliveryHeader = "X-Memos-Delivery"
+)
+
+// Activity types of the webhook requests.
+const (
+	ActivityTypeMemoCreated       = "memos.memo.created"
+	ActivityTypeMemoUpdated       = "memos.memo.updated"
+	ActivityTypeMemoDeleted       = "memos.memo.deleted"
+	ActivityTypeMemoArchived      = "memos.memo.archived"
+	ActivityTypeMemoRestored      = "memos.memo.restored"
+	ActivityTypeMemoPublished     = "memos.memo.published"
+	ActivityTypeMemoReminded      = "memos.memo.reminded"
+	ActivityTypeCommentCreated    = "memos.comment.created"
+	ActivityTypeReactionUpserted  = "memos.reaction.upserted"
+	ActivityTypeReactionDeleted   = "memos.reaction.deleted"
+	ActivityTypeRelationsUpdated  = "memos.relations.updated"
+	ActivityTypeAttachmentCreated = "memos.attachment.created"
+	ActivityTypeDigestCreated     = "memos.digest.created"
+)
+
+// ActivityTypes lists the activity types webhooks can subscribe to.
+var ActivityTypes = []string{
+	ActivityTypeMemoCreated,
+	ActivityTypeMemoUpdated,
+	ActivityTypeMemoDeleted,
+	ActivityTypeMemoArchived,
+	ActivityTypeMemoRestored,
+	ActivityTypeMemoPublished,
+	ActivityTypeMemoReminded,
+	ActivityTypeCommentCreated,
+	ActivityTypeReactionUpserted,
+	ActivityTypeReactionDeleted,
+	ActivityTypeRelationsUpdated,
+	ActivityTypeAttachmentCreated,
+	ActivityTypeDigestCreated,
+}
+
 type WebhookRequestPayload struct {
 	// The target URL for the webhook request.
 	URL string `json:"url"`
@@ -27,6 +74,45 @@ type WebhookRequestPayload struct {
 	Creator string `json:"creator"`
 	// The memo that triggered this webhook (if applicable).
 	Memo *v1pb.Memo `json:"memo"`
+	// The comment created on the memo, for comment activities.
+	Comment *v1pb.Memo `json:"comment,omitempty"`
+	// The reaction to the memo, for reaction activities.
+	Reaction *v1pb.Reaction `json:"reaction,omitempty"`
+	// The uploaded attachment, for attachment activities.
+	Attachment *v1pb.Attachment `json:"attachment,omitempty"`
+	// The periodic digest of the user, for digest activities.
+	Digest *Digest `json:"digest,omitempty"`
+}
+
+// Digest is a periodic summary of the activity of a user.
+type Digest struct {
+	Title string `json:"title"`
+	// Markdown is the digest in Markdown.
+	Markdown string `json:"markdown"`
+	// HTML is the digest rendered as HTML.
+	HTML      string    `json:"html"`
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+}
+
+// Request is a delivery to a webhook endpoint.
+type Request struct {
+	URL string
+	// Body is the JSON request body.
+	Body []byte
+	// Secret signs the request. The request is not signed when empty.
+	Secret string
+	// DeliveryID identifies the delivery across retries, so that receivers can ignore duplicates.
+	DeliveryID string
+	// CheckResponseCode requires the response body to be a JSON object with a zero `code`.
+	// Otherwise any 2xx response is accepted.
+	CheckResponseCode bool
+}
+
+// Response is the response of a webhook endpoint.
+type Response struct {
+	StatusCode int
+	Body       []byte
 }
 
 // Post posts the message to webhook endpoint.
@@ -35,56 +121,69 @@ func Post(requestPayload *WebhookRequestPayload) error {
 	if err != nil {
 		return errors.Wrapf(err, "failed to marshal webhook request to %s", requestPayload.URL)
 	}
+	_, err = Send(context.Background(), &Request{URL: requestPayload.URL, Body: body, CheckResponseCode: true})
+	return err
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of "{timestamp}.{body}" with the secret.
+// Receivers compute it from the timestamp header and the raw body, and compare it with the signature header.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
 
-	req, err := http.NewRequest("POST", requestPayload.URL, bytes.NewBuffer(body))
+// Send sends the request to webhook endpoint. The response is returned with the error when the endpoint rejects the request.
+func Send(ctx context.Context, request *Request) (*Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", request.URL, bytes.NewBuffer(request.Body))
 	if err != nil {
-		return errors.Wrapf(err, "failed to construct webhook request to %s", requestPayload.URL)
+		return nil, errors.Wrapf(err, "failed to construct webhook request to %s", request.URL)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if request.DeliveryID != "" {
+		req.Header.Set(DeliveryHeader, request.DeliveryID)
+	}
+	if request.Secret != "" {
+		timestamp := time.Now().Unix()
+		req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+		req.Header.Set(SignatureHeader, "sha256="+Sign(request.Secret, timestamp, request.Body))
+	}
 	client := &http.Client{
 		Timeout: timeout,
 	}
 	resp, err := client.Do(req)
 	if err != nil {
-		return errors.Wrapf(err, "failed to post webhook to %s", requestPayload.URL)
+		return nil, errors.Wrapf(err, "failed to post webhook to %s", request.URL)
 	}
 	defer resp.Body.Close()
 
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return errors.Wrapf(err, "failed to read webhook response from %s", requestPayload.URL)
+		return nil, errors.Wrapf(err, "failed to read webhook response from %s", request.URL)
 	}
+	response := &Response{StatusCode: resp.StatusCode, Body: b}
 
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return errors.Errorf("failed to post webhook %s, status code: %d, response body: %s", requestPayload.URL, resp.StatusCode, b)
+		return response, errors.Errorf("failed to post webhook %s, status code: %d, response body: %s", request.URL, resp.StatusCode, b)
+	}
+	if !request.CheckResponseCode {
+		return response, nil
 	}
 
-	response := &struct {
+	result := &struct {
 		Code    int    `json:"code"`
 		Message string `json:"message"`
 	}{}
-	if err := json.Unmarshal(b, response); err != nil {
-		return errors.Wrapf(err, "failed to unmarshal webhook response from %s", requestPayload.URL)
+	if err := json.Unmarshal(b, result); err != nil {
+		return response, errors.Wrapf(err, "failed to unmarshal webhook response from %s", request.URL)
 	}
 
-	if response.Code != 0 {
-		return errors.Errorf("receive error code sent by webhook server, code %d, msg: %s", response.Code, response.Message)
+	if result.Code != 0 {
+		return response, errors.Errorf("receive error code sent by webhook server, code %d, msg: %s", result.Code, result.Message)
 	}
 
-	return nil
-}
-
-// PostAsync posts the message to webhook endpoint asynchronously.
-// It spawns a new goroutine to handle the request and does not wait for the response.
-func PostAsync(requestPayload *WebhookRequestPayload) {
-	go func() {
-		if err := Post(requestPayload); err != nil {
-			// Since we're in a goroutine, we can only log the error
-			slog.Warn("Failed to dispatch webhook asynchronously",
-				slog.String("url", requestPayload.URL),
-				slog.String("activityType", requestPayload.ActivityType),
-				slog.Any("err", err))
-		}
-	}()
+	return response, nil
 }
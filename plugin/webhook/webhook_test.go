@@ -1 +1,74 @@
 package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendSignsRequest(t *testing.T) {
+	body := []byte(`{"activityType":"memos.memo.created"}`)
+	var header http.Header
+	var received []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		received, _ = io.ReadAll(r.Body)
+		_, _ = w.Write([]byte(`{"code":0}`))
+	}))
+	defer server.Close()
+
+	response, err := Send(context.Background(), &Request{URL: server.URL, Body: body, Secret: "secret", DeliveryID: "7"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, body, received)
+	require.Equal(t, "7", header.Get(DeliveryHeader))
+
+	timestamp, err := strconv.ParseInt(header.Get(TimestampHeader), 10, 64)
+	require.NoError(t, err)
+	require.Equal(t, "sha256="+Sign("secret", timestamp, received), header.Get(SignatureHeader))
+	require.NotEqual(t, Sign("other", timestamp, received), Sign("secret", timestamp, received))
+}
+
+func TestSendUnsignedWithoutSecret(t *testing.T) {
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		_, _ = w.Write([]byte(`{"code":0}`))
+	}))
+	defer server.Close()
+
+	_, err := Send(context.Background(), &Request{URL: server.URL, Body: []byte(`{}`)})
+	require.NoError(t, err)
+	require.Empty(t, header.Get(SignatureHeader))
+	require.Empty(t, header.Get(TimestampHeader))
+}
+
+func TestSendReturnsRejectedResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte("upstream down"))
+	}))
+	defer server.Close()
+
+	response, err := Send(context.Background(), &Request{URL: server.URL, Body: []byte(`{}`)})
+	require.Error(t, err)
+	require.Equal(t, http.StatusBadGateway, response.StatusCode)
+	require.Equal(t, "upstream down", string(response.Body))
+}
+
+func TestSendChecksResponseCode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	_, err := Send(context.Background(), &Request{URL: server.URL, Body: []byte(`{}`)})
+	require.NoError(t, err)
+	_, err = Send(context.Background(), &Request{URL: server.URL, Body: []byte(`{}`), CheckResponseCode: true})
+	require.Error(t, err)
+}
@@ -9,6 +9,7 @@ import (
 	context "context"
 	errors "errors"
 	v1 "github.com/usememos/memos/proto/gen/api/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	http "net/http"
 	strings "strings"
 )
@@ -42,6 +43,12 @@ const (
 	// InstanceServiceUpdateInstanceSettingProcedure is the fully-qualified name of the
 	// InstanceService's UpdateInstanceSetting RPC.
 	InstanceServiceUpdateInstanceSettingProcedure = "/memos.api.v1.InstanceService/UpdateInstanceSetting"
+	// InstanceServiceSendTestEmailProcedure is the fully-qualified name of the InstanceService's
+	// SendTestEmail RPC.
+	InstanceServiceSendTestEmailProcedure = "/memos.api.v1.InstanceService/SendTestEmail"
+	// InstanceServiceCreateBackupProcedure is the fully-qualified name of the InstanceService's
+	// CreateBackup RPC.
+	InstanceServiceCreateBackupProcedure = "/memos.api.v1.InstanceService/CreateBackup"
 )
 
 // InstanceServiceClient is a client for the memos.api.v1.InstanceService service.
@@ -52,6 +59,10 @@ type InstanceServiceClient interface {
 	GetInstanceSetting(context.Context, *connect.Request[v1.GetInstanceSettingRequest]) (*connect.Response[v1.InstanceSetting], error)
 	// Updates an instance setting.
 	UpdateInstanceSetting(context.Context, *connect.Request[v1.UpdateInstanceSettingRequest]) (*connect.Response[v1.InstanceSetting], error)
+	// Sends a test email with the email setting. Requires admin.
+	SendTestEmail(context.Context, *connect.Request[v1.SendTestEmailRequest]) (*connect.Response[emptypb.Empty], error)
+	// Creates a consistent backup of the database and the local attachments. Requires admin.
+	CreateBackup(context.Context, *connect.Request[v1.CreateBackupRequest]) (*connect.Response[v1.Backup], error)
 }
 
 // NewInstanceServiceClient constructs a client for the memos.api.v1.InstanceService service. By
@@ -83,6 +94,18 @@ func NewInstanceServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(instanceServiceMethods.ByName("UpdateInstanceSetting")),
 			connect.WithClientOptions(opts...),
 		),
+		sendTestEmail: connect.NewClient[v1.SendTestEmailRequest, emptypb.Empty](
+			httpClient,
+			baseURL+InstanceServiceSendTestEmailProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("SendTestEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		createBackup: connect.NewClient[v1.CreateBackupRequest, v1.Backup](
+			httpClient,
+			baseURL+InstanceServiceCreateBackupProcedure,
+			connect.WithSchema(instanceServiceMethods.ByName("CreateBackup")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -91,6 +114,8 @@ type instanceServiceClient struct {
 	getInstanceProfile    *connect.Client[v1.GetInstanceProfileRequest, v1.InstanceProfile]
 	getInstanceSetting    *connect.Client[v1.GetInstanceSettingRequest, v1.InstanceSetting]
 	updateInstanceSetting *connect.Client[v1.UpdateInstanceSettingRequest, v1.InstanceSetting]
+	sendTestEmail         *connect.Client[v1.SendTestEmailRequest, emptypb.Empty]
+	createBackup          *connect.Client[v1.CreateBackupRequest, v1.Backup]
 }
 
 // GetInstanceProfile calls memos.api.v1.InstanceService.GetInstanceProfile.
@@ -108,6 +133,16 @@ func (c *instanceServiceClient) UpdateInstanceSetting(ctx context.Context, req *
 	return c.updateInstanceSetting.CallUnary(ctx, req)
 }
 
+// SendTestEmail calls memos.api.v1.InstanceService.SendTestEmail.
+func (c *instanceServiceClient) SendTestEmail(ctx context.Context, req *connect.Request[v1.SendTestEmailRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.sendTestEmail.CallUnary(ctx, req)
+}
+
+// CreateBackup calls memos.api.v1.InstanceService.CreateBackup.
+func (c *instanceServiceClient) CreateBackup(ctx context.Context, req *connect.Request[v1.CreateBackupRequest]) (*connect.Response[v1.Backup], error) {
+	return c.createBackup.CallUnary(ctx, req)
+}
+
 // InstanceServiceHandler is an implementation of the memos.api.v1.InstanceService service.
 type InstanceServiceHandler interface {
 	// Gets the instance profile.
@@ -116,6 +151,10 @@ type InstanceServiceHandler interface {
 	GetInstanceSetting(context.Context, *connect.Request[v1.GetInstanceSettingRequest]) (*connect.Response[v1.InstanceSetting], error)
 	// Updates an instance setting.
 	UpdateInstanceSetting(context.Context, *connect.Request[v1.UpdateInstanceSettingRequest]) (*connect.Response[v1.InstanceSetting], error)
+	// Sends a test email with the email setting. Requires admin.
+	SendTestEmail(context.Context, *connect.Request[v1.SendTestEmailRequest]) (*connect.Response[emptypb.Empty], error)
+	// Creates a consistent backup of the database and the local attachments. Requires admin.
+	CreateBackup(context.Context, *connect.Request[v1.CreateBackupRequest]) (*connect.Response[v1.Backup], error)
 }
 
 // NewInstanceServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -143,6 +182,18 @@ func NewInstanceServiceHandler(svc InstanceServiceHandler, opts ...connect.Handl
 		connect.WithSchema(instanceServiceMethods.ByName("UpdateInstanceSetting")),
 		connect.WithHandlerOptions(opts...),
 	)
+	instanceServiceSendTestEmailHandler := connect.NewUnaryHandler(
+		InstanceServiceSendTestEmailProcedure,
+		svc.SendTestEmail,
+		connect.WithSchema(instanceServiceMethods.ByName("SendTestEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	instanceServiceCreateBackupHandler := connect.NewUnaryHandler(
+		InstanceServiceCreateBackupProcedure,
+		svc.CreateBackup,
+		connect.WithSchema(instanceServiceMethods.ByName("CreateBackup")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/memos.api.v1.InstanceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case InstanceServiceGetInstanceProfileProcedure:
@@ -151,6 +202,10 @@ func NewInstanceServiceHandler(svc InstanceServiceHandler, opts ...connect.Handl
 			instanceServiceGetInstanceSettingHandler.ServeHTTP(w, r)
 		case InstanceServiceUpdateInstanceSettingProcedure:
 			instanceServiceUpdateInstanceSettingHandler.ServeHTTP(w, r)
+		case InstanceServiceSendTestEmailProcedure:
+			instanceServiceSendTestEmailHandler.ServeHTTP(w, r)
+		case InstanceServiceCreateBackupProcedure:
+			instanceServiceCreateBackupHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -171,3 +226,11 @@ func (UnimplementedInstanceServiceHandler) GetInstanceSetting(context.Context, *
 func (UnimplementedInstanceServiceHandler) UpdateInstanceSetting(context.Context, *connect.Request[v1.UpdateInstanceSettingRequest]) (*connect.Response[v1.InstanceSetting], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.UpdateInstanceSetting is not implemented"))
 }
+
+func (UnimplementedInstanceServiceHandler) SendTestEmail(context.Context, *connect.Request[v1.SendTestEmailRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.SendTestEmail is not implemented"))
+}
+
+func (UnimplementedInstanceServiceHandler) CreateBackup(context.Context, *connect.Request[v1.CreateBackupRequest]) (*connect.Response[v1.Backup], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.InstanceService.CreateBackup is not implemented"))
+}
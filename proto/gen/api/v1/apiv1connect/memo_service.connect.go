@@ -41,6 +41,8 @@ const (
 	// MemoServiceSearchMemosSemanticProcedure is the fully-qualified name of the MemoService's
 	// SearchMemosSemantic RPC.
 	MemoServiceSearchMemosSemanticProcedure = "/memos.api.v1.MemoService/SearchMemosSemantic"
+	// MemoServiceSearchMemosProcedure is the fully-qualified name of the MemoService's SearchMemos RPC.
+	MemoServiceSearchMemosProcedure = "/memos.api.v1.MemoService/SearchMemos"
 	// MemoServiceGetMemoProcedure is the fully-qualified name of the MemoService's GetMemo RPC.
 	MemoServiceGetMemoProcedure = "/memos.api.v1.MemoService/GetMemo"
 	// MemoServiceUpdateMemoProcedure is the fully-qualified name of the MemoService's UpdateMemo RPC.
@@ -59,6 +61,20 @@ const (
 	// MemoServiceListMemoRelationsProcedure is the fully-qualified name of the MemoService's
 	// ListMemoRelations RPC.
 	MemoServiceListMemoRelationsProcedure = "/memos.api.v1.MemoService/ListMemoRelations"
+	// MemoServiceListRelatedMemosProcedure is the fully-qualified name of the MemoService's
+	// ListRelatedMemos RPC.
+	MemoServiceListRelatedMemosProcedure = "/memos.api.v1.MemoService/ListRelatedMemos"
+	// MemoServiceListDuplicateMemoClustersProcedure is the fully-qualified name of the MemoService's
+	// ListDuplicateMemoClusters RPC.
+	MemoServiceListDuplicateMemoClustersProcedure = "/memos.api.v1.MemoService/ListDuplicateMemoClusters"
+	// MemoServiceMergeMemosProcedure is the fully-qualified name of the MemoService's MergeMemos RPC.
+	MemoServiceMergeMemosProcedure = "/memos.api.v1.MemoService/MergeMemos"
+	// MemoServiceAcceptMemoEnrichmentProcedure is the fully-qualified name of the MemoService's
+	// AcceptMemoEnrichment RPC.
+	MemoServiceAcceptMemoEnrichmentProcedure = "/memos.api.v1.MemoService/AcceptMemoEnrichment"
+	// MemoServiceRejectMemoEnrichmentProcedure is the fully-qualified name of the MemoService's
+	// RejectMemoEnrichment RPC.
+	MemoServiceRejectMemoEnrichmentProcedure = "/memos.api.v1.MemoService/RejectMemoEnrichment"
 	// MemoServiceCreateMemoCommentProcedure is the fully-qualified name of the MemoService's
 	// CreateMemoComment RPC.
 	MemoServiceCreateMemoCommentProcedure = "/memos.api.v1.MemoService/CreateMemoComment"
@@ -74,6 +90,19 @@ const (
 	// MemoServiceDeleteMemoReactionProcedure is the fully-qualified name of the MemoService's
 	// DeleteMemoReaction RPC.
 	MemoServiceDeleteMemoReactionProcedure = "/memos.api.v1.MemoService/DeleteMemoReaction"
+	// MemoServiceListTasksProcedure is the fully-qualified name of the MemoService's ListTasks RPC.
+	MemoServiceListTasksProcedure = "/memos.api.v1.MemoService/ListTasks"
+	// MemoServiceToggleTaskProcedure is the fully-qualified name of the MemoService's ToggleTask RPC.
+	MemoServiceToggleTaskProcedure = "/memos.api.v1.MemoService/ToggleTask"
+	// MemoServiceListMemoRevisionsProcedure is the fully-qualified name of the MemoService's
+	// ListMemoRevisions RPC.
+	MemoServiceListMemoRevisionsProcedure = "/memos.api.v1.MemoService/ListMemoRevisions"
+	// MemoServiceGetMemoRevisionProcedure is the fully-qualified name of the MemoService's
+	// GetMemoRevision RPC.
+	MemoServiceGetMemoRevisionProcedure = "/memos.api.v1.MemoService/GetMemoRevision"
+	// MemoServiceRestoreMemoRevisionProcedure is the fully-qualified name of the MemoService's
+	// RestoreMemoRevision RPC.
+	MemoServiceRestoreMemoRevisionProcedure = "/memos.api.v1.MemoService/RestoreMemoRevision"
 )
 
 // MemoServiceClient is a client for the memos.api.v1.MemoService service.
@@ -84,6 +113,8 @@ type MemoServiceClient interface {
 	ListMemos(context.Context, *connect.Request[v1.ListMemosRequest]) (*connect.Response[v1.ListMemosResponse], error)
 	// SearchMemosSemantic searches memos by semantic similarity.
 	SearchMemosSemantic(context.Context, *connect.Request[v1.SearchMemosSemanticRequest]) (*connect.Response[v1.ListMemosResponse], error)
+	// SearchMemos searches memos by keyword, semantic similarity or both.
+	SearchMemos(context.Context, *connect.Request[v1.SearchMemosRequest]) (*connect.Response[v1.SearchMemosResponse], error)
 	// GetMemo gets a memo.
 	GetMemo(context.Context, *connect.Request[v1.GetMemoRequest]) (*connect.Response[v1.Memo], error)
 	// UpdateMemo updates a memo.
@@ -98,6 +129,17 @@ type MemoServiceClient interface {
 	SetMemoRelations(context.Context, *connect.Request[v1.SetMemoRelationsRequest]) (*connect.Response[emptypb.Empty], error)
 	// ListMemoRelations lists relations for a memo.
 	ListMemoRelations(context.Context, *connect.Request[v1.ListMemoRelationsRequest]) (*connect.Response[v1.ListMemoRelationsResponse], error)
+	// ListRelatedMemos lists memos related to a memo by content similarity, shared tags and references.
+	ListRelatedMemos(context.Context, *connect.Request[v1.ListRelatedMemosRequest]) (*connect.Response[v1.ListRelatedMemosResponse], error)
+	// ListDuplicateMemoClusters scans memos for near-duplicates and groups them into clusters.
+	// Only the 2000 most recently updated memos are scanned.
+	ListDuplicateMemoClusters(context.Context, *connect.Request[v1.ListDuplicateMemoClustersRequest]) (*connect.Response[v1.ListDuplicateMemoClustersResponse], error)
+	// MergeMemos merges memos into a surviving memo and deletes them.
+	MergeMemos(context.Context, *connect.Request[v1.MergeMemosRequest]) (*connect.Response[v1.Memo], error)
+	// AcceptMemoEnrichment accepts suggestions of the memo enrichment.
+	AcceptMemoEnrichment(context.Context, *connect.Request[v1.AcceptMemoEnrichmentRequest]) (*connect.Response[v1.Memo], error)
+	// RejectMemoEnrichment rejects suggestions of the memo enrichment.
+	RejectMemoEnrichment(context.Context, *connect.Request[v1.RejectMemoEnrichmentRequest]) (*connect.Response[v1.Memo], error)
 	// CreateMemoComment creates a comment for a memo.
 	CreateMemoComment(context.Context, *connect.Request[v1.CreateMemoCommentRequest]) (*connect.Response[v1.Memo], error)
 	// ListMemoComments lists comments for a memo.
@@ -108,6 +150,16 @@ type MemoServiceClient interface {
 	UpsertMemoReaction(context.Context, *connect.Request[v1.UpsertMemoReactionRequest]) (*connect.Response[v1.Reaction], error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(context.Context, *connect.Request[v1.DeleteMemoReactionRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListTasks lists the checklist items of the memos of the current user.
+	ListTasks(context.Context, *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error)
+	// ToggleTask checks or unchecks a checklist item by rewriting its checkbox in the memo content.
+	ToggleTask(context.Context, *connect.Request[v1.ToggleTaskRequest]) (*connect.Response[v1.Task], error)
+	// ListMemoRevisions lists the prior versions of a memo, newest first.
+	ListMemoRevisions(context.Context, *connect.Request[v1.ListMemoRevisionsRequest]) (*connect.Response[v1.ListMemoRevisionsResponse], error)
+	// GetMemoRevision gets a prior version of a memo, with a diff against the current version.
+	GetMemoRevision(context.Context, *connect.Request[v1.GetMemoRevisionRequest]) (*connect.Response[v1.MemoRevision], error)
+	// RestoreMemoRevision restores a memo to a prior version. The replaced version is recorded as a revision.
+	RestoreMemoRevision(context.Context, *connect.Request[v1.RestoreMemoRevisionRequest]) (*connect.Response[v1.Memo], error)
 }
 
 // NewMemoServiceClient constructs a client for the memos.api.v1.MemoService service. By default, it
@@ -139,6 +191,12 @@ func NewMemoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(memoServiceMethods.ByName("SearchMemosSemantic")),
 			connect.WithClientOptions(opts...),
 		),
+		searchMemos: connect.NewClient[v1.SearchMemosRequest, v1.SearchMemosResponse](
+			httpClient,
+			baseURL+MemoServiceSearchMemosProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("SearchMemos")),
+			connect.WithClientOptions(opts...),
+		),
 		getMemo: connect.NewClient[v1.GetMemoRequest, v1.Memo](
 			httpClient,
 			baseURL+MemoServiceGetMemoProcedure,
@@ -181,6 +239,36 @@ func NewMemoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(memoServiceMethods.ByName("ListMemoRelations")),
 			connect.WithClientOptions(opts...),
 		),
+		listRelatedMemos: connect.NewClient[v1.ListRelatedMemosRequest, v1.ListRelatedMemosResponse](
+			httpClient,
+			baseURL+MemoServiceListRelatedMemosProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("ListRelatedMemos")),
+			connect.WithClientOptions(opts...),
+		),
+		listDuplicateMemoClusters: connect.NewClient[v1.ListDuplicateMemoClustersRequest, v1.ListDuplicateMemoClustersResponse](
+			httpClient,
+			baseURL+MemoServiceListDuplicateMemoClustersProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("ListDuplicateMemoClusters")),
+			connect.WithClientOptions(opts...),
+		),
+		mergeMemos: connect.NewClient[v1.MergeMemosRequest, v1.Memo](
+			httpClient,
+			baseURL+MemoServiceMergeMemosProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("MergeMemos")),
+			connect.WithClientOptions(opts...),
+		),
+		acceptMemoEnrichment: connect.NewClient[v1.AcceptMemoEnrichmentRequest, v1.Memo](
+			httpClient,
+			baseURL+MemoServiceAcceptMemoEnrichmentProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("AcceptMemoEnrichment")),
+			connect.WithClientOptions(opts...),
+		),
+		rejectMemoEnrichment: connect.NewClient[v1.RejectMemoEnrichmentRequest, v1.Memo](
+			httpClient,
+			baseURL+MemoServiceRejectMemoEnrichmentProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("RejectMemoEnrichment")),
+			connect.WithClientOptions(opts...),
+		),
 		createMemoComment: connect.NewClient[v1.CreateMemoCommentRequest, v1.Memo](
 			httpClient,
 			baseURL+MemoServiceCreateMemoCommentProcedure,
@@ -211,26 +299,67 @@ func NewMemoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(memoServiceMethods.ByName("DeleteMemoReaction")),
 			connect.WithClientOptions(opts...),
 		),
+		listTasks: connect.NewClient[v1.ListTasksRequest, v1.ListTasksResponse](
+			httpClient,
+			baseURL+MemoServiceListTasksProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("ListTasks")),
+			connect.WithClientOptions(opts...),
+		),
+		toggleTask: connect.NewClient[v1.ToggleTaskRequest, v1.Task](
+			httpClient,
+			baseURL+MemoServiceToggleTaskProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("ToggleTask")),
+			connect.WithClientOptions(opts...),
+		),
+		listMemoRevisions: connect.NewClient[v1.ListMemoRevisionsRequest, v1.ListMemoRevisionsResponse](
+			httpClient,
+			baseURL+MemoServiceListMemoRevisionsProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("ListMemoRevisions")),
+			connect.WithClientOptions(opts...),
+		),
+		getMemoRevision: connect.NewClient[v1.GetMemoRevisionRequest, v1.MemoRevision](
+			httpClient,
+			baseURL+MemoServiceGetMemoRevisionProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("GetMemoRevision")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreMemoRevision: connect.NewClient[v1.RestoreMemoRevisionRequest, v1.Memo](
+			httpClient,
+			baseURL+MemoServiceRestoreMemoRevisionProcedure,
+			connect.WithSchema(memoServiceMethods.ByName("RestoreMemoRevision")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // memoServiceClient implements MemoServiceClient.
 type memoServiceClient struct {
-	createMemo          *connect.Client[v1.CreateMemoRequest, v1.Memo]
-	listMemos           *connect.Client[v1.ListMemosRequest, v1.ListMemosResponse]
-	searchMemosSemantic *connect.Client[v1.SearchMemosSemanticRequest, v1.ListMemosResponse]
-	getMemo             *connect.Client[v1.GetMemoRequest, v1.Memo]
-	updateMemo          *connect.Client[v1.UpdateMemoRequest, v1.Memo]
-	deleteMemo          *connect.Client[v1.DeleteMemoRequest, emptypb.Empty]
-	setMemoAttachments  *connect.Client[v1.SetMemoAttachmentsRequest, emptypb.Empty]
-	listMemoAttachments *connect.Client[v1.ListMemoAttachmentsRequest, v1.ListMemoAttachmentsResponse]
-	setMemoRelations    *connect.Client[v1.SetMemoRelationsRequest, emptypb.Empty]
-	listMemoRelations   *connect.Client[v1.ListMemoRelationsRequest, v1.ListMemoRelationsResponse]
-	createMemoComment   *connect.Client[v1.CreateMemoCommentRequest, v1.Memo]
-	listMemoComments    *connect.Client[v1.ListMemoCommentsRequest, v1.ListMemoCommentsResponse]
-	listMemoReactions   *connect.Client[v1.ListMemoReactionsRequest, v1.ListMemoReactionsResponse]
-	upsertMemoReaction  *connect.Client[v1.UpsertMemoReactionRequest, v1.Reaction]
-	deleteMemoReaction  *connect.Client[v1.DeleteMemoReactionRequest, emptypb.Empty]
+	createMemo                *connect.Client[v1.CreateMemoRequest, v1.Memo]
+	listMemos                 *connect.Client[v1.ListMemosRequest, v1.ListMemosResponse]
+	searchMemosSemantic       *connect.Client[v1.SearchMemosSemanticRequest, v1.ListMemosResponse]
+	searchMemos               *connect.Client[v1.SearchMemosRequest, v1.SearchMemosResponse]
+	getMemo                   *connect.Client[v1.GetMemoRequest, v1.Memo]
+	updateMemo                *connect.Client[v1.UpdateMemoRequest, v1.Memo]
+	deleteMemo                *connect.Client[v1.DeleteMemoRequest, emptypb.Empty]
+	setMemoAttachments        *connect.Client[v1.SetMemoAttachmentsRequest, emptypb.Empty]
+	listMemoAttachments       *connect.Client[v1.ListMemoAttachmentsRequest, v1.ListMemoAttachmentsResponse]
+	setMemoRelations          *connect.Client[v1.SetMemoRelationsRequest, emptypb.Empty]
+	listMemoRelations         *connect.Client[v1.ListMemoRelationsRequest, v1.ListMemoRelationsResponse]
+	listRelatedMemos          *connect.Client[v1.ListRelatedMemosRequest, v1.ListRelatedMemosResponse]
+	listDuplicateMemoClusters *connect.Client[v1.ListDuplicateMemoClustersRequest, v1.ListDuplicateMemoClustersResponse]
+	mergeMemos                *connect.Client[v1.MergeMemosRequest, v1.Memo]
+	acceptMemoEnrichment      *connect.Client[v1.AcceptMemoEnrichmentRequest, v1.Memo]
+	rejectMemoEnrichment      *connect.Client[v1.RejectMemoEnrichmentRequest, v1.Memo]
+	createMemoComment         *connect.Client[v1.CreateMemoCommentRequest, v1.Memo]
+	listMemoComments          *connect.Client[v1.ListMemoCommentsRequest, v1.ListMemoCommentsResponse]
+	listMemoReactions         *connect.Client[v1.ListMemoReactionsRequest, v1.ListMemoReactionsResponse]
+	upsertMemoReaction        *connect.Client[v1.UpsertMemoReactionRequest, v1.Reaction]
+	deleteMemoReaction        *connect.Client[v1.DeleteMemoReactionRequest, emptypb.Empty]
+	listTasks                 *connect.Client[v1.ListTasksRequest, v1.ListTasksResponse]
+	toggleTask                *connect.Client[v1.ToggleTaskRequest, v1.Task]
+	listMemoRevisions         *connect.Client[v1.ListMemoRevisionsRequest, v1.ListMemoRevisionsResponse]
+	getMemoRevision           *connect.Client[v1.GetMemoRevisionRequest, v1.MemoRevision]
+	restoreMemoRevision       *connect.Client[v1.RestoreMemoRevisionRequest, v1.Memo]
 }
 
 // CreateMemo calls memos.api.v1.MemoService.CreateMemo.
@@ -248,6 +377,11 @@ func (c *memoServiceClient) SearchMemosSemantic(ctx context.Context, req *connec
 	return c.searchMemosSemantic.CallUnary(ctx, req)
 }
 
+// SearchMemos calls memos.api.v1.MemoService.SearchMemos.
+func (c *memoServiceClient) SearchMemos(ctx context.Context, req *connect.Request[v1.SearchMemosRequest]) (*connect.Response[v1.SearchMemosResponse], error) {
+	return c.searchMemos.CallUnary(ctx, req)
+}
+
 // GetMemo calls memos.api.v1.MemoService.GetMemo.
 func (c *memoServiceClient) GetMemo(ctx context.Context, req *connect.Request[v1.GetMemoRequest]) (*connect.Response[v1.Memo], error) {
 	return c.getMemo.CallUnary(ctx, req)
@@ -283,6 +417,31 @@ func (c *memoServiceClient) ListMemoRelations(ctx context.Context, req *connect.
 	return c.listMemoRelations.CallUnary(ctx, req)
 }
 
+// ListRelatedMemos calls memos.api.v1.MemoService.ListRelatedMemos.
+func (c *memoServiceClient) ListRelatedMemos(ctx context.Context, req *connect.Request[v1.ListRelatedMemosRequest]) (*connect.Response[v1.ListRelatedMemosResponse], error) {
+	return c.listRelatedMemos.CallUnary(ctx, req)
+}
+
+// ListDuplicateMemoClusters calls memos.api.v1.MemoService.ListDuplicateMemoClusters.
+func (c *memoServiceClient) ListDuplicateMemoClusters(ctx context.Context, req *connect.Request[v1.ListDuplicateMemoClustersRequest]) (*connect.Response[v1.ListDuplicateMemoClustersResponse], error) {
+	return c.listDuplicateMemoClusters.CallUnary(ctx, req)
+}
+
+// MergeMemos calls memos.api.v1.MemoService.MergeMemos.
+func (c *memoServiceClient) MergeMemos(ctx context.Context, req *connect.Request[v1.MergeMemosRequest]) (*connect.Response[v1.Memo], error) {
+	return c.mergeMemos.CallUnary(ctx, req)
+}
+
+// AcceptMemoEnrichment calls memos.api.v1.MemoService.AcceptMemoEnrichment.
+func (c *memoServiceClient) AcceptMemoEnrichment(ctx context.Context, req *connect.Request[v1.AcceptMemoEnrichmentRequest]) (*connect.Response[v1.Memo], error) {
+	return c.acceptMemoEnrichment.CallUnary(ctx, req)
+}
+
+// RejectMemoEnrichment calls memos.api.v1.MemoService.RejectMemoEnrichment.
+func (c *memoServiceClient) RejectMemoEnrichment(ctx context.Context, req *connect.Request[v1.RejectMemoEnrichmentRequest]) (*connect.Response[v1.Memo], error) {
+	return c.rejectMemoEnrichment.CallUnary(ctx, req)
+}
+
 // CreateMemoComment calls memos.api.v1.MemoService.CreateMemoComment.
 func (c *memoServiceClient) CreateMemoComment(ctx context.Context, req *connect.Request[v1.CreateMemoCommentRequest]) (*connect.Response[v1.Memo], error) {
 	return c.createMemoComment.CallUnary(ctx, req)
@@ -308,6 +467,31 @@ func (c *memoServiceClient) DeleteMemoReaction(ctx context.Context, req *connect
 	return c.deleteMemoReaction.CallUnary(ctx, req)
 }
 
+// ListTasks calls memos.api.v1.MemoService.ListTasks.
+func (c *memoServiceClient) ListTasks(ctx context.Context, req *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error) {
+	return c.listTasks.CallUnary(ctx, req)
+}
+
+// ToggleTask calls memos.api.v1.MemoService.ToggleTask.
+func (c *memoServiceClient) ToggleTask(ctx context.Context, req *connect.Request[v1.ToggleTaskRequest]) (*connect.Response[v1.Task], error) {
+	return c.toggleTask.CallUnary(ctx, req)
+}
+
+// ListMemoRevisions calls memos.api.v1.MemoService.ListMemoRevisions.
+func (c *memoServiceClient) ListMemoRevisions(ctx context.Context, req *connect.Request[v1.ListMemoRevisionsRequest]) (*connect.Response[v1.ListMemoRevisionsResponse], error) {
+	return c.listMemoRevisions.CallUnary(ctx, req)
+}
+
+// GetMemoRevision calls memos.api.v1.MemoService.GetMemoRevision.
+func (c *memoServiceClient) GetMemoRevision(ctx context.Context, req *connect.Request[v1.GetMemoRevisionRequest]) (*connect.Response[v1.MemoRevision], error) {
+	return c.getMemoRevision.CallUnary(ctx, req)
+}
+
+// RestoreMemoRevision calls memos.api.v1.MemoService.RestoreMemoRevision.
+func (c *memoServiceClient) RestoreMemoRevision(ctx context.Context, req *connect.Request[v1.RestoreMemoRevisionRequest]) (*connect.Response[v1.Memo], error) {
+	return c.restoreMemoRevision.CallUnary(ctx, req)
+}
+
 // MemoServiceHandler is an implementation of the memos.api.v1.MemoService service.
 type MemoServiceHandler interface {
 	// CreateMemo creates a memo.
@@ -316,6 +500,8 @@ type MemoServiceHandler interface {
 	ListMemos(context.Context, *connect.Request[v1.ListMemosRequest]) (*connect.Response[v1.ListMemosResponse], error)
 	// SearchMemosSemantic searches memos by semantic similarity.
 	SearchMemosSemantic(context.Context, *connect.Request[v1.SearchMemosSemanticRequest]) (*connect.Response[v1.ListMemosResponse], error)
+	// SearchMemos searches memos by keyword, semantic similarity or both.
+	SearchMemos(context.Context, *connect.Request[v1.SearchMemosRequest]) (*connect.Response[v1.SearchMemosResponse], error)
 	// GetMemo gets a memo.
 	GetMemo(context.Context, *connect.Request[v1.GetMemoRequest]) (*connect.Response[v1.Memo], error)
 	// UpdateMemo updates a memo.
@@ -330,6 +516,17 @@ type MemoServiceHandler interface {
 	SetMemoRelations(context.Context, *connect.Request[v1.SetMemoRelationsRequest]) (*connect.Response[emptypb.Empty], error)
 	// ListMemoRelations lists relations for a memo.
 	ListMemoRelations(context.Context, *connect.Request[v1.ListMemoRelationsRequest]) (*connect.Response[v1.ListMemoRelationsResponse], error)
+	// ListRelatedMemos lists memos related to a memo by content similarity, shared tags and references.
+	ListRelatedMemos(context.Context, *connect.Request[v1.ListRelatedMemosRequest]) (*connect.Response[v1.ListRelatedMemosResponse], error)
+	// ListDuplicateMemoClusters scans memos for near-duplicates and groups them into clusters.
+	// Only the 2000 most recently updated memos are scanned.
+	ListDuplicateMemoClusters(context.Context, *connect.Request[v1.ListDuplicateMemoClustersRequest]) (*connect.Response[v1.ListDuplicateMemoClustersResponse], error)
+	// MergeMemos merges memos into a surviving memo and deletes them.
+	MergeMemos(context.Context, *connect.Request[v1.MergeMemosRequest]) (*connect.Response[v1.Memo], error)
+	// AcceptMemoEnrichment accepts suggestions of the memo enrichment.
+	AcceptMemoEnrichment(context.Context, *connect.Request[v1.AcceptMemoEnrichmentRequest]) (*connect.Response[v1.Memo], error)
+	// RejectMemoEnrichment rejects suggestions of the memo enrichment.
+	RejectMemoEnrichment(context.Context, *connect.Request[v1.RejectMemoEnrichmentRequest]) (*connect.Response[v1.Memo], error)
 	// CreateMemoComment creates a comment for a memo.
 	CreateMemoComment(context.Context, *connect.Request[v1.CreateMemoCommentRequest]) (*connect.Response[v1.Memo], error)
 	// ListMemoComments lists comments for a memo.
@@ -340,6 +537,16 @@ type MemoServiceHandler interface {
 	UpsertMemoReaction(context.Context, *connect.Request[v1.UpsertMemoReactionRequest]) (*connect.Response[v1.Reaction], error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(context.Context, *connect.Request[v1.DeleteMemoReactionRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListTasks lists the checklist items of the memos of the current user.
+	ListTasks(context.Context, *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error)
+	// ToggleTask checks or unchecks a checklist item by rewriting its checkbox in the memo content.
+	ToggleTask(context.Context, *connect.Request[v1.ToggleTaskRequest]) (*connect.Response[v1.Task], error)
+	// ListMemoRevisions lists the prior versions of a memo, newest first.
+	ListMemoRevisions(context.Context, *connect.Request[v1.ListMemoRevisionsRequest]) (*connect.Response[v1.ListMemoRevisionsResponse], error)
+	// GetMemoRevision gets a prior version of a memo, with a diff against the current version.
+	GetMemoRevision(context.Context, *connect.Request[v1.GetMemoRevisionRequest]) (*connect.Response[v1.MemoRevision], error)
+	// RestoreMemoRevision restores a memo to a prior version. The replaced version is recorded as a revision.
+	RestoreMemoRevision(context.Context, *connect.Request[v1.RestoreMemoRevisionRequest]) (*connect.Response[v1.Memo], error)
 }
 
 // NewMemoServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -367,6 +574,12 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(memoServiceMethods.ByName("SearchMemosSemantic")),
 		connect.WithHandlerOptions(opts...),
 	)
+	memoServiceSearchMemosHandler := connect.NewUnaryHandler(
+		MemoServiceSearchMemosProcedure,
+		svc.SearchMemos,
+		connect.WithSchema(memoServiceMethods.ByName("SearchMemos")),
+		connect.WithHandlerOptions(opts...),
+	)
 	memoServiceGetMemoHandler := connect.NewUnaryHandler(
 		MemoServiceGetMemoProcedure,
 		svc.GetMemo,
@@ -409,6 +622,36 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(memoServiceMethods.ByName("ListMemoRelations")),
 		connect.WithHandlerOptions(opts...),
 	)
+	memoServiceListRelatedMemosHandler := connect.NewUnaryHandler(
+		MemoServiceListRelatedMemosProcedure,
+		svc.ListRelatedMemos,
+		connect.WithSchema(memoServiceMethods.ByName("ListRelatedMemos")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceListDuplicateMemoClustersHandler := connect.NewUnaryHandler(
+		MemoServiceListDuplicateMemoClustersProcedure,
+		svc.ListDuplicateMemoClusters,
+		connect.WithSchema(memoServiceMethods.ByName("ListDuplicateMemoClusters")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceMergeMemosHandler := connect.NewUnaryHandler(
+		MemoServiceMergeMemosProcedure,
+		svc.MergeMemos,
+		connect.WithSchema(memoServiceMethods.ByName("MergeMemos")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceAcceptMemoEnrichmentHandler := connect.NewUnaryHandler(
+		MemoServiceAcceptMemoEnrichmentProcedure,
+		svc.AcceptMemoEnrichment,
+		connect.WithSchema(memoServiceMethods.ByName("AcceptMemoEnrichment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceRejectMemoEnrichmentHandler := connect.NewUnaryHandler(
+		MemoServiceRejectMemoEnrichmentProcedure,
+		svc.RejectMemoEnrichment,
+		connect.WithSchema(memoServiceMethods.ByName("RejectMemoEnrichment")),
+		connect.WithHandlerOptions(opts...),
+	)
 	memoServiceCreateMemoCommentHandler := connect.NewUnaryHandler(
 		MemoServiceCreateMemoCommentProcedure,
 		svc.CreateMemoComment,
@@ -439,6 +682,36 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(memoServiceMethods.ByName("DeleteMemoReaction")),
 		connect.WithHandlerOptions(opts...),
 	)
+	memoServiceListTasksHandler := connect.NewUnaryHandler(
+		MemoServiceListTasksProcedure,
+		svc.ListTasks,
+		connect.WithSchema(memoServiceMethods.ByName("ListTasks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceToggleTaskHandler := connect.NewUnaryHandler(
+		MemoServiceToggleTaskProcedure,
+		svc.ToggleTask,
+		connect.WithSchema(memoServiceMethods.ByName("ToggleTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceListMemoRevisionsHandler := connect.NewUnaryHandler(
+		MemoServiceListMemoRevisionsProcedure,
+		svc.ListMemoRevisions,
+		connect.WithSchema(memoServiceMethods.ByName("ListMemoRevisions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceGetMemoRevisionHandler := connect.NewUnaryHandler(
+		MemoServiceGetMemoRevisionProcedure,
+		svc.GetMemoRevision,
+		connect.WithSchema(memoServiceMethods.ByName("GetMemoRevision")),
+		connect.WithHandlerOptions(opts...),
+	)
+	memoServiceRestoreMemoRevisionHandler := connect.NewUnaryHandler(
+		MemoServiceRestoreMemoRevisionProcedure,
+		svc.RestoreMemoRevision,
+		connect.WithSchema(memoServiceMethods.ByName("RestoreMemoRevision")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/memos.api.v1.MemoService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case MemoServiceCreateMemoProcedure:
@@ -447,6 +720,8 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 			memoServiceListMemosHandler.ServeHTTP(w, r)
 		case MemoServiceSearchMemosSemanticProcedure:
 			memoServiceSearchMemosSemanticHandler.ServeHTTP(w, r)
+		case MemoServiceSearchMemosProcedure:
+			memoServiceSearchMemosHandler.ServeHTTP(w, r)
 		case MemoServiceGetMemoProcedure:
 			memoServiceGetMemoHandler.ServeHTTP(w, r)
 		case MemoServiceUpdateMemoProcedure:
@@ -461,6 +736,16 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 			memoServiceSetMemoRelationsHandler.ServeHTTP(w, r)
 		case MemoServiceListMemoRelationsProcedure:
 			memoServiceListMemoRelationsHandler.ServeHTTP(w, r)
+		case MemoServiceListRelatedMemosProcedure:
+			memoServiceListRelatedMemosHandler.ServeHTTP(w, r)
+		case MemoServiceListDuplicateMemoClustersProcedure:
+			memoServiceListDuplicateMemoClustersHandler.ServeHTTP(w, r)
+		case MemoServiceMergeMemosProcedure:
+			memoServiceMergeMemosHandler.ServeHTTP(w, r)
+		case MemoServiceAcceptMemoEnrichmentProcedure:
+			memoServiceAcceptMemoEnrichmentHandler.ServeHTTP(w, r)
+		case MemoServiceRejectMemoEnrichmentProcedure:
+			memoServiceRejectMemoEnrichmentHandler.ServeHTTP(w, r)
 		case MemoServiceCreateMemoCommentProcedure:
 			memoServiceCreateMemoCommentHandler.ServeHTTP(w, r)
 		case MemoServiceListMemoCommentsProcedure:
@@ -471,6 +756,16 @@ func NewMemoServiceHandler(svc MemoServiceHandler, opts ...connect.HandlerOption
 			memoServiceUpsertMemoReactionHandler.ServeHTTP(w, r)
 		case MemoServiceDeleteMemoReactionProcedure:
 			memoServiceDeleteMemoReactionHandler.ServeHTTP(w, r)
+		case MemoServiceListTasksProcedure:
+			memoServiceListTasksHandler.ServeHTTP(w, r)
+		case MemoServiceToggleTaskProcedure:
+			memoServiceToggleTaskHandler.ServeHTTP(w, r)
+		case MemoServiceListMemoRevisionsProcedure:
+			memoServiceListMemoRevisionsHandler.ServeHTTP(w, r)
+		case MemoServiceGetMemoRevisionProcedure:
+			memoServiceGetMemoRevisionHandler.ServeHTTP(w, r)
+		case MemoServiceRestoreMemoRevisionProcedure:
+			memoServiceRestoreMemoRevisionHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -492,6 +787,10 @@ func (UnimplementedMemoServiceHandler) SearchMemosSemantic(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.SearchMemosSemantic is not implemented"))
 }
 
+func (UnimplementedMemoServiceHandler) SearchMemos(context.Context, *connect.Request[v1.SearchMemosRequest]) (*connect.Response[v1.SearchMemosResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.SearchMemos is not implemented"))
+}
+
 func (UnimplementedMemoServiceHandler) GetMemo(context.Context, *connect.Request[v1.GetMemoRequest]) (*connect.Response[v1.Memo], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.GetMemo is not implemented"))
 }
@@ -520,6 +819,26 @@ func (UnimplementedMemoServiceHandler) ListMemoRelations(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ListMemoRelations is not implemented"))
 }
 
+func (UnimplementedMemoServiceHandler) ListRelatedMemos(context.Context, *connect.Request[v1.ListRelatedMemosRequest]) (*connect.Response[v1.ListRelatedMemosResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ListRelatedMemos is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) ListDuplicateMemoClusters(context.Context, *connect.Request[v1.ListDuplicateMemoClustersRequest]) (*connect.Response[v1.ListDuplicateMemoClustersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ListDuplicateMemoClusters is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) MergeMemos(context.Context, *connect.Request[v1.MergeMemosRequest]) (*connect.Response[v1.Memo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.MergeMemos is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) AcceptMemoEnrichment(context.Context, *connect.Request[v1.AcceptMemoEnrichmentRequest]) (*connect.Response[v1.Memo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.AcceptMemoEnrichment is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) RejectMemoEnrichment(context.Context, *connect.Request[v1.RejectMemoEnrichmentRequest]) (*connect.Response[v1.Memo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.RejectMemoEnrichment is not implemented"))
+}
+
 func (UnimplementedMemoServiceHandler) CreateMemoComment(context.Context, *connect.Request[v1.CreateMemoCommentRequest]) (*connect.Response[v1.Memo], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.CreateMemoComment is not implemented"))
 }
@@ -539,3 +858,23 @@ func (UnimplementedMemoServiceHandler) UpsertMemoReaction(context.Context, *conn
 func (UnimplementedMemoServiceHandler) DeleteMemoReaction(context.Context, *connect.Request[v1.DeleteMemoReactionRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.DeleteMemoReaction is not implemented"))
 }
+
+func (UnimplementedMemoServiceHandler) ListTasks(context.Context, *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ListTasks is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) ToggleTask(context.Context, *connect.Request[v1.ToggleTaskRequest]) (*connect.Response[v1.Task], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ToggleTask is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) ListMemoRevisions(context.Context, *connect.Request[v1.ListMemoRevisionsRequest]) (*connect.Response[v1.ListMemoRevisionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.ListMemoRevisions is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) GetMemoRevision(context.Context, *connect.Request[v1.GetMemoRevisionRequest]) (*connect.Response[v1.MemoRevision], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.GetMemoRevision is not implemented"))
+}
+
+func (UnimplementedMemoServiceHandler) RestoreMemoRevision(context.Context, *connect.Request[v1.RestoreMemoRevisionRequest]) (*connect.Response[v1.Memo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.MemoService.RestoreMemoRevision is not implemented"))
+}
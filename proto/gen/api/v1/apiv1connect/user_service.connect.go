@@ -80,6 +80,12 @@ const (
 	// UserServiceDeleteUserWebhookProcedure is the fully-qualified name of the UserService's
 	// DeleteUserWebhook RPC.
 	UserServiceDeleteUserWebhookProcedure = "/memos.api.v1.UserService/DeleteUserWebhook"
+	// UserServiceListUserWebhookDeliveriesProcedure is the fully-qualified name of the UserService's
+	// ListUserWebhookDeliveries RPC.
+	UserServiceListUserWebhookDeliveriesProcedure = "/memos.api.v1.UserService/ListUserWebhookDeliveries"
+	// UserServiceRedeliverUserWebhookDeliveryProcedure is the fully-qualified name of the UserService's
+	// RedeliverUserWebhookDelivery RPC.
+	UserServiceRedeliverUserWebhookDeliveryProcedure = "/memos.api.v1.UserService/RedeliverUserWebhookDelivery"
 	// UserServiceListUserNotificationsProcedure is the fully-qualified name of the UserService's
 	// ListUserNotifications RPC.
 	UserServiceListUserNotificationsProcedure = "/memos.api.v1.UserService/ListUserNotifications"
@@ -89,6 +95,14 @@ const (
 	// UserServiceDeleteUserNotificationProcedure is the fully-qualified name of the UserService's
 	// DeleteUserNotification RPC.
 	UserServiceDeleteUserNotificationProcedure = "/memos.api.v1.UserService/DeleteUserNotification"
+	// UserServiceExportUserDataProcedure is the fully-qualified name of the UserService's
+	// ExportUserData RPC.
+	UserServiceExportUserDataProcedure = "/memos.api.v1.UserService/ExportUserData"
+	// UserServiceImportUserDataProcedure is the fully-qualified name of the UserService's
+	// ImportUserData RPC.
+	UserServiceImportUserDataProcedure = "/memos.api.v1.UserService/ImportUserData"
+	// UserServiceImportNotesProcedure is the fully-qualified name of the UserService's ImportNotes RPC.
+	UserServiceImportNotesProcedure = "/memos.api.v1.UserService/ImportNotes"
 )
 
 // UserServiceClient is a client for the memos.api.v1.UserService service.
@@ -132,12 +146,23 @@ type UserServiceClient interface {
 	UpdateUserWebhook(context.Context, *connect.Request[v1.UpdateUserWebhookRequest]) (*connect.Response[v1.UserWebhook], error)
 	// DeleteUserWebhook deletes a webhook for a user.
 	DeleteUserWebhook(context.Context, *connect.Request[v1.DeleteUserWebhookRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListUserWebhookDeliveries returns the deliveries of a webhook, newest first.
+	ListUserWebhookDeliveries(context.Context, *connect.Request[v1.ListUserWebhookDeliveriesRequest]) (*connect.Response[v1.ListUserWebhookDeliveriesResponse], error)
+	// RedeliverUserWebhookDelivery sends the request of a delivery again as a new delivery.
+	RedeliverUserWebhookDelivery(context.Context, *connect.Request[v1.RedeliverUserWebhookDeliveryRequest]) (*connect.Response[v1.UserWebhookDelivery], error)
 	// ListUserNotifications lists notifications for a user.
 	ListUserNotifications(context.Context, *connect.Request[v1.ListUserNotificationsRequest]) (*connect.Response[v1.ListUserNotificationsResponse], error)
 	// UpdateUserNotification updates a notification.
 	UpdateUserNotification(context.Context, *connect.Request[v1.UpdateUserNotificationRequest]) (*connect.Response[v1.UserNotification], error)
 	// DeleteUserNotification deletes a notification.
 	DeleteUserNotification(context.Context, *connect.Request[v1.DeleteUserNotificationRequest]) (*connect.Response[emptypb.Empty], error)
+	// ExportUserData exports the memos and attachments of a user as a zip archive.
+	ExportUserData(context.Context, *connect.Request[v1.ExportUserDataRequest]) (*connect.Response[v1.ExportUserDataResponse], error)
+	// ImportUserData imports a zip archive made by ExportUserData into the memos of a user.
+	// Memos and attachments are matched by uid, so importing the same archive again updates them.
+	ImportUserData(context.Context, *connect.Request[v1.ImportUserDataRequest]) (*connect.Response[v1.ImportUserDataResponse], error)
+	// ImportNotes imports the notes exported from another note tool as memos of a user.
+	ImportNotes(context.Context, *connect.Request[v1.ImportNotesRequest]) (*connect.Response[v1.ImportNotesResponse], error)
 }
 
 // NewUserServiceClient constructs a client for the memos.api.v1.UserService service. By default, it
@@ -253,6 +278,18 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("DeleteUserWebhook")),
 			connect.WithClientOptions(opts...),
 		),
+		listUserWebhookDeliveries: connect.NewClient[v1.ListUserWebhookDeliveriesRequest, v1.ListUserWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+UserServiceListUserWebhookDeliveriesProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListUserWebhookDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
+		redeliverUserWebhookDelivery: connect.NewClient[v1.RedeliverUserWebhookDeliveryRequest, v1.UserWebhookDelivery](
+			httpClient,
+			baseURL+UserServiceRedeliverUserWebhookDeliveryProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RedeliverUserWebhookDelivery")),
+			connect.WithClientOptions(opts...),
+		),
 		listUserNotifications: connect.NewClient[v1.ListUserNotificationsRequest, v1.ListUserNotificationsResponse](
 			httpClient,
 			baseURL+UserServiceListUserNotificationsProcedure,
@@ -271,31 +308,54 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("DeleteUserNotification")),
 			connect.WithClientOptions(opts...),
 		),
+		exportUserData: connect.NewClient[v1.ExportUserDataRequest, v1.ExportUserDataResponse](
+			httpClient,
+			baseURL+UserServiceExportUserDataProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ExportUserData")),
+			connect.WithClientOptions(opts...),
+		),
+		importUserData: connect.NewClient[v1.ImportUserDataRequest, v1.ImportUserDataResponse](
+			httpClient,
+			baseURL+UserServiceImportUserDataProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ImportUserData")),
+			connect.WithClientOptions(opts...),
+		),
+		importNotes: connect.NewClient[v1.ImportNotesRequest, v1.ImportNotesResponse](
+			httpClient,
+			baseURL+UserServiceImportNotesProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ImportNotes")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	listUsers                 *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
-	getUser                   *connect.Client[v1.GetUserRequest, v1.User]
-	createUser                *connect.Client[v1.CreateUserRequest, v1.User]
-	updateUser                *connect.Client[v1.UpdateUserRequest, v1.User]
-	deleteUser                *connect.Client[v1.DeleteUserRequest, emptypb.Empty]
-	listAllUserStats          *connect.Client[v1.ListAllUserStatsRequest, v1.ListAllUserStatsResponse]
-	getUserStats              *connect.Client[v1.GetUserStatsRequest, v1.UserStats]
-	getUserSetting            *connect.Client[v1.GetUserSettingRequest, v1.UserSetting]
-	updateUserSetting         *connect.Client[v1.UpdateUserSettingRequest, v1.UserSetting]
-	listUserSettings          *connect.Client[v1.ListUserSettingsRequest, v1.ListUserSettingsResponse]
-	listPersonalAccessTokens  *connect.Client[v1.ListPersonalAccessTokensRequest, v1.ListPersonalAccessTokensResponse]
-	createPersonalAccessToken *connect.Client[v1.CreatePersonalAccessTokenRequest, v1.CreatePersonalAccessTokenResponse]
-	deletePersonalAccessToken *connect.Client[v1.DeletePersonalAccessTokenRequest, emptypb.Empty]
-	listUserWebhooks          *connect.Client[v1.ListUserWebhooksRequest, v1.ListUserWebhooksResponse]
-	createUserWebhook         *connect.Client[v1.CreateUserWebhookRequest, v1.UserWebhook]
-	updateUserWebhook         *connect.Client[v1.UpdateUserWebhookRequest, v1.UserWebhook]
-	deleteUserWebhook         *connect.Client[v1.DeleteUserWebhookRequest, emptypb.Empty]
-	listUserNotifications     *connect.Client[v1.ListUserNotificationsRequest, v1.ListUserNotificationsResponse]
-	updateUserNotification    *connect.Client[v1.UpdateUserNotificationRequest, v1.UserNotification]
-	deleteUserNotification    *connect.Client[v1.DeleteUserNotificationRequest, emptypb.Empty]
+	listUsers                    *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
+	getUser                      *connect.Client[v1.GetUserRequest, v1.User]
+	createUser                   *connect.Client[v1.CreateUserRequest, v1.User]
+	updateUser                   *connect.Client[v1.UpdateUserRequest, v1.User]
+	deleteUser                   *connect.Client[v1.DeleteUserRequest, emptypb.Empty]
+	listAllUserStats             *connect.Client[v1.ListAllUserStatsRequest, v1.ListAllUserStatsResponse]
+	getUserStats                 *connect.Client[v1.GetUserStatsRequest, v1.UserStats]
+	getUserSetting               *connect.Client[v1.GetUserSettingRequest, v1.UserSetting]
+	updateUserSetting            *connect.Client[v1.UpdateUserSettingRequest, v1.UserSetting]
+	listUserSettings             *connect.Client[v1.ListUserSettingsRequest, v1.ListUserSettingsResponse]
+	listPersonalAccessTokens     *connect.Client[v1.ListPersonalAccessTokensRequest, v1.ListPersonalAccessTokensResponse]
+	createPersonalAccessToken    *connect.Client[v1.CreatePersonalAccessTokenRequest, v1.CreatePersonalAccessTokenResponse]
+	deletePersonalAccessToken    *connect.Client[v1.DeletePersonalAccessTokenRequest, emptypb.Empty]
+	listUserWebhooks             *connect.Client[v1.ListUserWebhooksRequest, v1.ListUserWebhooksResponse]
+	createUserWebhook            *connect.Client[v1.CreateUserWebhookRequest, v1.UserWebhook]
+	updateUserWebhook            *connect.Client[v1.UpdateUserWebhookRequest, v1.UserWebhook]
+	deleteUserWebhook            *connect.Client[v1.DeleteUserWebhookRequest, emptypb.Empty]
+	listUserWebhookDeliveries    *connect.Client[v1.ListUserWebhookDeliveriesRequest, v1.ListUserWebhookDeliveriesResponse]
+	redeliverUserWebhookDelivery *connect.Client[v1.RedeliverUserWebhookDeliveryRequest, v1.UserWebhookDelivery]
+	listUserNotifications        *connect.Client[v1.ListUserNotificationsRequest, v1.ListUserNotificationsResponse]
+	updateUserNotification       *connect.Client[v1.UpdateUserNotificationRequest, v1.UserNotification]
+	deleteUserNotification       *connect.Client[v1.DeleteUserNotificationRequest, emptypb.Empty]
+	exportUserData               *connect.Client[v1.ExportUserDataRequest, v1.ExportUserDataResponse]
+	importUserData               *connect.Client[v1.ImportUserDataRequest, v1.ImportUserDataResponse]
+	importNotes                  *connect.Client[v1.ImportNotesRequest, v1.ImportNotesResponse]
 }
 
 // ListUsers calls memos.api.v1.UserService.ListUsers.
@@ -383,6 +443,16 @@ func (c *userServiceClient) DeleteUserWebhook(ctx context.Context, req *connect.
 	return c.deleteUserWebhook.CallUnary(ctx, req)
 }
 
+// ListUserWebhookDeliveries calls memos.api.v1.UserService.ListUserWebhookDeliveries.
+func (c *userServiceClient) ListUserWebhookDeliveries(ctx context.Context, req *connect.Request[v1.ListUserWebhookDeliveriesRequest]) (*connect.Response[v1.ListUserWebhookDeliveriesResponse], error) {
+	return c.listUserWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// RedeliverUserWebhookDelivery calls memos.api.v1.UserService.RedeliverUserWebhookDelivery.
+func (c *userServiceClient) RedeliverUserWebhookDelivery(ctx context.Context, req *connect.Request[v1.RedeliverUserWebhookDeliveryRequest]) (*connect.Response[v1.UserWebhookDelivery], error) {
+	return c.redeliverUserWebhookDelivery.CallUnary(ctx, req)
+}
+
 // ListUserNotifications calls memos.api.v1.UserService.ListUserNotifications.
 func (c *userServiceClient) ListUserNotifications(ctx context.Context, req *connect.Request[v1.ListUserNotificationsRequest]) (*connect.Response[v1.ListUserNotificationsResponse], error) {
 	return c.listUserNotifications.CallUnary(ctx, req)
@@ -398,6 +468,21 @@ func (c *userServiceClient) DeleteUserNotification(ctx context.Context, req *con
 	return c.deleteUserNotification.CallUnary(ctx, req)
 }
 
+// ExportUserData calls memos.api.v1.UserService.ExportUserData.
+func (c *userServiceClient) ExportUserData(ctx context.Context, req *connect.Request[v1.ExportUserDataRequest]) (*connect.Response[v1.ExportUserDataResponse], error) {
+	return c.exportUserData.CallUnary(ctx, req)
+}
+
+// ImportUserData calls memos.api.v1.UserService.ImportUserData.
+func (c *userServiceClient) ImportUserData(ctx context.Context, req *connect.Request[v1.ImportUserDataRequest]) (*connect.Response[v1.ImportUserDataResponse], error) {
+	return c.importUserData.CallUnary(ctx, req)
+}
+
+// ImportNotes calls memos.api.v1.UserService.ImportNotes.
+func (c *userServiceClient) ImportNotes(ctx context.Context, req *connect.Request[v1.ImportNotesRequest]) (*connect.Response[v1.ImportNotesResponse], error) {
+	return c.importNotes.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the memos.api.v1.UserService service.
 type UserServiceHandler interface {
 	// ListUsers returns a list of users.
@@ -439,12 +524,23 @@ type UserServiceHandler interface {
 	UpdateUserWebhook(context.Context, *connect.Request[v1.UpdateUserWebhookRequest]) (*connect.Response[v1.UserWebhook], error)
 	// DeleteUserWebhook deletes a webhook for a user.
 	DeleteUserWebhook(context.Context, *connect.Request[v1.DeleteUserWebhookRequest]) (*connect.Response[emptypb.Empty], error)
+	// ListUserWebhookDeliveries returns the deliveries of a webhook, newest first.
+	ListUserWebhookDeliveries(context.Context, *connect.Request[v1.ListUserWebhookDeliveriesRequest]) (*connect.Response[v1.ListUserWebhookDeliveriesResponse], error)
+	// RedeliverUserWebhookDelivery sends the request of a delivery again as a new delivery.
+	RedeliverUserWebhookDelivery(context.Context, *connect.Request[v1.RedeliverUserWebhookDeliveryRequest]) (*connect.Response[v1.UserWebhookDelivery], error)
 	// ListUserNotifications lists notifications for a user.
 	ListUserNotifications(context.Context, *connect.Request[v1.ListUserNotificationsRequest]) (*connect.Response[v1.ListUserNotificationsResponse], error)
 	// UpdateUserNotification updates a notification.
 	UpdateUserNotification(context.Context, *connect.Request[v1.UpdateUserNotificationRequest]) (*connect.Response[v1.UserNotification], error)
 	// DeleteUserNotification deletes a notification.
 	DeleteUserNotification(context.Context, *connect.Request[v1.DeleteUserNotificationRequest]) (*connect.Response[emptypb.Empty], error)
+	// ExportUserData exports the memos and attachments of a user as a zip archive.
+	ExportUserData(context.Context, *connect.Request[v1.ExportUserDataRequest]) (*connect.Response[v1.ExportUserDataResponse], error)
+	// ImportUserData imports a zip archive made by ExportUserData into the memos of a user.
+	// Memos and attachments are matched by uid, so importing the same archive again updates them.
+	ImportUserData(context.Context, *connect.Request[v1.ImportUserDataRequest]) (*connect.Response[v1.ImportUserDataResponse], error)
+	// ImportNotes imports the notes exported from another note tool as memos of a user.
+	ImportNotes(context.Context, *connect.Request[v1.ImportNotesRequest]) (*connect.Response[v1.ImportNotesResponse], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -556,6 +652,18 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("DeleteUserWebhook")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceListUserWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		UserServiceListUserWebhookDeliveriesProcedure,
+		svc.ListUserWebhookDeliveries,
+		connect.WithSchema(userServiceMethods.ByName("ListUserWebhookDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRedeliverUserWebhookDeliveryHandler := connect.NewUnaryHandler(
+		UserServiceRedeliverUserWebhookDeliveryProcedure,
+		svc.RedeliverUserWebhookDelivery,
+		connect.WithSchema(userServiceMethods.ByName("RedeliverUserWebhookDelivery")),
+		connect.WithHandlerOptions(opts...),
+	)
 	userServiceListUserNotificationsHandler := connect.NewUnaryHandler(
 		UserServiceListUserNotificationsProcedure,
 		svc.ListUserNotifications,
@@ -574,6 +682,24 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("DeleteUserNotification")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceExportUserDataHandler := connect.NewUnaryHandler(
+		UserServiceExportUserDataProcedure,
+		svc.ExportUserData,
+		connect.WithSchema(userServiceMethods.ByName("ExportUserData")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceImportUserDataHandler := connect.NewUnaryHandler(
+		UserServiceImportUserDataProcedure,
+		svc.ImportUserData,
+		connect.WithSchema(userServiceMethods.ByName("ImportUserData")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceImportNotesHandler := connect.NewUnaryHandler(
+		UserServiceImportNotesProcedure,
+		svc.ImportNotes,
+		connect.WithSchema(userServiceMethods.ByName("ImportNotes")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/memos.api.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceListUsersProcedure:
@@ -610,12 +736,22 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceUpdateUserWebhookHandler.ServeHTTP(w, r)
 		case UserServiceDeleteUserWebhookProcedure:
 			userServiceDeleteUserWebhookHandler.ServeHTTP(w, r)
+		case UserServiceListUserWebhookDeliveriesProcedure:
+			userServiceListUserWebhookDeliveriesHandler.ServeHTTP(w, r)
+		case UserServiceRedeliverUserWebhookDeliveryProcedure:
+			userServiceRedeliverUserWebhookDeliveryHandler.ServeHTTP(w, r)
 		case UserServiceListUserNotificationsProcedure:
 			userServiceListUserNotificationsHandler.ServeHTTP(w, r)
 		case UserServiceUpdateUserNotificationProcedure:
 			userServiceUpdateUserNotificationHandler.ServeHTTP(w, r)
 		case UserServiceDeleteUserNotificationProcedure:
 			userServiceDeleteUserNotificationHandler.ServeHTTP(w, r)
+		case UserServiceExportUserDataProcedure:
+			userServiceExportUserDataHandler.ServeHTTP(w, r)
+		case UserServiceImportUserDataProcedure:
+			userServiceImportUserDataHandler.ServeHTTP(w, r)
+		case UserServiceImportNotesProcedure:
+			userServiceImportNotesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -693,6 +829,14 @@ func (UnimplementedUserServiceHandler) DeleteUserWebhook(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DeleteUserWebhook is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) ListUserWebhookDeliveries(context.Context, *connect.Request[v1.ListUserWebhookDeliveriesRequest]) (*connect.Response[v1.ListUserWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListUserWebhookDeliveries is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RedeliverUserWebhookDelivery(context.Context, *connect.Request[v1.RedeliverUserWebhookDeliveryRequest]) (*connect.Response[v1.UserWebhookDelivery], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.RedeliverUserWebhookDelivery is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) ListUserNotifications(context.Context, *connect.Request[v1.ListUserNotificationsRequest]) (*connect.Response[v1.ListUserNotificationsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ListUserNotifications is not implemented"))
 }
@@ -704,3 +848,15 @@ func (UnimplementedUserServiceHandler) UpdateUserNotification(context.Context, *
 func (UnimplementedUserServiceHandler) DeleteUserNotification(context.Context, *connect.Request[v1.DeleteUserNotificationRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.DeleteUserNotification is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) ExportUserData(context.Context, *connect.Request[v1.ExportUserDataRequest]) (*connect.Response[v1.ExportUserDataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ExportUserData is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ImportUserData(context.Context, *connect.Request[v1.ImportUserDataRequest]) (*connect.Response[v1.ImportUserDataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ImportUserData is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ImportNotes(context.Context, *connect.Request[v1.ImportNotesRequest]) (*connect.Response[v1.ImportNotesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("memos.api.v1.UserService.ImportNotes is not implemented"))
+}
@@ -10,7 +10,9 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -36,6 +38,10 @@ const (
 	InstanceSetting_MEMO_RELATED InstanceSetting_Key = 3
 	// AI is the key for AI related settings.
 	InstanceSetting_AI InstanceSetting_Key = 4
+	// EMAIL is the key for email settings.
+	InstanceSetting_EMAIL InstanceSetting_Key = 5
+	// BACKUP is the key for backup settings.
+	InstanceSetting_BACKUP InstanceSetting_Key = 6
 )
 
 // Enum value maps for InstanceSetting_Key.
@@ -46,6 +52,8 @@ var (
 		2: "STORAGE",
 		3: "MEMO_RELATED",
 		4: "AI",
+		5: "EMAIL",
+		6: "BACKUP",
 	}
 	InstanceSetting_Key_value = map[string]int32{
 		"KEY_UNSPECIFIED": 0,
@@ -53,6 +61,8 @@ var (
 		"STORAGE":         2,
 		"MEMO_RELATED":    3,
 		"AI":              4,
+		"EMAIL":           5,
+		"BACKUP":          6,
 	}
 )
 
@@ -139,6 +149,58 @@ func (InstanceSetting_StorageSetting_StorageType) EnumDescriptor() ([]byte, []in
 	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 1, 0}
 }
 
+// Where a backup is written.
+type Backup_Destination int32
+
+const (
+	Backup_DESTINATION_UNSPECIFIED Backup_Destination = 0
+	// LOCAL writes the backup to the backups folder of the data directory.
+	Backup_LOCAL Backup_Destination = 1
+	// S3 uploads the backup to the bucket of the S3 storage config.
+	Backup_S3 Backup_Destination = 2
+)
+
+// Enum value maps for Backup_Destination.
+var (
+	Backup_Destination_name = map[int32]string{
+		0: "DESTINATION_UNSPECIFIED",
+		1: "LOCAL",
+		2: "S3",
+	}
+	Backup_Destination_value = map[string]int32{
+		"DESTINATION_UNSPECIFIED": 0,
+		"LOCAL":                   1,
+		"S3":                      2,
+	}
+)
+
+func (x Backup_Destination) Enum() *Backup_Destination {
+	p := new(Backup_Destination)
+	*p = x
+	return p
+}
+
+func (x Backup_Destination) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Backup_Destination) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_instance_service_proto_enumTypes[2].Descriptor()
+}
+
+func (Backup_Destination) Type() protoreflect.EnumType {
+	return &file_api_v1_instance_service_proto_enumTypes[2]
+}
+
+func (x Backup_Destination) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Backup_Destination.Descriptor instead.
+func (Backup_Destination) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{6, 0}
+}
+
 // Instance profile message containing basic instance information.
 type InstanceProfile struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -262,6 +324,8 @@ type InstanceSetting struct {
 	//	*InstanceSetting_StorageSetting_
 	//	*InstanceSetting_MemoRelatedSetting_
 	//	*InstanceSetting_AiSetting
+	//	*InstanceSetting_EmailSetting_
+	//	*InstanceSetting_BackupSetting_
 	Value         isInstanceSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -347,6 +411,24 @@ func (x *InstanceSetting) GetAiSetting() *InstanceSetting_AISetting {
 	return nil
 }
 
+func (x *InstanceSetting) GetEmailSetting() *InstanceSetting_EmailSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_EmailSetting_); ok {
+			return x.EmailSetting
+		}
+	}
+	return nil
+}
+
+func (x *InstanceSetting) GetBackupSetting() *InstanceSetting_BackupSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_BackupSetting_); ok {
+			return x.BackupSetting
+		}
+	}
+	return nil
+}
+
 type isInstanceSetting_Value interface {
 	isInstanceSetting_Value()
 }
@@ -367,6 +449,14 @@ type InstanceSetting_AiSetting struct {
 	AiSetting *InstanceSetting_AISetting `protobuf:"bytes,5,opt,name=ai_setting,json=aiSetting,proto3,oneof"`
 }
 
+type InstanceSetting_EmailSetting_ struct {
+	EmailSetting *InstanceSetting_EmailSetting `protobuf:"bytes,6,opt,name=email_setting,json=emailSetting,proto3,oneof"`
+}
+
+type InstanceSetting_BackupSetting_ struct {
+	BackupSetting *InstanceSetting_BackupSetting `protobuf:"bytes,7,opt,name=backup_setting,json=backupSetting,proto3,oneof"`
+}
+
 func (*InstanceSetting_GeneralSetting_) isInstanceSetting_Value() {}
 
 func (*InstanceSetting_StorageSetting_) isInstanceSetting_Value() {}
@@ -375,6 +465,10 @@ func (*InstanceSetting_MemoRelatedSetting_) isInstanceSetting_Value() {}
 
 func (*InstanceSetting_AiSetting) isInstanceSetting_Value() {}
 
+func (*InstanceSetting_EmailSetting_) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_BackupSetting_) isInstanceSetting_Value() {}
+
 // Request message for GetInstanceSetting method.
 type GetInstanceSettingRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -477,6 +571,199 @@ func (x *UpdateInstanceSettingRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	return nil
 }
 
+// Request message for SendTestEmail method.
+type SendTestEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The recipient of the test email. Defaults to the email of the current user.
+	Recipient     string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTestEmailRequest) Reset() {
+	*x = SendTestEmailRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTestEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTestEmailRequest) ProtoMessage() {}
+
+func (x *SendTestEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTestEmailRequest.ProtoReflect.Descriptor instead.
+func (*SendTestEmailRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SendTestEmailRequest) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+// A backup archive of the instance.
+type Backup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the backup.
+	// Format: instance/backups/{filename}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The destination the backup was written to.
+	Destination Backup_Destination `protobuf:"varint,2,opt,name=destination,proto3,enum=memos.api.v1.Backup_Destination" json:"destination,omitempty"`
+	// The location of the backup: the file path for LOCAL, the object key for S3.
+	Location string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	// The size of the backup in bytes.
+	Size int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// The time the snapshot was taken.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The number of local attachment files bundled in the backup.
+	FileCount int32 `protobuf:"varint,6,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
+	// The names of the older backups deleted by the retention.
+	DeletedBackups []string `protobuf:"bytes,7,rep,name=deleted_backups,json=deletedBackups,proto3" json:"deleted_backups,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Backup) Reset() {
+	*x = Backup{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Backup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Backup) ProtoMessage() {}
+
+func (x *Backup) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Backup.ProtoReflect.Descriptor instead.
+func (*Backup) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Backup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Backup) GetDestination() Backup_Destination {
+	if x != nil {
+		return x.Destination
+	}
+	return Backup_DESTINATION_UNSPECIFIED
+}
+
+func (x *Backup) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Backup) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Backup) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Backup) GetFileCount() int32 {
+	if x != nil {
+		return x.FileCount
+	}
+	return 0
+}
+
+func (x *Backup) GetDeletedBackups() []string {
+	if x != nil {
+		return x.DeletedBackups
+	}
+	return nil
+}
+
+// Request message for CreateBackup method.
+type CreateBackupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The destination of the backup. Defaults to the destination of the backup setting.
+	Destination   Backup_Destination `protobuf:"varint,1,opt,name=destination,proto3,enum=memos.api.v1.Backup_Destination" json:"destination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBackupRequest) Reset() {
+	*x = CreateBackupRequest{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBackupRequest) ProtoMessage() {}
+
+func (x *CreateBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBackupRequest.ProtoReflect.Descriptor instead.
+func (*CreateBackupRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateBackupRequest) GetDestination() Backup_Destination {
+	if x != nil {
+		return x.Destination
+	}
+	return Backup_DESTINATION_UNSPECIFIED
+}
+
 // General instance settings configuration.
 type InstanceSetting_GeneralSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -504,7 +791,7 @@ type InstanceSetting_GeneralSetting struct {
 
 func (x *InstanceSetting_GeneralSetting) Reset() {
 	*x = InstanceSetting_GeneralSetting{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[5]
+	mi := &file_api_v1_instance_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -516,7 +803,7 @@ func (x *InstanceSetting_GeneralSetting) String() string {
 func (*InstanceSetting_GeneralSetting) ProtoMessage() {}
 
 func (x *InstanceSetting_GeneralSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[5]
+	mi := &file_api_v1_instance_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -606,7 +893,7 @@ type InstanceSetting_StorageSetting struct {
 
 func (x *InstanceSetting_StorageSetting) Reset() {
 	*x = InstanceSetting_StorageSetting{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[6]
+	mi := &file_api_v1_instance_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -618,7 +905,7 @@ func (x *InstanceSetting_StorageSetting) String() string {
 func (*InstanceSetting_StorageSetting) ProtoMessage() {}
 
 func (x *InstanceSetting_StorageSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[6]
+	mi := &file_api_v1_instance_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -674,14 +961,19 @@ type InstanceSetting_MemoRelatedSetting struct {
 	// enable_double_click_edit enables editing on double click.
 	EnableDoubleClickEdit bool `protobuf:"varint,4,opt,name=enable_double_click_edit,json=enableDoubleClickEdit,proto3" json:"enable_double_click_edit,omitempty"`
 	// reactions is the list of reactions.
-	Reactions     []string `protobuf:"bytes,7,rep,name=reactions,proto3" json:"reactions,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Reactions []string `protobuf:"bytes,7,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// disable_memo_revisions stops recording the prior versions of memos on update.
+	DisableMemoRevisions bool `protobuf:"varint,8,opt,name=disable_memo_revisions,json=disableMemoRevisions,proto3" json:"disable_memo_revisions,omitempty"`
+	// memo_revision_limit is the number of revisions kept per memo, the oldest ones are deleted beyond it.
+	// Defaults to 50.
+	MemoRevisionLimit int32 `protobuf:"varint,9,opt,name=memo_revision_limit,json=memoRevisionLimit,proto3" json:"memo_revision_limit,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *InstanceSetting_MemoRelatedSetting) Reset() {
 	*x = InstanceSetting_MemoRelatedSetting{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[7]
+	mi := &file_api_v1_instance_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -693,7 +985,7 @@ func (x *InstanceSetting_MemoRelatedSetting) String() string {
 func (*InstanceSetting_MemoRelatedSetting) ProtoMessage() {}
 
 func (x *InstanceSetting_MemoRelatedSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[7]
+	mi := &file_api_v1_instance_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -744,6 +1036,20 @@ func (x *InstanceSetting_MemoRelatedSetting) GetReactions() []string {
 	return nil
 }
 
+func (x *InstanceSetting_MemoRelatedSetting) GetDisableMemoRevisions() bool {
+	if x != nil {
+		return x.DisableMemoRevisions
+	}
+	return false
+}
+
+func (x *InstanceSetting_MemoRelatedSetting) GetMemoRevisionLimit() int32 {
+	if x != nil {
+		return x.MemoRevisionLimit
+	}
+	return 0
+}
+
 // AI configuration settings for semantic search.
 type InstanceSetting_AISetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -786,13 +1092,22 @@ type InstanceSetting_AISetting struct {
 	// trigger_semantic_reindex starts a background reindex task when set to true in update request.
 	// This field is write-only and is not persisted.
 	TriggerSemanticReindex bool `protobuf:"varint,17,opt,name=trigger_semantic_reindex,json=triggerSemanticReindex,proto3" json:"trigger_semantic_reindex,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	// embedding_provider is the name of the embedding provider: "openai" calls an OpenAI-compatible API,
+	// "local" embeds in process without network access.
+	// Empty means using backend environment value or "openai".
+	EmbeddingProvider string `protobuf:"bytes,18,opt,name=embedding_provider,json=embeddingProvider,proto3" json:"embedding_provider,omitempty"`
+	// openai_completion_model is the chat completion model used to enrich memos.
+	// Empty means using backend environment value or "gpt-4o-mini".
+	OpenaiCompletionModel string `protobuf:"bytes,19,opt,name=openai_completion_model,json=openaiCompletionModel,proto3" json:"openai_completion_model,omitempty"`
+	// memo_enrichment_enabled enables generating summaries, tag suggestions and categories on memo save.
+	MemoEnrichmentEnabled bool `protobuf:"varint,20,opt,name=memo_enrichment_enabled,json=memoEnrichmentEnabled,proto3" json:"memo_enrichment_enabled,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *InstanceSetting_AISetting) Reset() {
 	*x = InstanceSetting_AISetting{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[8]
+	mi := &file_api_v1_instance_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -804,7 +1119,7 @@ func (x *InstanceSetting_AISetting) String() string {
 func (*InstanceSetting_AISetting) ProtoMessage() {}
 
 func (x *InstanceSetting_AISetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[8]
+	mi := &file_api_v1_instance_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -939,6 +1254,221 @@ func (x *InstanceSetting_AISetting) GetTriggerSemanticReindex() bool {
 	return false
 }
 
+func (x *InstanceSetting_AISetting) GetEmbeddingProvider() string {
+	if x != nil {
+		return x.EmbeddingProvider
+	}
+	return ""
+}
+
+func (x *InstanceSetting_AISetting) GetOpenaiCompletionModel() string {
+	if x != nil {
+		return x.OpenaiCompletionModel
+	}
+	return ""
+}
+
+func (x *InstanceSetting_AISetting) GetMemoEnrichmentEnabled() bool {
+	if x != nil {
+		return x.MemoEnrichmentEnabled
+	}
+	return false
+}
+
+// Email configuration settings for SMTP.
+type InstanceSetting_EmailSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// smtp_host is the host of the SMTP server. Emails are disabled when empty.
+	SmtpHost string `protobuf:"bytes,1,opt,name=smtp_host,json=smtpHost,proto3" json:"smtp_host,omitempty"`
+	// smtp_port is the port of the SMTP server.
+	SmtpPort int32 `protobuf:"varint,2,opt,name=smtp_port,json=smtpPort,proto3" json:"smtp_port,omitempty"`
+	// smtp_username is the username of the SMTP server.
+	SmtpUsername string `protobuf:"bytes,3,opt,name=smtp_username,json=smtpUsername,proto3" json:"smtp_username,omitempty"`
+	// smtp_password is write-only plain text password from frontend.
+	// It is encrypted before being persisted by backend.
+	SmtpPassword string `protobuf:"bytes,4,opt,name=smtp_password,json=smtpPassword,proto3" json:"smtp_password,omitempty"`
+	// smtp_password_set indicates if backend already has a stored password.
+	SmtpPasswordSet bool `protobuf:"varint,5,opt,name=smtp_password_set,json=smtpPasswordSet,proto3" json:"smtp_password_set,omitempty"`
+	// clear_smtp_password clears stored password when true.
+	ClearSmtpPassword bool `protobuf:"varint,6,opt,name=clear_smtp_password,json=clearSmtpPassword,proto3" json:"clear_smtp_password,omitempty"`
+	// from_email is the sender email address.
+	FromEmail string `protobuf:"bytes,7,opt,name=from_email,json=fromEmail,proto3" json:"from_email,omitempty"`
+	// from_name is the sender display name.
+	FromName string `protobuf:"bytes,8,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
+	// use_tls upgrades the connection with STARTTLS.
+	UseTls bool `protobuf:"varint,9,opt,name=use_tls,json=useTls,proto3" json:"use_tls,omitempty"`
+	// use_ssl connects with implicit TLS.
+	UseSsl        bool `protobuf:"varint,10,opt,name=use_ssl,json=useSsl,proto3" json:"use_ssl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_EmailSetting) Reset() {
+	*x = InstanceSetting_EmailSetting{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_EmailSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_EmailSetting) ProtoMessage() {}
+
+func (x *InstanceSetting_EmailSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_EmailSetting.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_EmailSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 4}
+}
+
+func (x *InstanceSetting_EmailSetting) GetSmtpHost() string {
+	if x != nil {
+		return x.SmtpHost
+	}
+	return ""
+}
+
+func (x *InstanceSetting_EmailSetting) GetSmtpPort() int32 {
+	if x != nil {
+		return x.SmtpPort
+	}
+	return 0
+}
+
+func (x *InstanceSetting_EmailSetting) GetSmtpUsername() string {
+	if x != nil {
+		return x.SmtpUsername
+	}
+	return ""
+}
+
+func (x *InstanceSetting_EmailSetting) GetSmtpPassword() string {
+	if x != nil {
+		return x.SmtpPassword
+	}
+	return ""
+}
+
+func (x *InstanceSetting_EmailSetting) GetSmtpPasswordSet() bool {
+	if x != nil {
+		return x.SmtpPasswordSet
+	}
+	return false
+}
+
+func (x *InstanceSetting_EmailSetting) GetClearSmtpPassword() bool {
+	if x != nil {
+		return x.ClearSmtpPassword
+	}
+	return false
+}
+
+func (x *InstanceSetting_EmailSetting) GetFromEmail() string {
+	if x != nil {
+		return x.FromEmail
+	}
+	return ""
+}
+
+func (x *InstanceSetting_EmailSetting) GetFromName() string {
+	if x != nil {
+		return x.FromName
+	}
+	return ""
+}
+
+func (x *InstanceSetting_EmailSetting) GetUseTls() bool {
+	if x != nil {
+		return x.UseTls
+	}
+	return false
+}
+
+func (x *InstanceSetting_EmailSetting) GetUseSsl() bool {
+	if x != nil {
+		return x.UseSsl
+	}
+	return false
+}
+
+// Backup configuration settings.
+type InstanceSetting_BackupSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schedule is the cron expression of the scheduled backups, in the server time zone.
+	// Scheduled backups are disabled when empty.
+	Schedule string `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// destination is where the backups are written. Defaults to LOCAL.
+	Destination Backup_Destination `protobuf:"varint,2,opt,name=destination,proto3,enum=memos.api.v1.Backup_Destination" json:"destination,omitempty"`
+	// retention_count is the number of backups kept in the destination, the older ones are deleted after a backup.
+	// Value <= 0 keeps all the backups.
+	RetentionCount int32 `protobuf:"varint,3,opt,name=retention_count,json=retentionCount,proto3" json:"retention_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InstanceSetting_BackupSetting) Reset() {
+	*x = InstanceSetting_BackupSetting{}
+	mi := &file_api_v1_instance_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceSetting_BackupSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSetting_BackupSetting) ProtoMessage() {}
+
+func (x *InstanceSetting_BackupSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_instance_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSetting_BackupSetting.ProtoReflect.Descriptor instead.
+func (*InstanceSetting_BackupSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_instance_service_proto_rawDescGZIP(), []int{2, 5}
+}
+
+func (x *InstanceSetting_BackupSetting) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *InstanceSetting_BackupSetting) GetDestination() Backup_Destination {
+	if x != nil {
+		return x.Destination
+	}
+	return Backup_DESTINATION_UNSPECIFIED
+}
+
+func (x *InstanceSetting_BackupSetting) GetRetentionCount() int32 {
+	if x != nil {
+		return x.RetentionCount
+	}
+	return 0
+}
+
 // Custom profile configuration for instance branding.
 type InstanceSetting_GeneralSetting_CustomProfile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -951,7 +1481,7 @@ type InstanceSetting_GeneralSetting_CustomProfile struct {
 
 func (x *InstanceSetting_GeneralSetting_CustomProfile) Reset() {
 	*x = InstanceSetting_GeneralSetting_CustomProfile{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[9]
+	mi := &file_api_v1_instance_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -963,7 +1493,7 @@ func (x *InstanceSetting_GeneralSetting_CustomProfile) String() string {
 func (*InstanceSetting_GeneralSetting_CustomProfile) ProtoMessage() {}
 
 func (x *InstanceSetting_GeneralSetting_CustomProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[9]
+	mi := &file_api_v1_instance_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1016,7 +1546,7 @@ type InstanceSetting_StorageSetting_S3Config struct {
 
 func (x *InstanceSetting_StorageSetting_S3Config) Reset() {
 	*x = InstanceSetting_StorageSetting_S3Config{}
-	mi := &file_api_v1_instance_service_proto_msgTypes[10]
+	mi := &file_api_v1_instance_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1028,7 +1558,7 @@ func (x *InstanceSetting_StorageSetting_S3Config) String() string {
 func (*InstanceSetting_StorageSetting_S3Config) ProtoMessage() {}
 
 func (x *InstanceSetting_StorageSetting_S3Config) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_instance_service_proto_msgTypes[10]
+	mi := &file_api_v1_instance_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1090,20 +1620,22 @@ var File_api_v1_instance_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_instance_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1dapi/v1/instance_service.proto\x12\fmemos.api.v1\x1a\x19api/v1/user_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\"\x8c\x01\n" +
+	"\x1dapi/v1/instance_service.proto\x12\fmemos.api.v1\x1a\x19api/v1/user_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8c\x01\n" +
 	"\x0fInstanceProfile\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x12\n" +
 	"\x04demo\x18\x03 \x01(\bR\x04demo\x12!\n" +
 	"\finstance_url\x18\x06 \x01(\tR\vinstanceUrl\x12(\n" +
 	"\x05admin\x18\a \x01(\v2\x12.memos.api.v1.UserR\x05admin\"\x1b\n" +
-	"\x19GetInstanceProfileRequest\"\xb4\x17\n" +
+	"\x19GetInstanceProfileRequest\"\xf3\x1e\n" +
 	"\x0fInstanceSetting\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12W\n" +
 	"\x0fgeneral_setting\x18\x02 \x01(\v2,.memos.api.v1.InstanceSetting.GeneralSettingH\x00R\x0egeneralSetting\x12W\n" +
 	"\x0fstorage_setting\x18\x03 \x01(\v2,.memos.api.v1.InstanceSetting.StorageSettingH\x00R\x0estorageSetting\x12d\n" +
 	"\x14memo_related_setting\x18\x04 \x01(\v20.memos.api.v1.InstanceSetting.MemoRelatedSettingH\x00R\x12memoRelatedSetting\x12H\n" +
 	"\n" +
-	"ai_setting\x18\x05 \x01(\v2'.memos.api.v1.InstanceSetting.AISettingH\x00R\taiSetting\x1a\xca\x04\n" +
+	"ai_setting\x18\x05 \x01(\v2'.memos.api.v1.InstanceSetting.AISettingH\x00R\taiSetting\x12Q\n" +
+	"\remail_setting\x18\x06 \x01(\v2*.memos.api.v1.InstanceSetting.EmailSettingH\x00R\femailSetting\x12T\n" +
+	"\x0ebackup_setting\x18\a \x01(\v2+.memos.api.v1.InstanceSetting.BackupSettingH\x00R\rbackupSetting\x1a\xca\x04\n" +
 	"\x0eGeneralSetting\x12<\n" +
 	"\x1adisallow_user_registration\x18\x02 \x01(\bR\x18disallowUserRegistration\x124\n" +
 	"\x16disallow_password_auth\x18\x03 \x01(\bR\x14disallowPasswordAuth\x12+\n" +
@@ -1133,13 +1665,15 @@ const file_api_v1_instance_service_proto_rawDesc = "" +
 	"\x18STORAGE_TYPE_UNSPECIFIED\x10\x00\x12\f\n" +
 	"\bDATABASE\x10\x01\x12\t\n" +
 	"\x05LOCAL\x10\x02\x12\x06\n" +
-	"\x02S3\x10\x03\x1a\x94\x02\n" +
+	"\x02S3\x10\x03\x1a\xfa\x02\n" +
 	"\x12MemoRelatedSetting\x12<\n" +
 	"\x1adisallow_public_visibility\x18\x01 \x01(\bR\x18disallowPublicVisibility\x127\n" +
 	"\x18display_with_update_time\x18\x02 \x01(\bR\x15displayWithUpdateTime\x120\n" +
 	"\x14content_length_limit\x18\x03 \x01(\x05R\x12contentLengthLimit\x127\n" +
 	"\x18enable_double_click_edit\x18\x04 \x01(\bR\x15enableDoubleClickEdit\x12\x1c\n" +
-	"\treactions\x18\a \x03(\tR\treactions\x1a\xc6\a\n" +
+	"\treactions\x18\a \x03(\tR\treactions\x124\n" +
+	"\x16disable_memo_revisions\x18\b \x01(\bR\x14disableMemoRevisions\x12.\n" +
+	"\x13memo_revision_limit\x18\t \x01(\x05R\x11memoRevisionLimit\x1a\xe5\b\n" +
 	"\tAISetting\x12&\n" +
 	"\x0fopenai_base_url\x18\x01 \x01(\tR\ropenaiBaseUrl\x124\n" +
 	"\x16openai_embedding_model\x18\x02 \x01(\tR\x14openaiEmbeddingModel\x12$\n" +
@@ -1158,13 +1692,36 @@ const file_api_v1_instance_service_proto_rawDesc = "" +
 	"\x1bsemantic_reindex_started_ts\x18\x0e \x01(\x03R\x18semanticReindexStartedTs\x12=\n" +
 	"\x1bsemantic_reindex_updated_ts\x18\x0f \x01(\x03R\x18semanticReindexUpdatedTs\x124\n" +
 	"\x16semantic_reindex_model\x18\x10 \x01(\tR\x14semanticReindexModel\x128\n" +
-	"\x18trigger_semantic_reindex\x18\x11 \x01(\bR\x16triggerSemanticReindex\"N\n" +
+	"\x18trigger_semantic_reindex\x18\x11 \x01(\bR\x16triggerSemanticReindex\x12-\n" +
+	"\x12embedding_provider\x18\x12 \x01(\tR\x11embeddingProvider\x126\n" +
+	"\x17openai_completion_model\x18\x13 \x01(\tR\x15openaiCompletionModel\x126\n" +
+	"\x17memo_enrichment_enabled\x18\x14 \x01(\bR\x15memoEnrichmentEnabled\x1a\xdc\x02\n" +
+	"\fEmailSetting\x12\x1b\n" +
+	"\tsmtp_host\x18\x01 \x01(\tR\bsmtpHost\x12\x1b\n" +
+	"\tsmtp_port\x18\x02 \x01(\x05R\bsmtpPort\x12#\n" +
+	"\rsmtp_username\x18\x03 \x01(\tR\fsmtpUsername\x12#\n" +
+	"\rsmtp_password\x18\x04 \x01(\tR\fsmtpPassword\x12*\n" +
+	"\x11smtp_password_set\x18\x05 \x01(\bR\x0fsmtpPasswordSet\x12.\n" +
+	"\x13clear_smtp_password\x18\x06 \x01(\bR\x11clearSmtpPassword\x12\x1d\n" +
+	"\n" +
+	"from_email\x18\a \x01(\tR\tfromEmail\x12\x1b\n" +
+	"\tfrom_name\x18\b \x01(\tR\bfromName\x12\x17\n" +
+	"\ause_tls\x18\t \x01(\bR\x06useTls\x12\x17\n" +
+	"\ause_ssl\x18\n" +
+	" \x01(\bR\x06useSsl\x1a\x98\x01\n" +
+	"\rBackupSetting\x12\x1a\n" +
+	"\bschedule\x18\x01 \x01(\tR\bschedule\x12B\n" +
+	"\vdestination\x18\x02 \x01(\x0e2 .memos.api.v1.Backup.DestinationR\vdestination\x12'\n" +
+	"\x0fretention_count\x18\x03 \x01(\x05R\x0eretentionCount\"e\n" +
 	"\x03Key\x12\x13\n" +
 	"\x0fKEY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aGENERAL\x10\x01\x12\v\n" +
 	"\aSTORAGE\x10\x02\x12\x10\n" +
 	"\fMEMO_RELATED\x10\x03\x12\x06\n" +
-	"\x02AI\x10\x04:a\xeaA^\n" +
+	"\x02AI\x10\x04\x12\t\n" +
+	"\x05EMAIL\x10\x05\x12\n" +
+	"\n" +
+	"\x06BACKUP\x10\x06:a\xeaA^\n" +
 	"\x1cmemos.api.v1/InstanceSetting\x12\x1binstance/settings/{setting}*\x10instanceSettings2\x0finstanceSettingB\a\n" +
 	"\x05value\"U\n" +
 	"\x19GetInstanceSettingRequest\x128\n" +
@@ -1173,11 +1730,31 @@ const file_api_v1_instance_service_proto_rawDesc = "" +
 	"\x1cUpdateInstanceSettingRequest\x12<\n" +
 	"\asetting\x18\x01 \x01(\v2\x1d.memos.api.v1.InstanceSettingB\x03\xe0A\x02R\asetting\x12@\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\n" +
-	"updateMask2\xdb\x03\n" +
+	"updateMask\"9\n" +
+	"\x14SendTestEmailRequest\x12!\n" +
+	"\trecipient\x18\x01 \x01(\tB\x03\xe0A\x01R\trecipient\"\xfa\x02\n" +
+	"\x06Backup\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x12G\n" +
+	"\vdestination\x18\x02 \x01(\x0e2 .memos.api.v1.Backup.DestinationB\x03\xe0A\x03R\vdestination\x12\x1f\n" +
+	"\blocation\x18\x03 \x01(\tB\x03\xe0A\x03R\blocation\x12\x17\n" +
+	"\x04size\x18\x04 \x01(\x03B\x03\xe0A\x03R\x04size\x12@\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12\"\n" +
+	"\n" +
+	"file_count\x18\x06 \x01(\x05B\x03\xe0A\x03R\tfileCount\x12,\n" +
+	"\x0fdeleted_backups\x18\a \x03(\tB\x03\xe0A\x03R\x0edeletedBackups\"=\n" +
+	"\vDestination\x12\x1b\n" +
+	"\x17DESTINATION_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05LOCAL\x10\x01\x12\x06\n" +
+	"\x02S3\x10\x02\"^\n" +
+	"\x13CreateBackupRequest\x12G\n" +
+	"\vdestination\x18\x01 \x01(\x0e2 .memos.api.v1.Backup.DestinationB\x03\xe0A\x01R\vdestination2\xd1\x05\n" +
 	"\x0fInstanceService\x12~\n" +
 	"\x12GetInstanceProfile\x12'.memos.api.v1.GetInstanceProfileRequest\x1a\x1d.memos.api.v1.InstanceProfile\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/instance/profile\x12\x8f\x01\n" +
 	"\x12GetInstanceSetting\x12'.memos.api.v1.GetInstanceSettingRequest\x1a\x1d.memos.api.v1.InstanceSetting\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=instance/settings/*}\x12\xb5\x01\n" +
-	"\x15UpdateInstanceSetting\x12*.memos.api.v1.UpdateInstanceSettingRequest\x1a\x1d.memos.api.v1.InstanceSetting\"Q\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x025:\asetting2*/api/v1/{setting.name=instance/settings/*}B\xac\x01\n" +
+	"\x15UpdateInstanceSetting\x12*.memos.api.v1.UpdateInstanceSettingRequest\x1a\x1d.memos.api.v1.InstanceSetting\"Q\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x025:\asetting2*/api/v1/{setting.name=instance/settings/*}\x12\x85\x01\n" +
+	"\rSendTestEmail\x12\".memos.api.v1.SendTestEmailRequest\x1a\x16.google.protobuf.Empty\"8\x82\xd3\xe4\x93\x022:\x01*\"-/api/v1/instance/settings/EMAIL:sendTestEmail\x12l\n" +
+	"\fCreateBackup\x12!.memos.api.v1.CreateBackupRequest\x1a\x14.memos.api.v1.Backup\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/instance/backupsB\xac\x01\n" +
 	"\x10com.memos.api.v1B\x14InstanceServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -1192,47 +1769,65 @@ func file_api_v1_instance_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_instance_service_proto_rawDescData
 }
 
-var file_api_v1_instance_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_instance_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_api_v1_instance_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_v1_instance_service_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_api_v1_instance_service_proto_goTypes = []any{
 	(InstanceSetting_Key)(0),                             // 0: memos.api.v1.InstanceSetting.Key
 	(InstanceSetting_StorageSetting_StorageType)(0),      // 1: memos.api.v1.InstanceSetting.StorageSetting.StorageType
-	(*InstanceProfile)(nil),                              // 2: memos.api.v1.InstanceProfile
-	(*GetInstanceProfileRequest)(nil),                    // 3: memos.api.v1.GetInstanceProfileRequest
-	(*InstanceSetting)(nil),                              // 4: memos.api.v1.InstanceSetting
-	(*GetInstanceSettingRequest)(nil),                    // 5: memos.api.v1.GetInstanceSettingRequest
-	(*UpdateInstanceSettingRequest)(nil),                 // 6: memos.api.v1.UpdateInstanceSettingRequest
-	(*InstanceSetting_GeneralSetting)(nil),               // 7: memos.api.v1.InstanceSetting.GeneralSetting
-	(*InstanceSetting_StorageSetting)(nil),               // 8: memos.api.v1.InstanceSetting.StorageSetting
-	(*InstanceSetting_MemoRelatedSetting)(nil),           // 9: memos.api.v1.InstanceSetting.MemoRelatedSetting
-	(*InstanceSetting_AISetting)(nil),                    // 10: memos.api.v1.InstanceSetting.AISetting
-	(*InstanceSetting_GeneralSetting_CustomProfile)(nil), // 11: memos.api.v1.InstanceSetting.GeneralSetting.CustomProfile
-	(*InstanceSetting_StorageSetting_S3Config)(nil),      // 12: memos.api.v1.InstanceSetting.StorageSetting.S3Config
-	(*User)(nil),                  // 13: memos.api.v1.User
-	(*fieldmaskpb.FieldMask)(nil), // 14: google.protobuf.FieldMask
+	(Backup_Destination)(0),                              // 2: memos.api.v1.Backup.Destination
+	(*InstanceProfile)(nil),                              // 3: memos.api.v1.InstanceProfile
+	(*GetInstanceProfileRequest)(nil),                    // 4: memos.api.v1.GetInstanceProfileRequest
+	(*InstanceSetting)(nil),                              // 5: memos.api.v1.InstanceSetting
+	(*GetInstanceSettingRequest)(nil),                    // 6: memos.api.v1.GetInstanceSettingRequest
+	(*UpdateInstanceSettingRequest)(nil),                 // 7: memos.api.v1.UpdateInstanceSettingRequest
+	(*SendTestEmailRequest)(nil),                         // 8: memos.api.v1.SendTestEmailRequest
+	(*Backup)(nil),                                       // 9: memos.api.v1.Backup
+	(*CreateBackupRequest)(nil),                          // 10: memos.api.v1.CreateBackupRequest
+	(*InstanceSetting_GeneralSetting)(nil),               // 11: memos.api.v1.InstanceSetting.GeneralSetting
+	(*InstanceSetting_StorageSetting)(nil),               // 12: memos.api.v1.InstanceSetting.StorageSetting
+	(*InstanceSetting_MemoRelatedSetting)(nil),           // 13: memos.api.v1.InstanceSetting.MemoRelatedSetting
+	(*InstanceSetting_AISetting)(nil),                    // 14: memos.api.v1.InstanceSetting.AISetting
+	(*InstanceSetting_EmailSetting)(nil),                 // 15: memos.api.v1.InstanceSetting.EmailSetting
+	(*InstanceSetting_BackupSetting)(nil),                // 16: memos.api.v1.InstanceSetting.BackupSetting
+	(*InstanceSetting_GeneralSetting_CustomProfile)(nil), // 17: memos.api.v1.InstanceSetting.GeneralSetting.CustomProfile
+	(*InstanceSetting_StorageSetting_S3Config)(nil),      // 18: memos.api.v1.InstanceSetting.StorageSetting.S3Config
+	(*User)(nil),                  // 19: memos.api.v1.User
+	(*fieldmaskpb.FieldMask)(nil), // 20: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil), // 21: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 22: google.protobuf.Empty
 }
 var file_api_v1_instance_service_proto_depIdxs = []int32{
-	13, // 0: memos.api.v1.InstanceProfile.admin:type_name -> memos.api.v1.User
-	7,  // 1: memos.api.v1.InstanceSetting.general_setting:type_name -> memos.api.v1.InstanceSetting.GeneralSetting
-	8,  // 2: memos.api.v1.InstanceSetting.storage_setting:type_name -> memos.api.v1.InstanceSetting.StorageSetting
-	9,  // 3: memos.api.v1.InstanceSetting.memo_related_setting:type_name -> memos.api.v1.InstanceSetting.MemoRelatedSetting
-	10, // 4: memos.api.v1.InstanceSetting.ai_setting:type_name -> memos.api.v1.InstanceSetting.AISetting
-	4,  // 5: memos.api.v1.UpdateInstanceSettingRequest.setting:type_name -> memos.api.v1.InstanceSetting
-	14, // 6: memos.api.v1.UpdateInstanceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	11, // 7: memos.api.v1.InstanceSetting.GeneralSetting.custom_profile:type_name -> memos.api.v1.InstanceSetting.GeneralSetting.CustomProfile
-	1,  // 8: memos.api.v1.InstanceSetting.StorageSetting.storage_type:type_name -> memos.api.v1.InstanceSetting.StorageSetting.StorageType
-	12, // 9: memos.api.v1.InstanceSetting.StorageSetting.s3_config:type_name -> memos.api.v1.InstanceSetting.StorageSetting.S3Config
-	3,  // 10: memos.api.v1.InstanceService.GetInstanceProfile:input_type -> memos.api.v1.GetInstanceProfileRequest
-	5,  // 11: memos.api.v1.InstanceService.GetInstanceSetting:input_type -> memos.api.v1.GetInstanceSettingRequest
-	6,  // 12: memos.api.v1.InstanceService.UpdateInstanceSetting:input_type -> memos.api.v1.UpdateInstanceSettingRequest
-	2,  // 13: memos.api.v1.InstanceService.GetInstanceProfile:output_type -> memos.api.v1.InstanceProfile
-	4,  // 14: memos.api.v1.InstanceService.GetInstanceSetting:output_type -> memos.api.v1.InstanceSetting
-	4,  // 15: memos.api.v1.InstanceService.UpdateInstanceSetting:output_type -> memos.api.v1.InstanceSetting
-	13, // [13:16] is the sub-list for method output_type
-	10, // [10:13] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	19, // 0: memos.api.v1.InstanceProfile.admin:type_name -> memos.api.v1.User
+	11, // 1: memos.api.v1.InstanceSetting.general_setting:type_name -> memos.api.v1.InstanceSetting.GeneralSetting
+	12, // 2: memos.api.v1.InstanceSetting.storage_setting:type_name -> memos.api.v1.InstanceSetting.StorageSetting
+	13, // 3: memos.api.v1.InstanceSetting.memo_related_setting:type_name -> memos.api.v1.InstanceSetting.MemoRelatedSetting
+	14, // 4: memos.api.v1.InstanceSetting.ai_setting:type_name -> memos.api.v1.InstanceSetting.AISetting
+	15, // 5: memos.api.v1.InstanceSetting.email_setting:type_name -> memos.api.v1.InstanceSetting.EmailSetting
+	16, // 6: memos.api.v1.InstanceSetting.backup_setting:type_name -> memos.api.v1.InstanceSetting.BackupSetting
+	5,  // 7: memos.api.v1.UpdateInstanceSettingRequest.setting:type_name -> memos.api.v1.InstanceSetting
+	20, // 8: memos.api.v1.UpdateInstanceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,  // 9: memos.api.v1.Backup.destination:type_name -> memos.api.v1.Backup.Destination
+	21, // 10: memos.api.v1.Backup.create_time:type_name -> google.protobuf.Timestamp
+	2,  // 11: memos.api.v1.CreateBackupRequest.destination:type_name -> memos.api.v1.Backup.Destination
+	17, // 12: memos.api.v1.InstanceSetting.GeneralSetting.custom_profile:type_name -> memos.api.v1.InstanceSetting.GeneralSetting.CustomProfile
+	1,  // 13: memos.api.v1.InstanceSetting.StorageSetting.storage_type:type_name -> memos.api.v1.InstanceSetting.StorageSetting.StorageType
+	18, // 14: memos.api.v1.InstanceSetting.StorageSetting.s3_config:type_name -> memos.api.v1.InstanceSetting.StorageSetting.S3Config
+	2,  // 15: memos.api.v1.InstanceSetting.BackupSetting.destination:type_name -> memos.api.v1.Backup.Destination
+	4,  // 16: memos.api.v1.InstanceService.GetInstanceProfile:input_type -> memos.api.v1.GetInstanceProfileRequest
+	6,  // 17: memos.api.v1.InstanceService.GetInstanceSetting:input_type -> memos.api.v1.GetInstanceSettingRequest
+	7,  // 18: memos.api.v1.InstanceService.UpdateInstanceSetting:input_type -> memos.api.v1.UpdateInstanceSettingRequest
+	8,  // 19: memos.api.v1.InstanceService.SendTestEmail:input_type -> memos.api.v1.SendTestEmailRequest
+	10, // 20: memos.api.v1.InstanceService.CreateBackup:input_type -> memos.api.v1.CreateBackupRequest
+	3,  // 21: memos.api.v1.InstanceService.GetInstanceProfile:output_type -> memos.api.v1.InstanceProfile
+	5,  // 22: memos.api.v1.InstanceService.GetInstanceSetting:output_type -> memos.api.v1.InstanceSetting
+	5,  // 23: memos.api.v1.InstanceService.UpdateInstanceSetting:output_type -> memos.api.v1.InstanceSetting
+	22, // 24: memos.api.v1.InstanceService.SendTestEmail:output_type -> google.protobuf.Empty
+	9,  // 25: memos.api.v1.InstanceService.CreateBackup:output_type -> memos.api.v1.Backup
+	21, // [21:26] is the sub-list for method output_type
+	16, // [16:21] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_instance_service_proto_init() }
@@ -1246,14 +1841,16 @@ func file_api_v1_instance_service_proto_init() {
 		(*InstanceSetting_StorageSetting_)(nil),
 		(*InstanceSetting_MemoRelatedSetting_)(nil),
 		(*InstanceSetting_AiSetting)(nil),
+		(*InstanceSetting_EmailSetting_)(nil),
+		(*InstanceSetting_BackupSetting_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_instance_service_proto_rawDesc), len(file_api_v1_instance_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   11,
+			NumEnums:      3,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -77,6 +77,64 @@ func (Visibility) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{0}
 }
 
+// Search modes.
+type SearchMemosRequest_Mode int32
+
+const (
+	// Unspecified mode, treated as `HYBRID`.
+	SearchMemosRequest_MODE_UNSPECIFIED SearchMemosRequest_Mode = 0
+	// Match the query terms against memo content.
+	SearchMemosRequest_KEYWORD SearchMemosRequest_Mode = 1
+	// Rank memos by embedding similarity to the query.
+	SearchMemosRequest_SEMANTIC SearchMemosRequest_Mode = 2
+	// Fuse the keyword and semantic rankings with reciprocal rank fusion.
+	// Falls back to keyword search when semantic search is not configured.
+	SearchMemosRequest_HYBRID SearchMemosRequest_Mode = 3
+)
+
+// Enum value maps for SearchMemosRequest_Mode.
+var (
+	SearchMemosRequest_Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "KEYWORD",
+		2: "SEMANTIC",
+		3: "HYBRID",
+	}
+	SearchMemosRequest_Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED": 0,
+		"KEYWORD":          1,
+		"SEMANTIC":         2,
+		"HYBRID":           3,
+	}
+)
+
+func (x SearchMemosRequest_Mode) Enum() *SearchMemosRequest_Mode {
+	p := new(SearchMemosRequest_Mode)
+	*p = x
+	return p
+}
+
+func (x SearchMemosRequest_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchMemosRequest_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[1].Descriptor()
+}
+
+func (SearchMemosRequest_Mode) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[1]
+}
+
+func (x SearchMemosRequest_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchMemosRequest_Mode.Descriptor instead.
+func (SearchMemosRequest_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{11, 0}
+}
+
 // The type of the relation.
 type MemoRelation_Type int32
 
@@ -111,11 +169,11 @@ func (x MemoRelation_Type) String() string {
 }
 
 func (MemoRelation_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_memo_service_proto_enumTypes[1].Descriptor()
+	return file_api_v1_memo_service_proto_enumTypes[2].Descriptor()
 }
 
 func (MemoRelation_Type) Type() protoreflect.EnumType {
-	return &file_api_v1_memo_service_proto_enumTypes[1]
+	return &file_api_v1_memo_service_proto_enumTypes[2]
 }
 
 func (x MemoRelation_Type) Number() protoreflect.EnumNumber {
@@ -124,7 +182,253 @@ func (x MemoRelation_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MemoRelation_Type.Descriptor instead.
 func (MemoRelation_Type) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{13, 0}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{19, 0}
+}
+
+type Task struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the task.
+	// Format: memos/{memo}/tasks/{task}, task is the 0-based index of the checklist item in the memo.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The text of the checklist item without its due date.
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// Whether the checklist item is checked.
+	Checked bool `protobuf:"varint,3,opt,name=checked,proto3" json:"checked,omitempty"`
+	// The 1-based line number of the checklist item in the memo content.
+	Line int32 `protobuf:"varint,4,opt,name=line,proto3" json:"line,omitempty"`
+	// The due date in YYYY-MM-DD format, written as "📅 2026-11-01" or "due:2026-11-01" in the content.
+	DueDate       string `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Task) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Task) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Task) GetChecked() bool {
+	if x != nil {
+		return x.Checked
+	}
+	return false
+}
+
+func (x *Task) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *Task) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+type ListTasksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The CEL filter of the memos to list the tasks of.
+	// Refer to `ListMemosRequest.filter` for the supported fields.
+	Filter string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Optional. Only list the tasks with the checked state.
+	Checked *bool `protobuf:"varint,2,opt,name=checked,proto3,oneof" json:"checked,omitempty"`
+	// Optional. Only list the tasks due on or before the date, in YYYY-MM-DD format.
+	DueBefore     string `protobuf:"bytes,3,opt,name=due_before,json=dueBefore,proto3" json:"due_before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTasksRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetChecked() bool {
+	if x != nil && x.Checked != nil {
+		return *x.Checked
+	}
+	return false
+}
+
+func (x *ListTasksRequest) GetDueBefore() string {
+	if x != nil {
+		return x.DueBefore
+	}
+	return ""
+}
+
+type ListTasksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The tasks, grouped by memo in display time order and in content order within a memo.
+	Tasks         []*Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksResponse) ProtoMessage() {}
+
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type ToggleTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the task.
+	// Format: memos/{memo}/tasks/{task}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The checked state to set. The checkbox is flipped when unset.
+	Checked       *bool `protobuf:"varint,2,opt,name=checked,proto3,oneof" json:"checked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleTaskRequest) Reset() {
+	*x = ToggleTaskRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleTaskRequest) ProtoMessage() {}
+
+func (x *ToggleTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleTaskRequest.ProtoReflect.Descriptor instead.
+func (*ToggleTaskRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ToggleTaskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ToggleTaskRequest) GetChecked() bool {
+	if x != nil && x.Checked != nil {
+		return *x.Checked
+	}
+	return false
 }
 
 type Reaction struct {
@@ -149,7 +453,7 @@ type Reaction struct {
 
 func (x *Reaction) Reset() {
 	*x = Reaction{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[0]
+	mi := &file_api_v1_memo_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -161,7 +465,7 @@ func (x *Reaction) String() string {
 func (*Reaction) ProtoMessage() {}
 
 func (x *Reaction) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[0]
+	mi := &file_api_v1_memo_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -174,7 +478,7 @@ func (x *Reaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
 func (*Reaction) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{0}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Reaction) GetName() string {
@@ -252,14 +556,32 @@ type Memo struct {
 	// Output only. The snippet of the memo content. Plain text only.
 	Snippet string `protobuf:"bytes,17,opt,name=snippet,proto3" json:"snippet,omitempty"`
 	// Optional. The location of the memo.
-	Location      *Location `protobuf:"bytes,18,opt,name=location,proto3,oneof" json:"location,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Location *Location `protobuf:"bytes,18,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	// Output only. The memos of the same creator whose content is nearly identical to this memo.
+	// Only set in the responses of CreateMemo and UpdateMemo.
+	// Format: memos/{memo}
+	PossibleDuplicates []string `protobuf:"bytes,19,rep,name=possible_duplicates,json=possibleDuplicates,proto3" json:"possible_duplicates,omitempty"`
+	// Output only. The summary, tag suggestions and category generated from the content.
+	Enrichment *Memo_Enrichment `protobuf:"bytes,20,opt,name=enrichment,proto3" json:"enrichment,omitempty"`
+	// Output only. The usernames mentioned in the content, without the leading "@".
+	Mentions []string `protobuf:"bytes,21,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	// Optional. The time to remind the creator about the memo.
+	// It is cleared once the reminder is sent.
+	RemindTime *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=remind_time,json=remindTime,proto3,oneof" json:"remind_time,omitempty"`
+	// Optional. The time to publish the memo.
+	// The memo stays private until then and is published with publish_visibility.
+	// It is cleared once the memo is published.
+	PublishTime *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=publish_time,json=publishTime,proto3,oneof" json:"publish_time,omitempty"`
+	// Optional. The visibility of the memo once it is published.
+	// Defaults to the visibility of the memo when publish_time is set on creation.
+	PublishVisibility Visibility `protobuf:"varint,24,opt,name=publish_visibility,json=publishVisibility,proto3,enum=memos.api.v1.Visibility" json:"publish_visibility,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Memo) Reset() {
 	*x = Memo{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[1]
+	mi := &file_api_v1_memo_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -271,7 +593,7 @@ func (x *Memo) String() string {
 func (*Memo) ProtoMessage() {}
 
 func (x *Memo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[1]
+	mi := &file_api_v1_memo_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -284,7 +606,7 @@ func (x *Memo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Memo.ProtoReflect.Descriptor instead.
 func (*Memo) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{1}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Memo) GetName() string {
@@ -406,6 +728,48 @@ func (x *Memo) GetLocation() *Location {
 	return nil
 }
 
+func (x *Memo) GetPossibleDuplicates() []string {
+	if x != nil {
+		return x.PossibleDuplicates
+	}
+	return nil
+}
+
+func (x *Memo) GetEnrichment() *Memo_Enrichment {
+	if x != nil {
+		return x.Enrichment
+	}
+	return nil
+}
+
+func (x *Memo) GetMentions() []string {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *Memo) GetRemindTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindTime
+	}
+	return nil
+}
+
+func (x *Memo) GetPublishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishTime
+	}
+	return nil
+}
+
+func (x *Memo) GetPublishVisibility() Visibility {
+	if x != nil {
+		return x.PublishVisibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
 type Location struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// A placeholder text for the location.
@@ -420,7 +784,7 @@ type Location struct {
 
 func (x *Location) Reset() {
 	*x = Location{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[2]
+	mi := &file_api_v1_memo_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -432,7 +796,7 @@ func (x *Location) String() string {
 func (*Location) ProtoMessage() {}
 
 func (x *Location) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[2]
+	mi := &file_api_v1_memo_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,7 +809,7 @@ func (x *Location) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Location.ProtoReflect.Descriptor instead.
 func (*Location) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{2}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Location) GetPlaceholder() string {
@@ -482,7 +846,7 @@ type CreateMemoRequest struct {
 
 func (x *CreateMemoRequest) Reset() {
 	*x = CreateMemoRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[3]
+	mi := &file_api_v1_memo_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -494,7 +858,7 @@ func (x *CreateMemoRequest) String() string {
 func (*CreateMemoRequest) ProtoMessage() {}
 
 func (x *CreateMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[3]
+	mi := &file_api_v1_memo_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -507,7 +871,7 @@ func (x *CreateMemoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMemoRequest.ProtoReflect.Descriptor instead.
 func (*CreateMemoRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{3}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateMemoRequest) GetMemo() *Memo {
@@ -541,7 +905,8 @@ type ListMemosRequest struct {
 	// Default to "display_time desc".
 	// Supports comma-separated list of fields following AIP-132.
 	// Example: "pinned desc, display_time desc" or "create_time asc"
-	// Supported fields: pinned, display_time, create_time, update_time, name
+	// Supported fields: pinned, display_time, create_time, update_time, name, relevance
+	// `relevance` ranks by how well memos match the `content.matches()` filter, best match first.
 	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	// Optional. Filter to apply to the list results.
 	// Filter is a CEL expression to filter memos.
@@ -555,7 +920,7 @@ type ListMemosRequest struct {
 
 func (x *ListMemosRequest) Reset() {
 	*x = ListMemosRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[4]
+	mi := &file_api_v1_memo_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -567,7 +932,7 @@ func (x *ListMemosRequest) String() string {
 func (*ListMemosRequest) ProtoMessage() {}
 
 func (x *ListMemosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[4]
+	mi := &file_api_v1_memo_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -580,7 +945,7 @@ func (x *ListMemosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMemosRequest.ProtoReflect.Descriptor instead.
 func (*ListMemosRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListMemosRequest) GetPageSize() int32 {
@@ -638,7 +1003,7 @@ type ListMemosResponse struct {
 
 func (x *ListMemosResponse) Reset() {
 	*x = ListMemosResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[5]
+	mi := &file_api_v1_memo_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -650,7 +1015,7 @@ func (x *ListMemosResponse) String() string {
 func (*ListMemosResponse) ProtoMessage() {}
 
 func (x *ListMemosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[5]
+	mi := &file_api_v1_memo_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -663,7 +1028,7 @@ func (x *ListMemosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMemosResponse.ProtoReflect.Descriptor instead.
 func (*ListMemosResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{5}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListMemosResponse) GetMemos() []*Memo {
@@ -701,7 +1066,7 @@ type SearchMemosSemanticRequest struct {
 
 func (x *SearchMemosSemanticRequest) Reset() {
 	*x = SearchMemosSemanticRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[6]
+	mi := &file_api_v1_memo_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -713,7 +1078,7 @@ func (x *SearchMemosSemanticRequest) String() string {
 func (*SearchMemosSemanticRequest) ProtoMessage() {}
 
 func (x *SearchMemosSemanticRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[6]
+	mi := &file_api_v1_memo_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,7 +1091,7 @@ func (x *SearchMemosSemanticRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchMemosSemanticRequest.ProtoReflect.Descriptor instead.
 func (*SearchMemosSemanticRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{6}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SearchMemosSemanticRequest) GetQuery() string {
@@ -764,6 +1129,155 @@ func (x *SearchMemosSemanticRequest) GetFilter() string {
 	return ""
 }
 
+type SearchMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The search query.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Optional. The search mode.
+	// Default to `HYBRID`.
+	Mode SearchMemosRequest_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=memos.api.v1.SearchMemosRequest_Mode" json:"mode,omitempty"`
+	// Optional. The maximum number of results to return.
+	// If unspecified, at most 10 results will be returned.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `SearchMemos` call.
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. The state of the memos to search.
+	// Default to `NORMAL`. Set to `ARCHIVED` to search archived memos.
+	State State `protobuf:"varint,5,opt,name=state,proto3,enum=memos.api.v1.State" json:"state,omitempty"`
+	// Optional. Additional CEL filter to narrow down the candidates.
+	// Refer to `Shortcut.filter`.
+	Filter        string `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMemosRequest) Reset() {
+	*x = SearchMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMemosRequest) ProtoMessage() {}
+
+func (x *SearchMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMemosRequest.ProtoReflect.Descriptor instead.
+func (*SearchMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchMemosRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchMemosRequest) GetMode() SearchMemosRequest_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return SearchMemosRequest_MODE_UNSPECIFIED
+}
+
+func (x *SearchMemosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchMemosRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchMemosRequest) GetState() State {
+	if x != nil {
+		return x.State
+	}
+	return State_STATE_UNSPECIFIED
+}
+
+func (x *SearchMemosRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type SearchMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The search results, best match first.
+	Results []*SearchMemosResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// A token that can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMemosResponse) Reset() {
+	*x = SearchMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMemosResponse) ProtoMessage() {}
+
+func (x *SearchMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMemosResponse.ProtoReflect.Descriptor instead.
+func (*SearchMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SearchMemosResponse) GetResults() []*SearchMemosResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchMemosResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetMemoRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The resource name of the memo.
@@ -775,7 +1289,7 @@ type GetMemoRequest struct {
 
 func (x *GetMemoRequest) Reset() {
 	*x = GetMemoRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[7]
+	mi := &file_api_v1_memo_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -787,7 +1301,7 @@ func (x *GetMemoRequest) String() string {
 func (*GetMemoRequest) ProtoMessage() {}
 
 func (x *GetMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[7]
+	mi := &file_api_v1_memo_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -800,7 +1314,7 @@ func (x *GetMemoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMemoRequest.ProtoReflect.Descriptor instead.
 func (*GetMemoRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{7}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetMemoRequest) GetName() string {
@@ -823,7 +1337,7 @@ type UpdateMemoRequest struct {
 
 func (x *UpdateMemoRequest) Reset() {
 	*x = UpdateMemoRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[8]
+	mi := &file_api_v1_memo_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -835,7 +1349,7 @@ func (x *UpdateMemoRequest) String() string {
 func (*UpdateMemoRequest) ProtoMessage() {}
 
 func (x *UpdateMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[8]
+	mi := &file_api_v1_memo_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -848,7 +1362,7 @@ func (x *UpdateMemoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMemoRequest.ProtoReflect.Descriptor instead.
 func (*UpdateMemoRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{8}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UpdateMemoRequest) GetMemo() *Memo {
@@ -878,7 +1392,7 @@ type DeleteMemoRequest struct {
 
 func (x *DeleteMemoRequest) Reset() {
 	*x = DeleteMemoRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[9]
+	mi := &file_api_v1_memo_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -890,7 +1404,7 @@ func (x *DeleteMemoRequest) String() string {
 func (*DeleteMemoRequest) ProtoMessage() {}
 
 func (x *DeleteMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[9]
+	mi := &file_api_v1_memo_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -903,7 +1417,7 @@ func (x *DeleteMemoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMemoRequest.ProtoReflect.Descriptor instead.
 func (*DeleteMemoRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{9}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DeleteMemoRequest) GetName() string {
@@ -933,7 +1447,7 @@ type SetMemoAttachmentsRequest struct {
 
 func (x *SetMemoAttachmentsRequest) Reset() {
 	*x = SetMemoAttachmentsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[10]
+	mi := &file_api_v1_memo_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -945,7 +1459,7 @@ func (x *SetMemoAttachmentsRequest) String() string {
 func (*SetMemoAttachmentsRequest) ProtoMessage() {}
 
 func (x *SetMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[10]
+	mi := &file_api_v1_memo_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,7 +1472,7 @@ func (x *SetMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetMemoAttachmentsRequest.ProtoReflect.Descriptor instead.
 func (*SetMemoAttachmentsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{10}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SetMemoAttachmentsRequest) GetName() string {
@@ -990,7 +1504,7 @@ type ListMemoAttachmentsRequest struct {
 
 func (x *ListMemoAttachmentsRequest) Reset() {
 	*x = ListMemoAttachmentsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[11]
+	mi := &file_api_v1_memo_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1002,7 +1516,7 @@ func (x *ListMemoAttachmentsRequest) String() string {
 func (*ListMemoAttachmentsRequest) ProtoMessage() {}
 
 func (x *ListMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[11]
+	mi := &file_api_v1_memo_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1015,7 +1529,7 @@ func (x *ListMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMemoAttachmentsRequest.ProtoReflect.Descriptor instead.
 func (*ListMemoAttachmentsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{11}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListMemoAttachmentsRequest) GetName() string {
@@ -1051,7 +1565,7 @@ type ListMemoAttachmentsResponse struct {
 
 func (x *ListMemoAttachmentsResponse) Reset() {
 	*x = ListMemoAttachmentsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[12]
+	mi := &file_api_v1_memo_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1063,7 +1577,7 @@ func (x *ListMemoAttachmentsResponse) String() string {
 func (*ListMemoAttachmentsResponse) ProtoMessage() {}
 
 func (x *ListMemoAttachmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[12]
+	mi := &file_api_v1_memo_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1076,7 +1590,7 @@ func (x *ListMemoAttachmentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMemoAttachmentsResponse.ProtoReflect.Descriptor instead.
 func (*ListMemoAttachmentsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{12}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListMemoAttachmentsResponse) GetAttachments() []*Attachment {
@@ -1106,7 +1620,7 @@ type MemoRelation struct {
 
 func (x *MemoRelation) Reset() {
 	*x = MemoRelation{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[13]
+	mi := &file_api_v1_memo_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1118,7 +1632,7 @@ func (x *MemoRelation) String() string {
 func (*MemoRelation) ProtoMessage() {}
 
 func (x *MemoRelation) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[13]
+	mi := &file_api_v1_memo_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1131,7 +1645,7 @@ func (x *MemoRelation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemoRelation.ProtoReflect.Descriptor instead.
 func (*MemoRelation) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{13}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *MemoRelation) GetMemo() *MemoRelation_Memo {
@@ -1168,7 +1682,7 @@ type SetMemoRelationsRequest struct {
 
 func (x *SetMemoRelationsRequest) Reset() {
 	*x = SetMemoRelationsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[14]
+	mi := &file_api_v1_memo_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1180,7 +1694,7 @@ func (x *SetMemoRelationsRequest) String() string {
 func (*SetMemoRelationsRequest) ProtoMessage() {}
 
 func (x *SetMemoRelationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[14]
+	mi := &file_api_v1_memo_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1193,7 +1707,7 @@ func (x *SetMemoRelationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetMemoRelationsRequest.ProtoReflect.Descriptor instead.
 func (*SetMemoRelationsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{14}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SetMemoRelationsRequest) GetName() string {
@@ -1225,7 +1739,7 @@ type ListMemoRelationsRequest struct {
 
 func (x *ListMemoRelationsRequest) Reset() {
 	*x = ListMemoRelationsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[15]
+	mi := &file_api_v1_memo_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1237,7 +1751,7 @@ func (x *ListMemoRelationsRequest) String() string {
 func (*ListMemoRelationsRequest) ProtoMessage() {}
 
 func (x *ListMemoRelationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[15]
+	mi := &file_api_v1_memo_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1250,7 +1764,7 @@ func (x *ListMemoRelationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMemoRelationsRequest.ProtoReflect.Descriptor instead.
 func (*ListMemoRelationsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{15}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ListMemoRelationsRequest) GetName() string {
@@ -1286,7 +1800,7 @@ type ListMemoRelationsResponse struct {
 
 func (x *ListMemoRelationsResponse) Reset() {
 	*x = ListMemoRelationsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[16]
+	mi := &file_api_v1_memo_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1298,7 +1812,7 @@ func (x *ListMemoRelationsResponse) String() string {
 func (*ListMemoRelationsResponse) ProtoMessage() {}
 
 func (x *ListMemoRelationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[16]
+	mi := &file_api_v1_memo_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1311,7 +1825,7 @@ func (x *ListMemoRelationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMemoRelationsResponse.ProtoReflect.Descriptor instead.
 func (*ListMemoRelationsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{16}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ListMemoRelationsResponse) GetRelations() []*MemoRelation {
@@ -1328,34 +1842,33 @@ func (x *ListMemoRelationsResponse) GetNextPageToken() string {
 	return ""
 }
 
-type CreateMemoCommentRequest struct {
+type ListRelatedMemosRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The resource name of the memo.
 	// Format: memos/{memo}
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Required. The comment to create.
-	Comment *Memo `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
-	// Optional. The comment ID to use.
-	CommentId     string `protobuf:"bytes,3,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// Optional. The maximum number of related memos to return.
+	// The default is 10 and the maximum is 50.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMemoCommentRequest) Reset() {
-	*x = CreateMemoCommentRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[17]
+func (x *ListRelatedMemosRequest) Reset() {
+	*x = ListRelatedMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMemoCommentRequest) String() string {
+func (x *ListRelatedMemosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMemoCommentRequest) ProtoMessage() {}
+func (*ListRelatedMemosRequest) ProtoMessage() {}
 
-func (x *CreateMemoCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[17]
+func (x *ListRelatedMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1366,62 +1879,48 @@ func (x *CreateMemoCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMemoCommentRequest.ProtoReflect.Descriptor instead.
-func (*CreateMemoCommentRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListRelatedMemosRequest.ProtoReflect.Descriptor instead.
+func (*ListRelatedMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *CreateMemoCommentRequest) GetName() string {
+func (x *ListRelatedMemosRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateMemoCommentRequest) GetComment() *Memo {
-	if x != nil {
-		return x.Comment
-	}
-	return nil
-}
-
-func (x *CreateMemoCommentRequest) GetCommentId() string {
+func (x *ListRelatedMemosRequest) GetLimit() int32 {
 	if x != nil {
-		return x.CommentId
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-type ListMemoCommentsRequest struct {
+type ListRelatedMemosResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. The maximum number of comments to return.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. A page token for pagination.
-	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	// Optional. The order to sort results by.
-	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// The related memos, most related first.
+	RelatedMemos  []*ListRelatedMemosResponse_RelatedMemo `protobuf:"bytes,1,rep,name=related_memos,json=relatedMemos,proto3" json:"related_memos,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoCommentsRequest) Reset() {
-	*x = ListMemoCommentsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[18]
+func (x *ListRelatedMemosResponse) Reset() {
+	*x = ListRelatedMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoCommentsRequest) String() string {
+func (x *ListRelatedMemosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoCommentsRequest) ProtoMessage() {}
+func (*ListRelatedMemosResponse) ProtoMessage() {}
 
-func (x *ListMemoCommentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[18]
+func (x *ListRelatedMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1432,66 +1931,926 @@ func (x *ListMemoCommentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoCommentsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoCommentsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListRelatedMemosResponse.ProtoReflect.Descriptor instead.
+func (*ListRelatedMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ListMemoCommentsRequest) GetName() string {
+func (x *ListRelatedMemosResponse) GetRelatedMemos() []*ListRelatedMemosResponse_RelatedMemo {
 	if x != nil {
-		return x.Name
+		return x.RelatedMemos
 	}
-	return ""
+	return nil
 }
 
-func (x *ListMemoCommentsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+type ListDuplicateMemoClustersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The creator whose memos are scanned.
+	// Format: users/{user}
+	// Defaults to the current user. Admins may scan another user, or every user by setting it to "users/-".
+	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoCommentsRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
-	}
-	return ""
-}
+func (x *ListDuplicateMemoClustersRequest) Reset() {
+	*x = ListDuplicateMemoClustersRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDuplicateMemoClustersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDuplicateMemoClustersRequest) ProtoMessage() {}
+
+func (x *ListDuplicateMemoClustersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDuplicateMemoClustersRequest.ProtoReflect.Descriptor instead.
+func (*ListDuplicateMemoClustersRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListDuplicateMemoClustersRequest) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+type ListDuplicateMemoClustersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The clusters of near-duplicate memos, largest first.
+	Clusters      []*ListDuplicateMemoClustersResponse_DuplicateMemoCluster `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDuplicateMemoClustersResponse) Reset() {
+	*x = ListDuplicateMemoClustersResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDuplicateMemoClustersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDuplicateMemoClustersResponse) ProtoMessage() {}
+
+func (x *ListDuplicateMemoClustersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDuplicateMemoClustersResponse.ProtoReflect.Descriptor instead.
+func (*ListDuplicateMemoClustersResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListDuplicateMemoClustersResponse) GetClusters() []*ListDuplicateMemoClustersResponse_DuplicateMemoCluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+type MergeMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the surviving memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The memos merged into the surviving memo, in the order their content is appended.
+	// They are deleted once merged.
+	// Format: memos/{memo}
+	MergedMemos   []string `protobuf:"bytes,2,rep,name=merged_memos,json=mergedMemos,proto3" json:"merged_memos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeMemosRequest) Reset() {
+	*x = MergeMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeMemosRequest) ProtoMessage() {}
+
+func (x *MergeMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeMemosRequest.ProtoReflect.Descriptor instead.
+func (*MergeMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MergeMemosRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MergeMemosRequest) GetMergedMemos() []string {
+	if x != nil {
+		return x.MergedMemos
+	}
+	return nil
+}
+
+type AcceptMemoEnrichmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. Whether to accept the summary.
+	Summary bool `protobuf:"varint,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	// Optional. The suggested tags to accept. They are appended to the content as hashtags.
+	Tags []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Optional. Whether to accept the category.
+	Category      bool `protobuf:"varint,4,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptMemoEnrichmentRequest) Reset() {
+	*x = AcceptMemoEnrichmentRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptMemoEnrichmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptMemoEnrichmentRequest) ProtoMessage() {}
+
+func (x *AcceptMemoEnrichmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptMemoEnrichmentRequest.ProtoReflect.Descriptor instead.
+func (*AcceptMemoEnrichmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AcceptMemoEnrichmentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AcceptMemoEnrichmentRequest) GetSummary() bool {
+	if x != nil {
+		return x.Summary
+	}
+	return false
+}
+
+func (x *AcceptMemoEnrichmentRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *AcceptMemoEnrichmentRequest) GetCategory() bool {
+	if x != nil {
+		return x.Category
+	}
+	return false
+}
+
+type RejectMemoEnrichmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. Whether to reject the summary.
+	Summary bool `protobuf:"varint,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	// Optional. The suggested tags to reject. Rejected tags are not suggested again.
+	Tags []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Optional. Whether to reject the category.
+	Category      bool `protobuf:"varint,4,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectMemoEnrichmentRequest) Reset() {
+	*x = RejectMemoEnrichmentRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectMemoEnrichmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectMemoEnrichmentRequest) ProtoMessage() {}
+
+func (x *RejectMemoEnrichmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectMemoEnrichmentRequest.ProtoReflect.Descriptor instead.
+func (*RejectMemoEnrichmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RejectMemoEnrichmentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RejectMemoEnrichmentRequest) GetSummary() bool {
+	if x != nil {
+		return x.Summary
+	}
+	return false
+}
+
+func (x *RejectMemoEnrichmentRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *RejectMemoEnrichmentRequest) GetCategory() bool {
+	if x != nil {
+		return x.Category
+	}
+	return false
+}
+
+type CreateMemoCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The comment to create.
+	Comment *Memo `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	// Optional. The comment ID to use.
+	CommentId     string `protobuf:"bytes,3,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemoCommentRequest) Reset() {
+	*x = CreateMemoCommentRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoCommentRequest) ProtoMessage() {}
+
+func (x *CreateMemoCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateMemoCommentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateMemoCommentRequest) GetComment() *Memo {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *CreateMemoCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+type ListMemoCommentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The maximum number of comments to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token for pagination.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. The order to sort results by.
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoCommentsRequest) Reset() {
+	*x = ListMemoCommentsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoCommentsRequest) ProtoMessage() {}
+
+func (x *ListMemoCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListMemoCommentsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListMemoCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemoCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMemoCommentsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListMemoCommentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of comment memos.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total count of comments.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoCommentsResponse) Reset() {
+	*x = ListMemoCommentsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoCommentsResponse) ProtoMessage() {}
+
+func (x *ListMemoCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListMemoCommentsResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *ListMemoCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMemoCommentsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ListMemoReactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The maximum number of reactions to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token for pagination.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoReactionsRequest) Reset() {
+	*x = ListMemoReactionsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoReactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoReactionsRequest) ProtoMessage() {}
+
+func (x *ListMemoReactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoReactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoReactionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListMemoReactionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListMemoReactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemoReactionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMemoReactionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of reactions.
+	Reactions []*Reaction `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total count of reactions.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoReactionsResponse) Reset() {
+	*x = ListMemoReactionsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoReactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoReactionsResponse) ProtoMessage() {}
+
+func (x *ListMemoReactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoReactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoReactionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListMemoReactionsResponse) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *ListMemoReactionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMemoReactionsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type UpsertMemoReactionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The reaction to upsert.
+	Reaction      *Reaction `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertMemoReactionRequest) Reset() {
+	*x = UpsertMemoReactionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertMemoReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertMemoReactionRequest) ProtoMessage() {}
+
+func (x *UpsertMemoReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertMemoReactionRequest.ProtoReflect.Descriptor instead.
+func (*UpsertMemoReactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpsertMemoReactionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpsertMemoReactionRequest) GetReaction() *Reaction {
+	if x != nil {
+		return x.Reaction
+	}
+	return nil
+}
+
+type DeleteMemoReactionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the reaction to delete.
+	// Format: memos/{memo}/reactions/{reaction}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemoReactionRequest) Reset() {
+	*x = DeleteMemoReactionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoReactionRequest) ProtoMessage() {}
+
+func (x *DeleteMemoReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoReactionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoReactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteMemoReactionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
 
-func (x *ListMemoCommentsRequest) GetOrderBy() string {
+// MemoRevision is a prior version of a memo, recorded when the memo is updated.
+type MemoRevision struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the revision.
+	// Format: memos/{memo}/revisions/{revision}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The content of the memo in this version.
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// The visibility of the memo in this version.
+	Visibility Visibility `protobuf:"varint,3,opt,name=visibility,proto3,enum=memos.api.v1.Visibility" json:"visibility,omitempty"`
+	// The location of the memo in this version.
+	Location *Location `protobuf:"bytes,4,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	// The time this version was saved.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The unified diff of the content of this version against the current content of the memo.
+	// Only set in the response of GetMemoRevision.
+	Diff          string `protobuf:"bytes,6,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoRevision) Reset() {
+	*x = MemoRevision{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRevision) ProtoMessage() {}
+
+func (x *MemoRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[37]
 	if x != nil {
-		return x.OrderBy
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoRevision.ProtoReflect.Descriptor instead.
+func (*MemoRevision) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MemoRevision) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-type ListMemoCommentsResponse struct {
+func (x *MemoRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MemoRevision) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *MemoRevision) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *MemoRevision) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *MemoRevision) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+type ListMemoRevisionsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of comment memos.
-	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
-	// A token for the next page of results.
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The maximum number of revisions to return.
+	// If unspecified, at most 10 revisions will be returned.
+	// The maximum value is 1000; values above 1000 will be coerced to 1000.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `ListMemoRevisions` call.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRevisionsRequest) Reset() {
+	*x = ListMemoRevisionsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRevisionsRequest) ProtoMessage() {}
+
+func (x *ListMemoRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListMemoRevisionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListMemoRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemoRevisionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMemoRevisionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of revisions, newest first. Their content is set but not their diff.
+	Revisions []*MemoRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// A token that can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
 	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// The total count of comments.
-	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoCommentsResponse) Reset() {
-	*x = ListMemoCommentsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[19]
+func (x *ListMemoRevisionsResponse) Reset() {
+	*x = ListMemoRevisionsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoCommentsResponse) String() string {
+func (x *ListMemoRevisionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoCommentsResponse) ProtoMessage() {}
+func (*ListMemoRevisionsResponse) ProtoMessage() {}
 
-func (x *ListMemoCommentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[19]
+func (x *ListMemoRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1502,60 +2861,218 @@ func (x *ListMemoCommentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoCommentsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoCommentsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListMemoRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ListMemoCommentsResponse) GetMemos() []*Memo {
+func (x *ListMemoRevisionsResponse) GetRevisions() []*MemoRevision {
 	if x != nil {
-		return x.Memos
+		return x.Revisions
 	}
 	return nil
 }
 
-func (x *ListMemoCommentsResponse) GetNextPageToken() string {
+func (x *ListMemoRevisionsResponse) GetNextPageToken() string {
 	if x != nil {
 		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *ListMemoCommentsResponse) GetTotalSize() int32 {
+type GetMemoRevisionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the revision.
+	// Format: memos/{memo}/revisions/{revision}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoRevisionRequest) Reset() {
+	*x = GetMemoRevisionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoRevisionRequest) ProtoMessage() {}
+
+func (x *GetMemoRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[40]
 	if x != nil {
-		return x.TotalSize
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetMemoRevisionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RestoreMemoRevisionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the revision to restore.
+	// Format: memos/{memo}/revisions/{revision}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreMemoRevisionRequest) Reset() {
+	*x = RestoreMemoRevisionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreMemoRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreMemoRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreMemoRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreMemoRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreMemoRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RestoreMemoRevisionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Computed properties of a memo.
+type Memo_Property struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	HasLink            bool                   `protobuf:"varint,1,opt,name=has_link,json=hasLink,proto3" json:"has_link,omitempty"`
+	HasTaskList        bool                   `protobuf:"varint,2,opt,name=has_task_list,json=hasTaskList,proto3" json:"has_task_list,omitempty"`
+	HasCode            bool                   `protobuf:"varint,3,opt,name=has_code,json=hasCode,proto3" json:"has_code,omitempty"`
+	HasIncompleteTasks bool                   `protobuf:"varint,4,opt,name=has_incomplete_tasks,json=hasIncompleteTasks,proto3" json:"has_incomplete_tasks,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Memo_Property) Reset() {
+	*x = Memo_Property{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Memo_Property) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Memo_Property) ProtoMessage() {}
+
+func (x *Memo_Property) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Memo_Property.ProtoReflect.Descriptor instead.
+func (*Memo_Property) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *Memo_Property) GetHasLink() bool {
+	if x != nil {
+		return x.HasLink
+	}
+	return false
+}
+
+func (x *Memo_Property) GetHasTaskList() bool {
+	if x != nil {
+		return x.HasTaskList
+	}
+	return false
+}
+
+func (x *Memo_Property) GetHasCode() bool {
+	if x != nil {
+		return x.HasCode
+	}
+	return false
+}
+
+func (x *Memo_Property) GetHasIncompleteTasks() bool {
+	if x != nil {
+		return x.HasIncompleteTasks
 	}
-	return 0
+	return false
 }
 
-type ListMemoReactionsRequest struct {
+// Suggestions generated by the language model. They stay suggestions until accepted.
+type Memo_Enrichment struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. The maximum number of reactions to return.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. A page token for pagination.
-	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListMemoReactionsRequest) Reset() {
-	*x = ListMemoReactionsRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+	// A one or two sentence summary of the content.
+	Summary string `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	// Tags suggested for the memo, without the leading "#".
+	SuggestedTags []string `protobuf:"bytes,2,rep,name=suggested_tags,json=suggestedTags,proto3" json:"suggested_tags,omitempty"`
+	// A single lowercase category, filterable with `category == "..."`.
+	Category string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	// Whether the summary was accepted.
+	SummaryAccepted bool `protobuf:"varint,4,opt,name=summary_accepted,json=summaryAccepted,proto3" json:"summary_accepted,omitempty"`
+	// Whether the category was accepted.
+	CategoryAccepted bool `protobuf:"varint,5,opt,name=category_accepted,json=categoryAccepted,proto3" json:"category_accepted,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Memo_Enrichment) Reset() {
+	*x = Memo_Enrichment{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoReactionsRequest) String() string {
+func (x *Memo_Enrichment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoReactionsRequest) ProtoMessage() {}
+func (*Memo_Enrichment) ProtoMessage() {}
 
-func (x *ListMemoReactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[20]
+func (x *Memo_Enrichment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1566,59 +3083,87 @@ func (x *ListMemoReactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoReactionsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoReactionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use Memo_Enrichment.ProtoReflect.Descriptor instead.
+func (*Memo_Enrichment) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{5, 1}
 }
 
-func (x *ListMemoReactionsRequest) GetName() string {
+func (x *Memo_Enrichment) GetSummary() string {
 	if x != nil {
-		return x.Name
+		return x.Summary
 	}
 	return ""
 }
 
-func (x *ListMemoReactionsRequest) GetPageSize() int32 {
+func (x *Memo_Enrichment) GetSuggestedTags() []string {
 	if x != nil {
-		return x.PageSize
+		return x.SuggestedTags
 	}
-	return 0
+	return nil
 }
 
-func (x *ListMemoReactionsRequest) GetPageToken() string {
+func (x *Memo_Enrichment) GetCategory() string {
 	if x != nil {
-		return x.PageToken
+		return x.Category
 	}
 	return ""
 }
 
-type ListMemoReactionsResponse struct {
+func (x *Memo_Enrichment) GetSummaryAccepted() bool {
+	if x != nil {
+		return x.SummaryAccepted
+	}
+	return false
+}
+
+func (x *Memo_Enrichment) GetCategoryAccepted() bool {
+	if x != nil {
+		return x.CategoryAccepted
+	}
+	return false
+}
+
+type SearchMemosResponse_Result struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of reactions.
-	Reactions []*Reaction `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
-	// A token for the next page of results.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// The total count of reactions.
-	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// The matched memo.
+	Memo *Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The score used to order the results.
+	// In hybrid mode this is the reciprocal rank fusion score.
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// The 1-based position of the memo in the keyword ranking, 0 if it did not match.
+	KeywordRank int32 `protobuf:"varint,3,opt,name=keyword_rank,json=keywordRank,proto3" json:"keyword_rank,omitempty"`
+	// The BM25 score of the memo for the query terms.
+	KeywordScore float64 `protobuf:"fixed64,4,opt,name=keyword_score,json=keywordScore,proto3" json:"keyword_score,omitempty"`
+	// The 1-based position of the memo in the semantic ranking, 0 if it did not match.
+	SemanticRank int32 `protobuf:"varint,5,opt,name=semantic_rank,json=semanticRank,proto3" json:"semantic_rank,omitempty"`
+	// The cosine similarity between the best matching passage of the memo and the query.
+	SemanticScore float64 `protobuf:"fixed64,6,opt,name=semantic_score,json=semanticScore,proto3" json:"semantic_score,omitempty"`
+	// The query terms found in the memo content.
+	MatchedTerms []string `protobuf:"bytes,7,rep,name=matched_terms,json=matchedTerms,proto3" json:"matched_terms,omitempty"`
+	// A plain text excerpt of the memo around the first matched term,
+	// or of the best matching passage when no term matched.
+	Snippet string `protobuf:"bytes,8,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// The raw content of the passage that matched the query semantically, empty if it did not match.
+	Passage       string `protobuf:"bytes,9,opt,name=passage,proto3" json:"passage,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoReactionsResponse) Reset() {
-	*x = ListMemoReactionsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+func (x *SearchMemosResponse_Result) Reset() {
+	*x = SearchMemosResponse_Result{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoReactionsResponse) String() string {
+func (x *SearchMemosResponse_Result) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoReactionsResponse) ProtoMessage() {}
+func (*SearchMemosResponse_Result) ProtoMessage() {}
 
-func (x *ListMemoReactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[21]
+func (x *SearchMemosResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1629,111 +3174,101 @@ func (x *ListMemoReactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoReactionsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoReactionsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use SearchMemosResponse_Result.ProtoReflect.Descriptor instead.
+func (*SearchMemosResponse_Result) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{12, 0}
 }
 
-func (x *ListMemoReactionsResponse) GetReactions() []*Reaction {
+func (x *SearchMemosResponse_Result) GetMemo() *Memo {
 	if x != nil {
-		return x.Reactions
+		return x.Memo
 	}
 	return nil
 }
 
-func (x *ListMemoReactionsResponse) GetNextPageToken() string {
+func (x *SearchMemosResponse_Result) GetScore() float64 {
 	if x != nil {
-		return x.NextPageToken
+		return x.Score
 	}
-	return ""
+	return 0
 }
 
-func (x *ListMemoReactionsResponse) GetTotalSize() int32 {
+func (x *SearchMemosResponse_Result) GetKeywordRank() int32 {
 	if x != nil {
-		return x.TotalSize
+		return x.KeywordRank
 	}
 	return 0
 }
 
-type UpsertMemoReactionRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Required. The reaction to upsert.
-	Reaction      *Reaction `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpsertMemoReactionRequest) Reset() {
-	*x = UpsertMemoReactionRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[22]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SearchMemosResponse_Result) GetKeywordScore() float64 {
+	if x != nil {
+		return x.KeywordScore
+	}
+	return 0
 }
 
-func (x *UpsertMemoReactionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SearchMemosResponse_Result) GetSemanticRank() int32 {
+	if x != nil {
+		return x.SemanticRank
+	}
+	return 0
 }
 
-func (*UpsertMemoReactionRequest) ProtoMessage() {}
-
-func (x *UpsertMemoReactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[22]
+func (x *SearchMemosResponse_Result) GetSemanticScore() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SemanticScore
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use UpsertMemoReactionRequest.ProtoReflect.Descriptor instead.
-func (*UpsertMemoReactionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{22}
+func (x *SearchMemosResponse_Result) GetMatchedTerms() []string {
+	if x != nil {
+		return x.MatchedTerms
+	}
+	return nil
 }
 
-func (x *UpsertMemoReactionRequest) GetName() string {
+func (x *SearchMemosResponse_Result) GetSnippet() string {
 	if x != nil {
-		return x.Name
+		return x.Snippet
 	}
 	return ""
 }
 
-func (x *UpsertMemoReactionRequest) GetReaction() *Reaction {
+func (x *SearchMemosResponse_Result) GetPassage() string {
 	if x != nil {
-		return x.Reaction
+		return x.Passage
 	}
-	return nil
+	return ""
 }
 
-type DeleteMemoReactionRequest struct {
+// Memo reference in relations.
+type MemoRelation_Memo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the reaction to delete.
-	// Format: memos/{memo}/reactions/{reaction}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Output only. The snippet of the memo content. Plain text only.
+	Snippet       string `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMemoReactionRequest) Reset() {
-	*x = DeleteMemoReactionRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[23]
+func (x *MemoRelation_Memo) Reset() {
+	*x = MemoRelation_Memo{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMemoReactionRequest) String() string {
+func (x *MemoRelation_Memo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMemoReactionRequest) ProtoMessage() {}
+func (*MemoRelation_Memo) ProtoMessage() {}
 
-func (x *DeleteMemoReactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[23]
+func (x *MemoRelation_Memo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1744,44 +3279,60 @@ func (x *DeleteMemoReactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMemoReactionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMemoReactionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use MemoRelation_Memo.ProtoReflect.Descriptor instead.
+func (*MemoRelation_Memo) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{19, 0}
 }
 
-func (x *DeleteMemoReactionRequest) GetName() string {
+func (x *MemoRelation_Memo) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-// Computed properties of a memo.
-type Memo_Property struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	HasLink            bool                   `protobuf:"varint,1,opt,name=has_link,json=hasLink,proto3" json:"has_link,omitempty"`
-	HasTaskList        bool                   `protobuf:"varint,2,opt,name=has_task_list,json=hasTaskList,proto3" json:"has_task_list,omitempty"`
-	HasCode            bool                   `protobuf:"varint,3,opt,name=has_code,json=hasCode,proto3" json:"has_code,omitempty"`
-	HasIncompleteTasks bool                   `protobuf:"varint,4,opt,name=has_incomplete_tasks,json=hasIncompleteTasks,proto3" json:"has_incomplete_tasks,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *MemoRelation_Memo) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
 }
 
-func (x *Memo_Property) Reset() {
-	*x = Memo_Property{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[24]
+type ListRelatedMemosResponse_RelatedMemo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The related memo.
+	Memo *Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The combined relatedness score used to order the results.
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// The cosine similarity between the embeddings of the two memos, 0 if either has no embedding.
+	SemanticScore float64 `protobuf:"fixed64,3,opt,name=semantic_score,json=semanticScore,proto3" json:"semantic_score,omitempty"`
+	// The tags both memos have in common.
+	SharedTags []string `protobuf:"bytes,4,rep,name=shared_tags,json=sharedTags,proto3" json:"shared_tags,omitempty"`
+	// Whether a REFERENCE relation already links the two memos, in either direction.
+	Referenced bool `protobuf:"varint,5,opt,name=referenced,proto3" json:"referenced,omitempty"`
+	// A REFERENCE relation from the requested memo to the related memo that the caller can accept
+	// by adding it to the relations sent to SetMemoRelations.
+	// Only set when the memos are not linked yet and the caller can edit the requested memo.
+	SuggestedRelation *MemoRelation `protobuf:"bytes,6,opt,name=suggested_relation,json=suggestedRelation,proto3" json:"suggested_relation,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListRelatedMemosResponse_RelatedMemo) Reset() {
+	*x = ListRelatedMemosResponse_RelatedMemo{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Memo_Property) String() string {
+func (x *ListRelatedMemosResponse_RelatedMemo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Memo_Property) ProtoMessage() {}
+func (*ListRelatedMemosResponse_RelatedMemo) ProtoMessage() {}
 
-func (x *Memo_Property) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[24]
+func (x *ListRelatedMemosResponse_RelatedMemo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1792,66 +3343,80 @@ func (x *Memo_Property) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Memo_Property.ProtoReflect.Descriptor instead.
-func (*Memo_Property) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use ListRelatedMemosResponse_RelatedMemo.ProtoReflect.Descriptor instead.
+func (*ListRelatedMemosResponse_RelatedMemo) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{24, 0}
 }
 
-func (x *Memo_Property) GetHasLink() bool {
+func (x *ListRelatedMemosResponse_RelatedMemo) GetMemo() *Memo {
 	if x != nil {
-		return x.HasLink
+		return x.Memo
 	}
-	return false
+	return nil
 }
 
-func (x *Memo_Property) GetHasTaskList() bool {
+func (x *ListRelatedMemosResponse_RelatedMemo) GetScore() float64 {
 	if x != nil {
-		return x.HasTaskList
+		return x.Score
 	}
-	return false
+	return 0
 }
 
-func (x *Memo_Property) GetHasCode() bool {
+func (x *ListRelatedMemosResponse_RelatedMemo) GetSemanticScore() float64 {
 	if x != nil {
-		return x.HasCode
+		return x.SemanticScore
 	}
-	return false
+	return 0
 }
 
-func (x *Memo_Property) GetHasIncompleteTasks() bool {
+func (x *ListRelatedMemosResponse_RelatedMemo) GetSharedTags() []string {
 	if x != nil {
-		return x.HasIncompleteTasks
+		return x.SharedTags
+	}
+	return nil
+}
+
+func (x *ListRelatedMemosResponse_RelatedMemo) GetReferenced() bool {
+	if x != nil {
+		return x.Referenced
 	}
 	return false
 }
 
-// Memo reference in relations.
-type MemoRelation_Memo struct {
+func (x *ListRelatedMemosResponse_RelatedMemo) GetSuggestedRelation() *MemoRelation {
+	if x != nil {
+		return x.SuggestedRelation
+	}
+	return nil
+}
+
+type ListDuplicateMemoClustersResponse_DuplicateMemoCluster struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Output only. The snippet of the memo content. Plain text only.
-	Snippet       string `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// The memos of the cluster, most recently updated first.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// Whether all memos of the cluster have the same normalized content.
+	Exact bool `protobuf:"varint,2,opt,name=exact,proto3" json:"exact,omitempty"`
+	// The lowest similarity between two memos linked in the cluster, 1 for exact duplicates.
+	Similarity    float64 `protobuf:"fixed64,3,opt,name=similarity,proto3" json:"similarity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemoRelation_Memo) Reset() {
-	*x = MemoRelation_Memo{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[25]
+func (x *ListDuplicateMemoClustersResponse_DuplicateMemoCluster) Reset() {
+	*x = ListDuplicateMemoClustersResponse_DuplicateMemoCluster{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemoRelation_Memo) String() string {
+func (x *ListDuplicateMemoClustersResponse_DuplicateMemoCluster) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemoRelation_Memo) ProtoMessage() {}
+func (*ListDuplicateMemoClustersResponse_DuplicateMemoCluster) ProtoMessage() {}
 
-func (x *MemoRelation_Memo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[25]
+func (x *ListDuplicateMemoClustersResponse_DuplicateMemoCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1862,30 +3427,59 @@ func (x *MemoRelation_Memo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemoRelation_Memo.ProtoReflect.Descriptor instead.
-func (*MemoRelation_Memo) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{13, 0}
+// Deprecated: Use ListDuplicateMemoClustersResponse_DuplicateMemoCluster.ProtoReflect.Descriptor instead.
+func (*ListDuplicateMemoClustersResponse_DuplicateMemoCluster) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{26, 0}
 }
 
-func (x *MemoRelation_Memo) GetName() string {
+func (x *ListDuplicateMemoClustersResponse_DuplicateMemoCluster) GetMemos() []*Memo {
 	if x != nil {
-		return x.Name
+		return x.Memos
 	}
-	return ""
+	return nil
 }
 
-func (x *MemoRelation_Memo) GetSnippet() string {
+func (x *ListDuplicateMemoClustersResponse_DuplicateMemoCluster) GetExact() bool {
 	if x != nil {
-		return x.Snippet
+		return x.Exact
 	}
-	return ""
+	return false
+}
+
+func (x *ListDuplicateMemoClustersResponse_DuplicateMemoCluster) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
 }
 
 var File_api_v1_memo_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\n" +
-	"\x19api/v1/memo_service.proto\x12\fmemos.api.v1\x1a\x1fapi/v1/attachment_service.proto\x1a\x13api/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdb\x02\n" +
+	"\x19api/v1/memo_service.proto\x12\fmemos.api.v1\x1a\x1fapi/v1/attachment_service.proto\x1a\x13api/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd9\x01\n" +
+	"\x04Task\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x12\x17\n" +
+	"\x04text\x18\x02 \x01(\tB\x03\xe0A\x03R\x04text\x12\x1d\n" +
+	"\achecked\x18\x03 \x01(\bB\x03\xe0A\x03R\achecked\x12\x17\n" +
+	"\x04line\x18\x04 \x01(\x05B\x03\xe0A\x03R\x04line\x12\x1e\n" +
+	"\bdue_date\x18\x05 \x01(\tB\x03\xe0A\x03R\adueDate:D\xeaAA\n" +
+	"\x11memos.api.v1/Task\x12\x19memos/{memo}/tasks/{task}\x1a\x04name*\x05tasks2\x04task\"\x83\x01\n" +
+	"\x10ListTasksRequest\x12\x1b\n" +
+	"\x06filter\x18\x01 \x01(\tB\x03\xe0A\x01R\x06filter\x12\"\n" +
+	"\achecked\x18\x02 \x01(\bB\x03\xe0A\x01H\x00R\achecked\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"due_before\x18\x03 \x01(\tB\x03\xe0A\x01R\tdueBeforeB\n" +
+	"\n" +
+	"\b_checked\"=\n" +
+	"\x11ListTasksResponse\x12(\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x12.memos.api.v1.TaskR\x05tasks\"r\n" +
+	"\x11ToggleTaskRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/TaskR\x04name\x12\"\n" +
+	"\achecked\x18\x02 \x01(\bB\x03\xe0A\x01H\x00R\achecked\x88\x01\x01B\n" +
+	"\n" +
+	"\b_checked\"\xdb\x02\n" +
 	"\bReaction\x12\x1a\n" +
 	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x123\n" +
 	"\acreator\x18\x02 \x01(\tB\x19\xe0A\x03\xfaA\x13\n" +
@@ -1896,7 +3490,7 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\rreaction_type\x18\x04 \x01(\tB\x03\xe0A\x02R\freactionType\x12@\n" +
 	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime:X\xeaAU\n" +
-	"\x15memos.api.v1/Reaction\x12!memos/{memo}/reactions/{reaction}\x1a\x04name*\treactions2\breaction\"\xd8\b\n" +
+	"\x15memos.api.v1/Reaction\x12!memos/{memo}/reactions/{reaction}\x1a\x04name*\treactions2\breaction\"\xcc\r\n" +
 	"\x04Memo\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12.\n" +
 	"\x05state\x18\x02 \x01(\x0e2\x13.memos.api.v1.StateB\x03\xe0A\x02R\x05state\x123\n" +
@@ -1921,15 +3515,34 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x06parent\x18\x10 \x01(\tB\x19\xe0A\x03\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoH\x00R\x06parent\x88\x01\x01\x12\x1d\n" +
 	"\asnippet\x18\x11 \x01(\tB\x03\xe0A\x03R\asnippet\x12<\n" +
-	"\blocation\x18\x12 \x01(\v2\x16.memos.api.v1.LocationB\x03\xe0A\x01H\x01R\blocation\x88\x01\x01\x1a\x96\x01\n" +
+	"\blocation\x18\x12 \x01(\v2\x16.memos.api.v1.LocationB\x03\xe0A\x01H\x01R\blocation\x88\x01\x01\x12J\n" +
+	"\x13possible_duplicates\x18\x13 \x03(\tB\x19\xe0A\x03\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x12possibleDuplicates\x12B\n" +
+	"\n" +
+	"enrichment\x18\x14 \x01(\v2\x1d.memos.api.v1.Memo.EnrichmentB\x03\xe0A\x03R\n" +
+	"enrichment\x12\x1f\n" +
+	"\bmentions\x18\x15 \x03(\tB\x03\xe0A\x03R\bmentions\x12E\n" +
+	"\vremind_time\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01H\x02R\n" +
+	"remindTime\x88\x01\x01\x12G\n" +
+	"\fpublish_time\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01H\x03R\vpublishTime\x88\x01\x01\x12L\n" +
+	"\x12publish_visibility\x18\x18 \x01(\x0e2\x18.memos.api.v1.VisibilityB\x03\xe0A\x01R\x11publishVisibility\x1a\x96\x01\n" +
 	"\bProperty\x12\x19\n" +
 	"\bhas_link\x18\x01 \x01(\bR\ahasLink\x12\"\n" +
 	"\rhas_task_list\x18\x02 \x01(\bR\vhasTaskList\x12\x19\n" +
 	"\bhas_code\x18\x03 \x01(\bR\ahasCode\x120\n" +
-	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasks:7\xeaA4\n" +
+	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasks\x1a\xc1\x01\n" +
+	"\n" +
+	"Enrichment\x12\x18\n" +
+	"\asummary\x18\x01 \x01(\tR\asummary\x12%\n" +
+	"\x0esuggested_tags\x18\x02 \x03(\tR\rsuggestedTags\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12)\n" +
+	"\x10summary_accepted\x18\x04 \x01(\bR\x0fsummaryAccepted\x12+\n" +
+	"\x11category_accepted\x18\x05 \x01(\bR\x10categoryAccepted:7\xeaA4\n" +
 	"\x11memos.api.v1/Memo\x12\fmemos/{memo}\x1a\x04name*\x05memos2\x04memoB\t\n" +
 	"\a_parentB\v\n" +
-	"\t_location\"u\n" +
+	"\t_locationB\x0e\n" +
+	"\f_remind_timeB\x0f\n" +
+	"\r_publish_time\"u\n" +
 	"\bLocation\x12%\n" +
 	"\vplaceholder\x18\x01 \x01(\tB\x03\xe0A\x01R\vplaceholder\x12\x1f\n" +
 	"\blatitude\x18\x02 \x01(\x01B\x03\xe0A\x01R\blatitude\x12!\n" +
@@ -1954,7 +3567,34 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\n" +
 	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\x12.\n" +
 	"\x05state\x18\x04 \x01(\x0e2\x13.memos.api.v1.StateB\x03\xe0A\x01R\x05state\x12\x1b\n" +
-	"\x06filter\x18\x05 \x01(\tB\x03\xe0A\x01R\x06filter\"?\n" +
+	"\x06filter\x18\x05 \x01(\tB\x03\xe0A\x01R\x06filter\"\xc7\x02\n" +
+	"\x12SearchMemosRequest\x12\x19\n" +
+	"\x05query\x18\x01 \x01(\tB\x03\xe0A\x02R\x05query\x12>\n" +
+	"\x04mode\x18\x02 \x01(\x0e2%.memos.api.v1.SearchMemosRequest.ModeB\x03\xe0A\x01R\x04mode\x12 \n" +
+	"\tpage_size\x18\x03 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tB\x03\xe0A\x01R\tpageToken\x12.\n" +
+	"\x05state\x18\x05 \x01(\x0e2\x13.memos.api.v1.StateB\x03\xe0A\x01R\x05state\x12\x1b\n" +
+	"\x06filter\x18\x06 \x01(\tB\x03\xe0A\x01R\x06filter\"C\n" +
+	"\x04Mode\x12\x14\n" +
+	"\x10MODE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aKEYWORD\x10\x01\x12\f\n" +
+	"\bSEMANTIC\x10\x02\x12\n" +
+	"\n" +
+	"\x06HYBRID\x10\x03\"\xb7\x03\n" +
+	"\x13SearchMemosResponse\x12B\n" +
+	"\aresults\x18\x01 \x03(\v2(.memos.api.v1.SearchMemosResponse.ResultR\aresults\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x1a\xb3\x02\n" +
+	"\x06Result\x12&\n" +
+	"\x04memo\x18\x01 \x01(\v2\x12.memos.api.v1.MemoR\x04memo\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12!\n" +
+	"\fkeyword_rank\x18\x03 \x01(\x05R\vkeywordRank\x12#\n" +
+	"\rkeyword_score\x18\x04 \x01(\x01R\fkeywordScore\x12#\n" +
+	"\rsemantic_rank\x18\x05 \x01(\x05R\fsemanticRank\x12%\n" +
+	"\x0esemantic_score\x18\x06 \x01(\x01R\rsemanticScore\x12#\n" +
+	"\rmatched_terms\x18\a \x03(\tR\fmatchedTerms\x12\x18\n" +
+	"\asnippet\x18\b \x01(\tR\asnippet\x12\x18\n" +
+	"\apassage\x18\t \x01(\tR\apassage\"?\n" +
 	"\x0eGetMemoRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\"\x82\x01\n" +
@@ -2003,7 +3643,51 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"}\n" +
 	"\x19ListMemoRelationsResponse\x128\n" +
 	"\trelations\x18\x01 \x03(\v2\x1a.memos.api.v1.MemoRelationR\trelations\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa0\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"c\n" +
+	"\x17ListRelatedMemosRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x05B\x03\xe0A\x01R\x05limit\"\xf4\x02\n" +
+	"\x18ListRelatedMemosResponse\x12W\n" +
+	"\rrelated_memos\x18\x01 \x03(\v22.memos.api.v1.ListRelatedMemosResponse.RelatedMemoR\frelatedMemos\x1a\xfe\x01\n" +
+	"\vRelatedMemo\x12&\n" +
+	"\x04memo\x18\x01 \x01(\v2\x12.memos.api.v1.MemoR\x04memo\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12%\n" +
+	"\x0esemantic_score\x18\x03 \x01(\x01R\rsemanticScore\x12\x1f\n" +
+	"\vshared_tags\x18\x04 \x03(\tR\n" +
+	"sharedTags\x12\x1e\n" +
+	"\n" +
+	"referenced\x18\x05 \x01(\bR\n" +
+	"referenced\x12I\n" +
+	"\x12suggested_relation\x18\x06 \x01(\v2\x1a.memos.api.v1.MemoRelationR\x11suggestedRelation\"W\n" +
+	" ListDuplicateMemoClustersRequest\x123\n" +
+	"\acreator\x18\x01 \x01(\tB\x19\xe0A\x01\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\acreator\"\xfd\x01\n" +
+	"!ListDuplicateMemoClustersResponse\x12`\n" +
+	"\bclusters\x18\x01 \x03(\v2D.memos.api.v1.ListDuplicateMemoClustersResponse.DuplicateMemoClusterR\bclusters\x1av\n" +
+	"\x14DuplicateMemoCluster\x12(\n" +
+	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12\x14\n" +
+	"\x05exact\x18\x02 \x01(\bR\x05exact\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x03 \x01(\x01R\n" +
+	"similarity\"\x80\x01\n" +
+	"\x11MergeMemosRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\x12<\n" +
+	"\fmerged_memos\x18\x02 \x03(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\vmergedMemos\"\xa5\x01\n" +
+	"\x1bAcceptMemoEnrichmentRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\x12\x1d\n" +
+	"\asummary\x18\x02 \x01(\bB\x03\xe0A\x01R\asummary\x12\x17\n" +
+	"\x04tags\x18\x03 \x03(\tB\x03\xe0A\x01R\x04tags\x12\x1f\n" +
+	"\bcategory\x18\x04 \x01(\bB\x03\xe0A\x01R\bcategory\"\xa5\x01\n" +
+	"\x1bRejectMemoEnrichmentRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\x12\x1d\n" +
+	"\asummary\x18\x02 \x01(\bB\x03\xe0A\x01R\asummary\x12\x17\n" +
+	"\x04tags\x18\x03 \x03(\tB\x03\xe0A\x01R\x04tags\x12\x1f\n" +
+	"\bcategory\x18\x04 \x01(\bB\x03\xe0A\x01R\bcategory\"\xa0\x01\n" +
 	"\x18CreateMemoCommentRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x121\n" +
@@ -2039,19 +3723,47 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\breaction\x18\x02 \x01(\v2\x16.memos.api.v1.ReactionB\x03\xe0A\x02R\breaction\"N\n" +
 	"\x19DeleteMemoReactionRequest\x121\n" +
 	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
-	"\x15memos.api.v1/ReactionR\x04name*P\n" +
+	"\x15memos.api.v1/ReactionR\x04name\"\x94\x03\n" +
+	"\fMemoRevision\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x12\x1d\n" +
+	"\acontent\x18\x02 \x01(\tB\x03\xe0A\x03R\acontent\x12=\n" +
+	"\n" +
+	"visibility\x18\x03 \x01(\x0e2\x18.memos.api.v1.VisibilityB\x03\xe0A\x03R\n" +
+	"visibility\x12<\n" +
+	"\blocation\x18\x04 \x01(\v2\x16.memos.api.v1.LocationB\x03\xe0A\x03H\x00R\blocation\x88\x01\x01\x12@\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12\x17\n" +
+	"\x04diff\x18\x06 \x01(\tB\x03\xe0A\x03R\x04diff:d\xeaAa\n" +
+	"\x19memos.api.v1/MemoRevision\x12!memos/{memo}/revisions/{revision}\x1a\x04name*\rmemoRevisions2\fmemoRevisionB\v\n" +
+	"\t_location\"\x8f\x01\n" +
+	"\x18ListMemoRevisionsRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"}\n" +
+	"\x19ListMemoRevisionsResponse\x128\n" +
+	"\trevisions\x18\x01 \x03(\v2\x1a.memos.api.v1.MemoRevisionR\trevisions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"O\n" +
+	"\x16GetMemoRevisionRequest\x125\n" +
+	"\x04name\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\n" +
+	"\x19memos.api.v1/MemoRevisionR\x04name\"S\n" +
+	"\x1aRestoreMemoRevisionRequest\x125\n" +
+	"\x04name\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\n" +
+	"\x19memos.api.v1/MemoRevisionR\x04name*P\n" +
 	"\n" +
 	"Visibility\x12\x1a\n" +
 	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aPRIVATE\x10\x01\x12\r\n" +
 	"\tPROTECTED\x10\x02\x12\n" +
 	"\n" +
-	"\x06PUBLIC\x10\x032\xe7\x0f\n" +
+	"\x06PUBLIC\x10\x032\xdc\x1b\n" +
 	"\vMemoService\x12e\n" +
 	"\n" +
 	"CreateMemo\x12\x1f.memos.api.v1.CreateMemoRequest\x1a\x12.memos.api.v1.Memo\"\"\xdaA\x04memo\x82\xd3\xe4\x93\x02\x15:\x04memo\"\r/api/v1/memos\x12f\n" +
 	"\tListMemos\x12\x1e.memos.api.v1.ListMemosRequest\x1a\x1f.memos.api.v1.ListMemosResponse\"\x18\xdaA\x00\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/memos\x12\x91\x01\n" +
-	"\x13SearchMemosSemantic\x12(.memos.api.v1.SearchMemosSemanticRequest\x1a\x1f.memos.api.v1.ListMemosResponse\"/\xdaA\x05query\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/memos:searchSemantic\x12b\n" +
+	"\x13SearchMemosSemantic\x12(.memos.api.v1.SearchMemosSemanticRequest\x1a\x1f.memos.api.v1.ListMemosResponse\"/\xdaA\x05query\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/memos:searchSemantic\x12{\n" +
+	"\vSearchMemos\x12 .memos.api.v1.SearchMemosRequest\x1a!.memos.api.v1.SearchMemosResponse\"'\xdaA\x05query\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/memos:search\x12b\n" +
 	"\aGetMemo\x12\x1c.memos.api.v1.GetMemoRequest\x1a\x12.memos.api.v1.Memo\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/{name=memos/*}\x12\x7f\n" +
 	"\n" +
 	"UpdateMemo\x12\x1f.memos.api.v1.UpdateMemoRequest\x1a\x12.memos.api.v1.Memo\"<\xdaA\x10memo,update_mask\x82\xd3\xe4\x93\x02#:\x04memo2\x1b/api/v1/{memo.name=memos/*}\x12l\n" +
@@ -2061,11 +3773,23 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x13ListMemoAttachments\x12(.memos.api.v1.ListMemoAttachmentsRequest\x1a).memos.api.v1.ListMemoAttachmentsResponse\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=memos/*}/attachments\x12\x85\x01\n" +
 	"\x10SetMemoRelations\x12%.memos.api.v1.SetMemoRelationsRequest\x1a\x16.google.protobuf.Empty\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%:\x01*2 /api/v1/{name=memos/*}/relations\x12\x95\x01\n" +
 	"\x11ListMemoRelations\x12&.memos.api.v1.ListMemoRelationsRequest\x1a'.memos.api.v1.ListMemoRelationsResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/relations\x12\x90\x01\n" +
+	"\x10ListRelatedMemos\x12%.memos.api.v1.ListRelatedMemosRequest\x1a&.memos.api.v1.ListRelatedMemosResponse\"-\xdaA\x04name\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/{name=memos/*}/related\x12\xa1\x01\n" +
+	"\x19ListDuplicateMemoClusters\x12..memos.api.v1.ListDuplicateMemoClustersRequest\x1a/.memos.api.v1.ListDuplicateMemoClustersResponse\"#\xdaA\x00\x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/memos:duplicates\x12~\n" +
+	"\n" +
+	"MergeMemos\x12\x1f.memos.api.v1.MergeMemosRequest\x1a\x12.memos.api.v1.Memo\";\xdaA\x11name,merged_memos\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/{name=memos/*}:merge\x12\x91\x01\n" +
+	"\x14AcceptMemoEnrichment\x12).memos.api.v1.AcceptMemoEnrichmentRequest\x1a\x12.memos.api.v1.Memo\":\xdaA\x04name\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/{name=memos/*}/enrichment:accept\x12\x91\x01\n" +
+	"\x14RejectMemoEnrichment\x12).memos.api.v1.RejectMemoEnrichmentRequest\x1a\x12.memos.api.v1.Memo\":\xdaA\x04name\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/{name=memos/*}/enrichment:reject\x12\x90\x01\n" +
 	"\x11CreateMemoComment\x12&.memos.api.v1.CreateMemoCommentRequest\x1a\x12.memos.api.v1.Memo\"?\xdaA\fname,comment\x82\xd3\xe4\x93\x02*:\acomment\"\x1f/api/v1/{name=memos/*}/comments\x12\x91\x01\n" +
 	"\x10ListMemoComments\x12%.memos.api.v1.ListMemoCommentsRequest\x1a&.memos.api.v1.ListMemoCommentsResponse\".\xdaA\x04name\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{name=memos/*}/comments\x12\x95\x01\n" +
 	"\x11ListMemoReactions\x12&.memos.api.v1.ListMemoReactionsRequest\x1a'.memos.api.v1.ListMemoReactionsResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/reactions\x12\x89\x01\n" +
 	"\x12UpsertMemoReaction\x12'.memos.api.v1.UpsertMemoReactionRequest\x1a\x16.memos.api.v1.Reaction\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/{name=memos/*}/reactions\x12\x88\x01\n" +
-	"\x12DeleteMemoReaction\x12'.memos.api.v1.DeleteMemoReactionRequest\x1a\x16.google.protobuf.Empty\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$*\"/api/v1/{name=memos/*/reactions/*}B\xa8\x01\n" +
+	"\x12DeleteMemoReaction\x12'.memos.api.v1.DeleteMemoReactionRequest\x1a\x16.google.protobuf.Empty\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$*\"/api/v1/{name=memos/*/reactions/*}\x12f\n" +
+	"\tListTasks\x12\x1e.memos.api.v1.ListTasksRequest\x1a\x1f.memos.api.v1.ListTasksResponse\"\x18\xdaA\x00\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/tasks\x12z\n" +
+	"\n" +
+	"ToggleTask\x12\x1f.memos.api.v1.ToggleTaskRequest\x1a\x12.memos.api.v1.Task\"7\xdaA\x04name\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/{name=memos/*/tasks/*}:toggle\x12\x95\x01\n" +
+	"\x11ListMemoRevisions\x12&.memos.api.v1.ListMemoRevisionsRequest\x1a'.memos.api.v1.ListMemoRevisionsResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/revisions\x12\x86\x01\n" +
+	"\x0fGetMemoRevision\x12$.memos.api.v1.GetMemoRevisionRequest\x1a\x1a.memos.api.v1.MemoRevision\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=memos/*/revisions/*}\x12\x91\x01\n" +
+	"\x13RestoreMemoRevision\x12(.memos.api.v1.RestoreMemoRevisionRequest\x1a\x12.memos.api.v1.Memo\"<\xdaA\x04name\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/{name=memos/*/revisions/*}:restoreB\xa8\x01\n" +
 	"\x10com.memos.api.v1B\x10MemoServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -2080,107 +3804,170 @@ func file_api_v1_memo_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_memo_service_proto_rawDescData
 }
 
-var file_api_v1_memo_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_memo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_api_v1_memo_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_v1_memo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
 var file_api_v1_memo_service_proto_goTypes = []any{
-	(Visibility)(0),                     // 0: memos.api.v1.Visibility
-	(MemoRelation_Type)(0),              // 1: memos.api.v1.MemoRelation.Type
-	(*Reaction)(nil),                    // 2: memos.api.v1.Reaction
-	(*Memo)(nil),                        // 3: memos.api.v1.Memo
-	(*Location)(nil),                    // 4: memos.api.v1.Location
-	(*CreateMemoRequest)(nil),           // 5: memos.api.v1.CreateMemoRequest
-	(*ListMemosRequest)(nil),            // 6: memos.api.v1.ListMemosRequest
-	(*ListMemosResponse)(nil),           // 7: memos.api.v1.ListMemosResponse
-	(*SearchMemosSemanticRequest)(nil),  // 8: memos.api.v1.SearchMemosSemanticRequest
-	(*GetMemoRequest)(nil),              // 9: memos.api.v1.GetMemoRequest
-	(*UpdateMemoRequest)(nil),           // 10: memos.api.v1.UpdateMemoRequest
-	(*DeleteMemoRequest)(nil),           // 11: memos.api.v1.DeleteMemoRequest
-	(*SetMemoAttachmentsRequest)(nil),   // 12: memos.api.v1.SetMemoAttachmentsRequest
-	(*ListMemoAttachmentsRequest)(nil),  // 13: memos.api.v1.ListMemoAttachmentsRequest
-	(*ListMemoAttachmentsResponse)(nil), // 14: memos.api.v1.ListMemoAttachmentsResponse
-	(*MemoRelation)(nil),                // 15: memos.api.v1.MemoRelation
-	(*SetMemoRelationsRequest)(nil),     // 16: memos.api.v1.SetMemoRelationsRequest
-	(*ListMemoRelationsRequest)(nil),    // 17: memos.api.v1.ListMemoRelationsRequest
-	(*ListMemoRelationsResponse)(nil),   // 18: memos.api.v1.ListMemoRelationsResponse
-	(*CreateMemoCommentRequest)(nil),    // 19: memos.api.v1.CreateMemoCommentRequest
-	(*ListMemoCommentsRequest)(nil),     // 20: memos.api.v1.ListMemoCommentsRequest
-	(*ListMemoCommentsResponse)(nil),    // 21: memos.api.v1.ListMemoCommentsResponse
-	(*ListMemoReactionsRequest)(nil),    // 22: memos.api.v1.ListMemoReactionsRequest
-	(*ListMemoReactionsResponse)(nil),   // 23: memos.api.v1.ListMemoReactionsResponse
-	(*UpsertMemoReactionRequest)(nil),   // 24: memos.api.v1.UpsertMemoReactionRequest
-	(*DeleteMemoReactionRequest)(nil),   // 25: memos.api.v1.DeleteMemoReactionRequest
-	(*Memo_Property)(nil),               // 26: memos.api.v1.Memo.Property
-	(*MemoRelation_Memo)(nil),           // 27: memos.api.v1.MemoRelation.Memo
-	(*timestamppb.Timestamp)(nil),       // 28: google.protobuf.Timestamp
-	(State)(0),                          // 29: memos.api.v1.State
-	(*Attachment)(nil),                  // 30: memos.api.v1.Attachment
-	(*fieldmaskpb.FieldMask)(nil),       // 31: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),               // 32: google.protobuf.Empty
+	(Visibility)(0),                                                // 0: memos.api.v1.Visibility
+	(SearchMemosRequest_Mode)(0),                                   // 1: memos.api.v1.SearchMemosRequest.Mode
+	(MemoRelation_Type)(0),                                         // 2: memos.api.v1.MemoRelation.Type
+	(*Task)(nil),                                                   // 3: memos.api.v1.Task
+	(*ListTasksRequest)(nil),                                       // 4: memos.api.v1.ListTasksRequest
+	(*ListTasksResponse)(nil),                                      // 5: memos.api.v1.ListTasksResponse
+	(*ToggleTaskRequest)(nil),                                      // 6: memos.api.v1.ToggleTaskRequest
+	(*Reaction)(nil),                                               // 7: memos.api.v1.Reaction
+	(*Memo)(nil),                                                   // 8: memos.api.v1.Memo
+	(*Location)(nil),                                               // 9: memos.api.v1.Location
+	(*CreateMemoRequest)(nil),                                      // 10: memos.api.v1.CreateMemoRequest
+	(*ListMemosRequest)(nil),                                       // 11: memos.api.v1.ListMemosRequest
+	(*ListMemosResponse)(nil),                                      // 12: memos.api.v1.ListMemosResponse
+	(*SearchMemosSemanticRequest)(nil),                             // 13: memos.api.v1.SearchMemosSemanticRequest
+	(*SearchMemosRequest)(nil),                                     // 14: memos.api.v1.SearchMemosRequest
+	(*SearchMemosResponse)(nil),                                    // 15: memos.api.v1.SearchMemosResponse
+	(*GetMemoRequest)(nil),                                         // 16: memos.api.v1.GetMemoRequest
+	(*UpdateMemoRequest)(nil),                                      // 17: memos.api.v1.UpdateMemoRequest
+	(*DeleteMemoRequest)(nil),                                      // 18: memos.api.v1.DeleteMemoRequest
+	(*SetMemoAttachmentsRequest)(nil),                              // 19: memos.api.v1.SetMemoAttachmentsRequest
+	(*ListMemoAttachmentsRequest)(nil),                             // 20: memos.api.v1.ListMemoAttachmentsRequest
+	(*ListMemoAttachmentsResponse)(nil),                            // 21: memos.api.v1.ListMemoAttachmentsResponse
+	(*MemoRelation)(nil),                                           // 22: memos.api.v1.MemoRelation
+	(*SetMemoRelationsRequest)(nil),                                // 23: memos.api.v1.SetMemoRelationsRequest
+	(*ListMemoRelationsRequest)(nil),                               // 24: memos.api.v1.ListMemoRelationsRequest
+	(*ListMemoRelationsResponse)(nil),                              // 25: memos.api.v1.ListMemoRelationsResponse
+	(*ListRelatedMemosRequest)(nil),                                // 26: memos.api.v1.ListRelatedMemosRequest
+	(*ListRelatedMemosResponse)(nil),                               // 27: memos.api.v1.ListRelatedMemosResponse
+	(*ListDuplicateMemoClustersRequest)(nil),                       // 28: memos.api.v1.ListDuplicateMemoClustersRequest
+	(*ListDuplicateMemoClustersResponse)(nil),                      // 29: memos.api.v1.ListDuplicateMemoClustersResponse
+	(*MergeMemosRequest)(nil),                                      // 30: memos.api.v1.MergeMemosRequest
+	(*AcceptMemoEnrichmentRequest)(nil),                            // 31: memos.api.v1.AcceptMemoEnrichmentRequest
+	(*RejectMemoEnrichmentRequest)(nil),                            // 32: memos.api.v1.RejectMemoEnrichmentRequest
+	(*CreateMemoCommentRequest)(nil),                               // 33: memos.api.v1.CreateMemoCommentRequest
+	(*ListMemoCommentsRequest)(nil),                                // 34: memos.api.v1.ListMemoCommentsRequest
+	(*ListMemoCommentsResponse)(nil),                               // 35: memos.api.v1.ListMemoCommentsResponse
+	(*ListMemoReactionsRequest)(nil),                               // 36: memos.api.v1.ListMemoReactionsRequest
+	(*ListMemoReactionsResponse)(nil),                              // 37: memos.api.v1.ListMemoReactionsResponse
+	(*UpsertMemoReactionRequest)(nil),                              // 38: memos.api.v1.UpsertMemoReactionRequest
+	(*DeleteMemoReactionRequest)(nil),                              // 39: memos.api.v1.DeleteMemoReactionRequest
+	(*MemoRevision)(nil),                                           // 40: memos.api.v1.MemoRevision
+	(*ListMemoRevisionsRequest)(nil),                               // 41: memos.api.v1.ListMemoRevisionsRequest
+	(*ListMemoRevisionsResponse)(nil),                              // 42: memos.api.v1.ListMemoRevisionsResponse
+	(*GetMemoRevisionRequest)(nil),                                 // 43: memos.api.v1.GetMemoRevisionRequest
+	(*RestoreMemoRevisionRequest)(nil),                             // 44: memos.api.v1.RestoreMemoRevisionRequest
+	(*Memo_Property)(nil),                                          // 45: memos.api.v1.Memo.Property
+	(*Memo_Enrichment)(nil),                                        // 46: memos.api.v1.Memo.Enrichment
+	(*SearchMemosResponse_Result)(nil),                             // 47: memos.api.v1.SearchMemosResponse.Result
+	(*MemoRelation_Memo)(nil),                                      // 48: memos.api.v1.MemoRelation.Memo
+	(*ListRelatedMemosResponse_RelatedMemo)(nil),                   // 49: memos.api.v1.ListRelatedMemosResponse.RelatedMemo
+	(*ListDuplicateMemoClustersResponse_DuplicateMemoCluster)(nil), // 50: memos.api.v1.ListDuplicateMemoClustersResponse.DuplicateMemoCluster
+	(*timestamppb.Timestamp)(nil),                                  // 51: google.protobuf.Timestamp
+	(State)(0),                                                     // 52: memos.api.v1.State
+	(*Attachment)(nil),                                             // 53: memos.api.v1.Attachment
+	(*fieldmaskpb.FieldMask)(nil),                                  // 54: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                                          // 55: google.protobuf.Empty
 }
 var file_api_v1_memo_service_proto_depIdxs = []int32{
-	28, // 0: memos.api.v1.Reaction.create_time:type_name -> google.protobuf.Timestamp
-	29, // 1: memos.api.v1.Memo.state:type_name -> memos.api.v1.State
-	28, // 2: memos.api.v1.Memo.create_time:type_name -> google.protobuf.Timestamp
-	28, // 3: memos.api.v1.Memo.update_time:type_name -> google.protobuf.Timestamp
-	28, // 4: memos.api.v1.Memo.display_time:type_name -> google.protobuf.Timestamp
-	0,  // 5: memos.api.v1.Memo.visibility:type_name -> memos.api.v1.Visibility
-	30, // 6: memos.api.v1.Memo.attachments:type_name -> memos.api.v1.Attachment
-	15, // 7: memos.api.v1.Memo.relations:type_name -> memos.api.v1.MemoRelation
-	2,  // 8: memos.api.v1.Memo.reactions:type_name -> memos.api.v1.Reaction
-	26, // 9: memos.api.v1.Memo.property:type_name -> memos.api.v1.Memo.Property
-	4,  // 10: memos.api.v1.Memo.location:type_name -> memos.api.v1.Location
-	3,  // 11: memos.api.v1.CreateMemoRequest.memo:type_name -> memos.api.v1.Memo
-	29, // 12: memos.api.v1.ListMemosRequest.state:type_name -> memos.api.v1.State
-	3,  // 13: memos.api.v1.ListMemosResponse.memos:type_name -> memos.api.v1.Memo
-	29, // 14: memos.api.v1.SearchMemosSemanticRequest.state:type_name -> memos.api.v1.State
-	3,  // 15: memos.api.v1.UpdateMemoRequest.memo:type_name -> memos.api.v1.Memo
-	31, // 16: memos.api.v1.UpdateMemoRequest.update_mask:type_name -> google.protobuf.FieldMask
-	30, // 17: memos.api.v1.SetMemoAttachmentsRequest.attachments:type_name -> memos.api.v1.Attachment
-	30, // 18: memos.api.v1.ListMemoAttachmentsResponse.attachments:type_name -> memos.api.v1.Attachment
-	27, // 19: memos.api.v1.MemoRelation.memo:type_name -> memos.api.v1.MemoRelation.Memo
-	27, // 20: memos.api.v1.MemoRelation.related_memo:type_name -> memos.api.v1.MemoRelation.Memo
-	1,  // 21: memos.api.v1.MemoRelation.type:type_name -> memos.api.v1.MemoRelation.Type
-	15, // 22: memos.api.v1.SetMemoRelationsRequest.relations:type_name -> memos.api.v1.MemoRelation
-	15, // 23: memos.api.v1.ListMemoRelationsResponse.relations:type_name -> memos.api.v1.MemoRelation
-	3,  // 24: memos.api.v1.CreateMemoCommentRequest.comment:type_name -> memos.api.v1.Memo
-	3,  // 25: memos.api.v1.ListMemoCommentsResponse.memos:type_name -> memos.api.v1.Memo
-	2,  // 26: memos.api.v1.ListMemoReactionsResponse.reactions:type_name -> memos.api.v1.Reaction
-	2,  // 27: memos.api.v1.UpsertMemoReactionRequest.reaction:type_name -> memos.api.v1.Reaction
-	5,  // 28: memos.api.v1.MemoService.CreateMemo:input_type -> memos.api.v1.CreateMemoRequest
-	6,  // 29: memos.api.v1.MemoService.ListMemos:input_type -> memos.api.v1.ListMemosRequest
-	8,  // 30: memos.api.v1.MemoService.SearchMemosSemantic:input_type -> memos.api.v1.SearchMemosSemanticRequest
-	9,  // 31: memos.api.v1.MemoService.GetMemo:input_type -> memos.api.v1.GetMemoRequest
-	10, // 32: memos.api.v1.MemoService.UpdateMemo:input_type -> memos.api.v1.UpdateMemoRequest
-	11, // 33: memos.api.v1.MemoService.DeleteMemo:input_type -> memos.api.v1.DeleteMemoRequest
-	12, // 34: memos.api.v1.MemoService.SetMemoAttachments:input_type -> memos.api.v1.SetMemoAttachmentsRequest
-	13, // 35: memos.api.v1.MemoService.ListMemoAttachments:input_type -> memos.api.v1.ListMemoAttachmentsRequest
-	16, // 36: memos.api.v1.MemoService.SetMemoRelations:input_type -> memos.api.v1.SetMemoRelationsRequest
-	17, // 37: memos.api.v1.MemoService.ListMemoRelations:input_type -> memos.api.v1.ListMemoRelationsRequest
-	19, // 38: memos.api.v1.MemoService.CreateMemoComment:input_type -> memos.api.v1.CreateMemoCommentRequest
-	20, // 39: memos.api.v1.MemoService.ListMemoComments:input_type -> memos.api.v1.ListMemoCommentsRequest
-	22, // 40: memos.api.v1.MemoService.ListMemoReactions:input_type -> memos.api.v1.ListMemoReactionsRequest
-	24, // 41: memos.api.v1.MemoService.UpsertMemoReaction:input_type -> memos.api.v1.UpsertMemoReactionRequest
-	25, // 42: memos.api.v1.MemoService.DeleteMemoReaction:input_type -> memos.api.v1.DeleteMemoReactionRequest
-	3,  // 43: memos.api.v1.MemoService.CreateMemo:output_type -> memos.api.v1.Memo
-	7,  // 44: memos.api.v1.MemoService.ListMemos:output_type -> memos.api.v1.ListMemosResponse
-	7,  // 45: memos.api.v1.MemoService.SearchMemosSemantic:output_type -> memos.api.v1.ListMemosResponse
-	3,  // 46: memos.api.v1.MemoService.GetMemo:output_type -> memos.api.v1.Memo
-	3,  // 47: memos.api.v1.MemoService.UpdateMemo:output_type -> memos.api.v1.Memo
-	32, // 48: memos.api.v1.MemoService.DeleteMemo:output_type -> google.protobuf.Empty
-	32, // 49: memos.api.v1.MemoService.SetMemoAttachments:output_type -> google.protobuf.Empty
-	14, // 50: memos.api.v1.MemoService.ListMemoAttachments:output_type -> memos.api.v1.ListMemoAttachmentsResponse
-	32, // 51: memos.api.v1.MemoService.SetMemoRelations:output_type -> google.protobuf.Empty
-	18, // 52: memos.api.v1.MemoService.ListMemoRelations:output_type -> memos.api.v1.ListMemoRelationsResponse
-	3,  // 53: memos.api.v1.MemoService.CreateMemoComment:output_type -> memos.api.v1.Memo
-	21, // 54: memos.api.v1.MemoService.ListMemoComments:output_type -> memos.api.v1.ListMemoCommentsResponse
-	23, // 55: memos.api.v1.MemoService.ListMemoReactions:output_type -> memos.api.v1.ListMemoReactionsResponse
-	2,  // 56: memos.api.v1.MemoService.UpsertMemoReaction:output_type -> memos.api.v1.Reaction
-	32, // 57: memos.api.v1.MemoService.DeleteMemoReaction:output_type -> google.protobuf.Empty
-	43, // [43:58] is the sub-list for method output_type
-	28, // [28:43] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	3,  // 0: memos.api.v1.ListTasksResponse.tasks:type_name -> memos.api.v1.Task
+	51, // 1: memos.api.v1.Reaction.create_time:type_name -> google.protobuf.Timestamp
+	52, // 2: memos.api.v1.Memo.state:type_name -> memos.api.v1.State
+	51, // 3: memos.api.v1.Memo.create_time:type_name -> google.protobuf.Timestamp
+	51, // 4: memos.api.v1.Memo.update_time:type_name -> google.protobuf.Timestamp
+	51, // 5: memos.api.v1.Memo.display_time:type_name -> google.protobuf.Timestamp
+	0,  // 6: memos.api.v1.Memo.visibility:type_name -> memos.api.v1.Visibility
+	53, // 7: memos.api.v1.Memo.attachments:type_name -> memos.api.v1.Attachment
+	22, // 8: memos.api.v1.Memo.relations:type_name -> memos.api.v1.MemoRelation
+	7,  // 9: memos.api.v1.Memo.reactions:type_name -> memos.api.v1.Reaction
+	45, // 10: memos.api.v1.Memo.property:type_name -> memos.api.v1.Memo.Property
+	9,  // 11: memos.api.v1.Memo.location:type_name -> memos.api.v1.Location
+	46, // 12: memos.api.v1.Memo.enrichment:type_name -> memos.api.v1.Memo.Enrichment
+	51, // 13: memos.api.v1.Memo.remind_time:type_name -> google.protobuf.Timestamp
+	51, // 14: memos.api.v1.Memo.publish_time:type_name -> google.protobuf.Timestamp
+	0,  // 15: memos.api.v1.Memo.publish_visibility:type_name -> memos.api.v1.Visibility
+	8,  // 16: memos.api.v1.CreateMemoRequest.memo:type_name -> memos.api.v1.Memo
+	52, // 17: memos.api.v1.ListMemosRequest.state:type_name -> memos.api.v1.State
+	8,  // 18: memos.api.v1.ListMemosResponse.memos:type_name -> memos.api.v1.Memo
+	52, // 19: memos.api.v1.SearchMemosSemanticRequest.state:type_name -> memos.api.v1.State
+	1,  // 20: memos.api.v1.SearchMemosRequest.mode:type_name -> memos.api.v1.SearchMemosRequest.Mode
+	52, // 21: memos.api.v1.SearchMemosRequest.state:type_name -> memos.api.v1.State
+	47, // 22: memos.api.v1.SearchMemosResponse.results:type_name -> memos.api.v1.SearchMemosResponse.Result
+	8,  // 23: memos.api.v1.UpdateMemoRequest.memo:type_name -> memos.api.v1.Memo
+	54, // 24: memos.api.v1.UpdateMemoRequest.update_mask:type_name -> google.protobuf.FieldMask
+	53, // 25: memos.api.v1.SetMemoAttachmentsRequest.attachments:type_name -> memos.api.v1.Attachment
+	53, // 26: memos.api.v1.ListMemoAttachmentsResponse.attachments:type_name -> memos.api.v1.Attachment
+	48, // 27: memos.api.v1.MemoRelation.memo:type_name -> memos.api.v1.MemoRelation.Memo
+	48, // 28: memos.api.v1.MemoRelation.related_memo:type_name -> memos.api.v1.MemoRelation.Memo
+	2,  // 29: memos.api.v1.MemoRelation.type:type_name -> memos.api.v1.MemoRelation.Type
+	22, // 30: memos.api.v1.SetMemoRelationsRequest.relations:type_name -> memos.api.v1.MemoRelation
+	22, // 31: memos.api.v1.ListMemoRelationsResponse.relations:type_name -> memos.api.v1.MemoRelation
+	49, // 32: memos.api.v1.ListRelatedMemosResponse.related_memos:type_name -> memos.api.v1.ListRelatedMemosResponse.RelatedMemo
+	50, // 33: memos.api.v1.ListDuplicateMemoClustersResponse.clusters:type_name -> memos.api.v1.ListDuplicateMemoClustersResponse.DuplicateMemoCluster
+	8,  // 34: memos.api.v1.CreateMemoCommentRequest.comment:type_name -> memos.api.v1.Memo
+	8,  // 35: memos.api.v1.ListMemoCommentsResponse.memos:type_name -> memos.api.v1.Memo
+	7,  // 36: memos.api.v1.ListMemoReactionsResponse.reactions:type_name -> memos.api.v1.Reaction
+	7,  // 37: memos.api.v1.UpsertMemoReactionRequest.reaction:type_name -> memos.api.v1.Reaction
+	0,  // 38: memos.api.v1.MemoRevision.visibility:type_name -> memos.api.v1.Visibility
+	9,  // 39: memos.api.v1.MemoRevision.location:type_name -> memos.api.v1.Location
+	51, // 40: memos.api.v1.MemoRevision.create_time:type_name -> google.protobuf.Timestamp
+	40, // 41: memos.api.v1.ListMemoRevisionsResponse.revisions:type_name -> memos.api.v1.MemoRevision
+	8,  // 42: memos.api.v1.SearchMemosResponse.Result.memo:type_name -> memos.api.v1.Memo
+	8,  // 43: memos.api.v1.ListRelatedMemosResponse.RelatedMemo.memo:type_name -> memos.api.v1.Memo
+	22, // 44: memos.api.v1.ListRelatedMemosResponse.RelatedMemo.suggested_relation:type_name -> memos.api.v1.MemoRelation
+	8,  // 45: memos.api.v1.ListDuplicateMemoClustersResponse.DuplicateMemoCluster.memos:type_name -> memos.api.v1.Memo
+	10, // 46: memos.api.v1.MemoService.CreateMemo:input_type -> memos.api.v1.CreateMemoRequest
+	11, // 47: memos.api.v1.MemoService.ListMemos:input_type -> memos.api.v1.ListMemosRequest
+	13, // 48: memos.api.v1.MemoService.SearchMemosSemantic:input_type -> memos.api.v1.SearchMemosSemanticRequest
+	14, // 49: memos.api.v1.MemoService.SearchMemos:input_type -> memos.api.v1.SearchMemosRequest
+	16, // 50: memos.api.v1.MemoService.GetMemo:input_type -> memos.api.v1.GetMemoRequest
+	17, // 51: memos.api.v1.MemoService.UpdateMemo:input_type -> memos.api.v1.UpdateMemoRequest
+	18, // 52: memos.api.v1.MemoService.DeleteMemo:input_type -> memos.api.v1.DeleteMemoRequest
+	19, // 53: memos.api.v1.MemoService.SetMemoAttachments:input_type -> memos.api.v1.SetMemoAttachmentsRequest
+	20, // 54: memos.api.v1.MemoService.ListMemoAttachments:input_type -> memos.api.v1.ListMemoAttachmentsRequest
+	23, // 55: memos.api.v1.MemoService.SetMemoRelations:input_type -> memos.api.v1.SetMemoRelationsRequest
+	24, // 56: memos.api.v1.MemoService.ListMemoRelations:input_type -> memos.api.v1.ListMemoRelationsRequest
+	26, // 57: memos.api.v1.MemoService.ListRelatedMemos:input_type -> memos.api.v1.ListRelatedMemosRequest
+	28, // 58: memos.api.v1.MemoService.ListDuplicateMemoClusters:input_type -> memos.api.v1.ListDuplicateMemoClustersRequest
+	30, // 59: memos.api.v1.MemoService.MergeMemos:input_type -> memos.api.v1.MergeMemosRequest
+	31, // 60: memos.api.v1.MemoService.AcceptMemoEnrichment:input_type -> memos.api.v1.AcceptMemoEnrichmentRequest
+	32, // 61: memos.api.v1.MemoService.RejectMemoEnrichment:input_type -> memos.api.v1.RejectMemoEnrichmentRequest
+	33, // 62: memos.api.v1.MemoService.CreateMemoComment:input_type -> memos.api.v1.CreateMemoCommentRequest
+	34, // 63: memos.api.v1.MemoService.ListMemoComments:input_type -> memos.api.v1.ListMemoCommentsRequest
+	36, // 64: memos.api.v1.MemoService.ListMemoReactions:input_type -> memos.api.v1.ListMemoReactionsRequest
+	38, // 65: memos.api.v1.MemoService.UpsertMemoReaction:input_type -> memos.api.v1.UpsertMemoReactionRequest
+	39, // 66: memos.api.v1.MemoService.DeleteMemoReaction:input_type -> memos.api.v1.DeleteMemoReactionRequest
+	4,  // 67: memos.api.v1.MemoService.ListTasks:input_type -> memos.api.v1.ListTasksRequest
+	6,  // 68: memos.api.v1.MemoService.ToggleTask:input_type -> memos.api.v1.ToggleTaskRequest
+	41, // 69: memos.api.v1.MemoService.ListMemoRevisions:input_type -> memos.api.v1.ListMemoRevisionsRequest
+	43, // 70: memos.api.v1.MemoService.GetMemoRevision:input_type -> memos.api.v1.GetMemoRevisionRequest
+	44, // 71: memos.api.v1.MemoService.RestoreMemoRevision:input_type -> memos.api.v1.RestoreMemoRevisionRequest
+	8,  // 72: memos.api.v1.MemoService.CreateMemo:output_type -> memos.api.v1.Memo
+	12, // 73: memos.api.v1.MemoService.ListMemos:output_type -> memos.api.v1.ListMemosResponse
+	12, // 74: memos.api.v1.MemoService.SearchMemosSemantic:output_type -> memos.api.v1.ListMemosResponse
+	15, // 75: memos.api.v1.MemoService.SearchMemos:output_type -> memos.api.v1.SearchMemosResponse
+	8,  // 76: memos.api.v1.MemoService.GetMemo:output_type -> memos.api.v1.Memo
+	8,  // 77: memos.api.v1.MemoService.UpdateMemo:output_type -> memos.api.v1.Memo
+	55, // 78: memos.api.v1.MemoService.DeleteMemo:output_type -> google.protobuf.Empty
+	55, // 79: memos.api.v1.MemoService.SetMemoAttachments:output_type -> google.protobuf.Empty
+	21, // 80: memos.api.v1.MemoService.ListMemoAttachments:output_type -> memos.api.v1.ListMemoAttachmentsResponse
+	55, // 81: memos.api.v1.MemoService.SetMemoRelations:output_type -> google.protobuf.Empty
+	25, // 82: memos.api.v1.MemoService.ListMemoRelations:output_type -> memos.api.v1.ListMemoRelationsResponse
+	27, // 83: memos.api.v1.MemoService.ListRelatedMemos:output_type -> memos.api.v1.ListRelatedMemosResponse
+	29, // 84: memos.api.v1.MemoService.ListDuplicateMemoClusters:output_type -> memos.api.v1.ListDuplicateMemoClustersResponse
+	8,  // 85: memos.api.v1.MemoService.MergeMemos:output_type -> memos.api.v1.Memo
+	8,  // 86: memos.api.v1.MemoService.AcceptMemoEnrichment:output_type -> memos.api.v1.Memo
+	8,  // 87: memos.api.v1.MemoService.RejectMemoEnrichment:output_type -> memos.api.v1.Memo
+	8,  // 88: memos.api.v1.MemoService.CreateMemoComment:output_type -> memos.api.v1.Memo
+	35, // 89: memos.api.v1.MemoService.ListMemoComments:output_type -> memos.api.v1.ListMemoCommentsResponse
+	37, // 90: memos.api.v1.MemoService.ListMemoReactions:output_type -> memos.api.v1.ListMemoReactionsResponse
+	7,  // 91: memos.api.v1.MemoService.UpsertMemoReaction:output_type -> memos.api.v1.Reaction
+	55, // 92: memos.api.v1.MemoService.DeleteMemoReaction:output_type -> google.protobuf.Empty
+	5,  // 93: memos.api.v1.MemoService.ListTasks:output_type -> memos.api.v1.ListTasksResponse
+	3,  // 94: memos.api.v1.MemoService.ToggleTask:output_type -> memos.api.v1.Task
+	42, // 95: memos.api.v1.MemoService.ListMemoRevisions:output_type -> memos.api.v1.ListMemoRevisionsResponse
+	40, // 96: memos.api.v1.MemoService.GetMemoRevision:output_type -> memos.api.v1.MemoRevision
+	8,  // 97: memos.api.v1.MemoService.RestoreMemoRevision:output_type -> memos.api.v1.Memo
+	72, // [72:98] is the sub-list for method output_type
+	46, // [46:72] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_memo_service_proto_init() }
@@ -2191,13 +3978,16 @@ func file_api_v1_memo_service_proto_init() {
 	file_api_v1_attachment_service_proto_init()
 	file_api_v1_common_proto_init()
 	file_api_v1_memo_service_proto_msgTypes[1].OneofWrappers = []any{}
+	file_api_v1_memo_service_proto_msgTypes[3].OneofWrappers = []any{}
+	file_api_v1_memo_service_proto_msgTypes[5].OneofWrappers = []any{}
+	file_api_v1_memo_service_proto_msgTypes[37].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_memo_service_proto_rawDesc), len(file_api_v1_memo_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   26,
+			NumEnums:      3,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
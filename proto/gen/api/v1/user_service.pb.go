@@ -86,6 +86,8 @@ const (
 	UserSetting_GENERAL UserSetting_Key = 1
 	// WEBHOOKS is the key for user webhooks.
 	UserSetting_WEBHOOKS UserSetting_Key = 4
+	// DIGEST is the key for the periodic digest subscription.
+	UserSetting_DIGEST UserSetting_Key = 5
 )
 
 // Enum value maps for UserSetting_Key.
@@ -94,11 +96,13 @@ var (
 		0: "KEY_UNSPECIFIED",
 		1: "GENERAL",
 		4: "WEBHOOKS",
+		5: "DIGEST",
 	}
 	UserSetting_Key_value = map[string]int32{
 		"KEY_UNSPECIFIED": 0,
 		"GENERAL":         1,
 		"WEBHOOKS":        4,
+		"DIGEST":          5,
 	}
 )
 
@@ -129,6 +133,237 @@ func (UserSetting_Key) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11, 0}
 }
 
+type UserSetting_DigestSetting_Frequency int32
+
+const (
+	// The digest is disabled.
+	UserSetting_DigestSetting_FREQUENCY_UNSPECIFIED UserSetting_DigestSetting_Frequency = 0
+	UserSetting_DigestSetting_DAILY                 UserSetting_DigestSetting_Frequency = 1
+	UserSetting_DigestSetting_WEEKLY                UserSetting_DigestSetting_Frequency = 2
+)
+
+// Enum value maps for UserSetting_DigestSetting_Frequency.
+var (
+	UserSetting_DigestSetting_Frequency_name = map[int32]string{
+		0: "FREQUENCY_UNSPECIFIED",
+		1: "DAILY",
+		2: "WEEKLY",
+	}
+	UserSetting_DigestSetting_Frequency_value = map[string]int32{
+		"FREQUENCY_UNSPECIFIED": 0,
+		"DAILY":                 1,
+		"WEEKLY":                2,
+	}
+)
+
+func (x UserSetting_DigestSetting_Frequency) Enum() *UserSetting_DigestSetting_Frequency {
+	p := new(UserSetting_DigestSetting_Frequency)
+	*p = x
+	return p
+}
+
+func (x UserSetting_DigestSetting_Frequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserSetting_DigestSetting_Frequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[2].Descriptor()
+}
+
+func (UserSetting_DigestSetting_Frequency) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[2]
+}
+
+func (x UserSetting_DigestSetting_Frequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserSetting_DigestSetting_Frequency.Descriptor instead.
+func (UserSetting_DigestSetting_Frequency) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11, 2, 0}
+}
+
+type UserSetting_DigestSetting_Channel int32
+
+const (
+	UserSetting_DigestSetting_CHANNEL_UNSPECIFIED UserSetting_DigestSetting_Channel = 0
+	// Sent by email. Requires the instance email setting.
+	UserSetting_DigestSetting_EMAIL UserSetting_DigestSetting_Channel = 1
+	// Sent to the user webhooks subscribed to "memos.digest.created".
+	UserSetting_DigestSetting_WEBHOOK UserSetting_DigestSetting_Channel = 2
+	// Created as a notification.
+	UserSetting_DigestSetting_INBOX UserSetting_DigestSetting_Channel = 3
+)
+
+// Enum value maps for UserSetting_DigestSetting_Channel.
+var (
+	UserSetting_DigestSetting_Channel_name = map[int32]string{
+		0: "CHANNEL_UNSPECIFIED",
+		1: "EMAIL",
+		2: "WEBHOOK",
+		3: "INBOX",
+	}
+	UserSetting_DigestSetting_Channel_value = map[string]int32{
+		"CHANNEL_UNSPECIFIED": 0,
+		"EMAIL":               1,
+		"WEBHOOK":             2,
+		"INBOX":               3,
+	}
+)
+
+func (x UserSetting_DigestSetting_Channel) Enum() *UserSetting_DigestSetting_Channel {
+	p := new(UserSetting_DigestSetting_Channel)
+	*p = x
+	return p
+}
+
+func (x UserSetting_DigestSetting_Channel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserSetting_DigestSetting_Channel) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[3].Descriptor()
+}
+
+func (UserSetting_DigestSetting_Channel) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[3]
+}
+
+func (x UserSetting_DigestSetting_Channel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserSetting_DigestSetting_Channel.Descriptor instead.
+func (UserSetting_DigestSetting_Channel) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11, 2, 1}
+}
+
+type UserWebhook_Format int32
+
+const (
+	UserWebhook_FORMAT_UNSPECIFIED UserWebhook_Format = 0
+	// The memos JSON payload.
+	UserWebhook_MEMOS UserWebhook_Format = 1
+	// Slack incoming webhook with blocks.
+	UserWebhook_SLACK UserWebhook_Format = 2
+	// Discord webhook with an embed.
+	UserWebhook_DISCORD UserWebhook_Format = 3
+	// Telegram Bot API sendMessage. The chat is taken from the `chat_id` query parameter of the URL.
+	UserWebhook_TELEGRAM UserWebhook_Format = 4
+	// Feishu/Lark custom bot with an interactive card.
+	UserWebhook_FEISHU UserWebhook_Format = 5
+	// DingTalk custom robot with a markdown message.
+	UserWebhook_DINGTALK UserWebhook_Format = 6
+	// A user-supplied Go text/template.
+	UserWebhook_TEMPLATE UserWebhook_Format = 7
+)
+
+// Enum value maps for UserWebhook_Format.
+var (
+	UserWebhook_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "MEMOS",
+		2: "SLACK",
+		3: "DISCORD",
+		4: "TELEGRAM",
+		5: "FEISHU",
+		6: "DINGTALK",
+		7: "TEMPLATE",
+	}
+	UserWebhook_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"MEMOS":              1,
+		"SLACK":              2,
+		"DISCORD":            3,
+		"TELEGRAM":           4,
+		"FEISHU":             5,
+		"DINGTALK":           6,
+		"TEMPLATE":           7,
+	}
+)
+
+func (x UserWebhook_Format) Enum() *UserWebhook_Format {
+	p := new(UserWebhook_Format)
+	*p = x
+	return p
+}
+
+func (x UserWebhook_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserWebhook_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[4].Descriptor()
+}
+
+func (UserWebhook_Format) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[4]
+}
+
+func (x UserWebhook_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserWebhook_Format.Descriptor instead.
+func (UserWebhook_Format) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{22, 0}
+}
+
+type UserWebhookDelivery_State int32
+
+const (
+	UserWebhookDelivery_STATE_UNSPECIFIED UserWebhookDelivery_State = 0
+	// The delivery is waiting for an attempt or a retry.
+	UserWebhookDelivery_PENDING UserWebhookDelivery_State = 1
+	// The receiver accepted the delivery.
+	UserWebhookDelivery_SUCCEEDED UserWebhookDelivery_State = 2
+	// All attempts failed.
+	UserWebhookDelivery_FAILED UserWebhookDelivery_State = 3
+)
+
+// Enum value maps for UserWebhookDelivery_State.
+var (
+	UserWebhookDelivery_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "PENDING",
+		2: "SUCCEEDED",
+		3: "FAILED",
+	}
+	UserWebhookDelivery_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"PENDING":           1,
+		"SUCCEEDED":         2,
+		"FAILED":            3,
+	}
+)
+
+func (x UserWebhookDelivery_State) Enum() *UserWebhookDelivery_State {
+	p := new(UserWebhookDelivery_State)
+	*p = x
+	return p
+}
+
+func (x UserWebhookDelivery_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserWebhookDelivery_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[5].Descriptor()
+}
+
+func (UserWebhookDelivery_State) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[5]
+}
+
+func (x UserWebhookDelivery_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserWebhookDelivery_State.Descriptor instead.
+func (UserWebhookDelivery_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{28, 0}
+}
+
 type UserNotification_Status int32
 
 const (
@@ -162,11 +397,11 @@ func (x UserNotification_Status) String() string {
 }
 
 func (UserNotification_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_user_service_proto_enumTypes[2].Descriptor()
+	return file_api_v1_user_service_proto_enumTypes[6].Descriptor()
 }
 
 func (UserNotification_Status) Type() protoreflect.EnumType {
-	return &file_api_v1_user_service_proto_enumTypes[2]
+	return &file_api_v1_user_service_proto_enumTypes[6]
 }
 
 func (x UserNotification_Status) Number() protoreflect.EnumNumber {
@@ -175,7 +410,7 @@ func (x UserNotification_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UserNotification_Status.Descriptor instead.
 func (UserNotification_Status) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{28, 0}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{32, 0}
 }
 
 type UserNotification_Type int32
@@ -183,6 +418,9 @@ type UserNotification_Type int32
 const (
 	UserNotification_TYPE_UNSPECIFIED UserNotification_Type = 0
 	UserNotification_MEMO_COMMENT     UserNotification_Type = 1
+	UserNotification_DIGEST           UserNotification_Type = 2
+	UserNotification_MEMO_MENTION     UserNotification_Type = 3
+	UserNotification_MEMO_REMINDER    UserNotification_Type = 4
 )
 
 // Enum value maps for UserNotification_Type.
@@ -190,10 +428,16 @@ var (
 	UserNotification_Type_name = map[int32]string{
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
+		2: "DIGEST",
+		3: "MEMO_MENTION",
+		4: "MEMO_REMINDER",
 	}
 	UserNotification_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
 		"MEMO_COMMENT":     1,
+		"DIGEST":           2,
+		"MEMO_MENTION":     3,
+		"MEMO_REMINDER":    4,
 	}
 )
 
@@ -208,11 +452,11 @@ func (x UserNotification_Type) String() string {
 }
 
 func (UserNotification_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_user_service_proto_enumTypes[3].Descriptor()
+	return file_api_v1_user_service_proto_enumTypes[7].Descriptor()
 }
 
 func (UserNotification_Type) Type() protoreflect.EnumType {
-	return &file_api_v1_user_service_proto_enumTypes[3]
+	return &file_api_v1_user_service_proto_enumTypes[7]
 }
 
 func (x UserNotification_Type) Number() protoreflect.EnumNumber {
@@ -221,7 +465,67 @@ func (x UserNotification_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UserNotification_Type.Descriptor instead.
 func (UserNotification_Type) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{28, 1}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{32, 1}
+}
+
+// The note tool the notes are exported from.
+type ImportNotesRequest_Format int32
+
+const (
+	ImportNotesRequest_FORMAT_UNSPECIFIED ImportNotesRequest_Format = 0
+	// An Obsidian vault, zipped.
+	ImportNotesRequest_OBSIDIAN ImportNotesRequest_Format = 1
+	// The zip archive exported by flomo.
+	ImportNotesRequest_FLOMO ImportNotesRequest_Format = 2
+	// The zip archive of Google Keep exported by Google Takeout.
+	ImportNotesRequest_GOOGLE_KEEP ImportNotesRequest_Format = 3
+	// An ENEX file exported by Evernote, or a zip archive of ENEX files.
+	ImportNotesRequest_EVERNOTE ImportNotesRequest_Format = 4
+)
+
+// Enum value maps for ImportNotesRequest_Format.
+var (
+	ImportNotesRequest_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "OBSIDIAN",
+		2: "FLOMO",
+		3: "GOOGLE_KEEP",
+		4: "EVERNOTE",
+	}
+	ImportNotesRequest_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"OBSIDIAN":           1,
+		"FLOMO":              2,
+		"GOOGLE_KEEP":        3,
+		"EVERNOTE":           4,
+	}
+)
+
+func (x ImportNotesRequest_Format) Enum() *ImportNotesRequest_Format {
+	p := new(ImportNotesRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ImportNotesRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportNotesRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[8].Descriptor()
+}
+
+func (ImportNotesRequest_Format) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[8]
+}
+
+func (x ImportNotesRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportNotesRequest_Format.Descriptor instead.
+func (ImportNotesRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{41, 0}
 }
 
 type User struct {
@@ -986,6 +1290,7 @@ type UserSetting struct {
 	//
 	//	*UserSetting_GeneralSetting_
 	//	*UserSetting_WebhooksSetting_
+	//	*UserSetting_DigestSetting_
 	Value         isUserSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1053,6 +1358,15 @@ func (x *UserSetting) GetWebhooksSetting() *UserSetting_WebhooksSetting {
 	return nil
 }
 
+func (x *UserSetting) GetDigestSetting() *UserSetting_DigestSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_DigestSetting_); ok {
+			return x.DigestSetting
+		}
+	}
+	return nil
+}
+
 type isUserSetting_Value interface {
 	isUserSetting_Value()
 }
@@ -1065,10 +1379,16 @@ type UserSetting_WebhooksSetting_ struct {
 	WebhooksSetting *UserSetting_WebhooksSetting `protobuf:"bytes,5,opt,name=webhooks_setting,json=webhooksSetting,proto3,oneof"`
 }
 
+type UserSetting_DigestSetting_ struct {
+	DigestSetting *UserSetting_DigestSetting `protobuf:"bytes,6,opt,name=digest_setting,json=digestSetting,proto3,oneof"`
+}
+
 func (*UserSetting_GeneralSetting_) isUserSetting_Value() {}
 
 func (*UserSetting_WebhooksSetting_) isUserSetting_Value() {}
 
+func (*UserSetting_DigestSetting_) isUserSetting_Value() {}
+
 type GetUserSettingRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The resource name of the user setting.
@@ -1692,9 +2012,26 @@ type UserWebhook struct {
 	// The creation time of the webhook.
 	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	// The last update time of the webhook.
-	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Optional. The secret used to sign deliveries with HMAC-SHA256.
+	// Generated when empty on creation, and rotated by updating the field with an empty value.
+	// Only returned by the creation and by the update setting it, it is empty otherwise.
+	Secret string `protobuf:"bytes,6,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Optional. The activity types the webhook subscribes to, e.g. "memos.memo.created".
+	// Empty subscribes to all activity types.
+	EventTypes []string `protobuf:"bytes,7,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	// Optional. The CEL filter the memo of an activity must match, using the memo filter syntax.
+	// Example: `tag in ["release"] && visibility == "PUBLIC"`.
+	Filter string `protobuf:"bytes,8,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Optional. The format of the request body. Defaults to the memos JSON payload.
+	Format UserWebhook_Format `protobuf:"varint,9,opt,name=format,proto3,enum=memos.api.v1.UserWebhook_Format" json:"format,omitempty"`
+	// Optional. The Go text/template rendering the request body of the TEMPLATE format.
+	// The template is executed with the memos payload and the rendered `Title`, `Snippet` and `Link`.
+	Template string `protobuf:"bytes,10,opt,name=template,proto3" json:"template,omitempty"`
+	// Optional. Whether the response body must be a JSON object with a zero `code`, e.g. `{"code":0}`.
+	CheckResponseCode bool `protobuf:"varint,11,opt,name=check_response_code,json=checkResponseCode,proto3" json:"check_response_code,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *UserWebhook) Reset() {
@@ -1762,6 +2099,48 @@ func (x *UserWebhook) GetUpdateTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *UserWebhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *UserWebhook) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *UserWebhook) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *UserWebhook) GetFormat() UserWebhook_Format {
+	if x != nil {
+		return x.Format
+	}
+	return UserWebhook_FORMAT_UNSPECIFIED
+}
+
+func (x *UserWebhook) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *UserWebhook) GetCheckResponseCode() bool {
+	if x != nil {
+		return x.CheckResponseCode
+	}
+	return false
+}
+
 type ListUserWebhooksRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The parent user resource.
@@ -2008,40 +2387,50 @@ func (x *DeleteUserWebhookRequest) GetName() string {
 	return ""
 }
 
-type UserNotification struct {
+// UserWebhookDelivery is a request sent to a webhook, retried until it succeeds or fails on all attempts.
+type UserWebhookDelivery struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The resource name of the notification.
-	// Format: users/{user}/notifications/{notification}
+	// The name of the delivery.
+	// Format: users/{user}/webhooks/{webhook}/deliveries/{delivery}
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The sender of the notification.
-	// Format: users/{user}
-	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	// The status of the notification.
-	Status UserNotification_Status `protobuf:"varint,3,opt,name=status,proto3,enum=memos.api.v1.UserNotification_Status" json:"status,omitempty"`
-	// The creation timestamp.
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	// The type of the notification.
-	Type UserNotification_Type `protobuf:"varint,5,opt,name=type,proto3,enum=memos.api.v1.UserNotification_Type" json:"type,omitempty"`
-	// The activity ID associated with this notification.
-	ActivityId    *int32 `protobuf:"varint,6,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
+	// The URL the request is sent to.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// The type of activity that triggered the delivery.
+	ActivityType string `protobuf:"bytes,3,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
+	// The state of the delivery.
+	State UserWebhookDelivery_State `protobuf:"varint,4,opt,name=state,proto3,enum=memos.api.v1.UserWebhookDelivery_State" json:"state,omitempty"`
+	// The number of attempts made.
+	Attempts int32 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// The JSON request body.
+	RequestBody string `protobuf:"bytes,6,opt,name=request_body,json=requestBody,proto3" json:"request_body,omitempty"`
+	// The HTTP status code of the last attempt, 0 when no response was received.
+	ResponseStatusCode int32 `protobuf:"varint,7,opt,name=response_status_code,json=responseStatusCode,proto3" json:"response_status_code,omitempty"`
+	// The response body of the last attempt, truncated.
+	ResponseBody string `protobuf:"bytes,8,opt,name=response_body,json=responseBody,proto3" json:"response_body,omitempty"`
+	// The error of the last failed attempt.
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	// The creation time of the delivery.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The time of the last attempt.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserNotification) Reset() {
-	*x = UserNotification{}
+func (x *UserWebhookDelivery) Reset() {
+	*x = UserWebhookDelivery{}
 	mi := &file_api_v1_user_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserNotification) String() string {
+func (x *UserWebhookDelivery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserNotification) ProtoMessage() {}
+func (*UserWebhookDelivery) ProtoMessage() {}
 
-func (x *UserNotification) ProtoReflect() protoreflect.Message {
+func (x *UserWebhookDelivery) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2053,79 +2442,117 @@ func (x *UserNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserNotification.ProtoReflect.Descriptor instead.
-func (*UserNotification) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserWebhookDelivery.ProtoReflect.Descriptor instead.
+func (*UserWebhookDelivery) Descriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *UserNotification) GetName() string {
+func (x *UserWebhookDelivery) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UserNotification) GetSender() string {
+func (x *UserWebhookDelivery) GetUrl() string {
 	if x != nil {
-		return x.Sender
+		return x.Url
 	}
 	return ""
 }
 
-func (x *UserNotification) GetStatus() UserNotification_Status {
+func (x *UserWebhookDelivery) GetActivityType() string {
 	if x != nil {
-		return x.Status
+		return x.ActivityType
 	}
-	return UserNotification_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *UserNotification) GetCreateTime() *timestamppb.Timestamp {
+func (x *UserWebhookDelivery) GetState() UserWebhookDelivery_State {
 	if x != nil {
-		return x.CreateTime
+		return x.State
 	}
-	return nil
+	return UserWebhookDelivery_STATE_UNSPECIFIED
 }
 
-func (x *UserNotification) GetType() UserNotification_Type {
+func (x *UserWebhookDelivery) GetAttempts() int32 {
 	if x != nil {
-		return x.Type
+		return x.Attempts
 	}
-	return UserNotification_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *UserNotification) GetActivityId() int32 {
-	if x != nil && x.ActivityId != nil {
-		return *x.ActivityId
+func (x *UserWebhookDelivery) GetRequestBody() string {
+	if x != nil {
+		return x.RequestBody
+	}
+	return ""
+}
+
+func (x *UserWebhookDelivery) GetResponseStatusCode() int32 {
+	if x != nil {
+		return x.ResponseStatusCode
 	}
 	return 0
 }
 
-type ListUserNotificationsRequest struct {
+func (x *UserWebhookDelivery) GetResponseBody() string {
+	if x != nil {
+		return x.ResponseBody
+	}
+	return ""
+}
+
+func (x *UserWebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *UserWebhookDelivery) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *UserWebhookDelivery) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type ListUserWebhookDeliveriesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The parent user resource.
-	// Format: users/{user}
-	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The parent webhook resource.
+	// Format: users/{user}/webhooks/{webhook}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Optional. The maximum number of deliveries to return.
+	// If unspecified, at most 10 deliveries will be returned.
+	// The maximum value is 1000; values above 1000 will be coerced to 1000.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `ListUserWebhookDeliveries` call.
 	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	Filter        string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserNotificationsRequest) Reset() {
-	*x = ListUserNotificationsRequest{}
+func (x *ListUserWebhookDeliveriesRequest) Reset() {
+	*x = ListUserWebhookDeliveriesRequest{}
 	mi := &file_api_v1_user_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserNotificationsRequest) String() string {
+func (x *ListUserWebhookDeliveriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserNotificationsRequest) ProtoMessage() {}
+func (*ListUserWebhookDeliveriesRequest) ProtoMessage() {}
 
-func (x *ListUserNotificationsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListUserWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_user_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2137,62 +2564,627 @@ func (x *ListUserNotificationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserNotificationsRequest.ProtoReflect.Descriptor instead.
-func (*ListUserNotificationsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *ListUserNotificationsRequest) GetParent() string {
-	if x != nil {
-		return x.Parent
-	}
-	return ""
-}
-
-func (x *ListUserNotificationsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use ListUserWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListUserWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListUserWebhookDeliveriesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListUserWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUserWebhookDeliveriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListUserWebhookDeliveriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of deliveries.
+	Deliveries []*UserWebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	// A token that can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserWebhookDeliveriesResponse) Reset() {
+	*x = ListUserWebhookDeliveriesResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListUserWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListUserWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListUserWebhookDeliveriesResponse) GetDeliveries() []*UserWebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListUserWebhookDeliveriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RedeliverUserWebhookDeliveryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the delivery to redeliver.
+	// Format: users/{user}/webhooks/{webhook}/deliveries/{delivery}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverUserWebhookDeliveryRequest) Reset() {
+	*x = RedeliverUserWebhookDeliveryRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverUserWebhookDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverUserWebhookDeliveryRequest) ProtoMessage() {}
+
+func (x *RedeliverUserWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverUserWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverUserWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RedeliverUserWebhookDeliveryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UserNotification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the notification.
+	// Format: users/{user}/notifications/{notification}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The sender of the notification.
+	// Format: users/{user}
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// The status of the notification.
+	Status UserNotification_Status `protobuf:"varint,3,opt,name=status,proto3,enum=memos.api.v1.UserNotification_Status" json:"status,omitempty"`
+	// The creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The type of the notification.
+	Type UserNotification_Type `protobuf:"varint,5,opt,name=type,proto3,enum=memos.api.v1.UserNotification_Type" json:"type,omitempty"`
+	// The activity ID associated with this notification.
+	ActivityId *int32 `protobuf:"varint,6,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
+	// The digest, for digest notifications.
+	Digest        *UserNotification_Digest `protobuf:"bytes,7,opt,name=digest,proto3" json:"digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserNotification) Reset() {
+	*x = UserNotification{}
+	mi := &file_api_v1_user_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserNotification) ProtoMessage() {}
+
+func (x *UserNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserNotification.ProtoReflect.Descriptor instead.
+func (*UserNotification) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UserNotification) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserNotification) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *UserNotification) GetStatus() UserNotification_Status {
+	if x != nil {
+		return x.Status
+	}
+	return UserNotification_STATUS_UNSPECIFIED
+}
+
+func (x *UserNotification) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *UserNotification) GetType() UserNotification_Type {
+	if x != nil {
+		return x.Type
+	}
+	return UserNotification_TYPE_UNSPECIFIED
+}
+
+func (x *UserNotification) GetActivityId() int32 {
+	if x != nil && x.ActivityId != nil {
+		return *x.ActivityId
+	}
+	return 0
+}
+
+func (x *UserNotification) GetDigest() *UserNotification_Digest {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+type ListUserNotificationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent user resource.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter        string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserNotificationsRequest) Reset() {
+	*x = ListUserNotificationsRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserNotificationsRequest) ProtoMessage() {}
+
+func (x *ListUserNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListUserNotificationsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListUserNotificationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUserNotificationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUserNotificationsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type ListUserNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*UserNotification    `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserNotificationsResponse) Reset() {
+	*x = ListUserNotificationsResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserNotificationsResponse) ProtoMessage() {}
+
+func (x *ListUserNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListUserNotificationsResponse) GetNotifications() []*UserNotification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListUserNotificationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateUserNotificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notification  *UserNotification      `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserNotificationRequest) Reset() {
+	*x = UpdateUserNotificationRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserNotificationRequest) ProtoMessage() {}
+
+func (x *UpdateUserNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserNotificationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateUserNotificationRequest) GetNotification() *UserNotification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+func (x *UpdateUserNotificationRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteUserNotificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Format: users/{user}/notifications/{notification}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserNotificationRequest) Reset() {
+	*x = DeleteUserNotificationRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserNotificationRequest) ProtoMessage() {}
+
+func (x *DeleteUserNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserNotificationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteUserNotificationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ExportUserDataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the user.
+	// Format: users/{user}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ExportUserDataRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ExportUserDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The zip archive, with a Markdown file with YAML front matter per memo
+	// in "memos/" and the attachment blobs in "attachments/".
+	Content       []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataResponse) Reset() {
+	*x = ExportUserDataResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataResponse) ProtoMessage() {}
+
+func (x *ExportUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ExportUserDataResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ImportUserDataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the user.
+	// Format: users/{user}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The zip archive made by ExportUserData.
+	Content       []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportUserDataRequest) Reset() {
+	*x = ImportUserDataRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUserDataRequest) ProtoMessage() {}
+
+func (x *ImportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ImportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ListUserNotificationsRequest) GetPageToken() string {
+func (x *ImportUserDataRequest) GetName() string {
 	if x != nil {
-		return x.PageToken
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListUserNotificationsRequest) GetFilter() string {
+func (x *ImportUserDataRequest) GetContent() []byte {
 	if x != nil {
-		return x.Filter
+		return x.Content
 	}
-	return ""
-}
-
-type ListUserNotificationsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Notifications []*UserNotification    `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return nil
 }
 
-func (x *ListUserNotificationsResponse) Reset() {
-	*x = ListUserNotificationsResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[30]
+type ImportUserDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of memos created.
+	CreatedMemoCount int32 `protobuf:"varint,1,opt,name=created_memo_count,json=createdMemoCount,proto3" json:"created_memo_count,omitempty"`
+	// The number of existing memos updated.
+	UpdatedMemoCount int32 `protobuf:"varint,2,opt,name=updated_memo_count,json=updatedMemoCount,proto3" json:"updated_memo_count,omitempty"`
+	// The number of attachments created.
+	CreatedAttachmentCount int32 `protobuf:"varint,3,opt,name=created_attachment_count,json=createdAttachmentCount,proto3" json:"created_attachment_count,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ImportUserDataResponse) Reset() {
+	*x = ImportUserDataResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserNotificationsResponse) String() string {
+func (x *ImportUserDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserNotificationsResponse) ProtoMessage() {}
+func (*ImportUserDataResponse) ProtoMessage() {}
 
-func (x *ListUserNotificationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[30]
+func (x *ImportUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2203,48 +3195,63 @@ func (x *ListUserNotificationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserNotificationsResponse.ProtoReflect.Descriptor instead.
-func (*ListUserNotificationsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ImportUserDataResponse.ProtoReflect.Descriptor instead.
+func (*ImportUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ListUserNotificationsResponse) GetNotifications() []*UserNotification {
+func (x *ImportUserDataResponse) GetCreatedMemoCount() int32 {
 	if x != nil {
-		return x.Notifications
+		return x.CreatedMemoCount
 	}
-	return nil
+	return 0
 }
 
-func (x *ListUserNotificationsResponse) GetNextPageToken() string {
+func (x *ImportUserDataResponse) GetUpdatedMemoCount() int32 {
 	if x != nil {
-		return x.NextPageToken
+		return x.UpdatedMemoCount
 	}
-	return ""
+	return 0
 }
 
-type UpdateUserNotificationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Notification  *UserNotification      `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+func (x *ImportUserDataResponse) GetCreatedAttachmentCount() int32 {
+	if x != nil {
+		return x.CreatedAttachmentCount
+	}
+	return 0
+}
+
+type ImportNotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the user.
+	// Format: users/{user}
+	Name   string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Format ImportNotesRequest_Format `protobuf:"varint,2,opt,name=format,proto3,enum=memos.api.v1.ImportNotesRequest_Format" json:"format,omitempty"`
+	// The name of the exported file. Files ending with .zip are extracted.
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// The content of the exported file.
+	Content []byte `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// Whether to only report what would be imported, without importing it.
+	DryRun        bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserNotificationRequest) Reset() {
-	*x = UpdateUserNotificationRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[31]
+func (x *ImportNotesRequest) Reset() {
+	*x = ImportNotesRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserNotificationRequest) String() string {
+func (x *ImportNotesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserNotificationRequest) ProtoMessage() {}
+func (*ImportNotesRequest) ProtoMessage() {}
 
-func (x *UpdateUserNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[31]
+func (x *ImportNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2255,48 +3262,77 @@ func (x *UpdateUserNotificationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserNotificationRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ImportNotesRequest.ProtoReflect.Descriptor instead.
+func (*ImportNotesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *UpdateUserNotificationRequest) GetNotification() *UserNotification {
+func (x *ImportNotesRequest) GetName() string {
 	if x != nil {
-		return x.Notification
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateUserNotificationRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *ImportNotesRequest) GetFormat() ImportNotesRequest_Format {
 	if x != nil {
-		return x.UpdateMask
+		return x.Format
+	}
+	return ImportNotesRequest_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportNotesRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ImportNotesRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
 	}
 	return nil
 }
 
-type DeleteUserNotificationRequest struct {
+func (x *ImportNotesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportNotesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Format: users/{user}/notifications/{notification}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The number of memos imported, or to be imported on a dry run.
+	MemoCount int32 `protobuf:"varint,1,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	// The number of attachments imported, or to be imported on a dry run.
+	AttachmentCount int32 `protobuf:"varint,2,opt,name=attachment_count,json=attachmentCount,proto3" json:"attachment_count,omitempty"`
+	// The number of reference relations made from the links between the notes.
+	RelationCount int32 `protobuf:"varint,3,opt,name=relation_count,json=relationCount,proto3" json:"relation_count,omitempty"`
+	// The tags of the imported memos.
+	Tags []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The problems found in the export, such as links to missing notes.
+	Warnings      []string `protobuf:"bytes,5,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserNotificationRequest) Reset() {
-	*x = DeleteUserNotificationRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[32]
+func (x *ImportNotesResponse) Reset() {
+	*x = ImportNotesResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserNotificationRequest) String() string {
+func (x *ImportNotesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserNotificationRequest) ProtoMessage() {}
+func (*ImportNotesResponse) ProtoMessage() {}
 
-func (x *DeleteUserNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[32]
+func (x *ImportNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2307,16 +3343,44 @@ func (x *DeleteUserNotificationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserNotificationRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ImportNotesResponse.ProtoReflect.Descriptor instead.
+func (*ImportNotesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *DeleteUserNotificationRequest) GetName() string {
+func (x *ImportNotesResponse) GetMemoCount() int32 {
 	if x != nil {
-		return x.Name
+		return x.MemoCount
 	}
-	return ""
+	return 0
+}
+
+func (x *ImportNotesResponse) GetAttachmentCount() int32 {
+	if x != nil {
+		return x.AttachmentCount
+	}
+	return 0
+}
+
+func (x *ImportNotesResponse) GetRelationCount() int32 {
+	if x != nil {
+		return x.RelationCount
+	}
+	return 0
+}
+
+func (x *ImportNotesResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ImportNotesResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
 }
 
 // Memo type statistics.
@@ -2332,7 +3396,7 @@ type UserStats_MemoTypeStats struct {
 
 func (x *UserStats_MemoTypeStats) Reset() {
 	*x = UserStats_MemoTypeStats{}
-	mi := &file_api_v1_user_service_proto_msgTypes[34]
+	mi := &file_api_v1_user_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2344,7 +3408,7 @@ func (x *UserStats_MemoTypeStats) String() string {
 func (*UserStats_MemoTypeStats) ProtoMessage() {}
 
 func (x *UserStats_MemoTypeStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[34]
+	mi := &file_api_v1_user_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2398,14 +3462,20 @@ type UserSetting_GeneralSetting struct {
 	// The preferred theme of the user.
 	// This references a CSS file in the web/public/themes/ directory.
 	// If not set, the default theme will be used.
-	Theme         string `protobuf:"bytes,4,opt,name=theme,proto3" json:"theme,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Theme string `protobuf:"bytes,4,opt,name=theme,proto3" json:"theme,omitempty"`
+	// Whether to email the user about new comments on their memos.
+	EmailOnComment bool `protobuf:"varint,5,opt,name=email_on_comment,json=emailOnComment,proto3" json:"email_on_comment,omitempty"`
+	// Whether to email the user when they are mentioned.
+	EmailOnMention bool `protobuf:"varint,6,opt,name=email_on_mention,json=emailOnMention,proto3" json:"email_on_mention,omitempty"`
+	// Whether to email the user about memo reminders.
+	EmailOnReminder bool `protobuf:"varint,7,opt,name=email_on_reminder,json=emailOnReminder,proto3" json:"email_on_reminder,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UserSetting_GeneralSetting) Reset() {
 	*x = UserSetting_GeneralSetting{}
-	mi := &file_api_v1_user_service_proto_msgTypes[35]
+	mi := &file_api_v1_user_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2417,7 +3487,7 @@ func (x *UserSetting_GeneralSetting) String() string {
 func (*UserSetting_GeneralSetting) ProtoMessage() {}
 
 func (x *UserSetting_GeneralSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[35]
+	mi := &file_api_v1_user_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2454,6 +3524,27 @@ func (x *UserSetting_GeneralSetting) GetTheme() string {
 	return ""
 }
 
+func (x *UserSetting_GeneralSetting) GetEmailOnComment() bool {
+	if x != nil {
+		return x.EmailOnComment
+	}
+	return false
+}
+
+func (x *UserSetting_GeneralSetting) GetEmailOnMention() bool {
+	if x != nil {
+		return x.EmailOnMention
+	}
+	return false
+}
+
+func (x *UserSetting_GeneralSetting) GetEmailOnReminder() bool {
+	if x != nil {
+		return x.EmailOnReminder
+	}
+	return false
+}
+
 // User webhooks configuration.
 type UserSetting_WebhooksSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -2465,7 +3556,7 @@ type UserSetting_WebhooksSetting struct {
 
 func (x *UserSetting_WebhooksSetting) Reset() {
 	*x = UserSetting_WebhooksSetting{}
-	mi := &file_api_v1_user_service_proto_msgTypes[36]
+	mi := &file_api_v1_user_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2477,7 +3568,7 @@ func (x *UserSetting_WebhooksSetting) String() string {
 func (*UserSetting_WebhooksSetting) ProtoMessage() {}
 
 func (x *UserSetting_WebhooksSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[36]
+	mi := &file_api_v1_user_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2500,6 +3591,139 @@ func (x *UserSetting_WebhooksSetting) GetWebhooks() []*UserWebhook {
 	return nil
 }
 
+// Periodic digest subscription.
+// Daily digests are sent every day and weekly digests every Monday, at 08:00 in the server time zone.
+type UserSetting_DigestSetting struct {
+	state     protoimpl.MessageState              `protogen:"open.v1"`
+	Frequency UserSetting_DigestSetting_Frequency `protobuf:"varint,1,opt,name=frequency,proto3,enum=memos.api.v1.UserSetting_DigestSetting_Frequency" json:"frequency,omitempty"`
+	Channels  []UserSetting_DigestSetting_Channel `protobuf:"varint,2,rep,packed,name=channels,proto3,enum=memos.api.v1.UserSetting_DigestSetting_Channel" json:"channels,omitempty"`
+	// The shortcut whose matching memos are included in the digest.
+	// Format: users/{user}/shortcuts/{shortcut}
+	Shortcut      string `protobuf:"bytes,3,opt,name=shortcut,proto3" json:"shortcut,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSetting_DigestSetting) Reset() {
+	*x = UserSetting_DigestSetting{}
+	mi := &file_api_v1_user_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSetting_DigestSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSetting_DigestSetting) ProtoMessage() {}
+
+func (x *UserSetting_DigestSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSetting_DigestSetting.ProtoReflect.Descriptor instead.
+func (*UserSetting_DigestSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11, 2}
+}
+
+func (x *UserSetting_DigestSetting) GetFrequency() UserSetting_DigestSetting_Frequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return UserSetting_DigestSetting_FREQUENCY_UNSPECIFIED
+}
+
+func (x *UserSetting_DigestSetting) GetChannels() []UserSetting_DigestSetting_Channel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *UserSetting_DigestSetting) GetShortcut() string {
+	if x != nil {
+		return x.Shortcut
+	}
+	return ""
+}
+
+type UserNotification_Digest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Title string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// The digest rendered as HTML.
+	Html          string                 `protobuf:"bytes,2,opt,name=html,proto3" json:"html,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserNotification_Digest) Reset() {
+	*x = UserNotification_Digest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserNotification_Digest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserNotification_Digest) ProtoMessage() {}
+
+func (x *UserNotification_Digest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserNotification_Digest.ProtoReflect.Descriptor instead.
+func (*UserNotification_Digest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *UserNotification_Digest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UserNotification_Digest) GetHtml() string {
+	if x != nil {
+		return x.Html
+	}
+	return ""
+}
+
+func (x *UserNotification_Digest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *UserNotification_Digest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
 var File_api_v1_user_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_user_service_proto_rawDesc = "" +
@@ -2581,21 +3805,41 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x11memos.api.v1/UserR\x04name\"\x19\n" +
 	"\x17ListAllUserStatsRequest\"I\n" +
 	"\x18ListAllUserStatsResponse\x12-\n" +
-	"\x05stats\x18\x01 \x03(\v2\x17.memos.api.v1.UserStatsR\x05stats\"\xb0\x04\n" +
+	"\x05stats\x18\x01 \x03(\v2\x17.memos.api.v1.UserStatsR\x05stats\"\xff\b\n" +
 	"\vUserSetting\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12S\n" +
 	"\x0fgeneral_setting\x18\x02 \x01(\v2(.memos.api.v1.UserSetting.GeneralSettingH\x00R\x0egeneralSetting\x12V\n" +
-	"\x10webhooks_setting\x18\x05 \x01(\v2).memos.api.v1.UserSetting.WebhooksSettingH\x00R\x0fwebhooksSetting\x1av\n" +
+	"\x10webhooks_setting\x18\x05 \x01(\v2).memos.api.v1.UserSetting.WebhooksSettingH\x00R\x0fwebhooksSetting\x12P\n" +
+	"\x0edigest_setting\x18\x06 \x01(\v2'.memos.api.v1.UserSetting.DigestSettingH\x00R\rdigestSetting\x1a\x85\x02\n" +
 	"\x0eGeneralSetting\x12\x1b\n" +
 	"\x06locale\x18\x01 \x01(\tB\x03\xe0A\x01R\x06locale\x12,\n" +
 	"\x0fmemo_visibility\x18\x03 \x01(\tB\x03\xe0A\x01R\x0ememoVisibility\x12\x19\n" +
-	"\x05theme\x18\x04 \x01(\tB\x03\xe0A\x01R\x05theme\x1aH\n" +
+	"\x05theme\x18\x04 \x01(\tB\x03\xe0A\x01R\x05theme\x12-\n" +
+	"\x10email_on_comment\x18\x05 \x01(\bB\x03\xe0A\x01R\x0eemailOnComment\x12-\n" +
+	"\x10email_on_mention\x18\x06 \x01(\bB\x03\xe0A\x01R\x0eemailOnMention\x12/\n" +
+	"\x11email_on_reminder\x18\a \x01(\bB\x03\xe0A\x01R\x0femailOnReminder\x1aH\n" +
 	"\x0fWebhooksSetting\x125\n" +
-	"\bwebhooks\x18\x01 \x03(\v2\x19.memos.api.v1.UserWebhookR\bwebhooks\"5\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x19.memos.api.v1.UserWebhookR\bwebhooks\x1a\xde\x02\n" +
+	"\rDigestSetting\x12T\n" +
+	"\tfrequency\x18\x01 \x01(\x0e21.memos.api.v1.UserSetting.DigestSetting.FrequencyB\x03\xe0A\x01R\tfrequency\x12P\n" +
+	"\bchannels\x18\x02 \x03(\x0e2/.memos.api.v1.UserSetting.DigestSetting.ChannelB\x03\xe0A\x01R\bchannels\x12\x1f\n" +
+	"\bshortcut\x18\x03 \x01(\tB\x03\xe0A\x01R\bshortcut\"=\n" +
+	"\tFrequency\x12\x19\n" +
+	"\x15FREQUENCY_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05DAILY\x10\x01\x12\n" +
+	"\n" +
+	"\x06WEEKLY\x10\x02\"E\n" +
+	"\aChannel\x12\x17\n" +
+	"\x13CHANNEL_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05EMAIL\x10\x01\x12\v\n" +
+	"\aWEBHOOK\x10\x02\x12\t\n" +
+	"\x05INBOX\x10\x03\"A\n" +
 	"\x03Key\x12\x13\n" +
 	"\x0fKEY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aGENERAL\x10\x01\x12\f\n" +
-	"\bWEBHOOKS\x10\x04:Y\xeaAV\n" +
+	"\bWEBHOOKS\x10\x04\x12\n" +
+	"\n" +
+	"\x06DIGEST\x10\x05:Y\xeaAV\n" +
 	"\x18memos.api.v1/UserSetting\x12\x1fusers/{user}/settings/{setting}*\fuserSettings2\vuserSettingB\a\n" +
 	"\x05value\"M\n" +
 	"\x15GetUserSettingRequest\x124\n" +
@@ -2647,7 +3891,7 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x05token\x18\x02 \x01(\tR\x05token\"`\n" +
 	" DeletePersonalAccessTokenRequest\x12<\n" +
 	"\x04name\x18\x01 \x01(\tB(\xe0A\x02\xfaA\"\n" +
-	" memos.api.v1/PersonalAccessTokenR\x04name\"\xda\x01\n" +
+	" memos.api.v1/PersonalAccessTokenR\x04name\"\xac\x04\n" +
 	"\vUserWebhook\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12!\n" +
@@ -2655,7 +3899,25 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime\x12@\n" +
 	"\vupdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
-	"updateTime\"6\n" +
+	"updateTime\x12\x16\n" +
+	"\x06secret\x18\x06 \x01(\tR\x06secret\x12\x1f\n" +
+	"\vevent_types\x18\a \x03(\tR\n" +
+	"eventTypes\x12\x16\n" +
+	"\x06filter\x18\b \x01(\tR\x06filter\x128\n" +
+	"\x06format\x18\t \x01(\x0e2 .memos.api.v1.UserWebhook.FormatR\x06format\x12\x1a\n" +
+	"\btemplate\x18\n" +
+	" \x01(\tR\btemplate\x12.\n" +
+	"\x13check_response_code\x18\v \x01(\bR\x11checkResponseCode\"y\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05MEMOS\x10\x01\x12\t\n" +
+	"\x05SLACK\x10\x02\x12\v\n" +
+	"\aDISCORD\x10\x03\x12\f\n" +
+	"\bTELEGRAM\x10\x04\x12\n" +
+	"\n" +
+	"\x06FEISHU\x10\x05\x12\f\n" +
+	"\bDINGTALK\x10\x06\x12\f\n" +
+	"\bTEMPLATE\x10\a\"6\n" +
 	"\x17ListUserWebhooksRequest\x12\x1b\n" +
 	"\x06parent\x18\x01 \x01(\tB\x03\xe0A\x02R\x06parent\"Q\n" +
 	"\x18ListUserWebhooksResponse\x125\n" +
@@ -2668,7 +3930,40 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"updateMask\"3\n" +
 	"\x18DeleteUserWebhookRequest\x12\x17\n" +
-	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"\xbe\x04\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"\xbf\x04\n" +
+	"\x13UserWebhookDelivery\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x15\n" +
+	"\x03url\x18\x02 \x01(\tB\x03\xe0A\x03R\x03url\x12(\n" +
+	"\ractivity_type\x18\x03 \x01(\tB\x03\xe0A\x03R\factivityType\x12B\n" +
+	"\x05state\x18\x04 \x01(\x0e2'.memos.api.v1.UserWebhookDelivery.StateB\x03\xe0A\x03R\x05state\x12\x1f\n" +
+	"\battempts\x18\x05 \x01(\x05B\x03\xe0A\x03R\battempts\x12&\n" +
+	"\frequest_body\x18\x06 \x01(\tB\x03\xe0A\x03R\vrequestBody\x125\n" +
+	"\x14response_status_code\x18\a \x01(\x05B\x03\xe0A\x03R\x12responseStatusCode\x12(\n" +
+	"\rresponse_body\x18\b \x01(\tB\x03\xe0A\x03R\fresponseBody\x12\x19\n" +
+	"\x05error\x18\t \x01(\tB\x03\xe0A\x03R\x05error\x12@\n" +
+	"\vcreate_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\"F\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\r\n" +
+	"\tSUCCEEDED\x10\x02\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x03\"\x85\x01\n" +
+	" ListUserWebhookDeliveriesRequest\x12\x1b\n" +
+	"\x06parent\x18\x01 \x01(\tB\x03\xe0A\x02R\x06parent\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"\x8e\x01\n" +
+	"!ListUserWebhookDeliveriesResponse\x12A\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2!.memos.api.v1.UserWebhookDeliveryR\n" +
+	"deliveries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\">\n" +
+	"#RedeliverUserWebhookDeliveryRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\"\xda\x06\n" +
 	"\x10UserNotification\x12\x1a\n" +
 	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x121\n" +
 	"\x06sender\x18\x02 \x01(\tB\x19\xe0A\x03\xfaA\x13\n" +
@@ -2678,15 +3973,26 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"createTime\x12<\n" +
 	"\x04type\x18\x05 \x01(\x0e2#.memos.api.v1.UserNotification.TypeB\x03\xe0A\x03R\x04type\x12)\n" +
 	"\vactivity_id\x18\x06 \x01(\x05B\x03\xe0A\x01H\x00R\n" +
-	"activityId\x88\x01\x01\":\n" +
+	"activityId\x88\x01\x01\x12B\n" +
+	"\x06digest\x18\a \x01(\v2%.memos.api.v1.UserNotification.DigestB\x03\xe0A\x03R\x06digest\x1a\xa4\x01\n" +
+	"\x06Digest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04html\x18\x02 \x01(\tR\x04html\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\":\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06UNREAD\x10\x01\x12\f\n" +
-	"\bARCHIVED\x10\x02\".\n" +
+	"\bARCHIVED\x10\x02\"_\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
-	"\fMEMO_COMMENT\x10\x01:p\xeaAm\n" +
+	"\fMEMO_COMMENT\x10\x01\x12\n" +
+	"\n" +
+	"\x06DIGEST\x10\x02\x12\x10\n" +
+	"\fMEMO_MENTION\x10\x03\x12\x11\n" +
+	"\rMEMO_REMINDER\x10\x04:p\xeaAm\n" +
 	"\x1dmemos.api.v1/UserNotification\x12)users/{user}/notifications/{notification}\x1a\x04name*\rnotifications2\fnotificationB\x0e\n" +
 	"\f_activity_id\"\xb4\x01\n" +
 	"\x1cListUserNotificationsRequest\x121\n" +
@@ -2705,7 +4011,40 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"updateMask\"Z\n" +
 	"\x1dDeleteUserNotificationRequest\x129\n" +
 	"\x04name\x18\x01 \x01(\tB%\xe0A\x02\xfaA\x1f\n" +
-	"\x1dmemos.api.v1/UserNotificationR\x04name2\x83\x17\n" +
+	"\x1dmemos.api.v1/UserNotificationR\x04name\"F\n" +
+	"\x15ExportUserDataRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04name\"2\n" +
+	"\x16ExportUserDataResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\"e\n" +
+	"\x15ImportUserDataRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04name\x12\x1d\n" +
+	"\acontent\x18\x02 \x01(\fB\x03\xe0A\x02R\acontent\"\xae\x01\n" +
+	"\x16ImportUserDataResponse\x12,\n" +
+	"\x12created_memo_count\x18\x01 \x01(\x05R\x10createdMemoCount\x12,\n" +
+	"\x12updated_memo_count\x18\x02 \x01(\x05R\x10updatedMemoCount\x128\n" +
+	"\x18created_attachment_count\x18\x03 \x01(\x05R\x16createdAttachmentCount\"\xc1\x02\n" +
+	"\x12ImportNotesRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04name\x12D\n" +
+	"\x06format\x18\x02 \x01(\x0e2'.memos.api.v1.ImportNotesRequest.FormatB\x03\xe0A\x02R\x06format\x12\x1f\n" +
+	"\bfilename\x18\x03 \x01(\tB\x03\xe0A\x02R\bfilename\x12\x1d\n" +
+	"\acontent\x18\x04 \x01(\fB\x03\xe0A\x02R\acontent\x12\x1c\n" +
+	"\adry_run\x18\x05 \x01(\bB\x03\xe0A\x01R\x06dryRun\"X\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bOBSIDIAN\x10\x01\x12\t\n" +
+	"\x05FLOMO\x10\x02\x12\x0f\n" +
+	"\vGOOGLE_KEEP\x10\x03\x12\f\n" +
+	"\bEVERNOTE\x10\x04\"\xb6\x01\n" +
+	"\x13ImportNotesResponse\x12\x1d\n" +
+	"\n" +
+	"memo_count\x18\x01 \x01(\x05R\tmemoCount\x12)\n" +
+	"\x10attachment_count\x18\x02 \x01(\x05R\x0fattachmentCount\x12%\n" +
+	"\x0erelation_count\x18\x03 \x01(\x05R\rrelationCount\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x1a\n" +
+	"\bwarnings\x18\x05 \x03(\tR\bwarnings2\xcb\x1d\n" +
 	"\vUserService\x12c\n" +
 	"\tListUsers\x12\x1e.memos.api.v1.ListUsersRequest\x1a\x1f.memos.api.v1.ListUsersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/users\x12b\n" +
 	"\aGetUser\x12\x1c.memos.api.v1.GetUserRequest\x1a\x12.memos.api.v1.User\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/{name=users/*}\x12e\n" +
@@ -2726,10 +4065,15 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x10ListUserWebhooks\x12%.memos.api.v1.ListUserWebhooksRequest\x1a&.memos.api.v1.ListUserWebhooksResponse\"2\xdaA\x06parent\x82\xd3\xe4\x93\x02#\x12!/api/v1/{parent=users/*}/webhooks\x12\x9b\x01\n" +
 	"\x11CreateUserWebhook\x12&.memos.api.v1.CreateUserWebhookRequest\x1a\x19.memos.api.v1.UserWebhook\"C\xdaA\x0eparent,webhook\x82\xd3\xe4\x93\x02,:\awebhook\"!/api/v1/{parent=users/*}/webhooks\x12\xa8\x01\n" +
 	"\x11UpdateUserWebhook\x12&.memos.api.v1.UpdateUserWebhookRequest\x1a\x19.memos.api.v1.UserWebhook\"P\xdaA\x13webhook,update_mask\x82\xd3\xe4\x93\x024:\awebhook2)/api/v1/{webhook.name=users/*/webhooks/*}\x12\x85\x01\n" +
-	"\x11DeleteUserWebhook\x12&.memos.api.v1.DeleteUserWebhookRequest\x1a\x16.google.protobuf.Empty\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#*!/api/v1/{name=users/*/webhooks/*}\x12\xa9\x01\n" +
+	"\x11DeleteUserWebhook\x12&.memos.api.v1.DeleteUserWebhookRequest\x1a\x16.google.protobuf.Empty\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#*!/api/v1/{name=users/*/webhooks/*}\x12\xbd\x01\n" +
+	"\x19ListUserWebhookDeliveries\x12..memos.api.v1.ListUserWebhookDeliveriesRequest\x1a/.memos.api.v1.ListUserWebhookDeliveriesResponse\"?\xdaA\x06parent\x82\xd3\xe4\x93\x020\x12./api/v1/{parent=users/*/webhooks/*}/deliveries\x12\xc0\x01\n" +
+	"\x1cRedeliverUserWebhookDelivery\x121.memos.api.v1.RedeliverUserWebhookDeliveryRequest\x1a!.memos.api.v1.UserWebhookDelivery\"J\xdaA\x04name\x82\xd3\xe4\x93\x02=:\x01*\"8/api/v1/{name=users/*/webhooks/*/deliveries/*}:redeliver\x12\xa9\x01\n" +
 	"\x15ListUserNotifications\x12*.memos.api.v1.ListUserNotificationsRequest\x1a+.memos.api.v1.ListUserNotificationsResponse\"7\xdaA\x06parent\x82\xd3\xe4\x93\x02(\x12&/api/v1/{parent=users/*}/notifications\x12\xcb\x01\n" +
 	"\x16UpdateUserNotification\x12+.memos.api.v1.UpdateUserNotificationRequest\x1a\x1e.memos.api.v1.UserNotification\"d\xdaA\x18notification,update_mask\x82\xd3\xe4\x93\x02C:\fnotification23/api/v1/{notification.name=users/*/notifications/*}\x12\x94\x01\n" +
-	"\x16DeleteUserNotification\x12+.memos.api.v1.DeleteUserNotificationRequest\x1a\x16.google.protobuf.Empty\"5\xdaA\x04name\x82\xd3\xe4\x93\x02(*&/api/v1/{name=users/*/notifications/*}B\xa8\x01\n" +
+	"\x16DeleteUserNotification\x12+.memos.api.v1.DeleteUserNotificationRequest\x1a\x16.google.protobuf.Empty\"5\xdaA\x04name\x82\xd3\xe4\x93\x02(*&/api/v1/{name=users/*/notifications/*}\x12\x8d\x01\n" +
+	"\x0eExportUserData\x12#.memos.api.v1.ExportUserDataRequest\x1a$.memos.api.v1.ExportUserDataResponse\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#\x12!/api/v1/{name=users/*}:exportData\x12\x98\x01\n" +
+	"\x0eImportUserData\x12#.memos.api.v1.ImportUserDataRequest\x1a$.memos.api.v1.ImportUserDataResponse\";\xdaA\fname,content\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/{name=users/*}:importData\x12\x97\x01\n" +
+	"\vImportNotes\x12 .memos.api.v1.ImportNotesRequest\x1a!.memos.api.v1.ImportNotesResponse\"C\xdaA\x13name,format,content\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/{name=users/*}:importNotesB\xa8\x01\n" +
 	"\x10com.memos.api.v1B\x10UserServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -2744,137 +4088,176 @@ func file_api_v1_user_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_user_service_proto_rawDescData
 }
 
-var file_api_v1_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_api_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_api_v1_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_api_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
 var file_api_v1_user_service_proto_goTypes = []any{
-	(User_Role)(0),                            // 0: memos.api.v1.User.Role
-	(UserSetting_Key)(0),                      // 1: memos.api.v1.UserSetting.Key
-	(UserNotification_Status)(0),              // 2: memos.api.v1.UserNotification.Status
-	(UserNotification_Type)(0),                // 3: memos.api.v1.UserNotification.Type
-	(*User)(nil),                              // 4: memos.api.v1.User
-	(*ListUsersRequest)(nil),                  // 5: memos.api.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),                 // 6: memos.api.v1.ListUsersResponse
-	(*GetUserRequest)(nil),                    // 7: memos.api.v1.GetUserRequest
-	(*CreateUserRequest)(nil),                 // 8: memos.api.v1.CreateUserRequest
-	(*UpdateUserRequest)(nil),                 // 9: memos.api.v1.UpdateUserRequest
-	(*DeleteUserRequest)(nil),                 // 10: memos.api.v1.DeleteUserRequest
-	(*UserStats)(nil),                         // 11: memos.api.v1.UserStats
-	(*GetUserStatsRequest)(nil),               // 12: memos.api.v1.GetUserStatsRequest
-	(*ListAllUserStatsRequest)(nil),           // 13: memos.api.v1.ListAllUserStatsRequest
-	(*ListAllUserStatsResponse)(nil),          // 14: memos.api.v1.ListAllUserStatsResponse
-	(*UserSetting)(nil),                       // 15: memos.api.v1.UserSetting
-	(*GetUserSettingRequest)(nil),             // 16: memos.api.v1.GetUserSettingRequest
-	(*UpdateUserSettingRequest)(nil),          // 17: memos.api.v1.UpdateUserSettingRequest
-	(*ListUserSettingsRequest)(nil),           // 18: memos.api.v1.ListUserSettingsRequest
-	(*ListUserSettingsResponse)(nil),          // 19: memos.api.v1.ListUserSettingsResponse
-	(*PersonalAccessToken)(nil),               // 20: memos.api.v1.PersonalAccessToken
-	(*ListPersonalAccessTokensRequest)(nil),   // 21: memos.api.v1.ListPersonalAccessTokensRequest
-	(*ListPersonalAccessTokensResponse)(nil),  // 22: memos.api.v1.ListPersonalAccessTokensResponse
-	(*CreatePersonalAccessTokenRequest)(nil),  // 23: memos.api.v1.CreatePersonalAccessTokenRequest
-	(*CreatePersonalAccessTokenResponse)(nil), // 24: memos.api.v1.CreatePersonalAccessTokenResponse
-	(*DeletePersonalAccessTokenRequest)(nil),  // 25: memos.api.v1.DeletePersonalAccessTokenRequest
-	(*UserWebhook)(nil),                       // 26: memos.api.v1.UserWebhook
-	(*ListUserWebhooksRequest)(nil),           // 27: memos.api.v1.ListUserWebhooksRequest
-	(*ListUserWebhooksResponse)(nil),          // 28: memos.api.v1.ListUserWebhooksResponse
-	(*CreateUserWebhookRequest)(nil),          // 29: memos.api.v1.CreateUserWebhookRequest
-	(*UpdateUserWebhookRequest)(nil),          // 30: memos.api.v1.UpdateUserWebhookRequest
-	(*DeleteUserWebhookRequest)(nil),          // 31: memos.api.v1.DeleteUserWebhookRequest
-	(*UserNotification)(nil),                  // 32: memos.api.v1.UserNotification
-	(*ListUserNotificationsRequest)(nil),      // 33: memos.api.v1.ListUserNotificationsRequest
-	(*ListUserNotificationsResponse)(nil),     // 34: memos.api.v1.ListUserNotificationsResponse
-	(*UpdateUserNotificationRequest)(nil),     // 35: memos.api.v1.UpdateUserNotificationRequest
-	(*DeleteUserNotificationRequest)(nil),     // 36: memos.api.v1.DeleteUserNotificationRequest
-	nil,                                       // 37: memos.api.v1.UserStats.TagCountEntry
-	(*UserStats_MemoTypeStats)(nil),           // 38: memos.api.v1.UserStats.MemoTypeStats
-	(*UserSetting_GeneralSetting)(nil),        // 39: memos.api.v1.UserSetting.GeneralSetting
-	(*UserSetting_WebhooksSetting)(nil),       // 40: memos.api.v1.UserSetting.WebhooksSetting
-	(State)(0),                                // 41: memos.api.v1.State
-	(*timestamppb.Timestamp)(nil),             // 42: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),             // 43: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                     // 44: google.protobuf.Empty
+	(User_Role)(0),                              // 0: memos.api.v1.User.Role
+	(UserSetting_Key)(0),                        // 1: memos.api.v1.UserSetting.Key
+	(UserSetting_DigestSetting_Frequency)(0),    // 2: memos.api.v1.UserSetting.DigestSetting.Frequency
+	(UserSetting_DigestSetting_Channel)(0),      // 3: memos.api.v1.UserSetting.DigestSetting.Channel
+	(UserWebhook_Format)(0),                     // 4: memos.api.v1.UserWebhook.Format
+	(UserWebhookDelivery_State)(0),              // 5: memos.api.v1.UserWebhookDelivery.State
+	(UserNotification_Status)(0),                // 6: memos.api.v1.UserNotification.Status
+	(UserNotification_Type)(0),                  // 7: memos.api.v1.UserNotification.Type
+	(ImportNotesRequest_Format)(0),              // 8: memos.api.v1.ImportNotesRequest.Format
+	(*User)(nil),                                // 9: memos.api.v1.User
+	(*ListUsersRequest)(nil),                    // 10: memos.api.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                   // 11: memos.api.v1.ListUsersResponse
+	(*GetUserRequest)(nil),                      // 12: memos.api.v1.GetUserRequest
+	(*CreateUserRequest)(nil),                   // 13: memos.api.v1.CreateUserRequest
+	(*UpdateUserRequest)(nil),                   // 14: memos.api.v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),                   // 15: memos.api.v1.DeleteUserRequest
+	(*UserStats)(nil),                           // 16: memos.api.v1.UserStats
+	(*GetUserStatsRequest)(nil),                 // 17: memos.api.v1.GetUserStatsRequest
+	(*ListAllUserStatsRequest)(nil),             // 18: memos.api.v1.ListAllUserStatsRequest
+	(*ListAllUserStatsResponse)(nil),            // 19: memos.api.v1.ListAllUserStatsResponse
+	(*UserSetting)(nil),                         // 20: memos.api.v1.UserSetting
+	(*GetUserSettingRequest)(nil),               // 21: memos.api.v1.GetUserSettingRequest
+	(*UpdateUserSettingRequest)(nil),            // 22: memos.api.v1.UpdateUserSettingRequest
+	(*ListUserSettingsRequest)(nil),             // 23: memos.api.v1.ListUserSettingsRequest
+	(*ListUserSettingsResponse)(nil),            // 24: memos.api.v1.ListUserSettingsResponse
+	(*PersonalAccessToken)(nil),                 // 25: memos.api.v1.PersonalAccessToken
+	(*ListPersonalAccessTokensRequest)(nil),     // 26: memos.api.v1.ListPersonalAccessTokensRequest
+	(*ListPersonalAccessTokensResponse)(nil),    // 27: memos.api.v1.ListPersonalAccessTokensResponse
+	(*CreatePersonalAccessTokenRequest)(nil),    // 28: memos.api.v1.CreatePersonalAccessTokenRequest
+	(*CreatePersonalAccessTokenResponse)(nil),   // 29: memos.api.v1.CreatePersonalAccessTokenResponse
+	(*DeletePersonalAccessTokenRequest)(nil),    // 30: memos.api.v1.DeletePersonalAccessTokenRequest
+	(*UserWebhook)(nil),                         // 31: memos.api.v1.UserWebhook
+	(*ListUserWebhooksRequest)(nil),             // 32: memos.api.v1.ListUserWebhooksRequest
+	(*ListUserWebhooksResponse)(nil),            // 33: memos.api.v1.ListUserWebhooksResponse
+	(*CreateUserWebhookRequest)(nil),            // 34: memos.api.v1.CreateUserWebhookRequest
+	(*UpdateUserWebhookRequest)(nil),            // 35: memos.api.v1.UpdateUserWebhookRequest
+	(*DeleteUserWebhookRequest)(nil),            // 36: memos.api.v1.DeleteUserWebhookRequest
+	(*UserWebhookDelivery)(nil),                 // 37: memos.api.v1.UserWebhookDelivery
+	(*ListUserWebhookDeliveriesRequest)(nil),    // 38: memos.api.v1.ListUserWebhookDeliveriesRequest
+	(*ListUserWebhookDeliveriesResponse)(nil),   // 39: memos.api.v1.ListUserWebhookDeliveriesResponse
+	(*RedeliverUserWebhookDeliveryRequest)(nil), // 40: memos.api.v1.RedeliverUserWebhookDeliveryRequest
+	(*UserNotification)(nil),                    // 41: memos.api.v1.UserNotification
+	(*ListUserNotificationsRequest)(nil),        // 42: memos.api.v1.ListUserNotificationsRequest
+	(*ListUserNotificationsResponse)(nil),       // 43: memos.api.v1.ListUserNotificationsResponse
+	(*UpdateUserNotificationRequest)(nil),       // 44: memos.api.v1.UpdateUserNotificationRequest
+	(*DeleteUserNotificationRequest)(nil),       // 45: memos.api.v1.DeleteUserNotificationRequest
+	(*ExportUserDataRequest)(nil),               // 46: memos.api.v1.ExportUserDataRequest
+	(*ExportUserDataResponse)(nil),              // 47: memos.api.v1.ExportUserDataResponse
+	(*ImportUserDataRequest)(nil),               // 48: memos.api.v1.ImportUserDataRequest
+	(*ImportUserDataResponse)(nil),              // 49: memos.api.v1.ImportUserDataResponse
+	(*ImportNotesRequest)(nil),                  // 50: memos.api.v1.ImportNotesRequest
+	(*ImportNotesResponse)(nil),                 // 51: memos.api.v1.ImportNotesResponse
+	nil,                                         // 52: memos.api.v1.UserStats.TagCountEntry
+	(*UserStats_MemoTypeStats)(nil),             // 53: memos.api.v1.UserStats.MemoTypeStats
+	(*UserSetting_GeneralSetting)(nil),          // 54: memos.api.v1.UserSetting.GeneralSetting
+	(*UserSetting_WebhooksSetting)(nil),         // 55: memos.api.v1.UserSetting.WebhooksSetting
+	(*UserSetting_DigestSetting)(nil),           // 56: memos.api.v1.UserSetting.DigestSetting
+	(*UserNotification_Digest)(nil),             // 57: memos.api.v1.UserNotification.Digest
+	(State)(0),                                  // 58: memos.api.v1.State
+	(*timestamppb.Timestamp)(nil),               // 59: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),               // 60: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                       // 61: google.protobuf.Empty
 }
 var file_api_v1_user_service_proto_depIdxs = []int32{
 	0,  // 0: memos.api.v1.User.role:type_name -> memos.api.v1.User.Role
-	41, // 1: memos.api.v1.User.state:type_name -> memos.api.v1.State
-	42, // 2: memos.api.v1.User.create_time:type_name -> google.protobuf.Timestamp
-	42, // 3: memos.api.v1.User.update_time:type_name -> google.protobuf.Timestamp
-	4,  // 4: memos.api.v1.ListUsersResponse.users:type_name -> memos.api.v1.User
-	43, // 5: memos.api.v1.GetUserRequest.read_mask:type_name -> google.protobuf.FieldMask
-	4,  // 6: memos.api.v1.CreateUserRequest.user:type_name -> memos.api.v1.User
-	4,  // 7: memos.api.v1.UpdateUserRequest.user:type_name -> memos.api.v1.User
-	43, // 8: memos.api.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
-	42, // 9: memos.api.v1.UserStats.memo_display_timestamps:type_name -> google.protobuf.Timestamp
-	38, // 10: memos.api.v1.UserStats.memo_type_stats:type_name -> memos.api.v1.UserStats.MemoTypeStats
-	37, // 11: memos.api.v1.UserStats.tag_count:type_name -> memos.api.v1.UserStats.TagCountEntry
-	11, // 12: memos.api.v1.ListAllUserStatsResponse.stats:type_name -> memos.api.v1.UserStats
-	39, // 13: memos.api.v1.UserSetting.general_setting:type_name -> memos.api.v1.UserSetting.GeneralSetting
-	40, // 14: memos.api.v1.UserSetting.webhooks_setting:type_name -> memos.api.v1.UserSetting.WebhooksSetting
-	15, // 15: memos.api.v1.UpdateUserSettingRequest.setting:type_name -> memos.api.v1.UserSetting
-	43, // 16: memos.api.v1.UpdateUserSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	15, // 17: memos.api.v1.ListUserSettingsResponse.settings:type_name -> memos.api.v1.UserSetting
-	42, // 18: memos.api.v1.PersonalAccessToken.created_at:type_name -> google.protobuf.Timestamp
-	42, // 19: memos.api.v1.PersonalAccessToken.expires_at:type_name -> google.protobuf.Timestamp
-	42, // 20: memos.api.v1.PersonalAccessToken.last_used_at:type_name -> google.protobuf.Timestamp
-	20, // 21: memos.api.v1.ListPersonalAccessTokensResponse.personal_access_tokens:type_name -> memos.api.v1.PersonalAccessToken
-	20, // 22: memos.api.v1.CreatePersonalAccessTokenResponse.personal_access_token:type_name -> memos.api.v1.PersonalAccessToken
-	42, // 23: memos.api.v1.UserWebhook.create_time:type_name -> google.protobuf.Timestamp
-	42, // 24: memos.api.v1.UserWebhook.update_time:type_name -> google.protobuf.Timestamp
-	26, // 25: memos.api.v1.ListUserWebhooksResponse.webhooks:type_name -> memos.api.v1.UserWebhook
-	26, // 26: memos.api.v1.CreateUserWebhookRequest.webhook:type_name -> memos.api.v1.UserWebhook
-	26, // 27: memos.api.v1.UpdateUserWebhookRequest.webhook:type_name -> memos.api.v1.UserWebhook
-	43, // 28: memos.api.v1.UpdateUserWebhookRequest.update_mask:type_name -> google.protobuf.FieldMask
-	2,  // 29: memos.api.v1.UserNotification.status:type_name -> memos.api.v1.UserNotification.Status
-	42, // 30: memos.api.v1.UserNotification.create_time:type_name -> google.protobuf.Timestamp
-	3,  // 31: memos.api.v1.UserNotification.type:type_name -> memos.api.v1.UserNotification.Type
-	32, // 32: memos.api.v1.ListUserNotificationsResponse.notifications:type_name -> memos.api.v1.UserNotification
-	32, // 33: memos.api.v1.UpdateUserNotificationRequest.notification:type_name -> memos.api.v1.UserNotification
-	43, // 34: memos.api.v1.UpdateUserNotificationRequest.update_mask:type_name -> google.protobuf.FieldMask
-	26, // 35: memos.api.v1.UserSetting.WebhooksSetting.webhooks:type_name -> memos.api.v1.UserWebhook
-	5,  // 36: memos.api.v1.UserService.ListUsers:input_type -> memos.api.v1.ListUsersRequest
-	7,  // 37: memos.api.v1.UserService.GetUser:input_type -> memos.api.v1.GetUserRequest
-	8,  // 38: memos.api.v1.UserService.CreateUser:input_type -> memos.api.v1.CreateUserRequest
-	9,  // 39: memos.api.v1.UserService.UpdateUser:input_type -> memos.api.v1.UpdateUserRequest
-	10, // 40: memos.api.v1.UserService.DeleteUser:input_type -> memos.api.v1.DeleteUserRequest
-	13, // 41: memos.api.v1.UserService.ListAllUserStats:input_type -> memos.api.v1.ListAllUserStatsRequest
-	12, // 42: memos.api.v1.UserService.GetUserStats:input_type -> memos.api.v1.GetUserStatsRequest
-	16, // 43: memos.api.v1.UserService.GetUserSetting:input_type -> memos.api.v1.GetUserSettingRequest
-	17, // 44: memos.api.v1.UserService.UpdateUserSetting:input_type -> memos.api.v1.UpdateUserSettingRequest
-	18, // 45: memos.api.v1.UserService.ListUserSettings:input_type -> memos.api.v1.ListUserSettingsRequest
-	21, // 46: memos.api.v1.UserService.ListPersonalAccessTokens:input_type -> memos.api.v1.ListPersonalAccessTokensRequest
-	23, // 47: memos.api.v1.UserService.CreatePersonalAccessToken:input_type -> memos.api.v1.CreatePersonalAccessTokenRequest
-	25, // 48: memos.api.v1.UserService.DeletePersonalAccessToken:input_type -> memos.api.v1.DeletePersonalAccessTokenRequest
-	27, // 49: memos.api.v1.UserService.ListUserWebhooks:input_type -> memos.api.v1.ListUserWebhooksRequest
-	29, // 50: memos.api.v1.UserService.CreateUserWebhook:input_type -> memos.api.v1.CreateUserWebhookRequest
-	30, // 51: memos.api.v1.UserService.UpdateUserWebhook:input_type -> memos.api.v1.UpdateUserWebhookRequest
-	31, // 52: memos.api.v1.UserService.DeleteUserWebhook:input_type -> memos.api.v1.DeleteUserWebhookRequest
-	33, // 53: memos.api.v1.UserService.ListUserNotifications:input_type -> memos.api.v1.ListUserNotificationsRequest
-	35, // 54: memos.api.v1.UserService.UpdateUserNotification:input_type -> memos.api.v1.UpdateUserNotificationRequest
-	36, // 55: memos.api.v1.UserService.DeleteUserNotification:input_type -> memos.api.v1.DeleteUserNotificationRequest
-	6,  // 56: memos.api.v1.UserService.ListUsers:output_type -> memos.api.v1.ListUsersResponse
-	4,  // 57: memos.api.v1.UserService.GetUser:output_type -> memos.api.v1.User
-	4,  // 58: memos.api.v1.UserService.CreateUser:output_type -> memos.api.v1.User
-	4,  // 59: memos.api.v1.UserService.UpdateUser:output_type -> memos.api.v1.User
-	44, // 60: memos.api.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
-	14, // 61: memos.api.v1.UserService.ListAllUserStats:output_type -> memos.api.v1.ListAllUserStatsResponse
-	11, // 62: memos.api.v1.UserService.GetUserStats:output_type -> memos.api.v1.UserStats
-	15, // 63: memos.api.v1.UserService.GetUserSetting:output_type -> memos.api.v1.UserSetting
-	15, // 64: memos.api.v1.UserService.UpdateUserSetting:output_type -> memos.api.v1.UserSetting
-	19, // 65: memos.api.v1.UserService.ListUserSettings:output_type -> memos.api.v1.ListUserSettingsResponse
-	22, // 66: memos.api.v1.UserService.ListPersonalAccessTokens:output_type -> memos.api.v1.ListPersonalAccessTokensResponse
-	24, // 67: memos.api.v1.UserService.CreatePersonalAccessToken:output_type -> memos.api.v1.CreatePersonalAccessTokenResponse
-	44, // 68: memos.api.v1.UserService.DeletePersonalAccessToken:output_type -> google.protobuf.Empty
-	28, // 69: memos.api.v1.UserService.ListUserWebhooks:output_type -> memos.api.v1.ListUserWebhooksResponse
-	26, // 70: memos.api.v1.UserService.CreateUserWebhook:output_type -> memos.api.v1.UserWebhook
-	26, // 71: memos.api.v1.UserService.UpdateUserWebhook:output_type -> memos.api.v1.UserWebhook
-	44, // 72: memos.api.v1.UserService.DeleteUserWebhook:output_type -> google.protobuf.Empty
-	34, // 73: memos.api.v1.UserService.ListUserNotifications:output_type -> memos.api.v1.ListUserNotificationsResponse
-	32, // 74: memos.api.v1.UserService.UpdateUserNotification:output_type -> memos.api.v1.UserNotification
-	44, // 75: memos.api.v1.UserService.DeleteUserNotification:output_type -> google.protobuf.Empty
-	56, // [56:76] is the sub-list for method output_type
-	36, // [36:56] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	58, // 1: memos.api.v1.User.state:type_name -> memos.api.v1.State
+	59, // 2: memos.api.v1.User.create_time:type_name -> google.protobuf.Timestamp
+	59, // 3: memos.api.v1.User.update_time:type_name -> google.protobuf.Timestamp
+	9,  // 4: memos.api.v1.ListUsersResponse.users:type_name -> memos.api.v1.User
+	60, // 5: memos.api.v1.GetUserRequest.read_mask:type_name -> google.protobuf.FieldMask
+	9,  // 6: memos.api.v1.CreateUserRequest.user:type_name -> memos.api.v1.User
+	9,  // 7: memos.api.v1.UpdateUserRequest.user:type_name -> memos.api.v1.User
+	60, // 8: memos.api.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	59, // 9: memos.api.v1.UserStats.memo_display_timestamps:type_name -> google.protobuf.Timestamp
+	53, // 10: memos.api.v1.UserStats.memo_type_stats:type_name -> memos.api.v1.UserStats.MemoTypeStats
+	52, // 11: memos.api.v1.UserStats.tag_count:type_name -> memos.api.v1.UserStats.TagCountEntry
+	16, // 12: memos.api.v1.ListAllUserStatsResponse.stats:type_name -> memos.api.v1.UserStats
+	54, // 13: memos.api.v1.UserSetting.general_setting:type_name -> memos.api.v1.UserSetting.GeneralSetting
+	55, // 14: memos.api.v1.UserSetting.webhooks_setting:type_name -> memos.api.v1.UserSetting.WebhooksSetting
+	56, // 15: memos.api.v1.UserSetting.digest_setting:type_name -> memos.api.v1.UserSetting.DigestSetting
+	20, // 16: memos.api.v1.UpdateUserSettingRequest.setting:type_name -> memos.api.v1.UserSetting
+	60, // 17: memos.api.v1.UpdateUserSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	20, // 18: memos.api.v1.ListUserSettingsResponse.settings:type_name -> memos.api.v1.UserSetting
+	59, // 19: memos.api.v1.PersonalAccessToken.created_at:type_name -> google.protobuf.Timestamp
+	59, // 20: memos.api.v1.PersonalAccessToken.expires_at:type_name -> google.protobuf.Timestamp
+	59, // 21: memos.api.v1.PersonalAccessToken.last_used_at:type_name -> google.protobuf.Timestamp
+	25, // 22: memos.api.v1.ListPersonalAccessTokensResponse.personal_access_tokens:type_name -> memos.api.v1.PersonalAccessToken
+	25, // 23: memos.api.v1.CreatePersonalAccessTokenResponse.personal_access_token:type_name -> memos.api.v1.PersonalAccessToken
+	59, // 24: memos.api.v1.UserWebhook.create_time:type_name -> google.protobuf.Timestamp
+	59, // 25: memos.api.v1.UserWebhook.update_time:type_name -> google.protobuf.Timestamp
+	4,  // 26: memos.api.v1.UserWebhook.format:type_name -> memos.api.v1.UserWebhook.Format
+	31, // 27: memos.api.v1.ListUserWebhooksResponse.webhooks:type_name -> memos.api.v1.UserWebhook
+	31, // 28: memos.api.v1.CreateUserWebhookRequest.webhook:type_name -> memos.api.v1.UserWebhook
+	31, // 29: memos.api.v1.UpdateUserWebhookRequest.webhook:type_name -> memos.api.v1.UserWebhook
+	60, // 30: memos.api.v1.UpdateUserWebhookRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5,  // 31: memos.api.v1.UserWebhookDelivery.state:type_name -> memos.api.v1.UserWebhookDelivery.State
+	59, // 32: memos.api.v1.UserWebhookDelivery.create_time:type_name -> google.protobuf.Timestamp
+	59, // 33: memos.api.v1.UserWebhookDelivery.update_time:type_name -> google.protobuf.Timestamp
+	37, // 34: memos.api.v1.ListUserWebhookDeliveriesResponse.deliveries:type_name -> memos.api.v1.UserWebhookDelivery
+	6,  // 35: memos.api.v1.UserNotification.status:type_name -> memos.api.v1.UserNotification.Status
+	59, // 36: memos.api.v1.UserNotification.create_time:type_name -> google.protobuf.Timestamp
+	7,  // 37: memos.api.v1.UserNotification.type:type_name -> memos.api.v1.UserNotification.Type
+	57, // 38: memos.api.v1.UserNotification.digest:type_name -> memos.api.v1.UserNotification.Digest
+	41, // 39: memos.api.v1.ListUserNotificationsResponse.notifications:type_name -> memos.api.v1.UserNotification
+	41, // 40: memos.api.v1.UpdateUserNotificationRequest.notification:type_name -> memos.api.v1.UserNotification
+	60, // 41: memos.api.v1.UpdateUserNotificationRequest.update_mask:type_name -> google.protobuf.FieldMask
+	8,  // 42: memos.api.v1.ImportNotesRequest.format:type_name -> memos.api.v1.ImportNotesRequest.Format
+	31, // 43: memos.api.v1.UserSetting.WebhooksSetting.webhooks:type_name -> memos.api.v1.UserWebhook
+	2,  // 44: memos.api.v1.UserSetting.DigestSetting.frequency:type_name -> memos.api.v1.UserSetting.DigestSetting.Frequency
+	3,  // 45: memos.api.v1.UserSetting.DigestSetting.channels:type_name -> memos.api.v1.UserSetting.DigestSetting.Channel
+	59, // 46: memos.api.v1.UserNotification.Digest.start_time:type_name -> google.protobuf.Timestamp
+	59, // 47: memos.api.v1.UserNotification.Digest.end_time:type_name -> google.protobuf.Timestamp
+	10, // 48: memos.api.v1.UserService.ListUsers:input_type -> memos.api.v1.ListUsersRequest
+	12, // 49: memos.api.v1.UserService.GetUser:input_type -> memos.api.v1.GetUserRequest
+	13, // 50: memos.api.v1.UserService.CreateUser:input_type -> memos.api.v1.CreateUserRequest
+	14, // 51: memos.api.v1.UserService.UpdateUser:input_type -> memos.api.v1.UpdateUserRequest
+	15, // 52: memos.api.v1.UserService.DeleteUser:input_type -> memos.api.v1.DeleteUserRequest
+	18, // 53: memos.api.v1.UserService.ListAllUserStats:input_type -> memos.api.v1.ListAllUserStatsRequest
+	17, // 54: memos.api.v1.UserService.GetUserStats:input_type -> memos.api.v1.GetUserStatsRequest
+	21, // 55: memos.api.v1.UserService.GetUserSetting:input_type -> memos.api.v1.GetUserSettingRequest
+	22, // 56: memos.api.v1.UserService.UpdateUserSetting:input_type -> memos.api.v1.UpdateUserSettingRequest
+	23, // 57: memos.api.v1.UserService.ListUserSettings:input_type -> memos.api.v1.ListUserSettingsRequest
+	26, // 58: memos.api.v1.UserService.ListPersonalAccessTokens:input_type -> memos.api.v1.ListPersonalAccessTokensRequest
+	28, // 59: memos.api.v1.UserService.CreatePersonalAccessToken:input_type -> memos.api.v1.CreatePersonalAccessTokenRequest
+	30, // 60: memos.api.v1.UserService.DeletePersonalAccessToken:input_type -> memos.api.v1.DeletePersonalAccessTokenRequest
+	32, // 61: memos.api.v1.UserService.ListUserWebhooks:input_type -> memos.api.v1.ListUserWebhooksRequest
+	34, // 62: memos.api.v1.UserService.CreateUserWebhook:input_type -> memos.api.v1.CreateUserWebhookRequest
+	35, // 63: memos.api.v1.UserService.UpdateUserWebhook:input_type -> memos.api.v1.UpdateUserWebhookRequest
+	36, // 64: memos.api.v1.UserService.DeleteUserWebhook:input_type -> memos.api.v1.DeleteUserWebhookRequest
+	38, // 65: memos.api.v1.UserService.ListUserWebhookDeliveries:input_type -> memos.api.v1.ListUserWebhookDeliveriesRequest
+	40, // 66: memos.api.v1.UserService.RedeliverUserWebhookDelivery:input_type -> memos.api.v1.RedeliverUserWebhookDeliveryRequest
+	42, // 67: memos.api.v1.UserService.ListUserNotifications:input_type -> memos.api.v1.ListUserNotificationsRequest
+	44, // 68: memos.api.v1.UserService.UpdateUserNotification:input_type -> memos.api.v1.UpdateUserNotificationRequest
+	45, // 69: memos.api.v1.UserService.DeleteUserNotification:input_type -> memos.api.v1.DeleteUserNotificationRequest
+	46, // 70: memos.api.v1.UserService.ExportUserData:input_type -> memos.api.v1.ExportUserDataRequest
+	48, // 71: memos.api.v1.UserService.ImportUserData:input_type -> memos.api.v1.ImportUserDataRequest
+	50, // 72: memos.api.v1.UserService.ImportNotes:input_type -> memos.api.v1.ImportNotesRequest
+	11, // 73: memos.api.v1.UserService.ListUsers:output_type -> memos.api.v1.ListUsersResponse
+	9,  // 74: memos.api.v1.UserService.GetUser:output_type -> memos.api.v1.User
+	9,  // 75: memos.api.v1.UserService.CreateUser:output_type -> memos.api.v1.User
+	9,  // 76: memos.api.v1.UserService.UpdateUser:output_type -> memos.api.v1.User
+	61, // 77: memos.api.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
+	19, // 78: memos.api.v1.UserService.ListAllUserStats:output_type -> memos.api.v1.ListAllUserStatsResponse
+	16, // 79: memos.api.v1.UserService.GetUserStats:output_type -> memos.api.v1.UserStats
+	20, // 80: memos.api.v1.UserService.GetUserSetting:output_type -> memos.api.v1.UserSetting
+	20, // 81: memos.api.v1.UserService.UpdateUserSetting:output_type -> memos.api.v1.UserSetting
+	24, // 82: memos.api.v1.UserService.ListUserSettings:output_type -> memos.api.v1.ListUserSettingsResponse
+	27, // 83: memos.api.v1.UserService.ListPersonalAccessTokens:output_type -> memos.api.v1.ListPersonalAccessTokensResponse
+	29, // 84: memos.api.v1.UserService.CreatePersonalAccessToken:output_type -> memos.api.v1.CreatePersonalAccessTokenResponse
+	61, // 85: memos.api.v1.UserService.DeletePersonalAccessToken:output_type -> google.protobuf.Empty
+	33, // 86: memos.api.v1.UserService.ListUserWebhooks:output_type -> memos.api.v1.ListUserWebhooksResponse
+	31, // 87: memos.api.v1.UserService.CreateUserWebhook:output_type -> memos.api.v1.UserWebhook
+	31, // 88: memos.api.v1.UserService.UpdateUserWebhook:output_type -> memos.api.v1.UserWebhook
+	61, // 89: memos.api.v1.UserService.DeleteUserWebhook:output_type -> google.protobuf.Empty
+	39, // 90: memos.api.v1.UserService.ListUserWebhookDeliveries:output_type -> memos.api.v1.ListUserWebhookDeliveriesResponse
+	37, // 91: memos.api.v1.UserService.RedeliverUserWebhookDelivery:output_type -> memos.api.v1.UserWebhookDelivery
+	43, // 92: memos.api.v1.UserService.ListUserNotifications:output_type -> memos.api.v1.ListUserNotificationsResponse
+	41, // 93: memos.api.v1.UserService.UpdateUserNotification:output_type -> memos.api.v1.UserNotification
+	61, // 94: memos.api.v1.UserService.DeleteUserNotification:output_type -> google.protobuf.Empty
+	47, // 95: memos.api.v1.UserService.ExportUserData:output_type -> memos.api.v1.ExportUserDataResponse
+	49, // 96: memos.api.v1.UserService.ImportUserData:output_type -> memos.api.v1.ImportUserDataResponse
+	51, // 97: memos.api.v1.UserService.ImportNotes:output_type -> memos.api.v1.ImportNotesResponse
+	73, // [73:98] is the sub-list for method output_type
+	48, // [48:73] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_user_service_proto_init() }
@@ -2886,15 +4269,16 @@ func file_api_v1_user_service_proto_init() {
 	file_api_v1_user_service_proto_msgTypes[11].OneofWrappers = []any{
 		(*UserSetting_GeneralSetting_)(nil),
 		(*UserSetting_WebhooksSetting_)(nil),
+		(*UserSetting_DigestSetting_)(nil),
 	}
-	file_api_v1_user_service_proto_msgTypes[28].OneofWrappers = []any{}
+	file_api_v1_user_service_proto_msgTypes[32].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_user_service_proto_rawDesc), len(file_api_v1_user_service_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   37,
+			NumEnums:      9,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,26 +20,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_ListUsers_FullMethodName                 = "/memos.api.v1.UserService/ListUsers"
-	UserService_GetUser_FullMethodName                   = "/memos.api.v1.UserService/GetUser"
-	UserService_CreateUser_FullMethodName                = "/memos.api.v1.UserService/CreateUser"
-	UserService_UpdateUser_FullMethodName                = "/memos.api.v1.UserService/UpdateUser"
-	UserService_DeleteUser_FullMethodName                = "/memos.api.v1.UserService/DeleteUser"
-	UserService_ListAllUserStats_FullMethodName          = "/memos.api.v1.UserService/ListAllUserStats"
-	UserService_GetUserStats_FullMethodName              = "/memos.api.v1.UserService/GetUserStats"
-	UserService_GetUserSetting_FullMethodName            = "/memos.api.v1.UserService/GetUserSetting"
-	UserService_UpdateUserSetting_FullMethodName         = "/memos.api.v1.UserService/UpdateUserSetting"
-	UserService_ListUserSettings_FullMethodName          = "/memos.api.v1.UserService/ListUserSettings"
-	UserService_ListPersonalAccessTokens_FullMethodName  = "/memos.api.v1.UserService/ListPersonalAccessTokens"
-	UserService_CreatePersonalAccessToken_FullMethodName = "/memos.api.v1.UserService/CreatePersonalAccessToken"
-	UserService_DeletePersonalAccessToken_FullMethodName = "/memos.api.v1.UserService/DeletePersonalAccessToken"
-	UserService_ListUserWebhooks_FullMethodName          = "/memos.api.v1.UserService/ListUserWebhooks"
-	UserService_CreateUserWebhook_FullMethodName         = "/memos.api.v1.UserService/CreateUserWebhook"
-	UserService_UpdateUserWebhook_FullMethodName         = "/memos.api.v1.UserService/UpdateUserWebhook"
-	UserService_DeleteUserWebhook_FullMethodName         = "/memos.api.v1.UserService/DeleteUserWebhook"
-	UserService_ListUserNotifications_FullMethodName     = "/memos.api.v1.UserService/ListUserNotifications"
-	UserService_UpdateUserNotification_FullMethodName    = "/memos.api.v1.UserService/UpdateUserNotification"
-	UserService_DeleteUserNotification_FullMethodName    = "/memos.api.v1.UserService/DeleteUserNotification"
+	UserService_ListUsers_FullMethodName                    = "/memos.api.v1.UserService/ListUsers"
+	UserService_GetUser_FullMethodName                      = "/memos.api.v1.UserService/GetUser"
+	UserService_CreateUser_FullMethodName                   = "/memos.api.v1.UserService/CreateUser"
+	UserService_UpdateUser_FullMethodName                   = "/memos.api.v1.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName                   = "/memos.api.v1.UserService/DeleteUser"
+	UserService_ListAllUserStats_FullMethodName             = "/memos.api.v1.UserService/ListAllUserStats"
+	UserService_GetUserStats_FullMethodName                 = "/memos.api.v1.UserService/GetUserStats"
+	UserService_GetUserSetting_FullMethodName               = "/memos.api.v1.UserService/GetUserSetting"
+	UserService_UpdateUserSetting_FullMethodName            = "/memos.api.v1.UserService/UpdateUserSetting"
+	UserService_ListUserSettings_FullMethodName             = "/memos.api.v1.UserService/ListUserSettings"
+	UserService_ListPersonalAccessTokens_FullMethodName     = "/memos.api.v1.UserService/ListPersonalAccessTokens"
+	UserService_CreatePersonalAccessToken_FullMethodName    = "/memos.api.v1.UserService/CreatePersonalAccessToken"
+	UserService_DeletePersonalAccessToken_FullMethodName    = "/memos.api.v1.UserService/DeletePersonalAccessToken"
+	UserService_ListUserWebhooks_FullMethodName             = "/memos.api.v1.UserService/ListUserWebhooks"
+	UserService_CreateUserWebhook_FullMethodName            = "/memos.api.v1.UserService/CreateUserWebhook"
+	UserService_UpdateUserWebhook_FullMethodName            = "/memos.api.v1.UserService/UpdateUserWebhook"
+	UserService_DeleteUserWebhook_FullMethodName            = "/memos.api.v1.UserService/DeleteUserWebhook"
+	UserService_ListUserWebhookDeliveries_FullMethodName    = "/memos.api.v1.UserService/ListUserWebhookDeliveries"
+	UserService_RedeliverUserWebhookDelivery_FullMethodName = "/memos.api.v1.UserService/RedeliverUserWebhookDelivery"
+	UserService_ListUserNotifications_FullMethodName        = "/memos.api.v1.UserService/ListUserNotifications"
+	UserService_UpdateUserNotification_FullMethodName       = "/memos.api.v1.UserService/UpdateUserNotification"
+	UserService_DeleteUserNotification_FullMethodName       = "/memos.api.v1.UserService/DeleteUserNotification"
+	UserService_ExportUserData_FullMethodName               = "/memos.api.v1.UserService/ExportUserData"
+	UserService_ImportUserData_FullMethodName               = "/memos.api.v1.UserService/ImportUserData"
+	UserService_ImportNotes_FullMethodName                  = "/memos.api.v1.UserService/ImportNotes"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -85,12 +90,23 @@ type UserServiceClient interface {
 	UpdateUserWebhook(ctx context.Context, in *UpdateUserWebhookRequest, opts ...grpc.CallOption) (*UserWebhook, error)
 	// DeleteUserWebhook deletes a webhook for a user.
 	DeleteUserWebhook(ctx context.Context, in *DeleteUserWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListUserWebhookDeliveries returns the deliveries of a webhook, newest first.
+	ListUserWebhookDeliveries(ctx context.Context, in *ListUserWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListUserWebhookDeliveriesResponse, error)
+	// RedeliverUserWebhookDelivery sends the request of a delivery again as a new delivery.
+	RedeliverUserWebhookDelivery(ctx context.Context, in *RedeliverUserWebhookDeliveryRequest, opts ...grpc.CallOption) (*UserWebhookDelivery, error)
 	// ListUserNotifications lists notifications for a user.
 	ListUserNotifications(ctx context.Context, in *ListUserNotificationsRequest, opts ...grpc.CallOption) (*ListUserNotificationsResponse, error)
 	// UpdateUserNotification updates a notification.
 	UpdateUserNotification(ctx context.Context, in *UpdateUserNotificationRequest, opts ...grpc.CallOption) (*UserNotification, error)
 	// DeleteUserNotification deletes a notification.
 	DeleteUserNotification(ctx context.Context, in *DeleteUserNotificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ExportUserData exports the memos and attachments of a user as a zip archive.
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error)
+	// ImportUserData imports a zip archive made by ExportUserData into the memos of a user.
+	// Memos and attachments are matched by uid, so importing the same archive again updates them.
+	ImportUserData(ctx context.Context, in *ImportUserDataRequest, opts ...grpc.CallOption) (*ImportUserDataResponse, error)
+	// ImportNotes imports the notes exported from another note tool as memos of a user.
+	ImportNotes(ctx context.Context, in *ImportNotesRequest, opts ...grpc.CallOption) (*ImportNotesResponse, error)
 }
 
 type userServiceClient struct {
@@ -271,6 +287,26 @@ func (c *userServiceClient) DeleteUserWebhook(ctx context.Context, in *DeleteUse
 	return out, nil
 }
 
+func (c *userServiceClient) ListUserWebhookDeliveries(ctx context.Context, in *ListUserWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListUserWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUserWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RedeliverUserWebhookDelivery(ctx context.Context, in *RedeliverUserWebhookDeliveryRequest, opts ...grpc.CallOption) (*UserWebhookDelivery, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserWebhookDelivery)
+	err := c.cc.Invoke(ctx, UserService_RedeliverUserWebhookDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ListUserNotifications(ctx context.Context, in *ListUserNotificationsRequest, opts ...grpc.CallOption) (*ListUserNotificationsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListUserNotificationsResponse)
@@ -301,6 +337,36 @@ func (c *userServiceClient) DeleteUserNotification(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *userServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserDataResponse)
+	err := c.cc.Invoke(ctx, UserService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ImportUserData(ctx context.Context, in *ImportUserDataRequest, opts ...grpc.CallOption) (*ImportUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportUserDataResponse)
+	err := c.cc.Invoke(ctx, UserService_ImportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ImportNotes(ctx context.Context, in *ImportNotesRequest, opts ...grpc.CallOption) (*ImportNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportNotesResponse)
+	err := c.cc.Invoke(ctx, UserService_ImportNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -344,12 +410,23 @@ type UserServiceServer interface {
 	UpdateUserWebhook(context.Context, *UpdateUserWebhookRequest) (*UserWebhook, error)
 	// DeleteUserWebhook deletes a webhook for a user.
 	DeleteUserWebhook(context.Context, *DeleteUserWebhookRequest) (*emptypb.Empty, error)
+	// ListUserWebhookDeliveries returns the deliveries of a webhook, newest first.
+	ListUserWebhookDeliveries(context.Context, *ListUserWebhookDeliveriesRequest) (*ListUserWebhookDeliveriesResponse, error)
+	// RedeliverUserWebhookDelivery sends the request of a delivery again as a new delivery.
+	RedeliverUserWebhookDelivery(context.Context, *RedeliverUserWebhookDeliveryRequest) (*UserWebhookDelivery, error)
 	// ListUserNotifications lists notifications for a user.
 	ListUserNotifications(context.Context, *ListUserNotificationsRequest) (*ListUserNotificationsResponse, error)
 	// UpdateUserNotification updates a notification.
 	UpdateUserNotification(context.Context, *UpdateUserNotificationRequest) (*UserNotification, error)
 	// DeleteUserNotification deletes a notification.
 	DeleteUserNotification(context.Context, *DeleteUserNotificationRequest) (*emptypb.Empty, error)
+	// ExportUserData exports the memos and attachments of a user as a zip archive.
+	ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error)
+	// ImportUserData imports a zip archive made by ExportUserData into the memos of a user.
+	// Memos and attachments are matched by uid, so importing the same archive again updates them.
+	ImportUserData(context.Context, *ImportUserDataRequest) (*ImportUserDataResponse, error)
+	// ImportNotes imports the notes exported from another note tool as memos of a user.
+	ImportNotes(context.Context, *ImportNotesRequest) (*ImportNotesResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -411,6 +488,12 @@ func (UnimplementedUserServiceServer) UpdateUserWebhook(context.Context, *Update
 func (UnimplementedUserServiceServer) DeleteUserWebhook(context.Context, *DeleteUserWebhookRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteUserWebhook not implemented")
 }
+func (UnimplementedUserServiceServer) ListUserWebhookDeliveries(context.Context, *ListUserWebhookDeliveriesRequest) (*ListUserWebhookDeliveriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserWebhookDeliveries not implemented")
+}
+func (UnimplementedUserServiceServer) RedeliverUserWebhookDelivery(context.Context, *RedeliverUserWebhookDeliveryRequest) (*UserWebhookDelivery, error) {
+	return nil, status.Error(codes.Unimplemented, "method RedeliverUserWebhookDelivery not implemented")
+}
 func (UnimplementedUserServiceServer) ListUserNotifications(context.Context, *ListUserNotificationsRequest) (*ListUserNotificationsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListUserNotifications not implemented")
 }
@@ -420,6 +503,15 @@ func (UnimplementedUserServiceServer) UpdateUserNotification(context.Context, *U
 func (UnimplementedUserServiceServer) DeleteUserNotification(context.Context, *DeleteUserNotificationRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteUserNotification not implemented")
 }
+func (UnimplementedUserServiceServer) ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedUserServiceServer) ImportUserData(context.Context, *ImportUserDataRequest) (*ImportUserDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportUserData not implemented")
+}
+func (UnimplementedUserServiceServer) ImportNotes(context.Context, *ImportNotesRequest) (*ImportNotesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportNotes not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -747,6 +839,42 @@ func _UserService_DeleteUserWebhook_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ListUserWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserWebhookDeliveries(ctx, req.(*ListUserWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RedeliverUserWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverUserWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RedeliverUserWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RedeliverUserWebhookDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RedeliverUserWebhookDelivery(ctx, req.(*RedeliverUserWebhookDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_ListUserNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListUserNotificationsRequest)
 	if err := dec(in); err != nil {
@@ -801,6 +929,60 @@ func _UserService_DeleteUserNotification_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ImportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ImportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ImportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ImportUserData(ctx, req.(*ImportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ImportNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ImportNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ImportNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ImportNotes(ctx, req.(*ImportNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -876,6 +1058,14 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUserWebhook",
 			Handler:    _UserService_DeleteUserWebhook_Handler,
 		},
+		{
+			MethodName: "ListUserWebhookDeliveries",
+			Handler:    _UserService_ListUserWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RedeliverUserWebhookDelivery",
+			Handler:    _UserService_RedeliverUserWebhookDelivery_Handler,
+		},
 		{
 			MethodName: "ListUserNotifications",
 			Handler:    _UserService_ListUserNotifications_Handler,
@@ -888,6 +1078,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUserNotification",
 			Handler:    _UserService_DeleteUserNotification_Handler,
 		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _UserService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "ImportUserData",
+			Handler:    _UserService_ImportUserData_Handler,
+		},
+		{
+			MethodName: "ImportNotes",
+			Handler:    _UserService_ImportNotes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/user_service.proto",
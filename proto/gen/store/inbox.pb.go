@@ -27,6 +27,12 @@ const (
 	InboxMessage_TYPE_UNSPECIFIED InboxMessage_Type = 0
 	// Memo comment notification.
 	InboxMessage_MEMO_COMMENT InboxMessage_Type = 1
+	// Periodic digest notification.
+	InboxMessage_DIGEST InboxMessage_Type = 2
+	// Memo mention notification.
+	InboxMessage_MEMO_MENTION InboxMessage_Type = 3
+	// Memo reminder notification.
+	InboxMessage_MEMO_REMINDER InboxMessage_Type = 4
 )
 
 // Enum value maps for InboxMessage_Type.
@@ -34,10 +40,16 @@ var (
 	InboxMessage_Type_name = map[int32]string{
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
+		2: "DIGEST",
+		3: "MEMO_MENTION",
+		4: "MEMO_REMINDER",
 	}
 	InboxMessage_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED": 0,
 		"MEMO_COMMENT":     1,
+		"DIGEST":           2,
+		"MEMO_MENTION":     3,
+		"MEMO_REMINDER":    4,
 	}
 )
 
@@ -73,7 +85,9 @@ type InboxMessage struct {
 	// The type of the inbox message.
 	Type InboxMessage_Type `protobuf:"varint,1,opt,name=type,proto3,enum=memos.store.InboxMessage_Type" json:"type,omitempty"`
 	// The system-generated unique ID of related activity.
-	ActivityId    *int32 `protobuf:"varint,2,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
+	ActivityId *int32 `protobuf:"varint,2,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
+	// The digest, for digest notifications.
+	Digest        *InboxMessage_Digest `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -122,18 +136,105 @@ func (x *InboxMessage) GetActivityId() int32 {
 	return 0
 }
 
+func (x *InboxMessage) GetDigest() *InboxMessage_Digest {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+type InboxMessage_Digest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Title string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// The digest rendered as HTML.
+	Html string `protobuf:"bytes,2,opt,name=html,proto3" json:"html,omitempty"`
+	// The period of the digest in unix seconds.
+	StartTs       int64 `protobuf:"varint,3,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	EndTs         int64 `protobuf:"varint,4,opt,name=end_ts,json=endTs,proto3" json:"end_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InboxMessage_Digest) Reset() {
+	*x = InboxMessage_Digest{}
+	mi := &file_store_inbox_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboxMessage_Digest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxMessage_Digest) ProtoMessage() {}
+
+func (x *InboxMessage_Digest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_inbox_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxMessage_Digest.ProtoReflect.Descriptor instead.
+func (*InboxMessage_Digest) Descriptor() ([]byte, []int) {
+	return file_store_inbox_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *InboxMessage_Digest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *InboxMessage_Digest) GetHtml() string {
+	if x != nil {
+		return x.Html
+	}
+	return ""
+}
+
+func (x *InboxMessage_Digest) GetStartTs() int64 {
+	if x != nil {
+		return x.StartTs
+	}
+	return 0
+}
+
+func (x *InboxMessage_Digest) GetEndTs() int64 {
+	if x != nil {
+		return x.EndTs
+	}
+	return 0
+}
+
 var File_store_inbox_proto protoreflect.FileDescriptor
 
 const file_store_inbox_proto_rawDesc = "" +
 	"\n" +
-	"\x11store/inbox.proto\x12\vmemos.store\"\xa8\x01\n" +
+	"\x11store/inbox.proto\x12\vmemos.store\"\xf9\x02\n" +
 	"\fInboxMessage\x122\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1e.memos.store.InboxMessage.TypeR\x04type\x12$\n" +
 	"\vactivity_id\x18\x02 \x01(\x05H\x00R\n" +
-	"activityId\x88\x01\x01\".\n" +
+	"activityId\x88\x01\x01\x128\n" +
+	"\x06digest\x18\x03 \x01(\v2 .memos.store.InboxMessage.DigestR\x06digest\x1ad\n" +
+	"\x06Digest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04html\x18\x02 \x01(\tR\x04html\x12\x19\n" +
+	"\bstart_ts\x18\x03 \x01(\x03R\astartTs\x12\x15\n" +
+	"\x06end_ts\x18\x04 \x01(\x03R\x05endTs\"_\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
-	"\fMEMO_COMMENT\x10\x01B\x0e\n" +
+	"\fMEMO_COMMENT\x10\x01\x12\n" +
+	"\n" +
+	"\x06DIGEST\x10\x02\x12\x10\n" +
+	"\fMEMO_MENTION\x10\x03\x12\x11\n" +
+	"\rMEMO_REMINDER\x10\x04B\x0e\n" +
 	"\f_activity_idB\x95\x01\n" +
 	"\x0fcom.memos.storeB\n" +
 	"InboxProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
@@ -151,18 +252,20 @@ func file_store_inbox_proto_rawDescGZIP() []byte {
 }
 
 var file_store_inbox_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_inbox_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_store_inbox_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_store_inbox_proto_goTypes = []any{
-	(InboxMessage_Type)(0), // 0: memos.store.InboxMessage.Type
-	(*InboxMessage)(nil),   // 1: memos.store.InboxMessage
+	(InboxMessage_Type)(0),      // 0: memos.store.InboxMessage.Type
+	(*InboxMessage)(nil),        // 1: memos.store.InboxMessage
+	(*InboxMessage_Digest)(nil), // 2: memos.store.InboxMessage.Digest
 }
 var file_store_inbox_proto_depIdxs = []int32{
 	0, // 0: memos.store.InboxMessage.type:type_name -> memos.store.InboxMessage.Type
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: memos.store.InboxMessage.digest:type_name -> memos.store.InboxMessage.Digest
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_store_inbox_proto_init() }
@@ -177,7 +280,7 @@ func file_store_inbox_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_inbox_proto_rawDesc), len(file_store_inbox_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
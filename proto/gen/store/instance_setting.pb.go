@@ -35,6 +35,10 @@ const (
 	InstanceSettingKey_MEMO_RELATED InstanceSettingKey = 4
 	// AI is the key for AI related settings.
 	InstanceSettingKey_AI InstanceSettingKey = 5
+	// EMAIL is the key for email settings.
+	InstanceSettingKey_EMAIL InstanceSettingKey = 6
+	// BACKUP is the key for backup settings.
+	InstanceSettingKey_BACKUP InstanceSettingKey = 7
 )
 
 // Enum value maps for InstanceSettingKey.
@@ -46,6 +50,8 @@ var (
 		3: "STORAGE",
 		4: "MEMO_RELATED",
 		5: "AI",
+		6: "EMAIL",
+		7: "BACKUP",
 	}
 	InstanceSettingKey_value = map[string]int32{
 		"INSTANCE_SETTING_KEY_UNSPECIFIED": 0,
@@ -54,6 +60,8 @@ var (
 		"STORAGE":                          3,
 		"MEMO_RELATED":                     4,
 		"AI":                               5,
+		"EMAIL":                            6,
+		"BACKUP":                           7,
 	}
 )
 
@@ -139,6 +147,57 @@ func (InstanceStorageSetting_StorageType) EnumDescriptor() ([]byte, []int) {
 	return file_store_instance_setting_proto_rawDescGZIP(), []int{4, 0}
 }
 
+type InstanceBackupSetting_Destination int32
+
+const (
+	InstanceBackupSetting_DESTINATION_UNSPECIFIED InstanceBackupSetting_Destination = 0
+	// LOCAL writes the backups to the backups folder of the data directory.
+	InstanceBackupSetting_LOCAL InstanceBackupSetting_Destination = 1
+	// S3 uploads the backups to the bucket of the S3 storage config.
+	InstanceBackupSetting_S3 InstanceBackupSetting_Destination = 2
+)
+
+// Enum value maps for InstanceBackupSetting_Destination.
+var (
+	InstanceBackupSetting_Destination_name = map[int32]string{
+		0: "DESTINATION_UNSPECIFIED",
+		1: "LOCAL",
+		2: "S3",
+	}
+	InstanceBackupSetting_Destination_value = map[string]int32{
+		"DESTINATION_UNSPECIFIED": 0,
+		"LOCAL":                   1,
+		"S3":                      2,
+	}
+)
+
+func (x InstanceBackupSetting_Destination) Enum() *InstanceBackupSetting_Destination {
+	p := new(InstanceBackupSetting_Destination)
+	*p = x
+	return p
+}
+
+func (x InstanceBackupSetting_Destination) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstanceBackupSetting_Destination) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_instance_setting_proto_enumTypes[2].Descriptor()
+}
+
+func (InstanceBackupSetting_Destination) Type() protoreflect.EnumType {
+	return &file_store_instance_setting_proto_enumTypes[2]
+}
+
+func (x InstanceBackupSetting_Destination) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstanceBackupSetting_Destination.Descriptor instead.
+func (InstanceBackupSetting_Destination) EnumDescriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{9, 0}
+}
+
 type InstanceSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Key   InstanceSettingKey     `protobuf:"varint,1,opt,name=key,proto3,enum=memos.store.InstanceSettingKey" json:"key,omitempty"`
@@ -149,6 +208,8 @@ type InstanceSetting struct {
 	//	*InstanceSetting_StorageSetting
 	//	*InstanceSetting_MemoRelatedSetting
 	//	*InstanceSetting_AiSetting
+	//	*InstanceSetting_EmailSetting
+	//	*InstanceSetting_BackupSetting
 	Value         isInstanceSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -243,6 +304,24 @@ func (x *InstanceSetting) GetAiSetting() *InstanceAISetting {
 	return nil
 }
 
+func (x *InstanceSetting) GetEmailSetting() *InstanceEmailSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_EmailSetting); ok {
+			return x.EmailSetting
+		}
+	}
+	return nil
+}
+
+func (x *InstanceSetting) GetBackupSetting() *InstanceBackupSetting {
+	if x != nil {
+		if x, ok := x.Value.(*InstanceSetting_BackupSetting); ok {
+			return x.BackupSetting
+		}
+	}
+	return nil
+}
+
 type isInstanceSetting_Value interface {
 	isInstanceSetting_Value()
 }
@@ -267,6 +346,14 @@ type InstanceSetting_AiSetting struct {
 	AiSetting *InstanceAISetting `protobuf:"bytes,6,opt,name=ai_setting,json=aiSetting,proto3,oneof"`
 }
 
+type InstanceSetting_EmailSetting struct {
+	EmailSetting *InstanceEmailSetting `protobuf:"bytes,7,opt,name=email_setting,json=emailSetting,proto3,oneof"`
+}
+
+type InstanceSetting_BackupSetting struct {
+	BackupSetting *InstanceBackupSetting `protobuf:"bytes,8,opt,name=backup_setting,json=backupSetting,proto3,oneof"`
+}
+
 func (*InstanceSetting_BasicSetting) isInstanceSetting_Value() {}
 
 func (*InstanceSetting_GeneralSetting) isInstanceSetting_Value() {}
@@ -277,6 +364,10 @@ func (*InstanceSetting_MemoRelatedSetting) isInstanceSetting_Value() {}
 
 func (*InstanceSetting_AiSetting) isInstanceSetting_Value() {}
 
+func (*InstanceSetting_EmailSetting) isInstanceSetting_Value() {}
+
+func (*InstanceSetting_BackupSetting) isInstanceSetting_Value() {}
+
 type InstanceBasicSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The secret key for instance. Mainly used for session management.
@@ -670,9 +761,13 @@ type InstanceMemoRelatedSetting struct {
 	// enable_double_click_edit enables editing on double click.
 	EnableDoubleClickEdit bool `protobuf:"varint,4,opt,name=enable_double_click_edit,json=enableDoubleClickEdit,proto3" json:"enable_double_click_edit,omitempty"`
 	// reactions is the list of reactions.
-	Reactions     []string `protobuf:"bytes,7,rep,name=reactions,proto3" json:"reactions,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Reactions []string `protobuf:"bytes,7,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// disable_memo_revisions stops recording the prior versions of memos on update.
+	DisableMemoRevisions bool `protobuf:"varint,8,opt,name=disable_memo_revisions,json=disableMemoRevisions,proto3" json:"disable_memo_revisions,omitempty"`
+	// memo_revision_limit is the number of revisions kept per memo, the oldest ones are deleted beyond it.
+	MemoRevisionLimit int32 `protobuf:"varint,9,opt,name=memo_revision_limit,json=memoRevisionLimit,proto3" json:"memo_revision_limit,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *InstanceMemoRelatedSetting) Reset() {
@@ -740,6 +835,20 @@ func (x *InstanceMemoRelatedSetting) GetReactions() []string {
 	return nil
 }
 
+func (x *InstanceMemoRelatedSetting) GetDisableMemoRevisions() bool {
+	if x != nil {
+		return x.DisableMemoRevisions
+	}
+	return false
+}
+
+func (x *InstanceMemoRelatedSetting) GetMemoRevisionLimit() int32 {
+	if x != nil {
+		return x.MemoRevisionLimit
+	}
+	return 0
+}
+
 type InstanceAISetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// openai_base_url is the base URL for OpenAI-compatible embedding API.
@@ -756,22 +865,15 @@ type InstanceAISetting struct {
 	SemanticEmbeddingConcurrency int32 `protobuf:"varint,6,opt,name=semantic_embedding_concurrency,json=semanticEmbeddingConcurrency,proto3" json:"semantic_embedding_concurrency,omitempty"`
 	// openai_embedding_models is the saved model list for quick switching.
 	OpenaiEmbeddingModels []string `protobuf:"bytes,7,rep,name=openai_embedding_models,json=openaiEmbeddingModels,proto3" json:"openai_embedding_models,omitempty"`
-	// semantic_reindex_running indicates whether a background semantic reindex task is running.
-	SemanticReindexRunning bool `protobuf:"varint,8,opt,name=semantic_reindex_running,json=semanticReindexRunning,proto3" json:"semantic_reindex_running,omitempty"`
-	// semantic_reindex_total is the total memo count for current/last reindex task.
-	SemanticReindexTotal int32 `protobuf:"varint,9,opt,name=semantic_reindex_total,json=semanticReindexTotal,proto3" json:"semantic_reindex_total,omitempty"`
-	// semantic_reindex_processed is the processed memo count for current/last reindex task.
-	SemanticReindexProcessed int32 `protobuf:"varint,10,opt,name=semantic_reindex_processed,json=semanticReindexProcessed,proto3" json:"semantic_reindex_processed,omitempty"`
-	// semantic_reindex_failed is the failed memo count for current/last reindex task.
-	SemanticReindexFailed int32 `protobuf:"varint,11,opt,name=semantic_reindex_failed,json=semanticReindexFailed,proto3" json:"semantic_reindex_failed,omitempty"`
-	// semantic_reindex_started_ts is the unix timestamp in seconds when current/last reindex started.
-	SemanticReindexStartedTs int64 `protobuf:"varint,12,opt,name=semantic_reindex_started_ts,json=semanticReindexStartedTs,proto3" json:"semantic_reindex_started_ts,omitempty"`
-	// semantic_reindex_updated_ts is the unix timestamp in seconds when progress last updated.
-	SemanticReindexUpdatedTs int64 `protobuf:"varint,13,opt,name=semantic_reindex_updated_ts,json=semanticReindexUpdatedTs,proto3" json:"semantic_reindex_updated_ts,omitempty"`
-	// semantic_reindex_model is the model used by current/last reindex task.
-	SemanticReindexModel string `protobuf:"bytes,14,opt,name=semantic_reindex_model,json=semanticReindexModel,proto3" json:"semantic_reindex_model,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// embedding_provider is the name of the embedding provider, e.g. "openai" or "local".
+	// Empty means the MEMOS_EMBEDDING_PROVIDER environment value or "openai".
+	EmbeddingProvider string `protobuf:"bytes,15,opt,name=embedding_provider,json=embeddingProvider,proto3" json:"embedding_provider,omitempty"`
+	// openai_completion_model is the chat completion model used to enrich memos.
+	OpenaiCompletionModel string `protobuf:"bytes,16,opt,name=openai_completion_model,json=openaiCompletionModel,proto3" json:"openai_completion_model,omitempty"`
+	// memo_enrichment_enabled enables generating summaries, tag suggestions and categories on memo save.
+	MemoEnrichmentEnabled bool `protobuf:"varint,17,opt,name=memo_enrichment_enabled,json=memoEnrichmentEnabled,proto3" json:"memo_enrichment_enabled,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *InstanceAISetting) Reset() {
@@ -853,60 +955,205 @@ func (x *InstanceAISetting) GetOpenaiEmbeddingModels() []string {
 	return nil
 }
 
-func (x *InstanceAISetting) GetSemanticReindexRunning() bool {
+func (x *InstanceAISetting) GetEmbeddingProvider() string {
 	if x != nil {
-		return x.SemanticReindexRunning
+		return x.EmbeddingProvider
+	}
+	return ""
+}
+
+func (x *InstanceAISetting) GetOpenaiCompletionModel() string {
+	if x != nil {
+		return x.OpenaiCompletionModel
+	}
+	return ""
+}
+
+func (x *InstanceAISetting) GetMemoEnrichmentEnabled() bool {
+	if x != nil {
+		return x.MemoEnrichmentEnabled
 	}
 	return false
 }
 
-func (x *InstanceAISetting) GetSemanticReindexTotal() int32 {
+type InstanceEmailSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// smtp_host is the host of the SMTP server. Emails are disabled when empty.
+	SmtpHost string `protobuf:"bytes,1,opt,name=smtp_host,json=smtpHost,proto3" json:"smtp_host,omitempty"`
+	// smtp_port is the port of the SMTP server.
+	SmtpPort int32 `protobuf:"varint,2,opt,name=smtp_port,json=smtpPort,proto3" json:"smtp_port,omitempty"`
+	// smtp_username is the username of the SMTP server.
+	SmtpUsername string `protobuf:"bytes,3,opt,name=smtp_username,json=smtpUsername,proto3" json:"smtp_username,omitempty"`
+	// smtp_password_encrypted stores encrypted SMTP password ciphertext.
+	SmtpPasswordEncrypted string `protobuf:"bytes,4,opt,name=smtp_password_encrypted,json=smtpPasswordEncrypted,proto3" json:"smtp_password_encrypted,omitempty"`
+	// from_email is the sender email address.
+	FromEmail string `protobuf:"bytes,5,opt,name=from_email,json=fromEmail,proto3" json:"from_email,omitempty"`
+	// from_name is the sender display name.
+	FromName string `protobuf:"bytes,6,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
+	// use_tls upgrades the connection with STARTTLS.
+	UseTls bool `protobuf:"varint,7,opt,name=use_tls,json=useTls,proto3" json:"use_tls,omitempty"`
+	// use_ssl connects with implicit TLS.
+	UseSsl        bool `protobuf:"varint,8,opt,name=use_ssl,json=useSsl,proto3" json:"use_ssl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceEmailSetting) Reset() {
+	*x = InstanceEmailSetting{}
+	mi := &file_store_instance_setting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceEmailSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceEmailSetting) ProtoMessage() {}
+
+func (x *InstanceEmailSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[8]
 	if x != nil {
-		return x.SemanticReindexTotal
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceEmailSetting.ProtoReflect.Descriptor instead.
+func (*InstanceEmailSetting) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *InstanceAISetting) GetSemanticReindexProcessed() int32 {
+func (x *InstanceEmailSetting) GetSmtpHost() string {
 	if x != nil {
-		return x.SemanticReindexProcessed
+		return x.SmtpHost
 	}
-	return 0
+	return ""
 }
 
-func (x *InstanceAISetting) GetSemanticReindexFailed() int32 {
+func (x *InstanceEmailSetting) GetSmtpPort() int32 {
 	if x != nil {
-		return x.SemanticReindexFailed
+		return x.SmtpPort
 	}
 	return 0
 }
 
-func (x *InstanceAISetting) GetSemanticReindexStartedTs() int64 {
+func (x *InstanceEmailSetting) GetSmtpUsername() string {
 	if x != nil {
-		return x.SemanticReindexStartedTs
+		return x.SmtpUsername
 	}
-	return 0
+	return ""
 }
 
-func (x *InstanceAISetting) GetSemanticReindexUpdatedTs() int64 {
+func (x *InstanceEmailSetting) GetSmtpPasswordEncrypted() string {
 	if x != nil {
-		return x.SemanticReindexUpdatedTs
+		return x.SmtpPasswordEncrypted
 	}
-	return 0
+	return ""
+}
+
+func (x *InstanceEmailSetting) GetFromEmail() string {
+	if x != nil {
+		return x.FromEmail
+	}
+	return ""
+}
+
+func (x *InstanceEmailSetting) GetFromName() string {
+	if x != nil {
+		return x.FromName
+	}
+	return ""
+}
+
+func (x *InstanceEmailSetting) GetUseTls() bool {
+	if x != nil {
+		return x.UseTls
+	}
+	return false
+}
+
+func (x *InstanceEmailSetting) GetUseSsl() bool {
+	if x != nil {
+		return x.UseSsl
+	}
+	return false
+}
+
+type InstanceBackupSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// schedule is the cron expression of the scheduled backups, in the server time zone.
+	// Scheduled backups are disabled when empty.
+	Schedule string `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// destination is where the backups are written. Defaults to LOCAL.
+	Destination InstanceBackupSetting_Destination `protobuf:"varint,2,opt,name=destination,proto3,enum=memos.store.InstanceBackupSetting_Destination" json:"destination,omitempty"`
+	// retention_count is the number of backups kept in the destination, the older ones are deleted after a backup.
+	// Value <= 0 keeps all the backups.
+	RetentionCount int32 `protobuf:"varint,3,opt,name=retention_count,json=retentionCount,proto3" json:"retention_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InstanceBackupSetting) Reset() {
+	*x = InstanceBackupSetting{}
+	mi := &file_store_instance_setting_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceBackupSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceBackupSetting) ProtoMessage() {}
+
+func (x *InstanceBackupSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_instance_setting_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *InstanceAISetting) GetSemanticReindexModel() string {
+// Deprecated: Use InstanceBackupSetting.ProtoReflect.Descriptor instead.
+func (*InstanceBackupSetting) Descriptor() ([]byte, []int) {
+	return file_store_instance_setting_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InstanceBackupSetting) GetSchedule() string {
 	if x != nil {
-		return x.SemanticReindexModel
+		return x.Schedule
 	}
 	return ""
 }
 
+func (x *InstanceBackupSetting) GetDestination() InstanceBackupSetting_Destination {
+	if x != nil {
+		return x.Destination
+	}
+	return InstanceBackupSetting_DESTINATION_UNSPECIFIED
+}
+
+func (x *InstanceBackupSetting) GetRetentionCount() int32 {
+	if x != nil {
+		return x.RetentionCount
+	}
+	return 0
+}
+
 var File_store_instance_setting_proto protoreflect.FileDescriptor
 
 const file_store_instance_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x1cstore/instance_setting.proto\x12\vmemos.store\"\xd5\x03\n" +
+	"\x1cstore/instance_setting.proto\x12\vmemos.store\"\xec\x04\n" +
 	"\x0fInstanceSetting\x121\n" +
 	"\x03key\x18\x01 \x01(\x0e2\x1f.memos.store.InstanceSettingKeyR\x03key\x12H\n" +
 	"\rbasic_setting\x18\x02 \x01(\v2!.memos.store.InstanceBasicSettingH\x00R\fbasicSetting\x12N\n" +
@@ -914,7 +1161,9 @@ const file_store_instance_setting_proto_rawDesc = "" +
 	"\x0fstorage_setting\x18\x04 \x01(\v2#.memos.store.InstanceStorageSettingH\x00R\x0estorageSetting\x12[\n" +
 	"\x14memo_related_setting\x18\x05 \x01(\v2'.memos.store.InstanceMemoRelatedSettingH\x00R\x12memoRelatedSetting\x12?\n" +
 	"\n" +
-	"ai_setting\x18\x06 \x01(\v2\x1e.memos.store.InstanceAISettingH\x00R\taiSettingB\a\n" +
+	"ai_setting\x18\x06 \x01(\v2\x1e.memos.store.InstanceAISettingH\x00R\taiSetting\x12H\n" +
+	"\remail_setting\x18\a \x01(\v2!.memos.store.InstanceEmailSettingH\x00R\femailSetting\x12K\n" +
+	"\x0ebackup_setting\x18\b \x01(\v2\".memos.store.InstanceBackupSettingH\x00R\rbackupSettingB\a\n" +
 	"\x05value\"\\\n" +
 	"\x14InstanceBasicSetting\x12\x1d\n" +
 	"\n" +
@@ -949,13 +1198,15 @@ const file_store_instance_setting_proto_rawDesc = "" +
 	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x16\n" +
 	"\x06region\x18\x04 \x01(\tR\x06region\x12\x16\n" +
 	"\x06bucket\x18\x05 \x01(\tR\x06bucket\x12$\n" +
-	"\x0euse_path_style\x18\x06 \x01(\bR\fusePathStyle\"\x9c\x02\n" +
+	"\x0euse_path_style\x18\x06 \x01(\bR\fusePathStyle\"\x82\x03\n" +
 	"\x1aInstanceMemoRelatedSetting\x12<\n" +
 	"\x1adisallow_public_visibility\x18\x01 \x01(\bR\x18disallowPublicVisibility\x127\n" +
 	"\x18display_with_update_time\x18\x02 \x01(\bR\x15displayWithUpdateTime\x120\n" +
 	"\x14content_length_limit\x18\x03 \x01(\x05R\x12contentLengthLimit\x127\n" +
 	"\x18enable_double_click_edit\x18\x04 \x01(\bR\x15enableDoubleClickEdit\x12\x1c\n" +
-	"\treactions\x18\a \x03(\tR\treactions\"\xc9\x06\n" +
+	"\treactions\x18\a \x03(\tR\treactions\x124\n" +
+	"\x16disable_memo_revisions\x18\b \x01(\bR\x14disableMemoRevisions\x12.\n" +
+	"\x13memo_revision_limit\x18\t \x01(\x05R\x11memoRevisionLimit\"\xd4\x04\n" +
 	"\x11InstanceAISetting\x12&\n" +
 	"\x0fopenai_base_url\x18\x01 \x01(\tR\ropenaiBaseUrl\x124\n" +
 	"\x16openai_embedding_model\x18\x02 \x01(\tR\x14openaiEmbeddingModel\x127\n" +
@@ -963,22 +1214,38 @@ const file_store_instance_setting_proto_rawDesc = "" +
 	"\x1aopenai_embedding_max_retry\x18\x04 \x01(\x05R\x17openaiEmbeddingMaxRetry\x12H\n" +
 	"!openai_embedding_retry_backoff_ms\x18\x05 \x01(\x05R\x1dopenaiEmbeddingRetryBackoffMs\x12D\n" +
 	"\x1esemantic_embedding_concurrency\x18\x06 \x01(\x05R\x1csemanticEmbeddingConcurrency\x126\n" +
-	"\x17openai_embedding_models\x18\a \x03(\tR\x15openaiEmbeddingModels\x128\n" +
-	"\x18semantic_reindex_running\x18\b \x01(\bR\x16semanticReindexRunning\x124\n" +
-	"\x16semantic_reindex_total\x18\t \x01(\x05R\x14semanticReindexTotal\x12<\n" +
-	"\x1asemantic_reindex_processed\x18\n" +
-	" \x01(\x05R\x18semanticReindexProcessed\x126\n" +
-	"\x17semantic_reindex_failed\x18\v \x01(\x05R\x15semanticReindexFailed\x12=\n" +
-	"\x1bsemantic_reindex_started_ts\x18\f \x01(\x03R\x18semanticReindexStartedTs\x12=\n" +
-	"\x1bsemantic_reindex_updated_ts\x18\r \x01(\x03R\x18semanticReindexUpdatedTs\x124\n" +
-	"\x16semantic_reindex_model\x18\x0e \x01(\tR\x14semanticReindexModel*y\n" +
+	"\x17openai_embedding_models\x18\a \x03(\tR\x15openaiEmbeddingModels\x12-\n" +
+	"\x12embedding_provider\x18\x0f \x01(\tR\x11embeddingProvider\x126\n" +
+	"\x17openai_completion_model\x18\x10 \x01(\tR\x15openaiCompletionModel\x126\n" +
+	"\x17memo_enrichment_enabled\x18\x11 \x01(\bR\x15memoEnrichmentEnabledJ\x04\b\b\x10\x0f\"\x9b\x02\n" +
+	"\x14InstanceEmailSetting\x12\x1b\n" +
+	"\tsmtp_host\x18\x01 \x01(\tR\bsmtpHost\x12\x1b\n" +
+	"\tsmtp_port\x18\x02 \x01(\x05R\bsmtpPort\x12#\n" +
+	"\rsmtp_username\x18\x03 \x01(\tR\fsmtpUsername\x126\n" +
+	"\x17smtp_password_encrypted\x18\x04 \x01(\tR\x15smtpPasswordEncrypted\x12\x1d\n" +
+	"\n" +
+	"from_email\x18\x05 \x01(\tR\tfromEmail\x12\x1b\n" +
+	"\tfrom_name\x18\x06 \x01(\tR\bfromName\x12\x17\n" +
+	"\ause_tls\x18\a \x01(\bR\x06useTls\x12\x17\n" +
+	"\ause_ssl\x18\b \x01(\bR\x06useSsl\"\xed\x01\n" +
+	"\x15InstanceBackupSetting\x12\x1a\n" +
+	"\bschedule\x18\x01 \x01(\tR\bschedule\x12P\n" +
+	"\vdestination\x18\x02 \x01(\x0e2..memos.store.InstanceBackupSetting.DestinationR\vdestination\x12'\n" +
+	"\x0fretention_count\x18\x03 \x01(\x05R\x0eretentionCount\"=\n" +
+	"\vDestination\x12\x1b\n" +
+	"\x17DESTINATION_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05LOCAL\x10\x01\x12\x06\n" +
+	"\x02S3\x10\x02*\x90\x01\n" +
 	"\x12InstanceSettingKey\x12$\n" +
 	" INSTANCE_SETTING_KEY_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05BASIC\x10\x01\x12\v\n" +
 	"\aGENERAL\x10\x02\x12\v\n" +
 	"\aSTORAGE\x10\x03\x12\x10\n" +
 	"\fMEMO_RELATED\x10\x04\x12\x06\n" +
-	"\x02AI\x10\x05B\x9f\x01\n" +
+	"\x02AI\x10\x05\x12\t\n" +
+	"\x05EMAIL\x10\x06\x12\n" +
+	"\n" +
+	"\x06BACKUP\x10\aB\x9f\x01\n" +
 	"\x0fcom.memos.storeB\x14InstanceSettingProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -993,35 +1260,41 @@ func file_store_instance_setting_proto_rawDescGZIP() []byte {
 	return file_store_instance_setting_proto_rawDescData
 }
 
-var file_store_instance_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_store_instance_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_store_instance_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_store_instance_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_store_instance_setting_proto_goTypes = []any{
 	(InstanceSettingKey)(0),                 // 0: memos.store.InstanceSettingKey
 	(InstanceStorageSetting_StorageType)(0), // 1: memos.store.InstanceStorageSetting.StorageType
-	(*InstanceSetting)(nil),                 // 2: memos.store.InstanceSetting
-	(*InstanceBasicSetting)(nil),            // 3: memos.store.InstanceBasicSetting
-	(*InstanceGeneralSetting)(nil),          // 4: memos.store.InstanceGeneralSetting
-	(*InstanceCustomProfile)(nil),           // 5: memos.store.InstanceCustomProfile
-	(*InstanceStorageSetting)(nil),          // 6: memos.store.InstanceStorageSetting
-	(*StorageS3Config)(nil),                 // 7: memos.store.StorageS3Config
-	(*InstanceMemoRelatedSetting)(nil),      // 8: memos.store.InstanceMemoRelatedSetting
-	(*InstanceAISetting)(nil),               // 9: memos.store.InstanceAISetting
+	(InstanceBackupSetting_Destination)(0),  // 2: memos.store.InstanceBackupSetting.Destination
+	(*InstanceSetting)(nil),                 // 3: memos.store.InstanceSetting
+	(*InstanceBasicSetting)(nil),            // 4: memos.store.InstanceBasicSetting
+	(*InstanceGeneralSetting)(nil),          // 5: memos.store.InstanceGeneralSetting
+	(*InstanceCustomProfile)(nil),           // 6: memos.store.InstanceCustomProfile
+	(*InstanceStorageSetting)(nil),          // 7: memos.store.InstanceStorageSetting
+	(*StorageS3Config)(nil),                 // 8: memos.store.StorageS3Config
+	(*InstanceMemoRelatedSetting)(nil),      // 9: memos.store.InstanceMemoRelatedSetting
+	(*InstanceAISetting)(nil),               // 10: memos.store.InstanceAISetting
+	(*InstanceEmailSetting)(nil),            // 11: memos.store.InstanceEmailSetting
+	(*InstanceBackupSetting)(nil),           // 12: memos.store.InstanceBackupSetting
 }
 var file_store_instance_setting_proto_depIdxs = []int32{
-	0, // 0: memos.store.InstanceSetting.key:type_name -> memos.store.InstanceSettingKey
-	3, // 1: memos.store.InstanceSetting.basic_setting:type_name -> memos.store.InstanceBasicSetting
-	4, // 2: memos.store.InstanceSetting.general_setting:type_name -> memos.store.InstanceGeneralSetting
-	6, // 3: memos.store.InstanceSetting.storage_setting:type_name -> memos.store.InstanceStorageSetting
-	8, // 4: memos.store.InstanceSetting.memo_related_setting:type_name -> memos.store.InstanceMemoRelatedSetting
-	9, // 5: memos.store.InstanceSetting.ai_setting:type_name -> memos.store.InstanceAISetting
-	5, // 6: memos.store.InstanceGeneralSetting.custom_profile:type_name -> memos.store.InstanceCustomProfile
-	1, // 7: memos.store.InstanceStorageSetting.storage_type:type_name -> memos.store.InstanceStorageSetting.StorageType
-	7, // 8: memos.store.InstanceStorageSetting.s3_config:type_name -> memos.store.StorageS3Config
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	0,  // 0: memos.store.InstanceSetting.key:type_name -> memos.store.InstanceSettingKey
+	4,  // 1: memos.store.InstanceSetting.basic_setting:type_name -> memos.store.InstanceBasicSetting
+	5,  // 2: memos.store.InstanceSetting.general_setting:type_name -> memos.store.InstanceGeneralSetting
+	7,  // 3: memos.store.InstanceSetting.storage_setting:type_name -> memos.store.InstanceStorageSetting
+	9,  // 4: memos.store.InstanceSetting.memo_related_setting:type_name -> memos.store.InstanceMemoRelatedSetting
+	10, // 5: memos.store.InstanceSetting.ai_setting:type_name -> memos.store.InstanceAISetting
+	11, // 6: memos.store.InstanceSetting.email_setting:type_name -> memos.store.InstanceEmailSetting
+	12, // 7: memos.store.InstanceSetting.backup_setting:type_name -> memos.store.InstanceBackupSetting
+	6,  // 8: memos.store.InstanceGeneralSetting.custom_profile:type_name -> memos.store.InstanceCustomProfile
+	1,  // 9: memos.store.InstanceStorageSetting.storage_type:type_name -> memos.store.InstanceStorageSetting.StorageType
+	8,  // 10: memos.store.InstanceStorageSetting.s3_config:type_name -> memos.store.StorageS3Config
+	2,  // 11: memos.store.InstanceBackupSetting.destination:type_name -> memos.store.InstanceBackupSetting.Destination
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_store_instance_setting_proto_init() }
@@ -1035,14 +1308,16 @@ func file_store_instance_setting_proto_init() {
 		(*InstanceSetting_StorageSetting)(nil),
 		(*InstanceSetting_MemoRelatedSetting)(nil),
 		(*InstanceSetting_AiSetting)(nil),
+		(*InstanceSetting_EmailSetting)(nil),
+		(*InstanceSetting_BackupSetting)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_instance_setting_proto_rawDesc), len(file_store_instance_setting_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   8,
+			NumEnums:      3,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -22,10 +22,22 @@ const (
 )
 
 type MemoPayload struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Property      *MemoPayload_Property  `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
-	Location      *MemoPayload_Location  `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
-	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	state      protoimpl.MessageState  `protogen:"open.v1"`
+	Property   *MemoPayload_Property   `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	Location   *MemoPayload_Location   `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Tags       []string                `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Enrichment *MemoPayload_Enrichment `protobuf:"bytes,4,opt,name=enrichment,proto3" json:"enrichment,omitempty"`
+	// The usernames mentioned in the content, without the leading "@".
+	Mentions []string `protobuf:"bytes,5,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	// The time in unix seconds to remind the creator about the memo, cleared once the reminder is sent.
+	RemindTs int64 `protobuf:"varint,6,opt,name=remind_ts,json=remindTs,proto3" json:"remind_ts,omitempty"`
+	// The time in unix seconds to publish the memo, cleared once the memo is published.
+	// Scheduled memos stay private until then.
+	PublishTs int64 `protobuf:"varint,7,opt,name=publish_ts,json=publishTs,proto3" json:"publish_ts,omitempty"`
+	// The visibility of the memo once it is published.
+	PublishVisibility string `protobuf:"bytes,8,opt,name=publish_visibility,json=publishVisibility,proto3" json:"publish_visibility,omitempty"`
+	// The checklist items extracted from the content, in content order.
+	Tasks         []*MemoPayload_Task `protobuf:"bytes,9,rep,name=tasks,proto3" json:"tasks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,6 +93,48 @@ func (x *MemoPayload) GetTags() []string {
 	return nil
 }
 
+func (x *MemoPayload) GetEnrichment() *MemoPayload_Enrichment {
+	if x != nil {
+		return x.Enrichment
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetMentions() []string {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *MemoPayload) GetRemindTs() int64 {
+	if x != nil {
+		return x.RemindTs
+	}
+	return 0
+}
+
+func (x *MemoPayload) GetPublishTs() int64 {
+	if x != nil {
+		return x.PublishTs
+	}
+	return 0
+}
+
+func (x *MemoPayload) GetPublishVisibility() string {
+	if x != nil {
+		return x.PublishVisibility
+	}
+	return ""
+}
+
+func (x *MemoPayload) GetTasks() []*MemoPayload_Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
 // The calculated properties from the memo content.
 type MemoPayload_Property struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
@@ -88,8 +142,10 @@ type MemoPayload_Property struct {
 	HasTaskList        bool                   `protobuf:"varint,2,opt,name=has_task_list,json=hasTaskList,proto3" json:"has_task_list,omitempty"`
 	HasCode            bool                   `protobuf:"varint,3,opt,name=has_code,json=hasCode,proto3" json:"has_code,omitempty"`
 	HasIncompleteTasks bool                   `protobuf:"varint,4,opt,name=has_incomplete_tasks,json=hasIncompleteTasks,proto3" json:"has_incomplete_tasks,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// The sha256 hash of the content with case and whitespace normalized, used to detect duplicates.
+	ContentHash   string `protobuf:"bytes,5,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MemoPayload_Property) Reset() {
@@ -150,6 +206,187 @@ func (x *MemoPayload_Property) GetHasIncompleteTasks() bool {
 	return false
 }
 
+func (x *MemoPayload_Property) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+// The suggestions generated by the language model from the memo content.
+type MemoPayload_Enrichment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       string                 `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	SuggestedTags []string               `protobuf:"bytes,2,rep,name=suggested_tags,json=suggestedTags,proto3" json:"suggested_tags,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	// The hash of the content the suggestions were generated from.
+	ContentHash      string `protobuf:"bytes,4,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	SummaryAccepted  bool   `protobuf:"varint,5,opt,name=summary_accepted,json=summaryAccepted,proto3" json:"summary_accepted,omitempty"`
+	CategoryAccepted bool   `protobuf:"varint,6,opt,name=category_accepted,json=categoryAccepted,proto3" json:"category_accepted,omitempty"`
+	// The tags the user rejected, never suggested again.
+	RejectedTags  []string `protobuf:"bytes,7,rep,name=rejected_tags,json=rejectedTags,proto3" json:"rejected_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoPayload_Enrichment) Reset() {
+	*x = MemoPayload_Enrichment{}
+	mi := &file_store_memo_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_Enrichment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_Enrichment) ProtoMessage() {}
+
+func (x *MemoPayload_Enrichment) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_Enrichment.ProtoReflect.Descriptor instead.
+func (*MemoPayload_Enrichment) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *MemoPayload_Enrichment) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *MemoPayload_Enrichment) GetSuggestedTags() []string {
+	if x != nil {
+		return x.SuggestedTags
+	}
+	return nil
+}
+
+func (x *MemoPayload_Enrichment) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *MemoPayload_Enrichment) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *MemoPayload_Enrichment) GetSummaryAccepted() bool {
+	if x != nil {
+		return x.SummaryAccepted
+	}
+	return false
+}
+
+func (x *MemoPayload_Enrichment) GetCategoryAccepted() bool {
+	if x != nil {
+		return x.CategoryAccepted
+	}
+	return false
+}
+
+func (x *MemoPayload_Enrichment) GetRejectedTags() []string {
+	if x != nil {
+		return x.RejectedTags
+	}
+	return nil
+}
+
+type MemoPayload_Task struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Text    string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Checked bool                   `protobuf:"varint,2,opt,name=checked,proto3" json:"checked,omitempty"`
+	// The 1-based line number of the checkbox.
+	Line int32 `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`
+	// The byte offset of the checkbox in the content.
+	Offset int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The due date in YYYY-MM-DD format.
+	DueDate       string `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoPayload_Task) Reset() {
+	*x = MemoPayload_Task{}
+	mi := &file_store_memo_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoPayload_Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoPayload_Task) ProtoMessage() {}
+
+func (x *MemoPayload_Task) ProtoReflect() protoreflect.Message {
+	mi := &file_store_memo_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoPayload_Task.ProtoReflect.Descriptor instead.
+func (*MemoPayload_Task) Descriptor() ([]byte, []int) {
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *MemoPayload_Task) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *MemoPayload_Task) GetChecked() bool {
+	if x != nil {
+		return x.Checked
+	}
+	return false
+}
+
+func (x *MemoPayload_Task) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *MemoPayload_Task) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *MemoPayload_Task) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
 type MemoPayload_Location struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Placeholder   string                 `protobuf:"bytes,1,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
@@ -161,7 +398,7 @@ type MemoPayload_Location struct {
 
 func (x *MemoPayload_Location) Reset() {
 	*x = MemoPayload_Location{}
-	mi := &file_store_memo_proto_msgTypes[2]
+	mi := &file_store_memo_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -173,7 +410,7 @@ func (x *MemoPayload_Location) String() string {
 func (*MemoPayload_Location) ProtoMessage() {}
 
 func (x *MemoPayload_Location) ProtoReflect() protoreflect.Message {
-	mi := &file_store_memo_proto_msgTypes[2]
+	mi := &file_store_memo_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -186,7 +423,7 @@ func (x *MemoPayload_Location) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemoPayload_Location.ProtoReflect.Descriptor instead.
 func (*MemoPayload_Location) Descriptor() ([]byte, []int) {
-	return file_store_memo_proto_rawDescGZIP(), []int{0, 1}
+	return file_store_memo_proto_rawDescGZIP(), []int{0, 3}
 }
 
 func (x *MemoPayload_Location) GetPlaceholder() string {
@@ -214,16 +451,41 @@ var File_store_memo_proto protoreflect.FileDescriptor
 
 const file_store_memo_proto_rawDesc = "" +
 	"\n" +
-	"\x10store/memo.proto\x12\vmemos.store\"\xa0\x03\n" +
+	"\x10store/memo.proto\x12\vmemos.store\"\xcd\b\n" +
 	"\vMemoPayload\x12=\n" +
 	"\bproperty\x18\x01 \x01(\v2!.memos.store.MemoPayload.PropertyR\bproperty\x12=\n" +
 	"\blocation\x18\x02 \x01(\v2!.memos.store.MemoPayload.LocationR\blocation\x12\x12\n" +
-	"\x04tags\x18\x03 \x03(\tR\x04tags\x1a\x96\x01\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12C\n" +
+	"\n" +
+	"enrichment\x18\x04 \x01(\v2#.memos.store.MemoPayload.EnrichmentR\n" +
+	"enrichment\x12\x1a\n" +
+	"\bmentions\x18\x05 \x03(\tR\bmentions\x12\x1b\n" +
+	"\tremind_ts\x18\x06 \x01(\x03R\bremindTs\x12\x1d\n" +
+	"\n" +
+	"publish_ts\x18\a \x01(\x03R\tpublishTs\x12-\n" +
+	"\x12publish_visibility\x18\b \x01(\tR\x11publishVisibility\x123\n" +
+	"\x05tasks\x18\t \x03(\v2\x1d.memos.store.MemoPayload.TaskR\x05tasks\x1a\xb9\x01\n" +
 	"\bProperty\x12\x19\n" +
 	"\bhas_link\x18\x01 \x01(\bR\ahasLink\x12\"\n" +
 	"\rhas_task_list\x18\x02 \x01(\bR\vhasTaskList\x12\x19\n" +
 	"\bhas_code\x18\x03 \x01(\bR\ahasCode\x120\n" +
-	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasks\x1af\n" +
+	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasks\x12!\n" +
+	"\fcontent_hash\x18\x05 \x01(\tR\vcontentHash\x1a\x89\x02\n" +
+	"\n" +
+	"Enrichment\x12\x18\n" +
+	"\asummary\x18\x01 \x01(\tR\asummary\x12%\n" +
+	"\x0esuggested_tags\x18\x02 \x03(\tR\rsuggestedTags\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12!\n" +
+	"\fcontent_hash\x18\x04 \x01(\tR\vcontentHash\x12)\n" +
+	"\x10summary_accepted\x18\x05 \x01(\bR\x0fsummaryAccepted\x12+\n" +
+	"\x11category_accepted\x18\x06 \x01(\bR\x10categoryAccepted\x12#\n" +
+	"\rrejected_tags\x18\a \x03(\tR\frejectedTags\x1a{\n" +
+	"\x04Task\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x18\n" +
+	"\achecked\x18\x02 \x01(\bR\achecked\x12\x12\n" +
+	"\x04line\x18\x03 \x01(\x05R\x04line\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12\x19\n" +
+	"\bdue_date\x18\x05 \x01(\tR\adueDate\x1af\n" +
 	"\bLocation\x12 \n" +
 	"\vplaceholder\x18\x01 \x01(\tR\vplaceholder\x12\x1a\n" +
 	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
@@ -242,20 +504,24 @@ func file_store_memo_proto_rawDescGZIP() []byte {
 	return file_store_memo_proto_rawDescData
 }
 
-var file_store_memo_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_store_memo_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_store_memo_proto_goTypes = []any{
-	(*MemoPayload)(nil),          // 0: memos.store.MemoPayload
-	(*MemoPayload_Property)(nil), // 1: memos.store.MemoPayload.Property
-	(*MemoPayload_Location)(nil), // 2: memos.store.MemoPayload.Location
+	(*MemoPayload)(nil),            // 0: memos.store.MemoPayload
+	(*MemoPayload_Property)(nil),   // 1: memos.store.MemoPayload.Property
+	(*MemoPayload_Enrichment)(nil), // 2: memos.store.MemoPayload.Enrichment
+	(*MemoPayload_Task)(nil),       // 3: memos.store.MemoPayload.Task
+	(*MemoPayload_Location)(nil),   // 4: memos.store.MemoPayload.Location
 }
 var file_store_memo_proto_depIdxs = []int32{
 	1, // 0: memos.store.MemoPayload.property:type_name -> memos.store.MemoPayload.Property
-	2, // 1: memos.store.MemoPayload.location:type_name -> memos.store.MemoPayload.Location
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	4, // 1: memos.store.MemoPayload.location:type_name -> memos.store.MemoPayload.Location
+	2, // 2: memos.store.MemoPayload.enrichment:type_name -> memos.store.MemoPayload.Enrichment
+	3, // 3: memos.store.MemoPayload.tasks:type_name -> memos.store.MemoPayload.Task
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_store_memo_proto_init() }
@@ -269,7 +535,7 @@ func file_store_memo_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_memo_proto_rawDesc), len(file_store_memo_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
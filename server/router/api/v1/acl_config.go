@@ -32,6 +32,7 @@ var PublicMethods = map[string]struct{}{
 	"/memos.api.v1.MemoService/GetMemo":             {},
 	"/memos.api.v1.MemoService/ListMemos":           {},
 	"/memos.api.v1.MemoService/SearchMemosSemantic": {},
+	"/memos.api.v1.MemoService/SearchMemos":         {},
 	"/memos.api.v1.MemoService/ListMemoComments":    {},
 }
 
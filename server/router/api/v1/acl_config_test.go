@@ -27,6 +27,7 @@ func TestPublicMethodsArePublic(t *testing.T) {
 		// Memo Service
 		"/memos.api.v1.MemoService/GetMemo",
 		"/memos.api.v1.MemoService/ListMemos",
+		"/memos.api.v1.MemoService/SearchMemos",
 	}
 
 	for _, method := range publicMethods {
@@ -44,14 +45,21 @@ func TestProtectedMethodsRequireAuth(t *testing.T) {
 		"/memos.api.v1.AuthService/GetCurrentUser",
 		// Instance Service - admin operations
 		"/memos.api.v1.InstanceService/UpdateInstanceSetting",
+		"/memos.api.v1.InstanceService/CreateBackup",
 		// User Service - modification operations
 		"/memos.api.v1.UserService/ListUsers",
 		"/memos.api.v1.UserService/UpdateUser",
 		"/memos.api.v1.UserService/DeleteUser",
+		"/memos.api.v1.UserService/ExportUserData",
+		"/memos.api.v1.UserService/ImportUserData",
+		"/memos.api.v1.UserService/ImportNotes",
 		// Memo Service - write operations
 		"/memos.api.v1.MemoService/CreateMemo",
 		"/memos.api.v1.MemoService/UpdateMemo",
 		"/memos.api.v1.MemoService/DeleteMemo",
+		"/memos.api.v1.MemoService/ListMemoRevisions",
+		"/memos.api.v1.MemoService/GetMemoRevision",
+		"/memos.api.v1.MemoService/RestoreMemoRevision",
 		// Attachment Service - write operations
 		"/memos.api.v1.AttachmentService/CreateAttachment",
 		"/memos.api.v1.AttachmentService/DeleteAttachment",
@@ -62,6 +70,8 @@ func TestProtectedMethodsRequireAuth(t *testing.T) {
 		"/memos.api.v1.ShortcutService/DeleteShortcut",
 		// Activity Service
 		"/memos.api.v1.ActivityService/GetActivity",
+		// Event Service
+		"/memos.api.v1.EventService/StreamEvents",
 	}
 
 	for _, method := range protectedMethods {
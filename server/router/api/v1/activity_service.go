@@ -89,6 +89,10 @@ func (s *APIV1Service) convertActivityFromStore(ctx context.Context, activity *s
 	switch activity.Type {
 	case store.ActivityTypeMemoComment:
 		activityType = v1pb.Activity_MEMO_COMMENT
+	case store.ActivityTypeMemoMention:
+		activityType = v1pb.Activity_MEMO_MENTION
+	case store.ActivityTypeMemoReminder:
+		activityType = v1pb.Activity_MEMO_REMINDER
 	default:
 		activityType = v1pb.Activity_TYPE_UNSPECIFIED
 	}
@@ -151,5 +155,43 @@ func (s *APIV1Service) convertActivityPayloadFromStore(ctx context.Context, payl
 			},
 		}
 	}
+	if payload.MemoMention != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.MemoMention.MemoId,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		// If the mentioning memo was deleted, skip this activity gracefully
+		if memo == nil {
+			return nil, nil
+		}
+
+		v2Payload.Payload = &v1pb.ActivityPayload_MemoMention{
+			MemoMention: &v1pb.ActivityMemoMentionPayload{
+				Memo: fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+			},
+		}
+	}
+	if payload.MemoReminder != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.MemoReminder.MemoId,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		// If the memo was deleted, skip this activity gracefully
+		if memo == nil {
+			return nil, nil
+		}
+
+		v2Payload.Payload = &v1pb.ActivityPayload_MemoReminder{
+			MemoReminder: &v1pb.ActivityMemoReminderPayload{
+				Memo: fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+			},
+		}
+	}
 	return v2Payload, nil
 }
@@ -147,12 +147,13 @@ func (s *APIV1Service) CreateAttachment(ctx context.Context, request *v1pb.Creat
 		return nil, status.Errorf(codes.Internal, "failed to save attachment blob: %v", err)
 	}
 
+	var memo *store.Memo
 	if request.Attachment.Memo != nil {
 		memoUID, err := ExtractMemoUIDFromName(*request.Attachment.Memo)
 		if err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
 		}
-		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+		memo, err = s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to find memo: %v", err)
 		}
@@ -166,6 +167,14 @@ func (s *APIV1Service) CreateAttachment(ctx context.Context, request *v1pb.Creat
 		return nil, status.Errorf(codes.Internal, "failed to create attachment: %v", err)
 	}
 
+	event := AttachmentUploaded{Attachment: attachment, Memo: memo}
+	if memo != nil {
+		if event.Message, err = s.convertMemoFromStore(ctx, memo, nil, nil); err != nil {
+			slog.Warn("Failed to convert memo of uploaded attachment", slog.Any("err", err))
+		}
+	}
+	publishEvent(ctx, s, event)
+
 	return convertAttachmentFromStore(attachment), nil
 }
 
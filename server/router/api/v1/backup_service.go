@@ -0,0 +1,303 @@
+package v1
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/scheduler"
+	"github.com/usememos/memos/plugin/storage/s3"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// BackupDirName is the folder of the data directory the local backups are written to.
+	BackupDirName = "backups"
+	// backupKeyPrefix is the prefix of the keys of the backups uploaded to S3.
+	backupKeyPrefix  = "backups/"
+	backupNamePrefix = "memos-backup-"
+	backupNameSuffix = ".zip"
+	// backupTimeLayout formats the snapshot time in the backup names, so that they sort by time.
+	backupTimeLayout = "20060102T150405Z"
+)
+
+// newBackupName returns the name of a backup taken at the time. The name ends with a random part, so that backups
+// taken within the same second do not overwrite each other.
+func newBackupName(now time.Time) string {
+	b := make([]byte, 4)
+	rand.Read(b)
+	return fmt.Sprintf("%s%s-%s%s", backupNamePrefix, now.UTC().Format(backupTimeLayout), hex.EncodeToString(b), backupNameSuffix)
+}
+
+// backupDestination stores the backup archives.
+type backupDestination interface {
+	// save stores the archive under the name and returns its location.
+	save(ctx context.Context, name string, archive *os.File) (string, error)
+	// list returns the names of the stored backups.
+	list(ctx context.Context) ([]string, error)
+	delete(ctx context.Context, name string) error
+}
+
+// localBackupDestination stores the backups in a folder of the data directory.
+type localBackupDestination struct {
+	dir string
+}
+
+func (d *localBackupDestination) save(_ context.Context, name string, archive *os.File) (string, error) {
+	location := filepath.Join(d.dir, name)
+	if _, err := os.Stat(location); err == nil {
+		return "", errors.Errorf("backup %s already exists", name)
+	}
+	if err := archive.Close(); err != nil {
+		return "", errors.Wrap(err, "failed to write archive")
+	}
+	if err := os.Rename(archive.Name(), location); err != nil {
+		return "", errors.Wrap(err, "failed to move archive")
+	}
+	return location, nil
+}
+
+func (d *localBackupDestination) list(_ context.Context) ([]string, error) {
+	entries, err := os.ReadDir(d.dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the backup directory")
+	}
+	names := []string{}
+	for _, entry := range entries {
+		if !entry.IsDir() && isBackupName(entry.Name()) {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
+
+func (d *localBackupDestination) delete(_ context.Context, name string) error {
+	return os.Remove(filepath.Join(d.dir, name))
+}
+
+// s3BackupDestination uploads the backups to the bucket of the S3 storage config.
+type s3BackupDestination struct {
+	client *s3.Client
+}
+
+func (d *s3BackupDestination) save(ctx context.Context, name string, archive *os.File) (string, error) {
+	if _, err := archive.Seek(0, 0); err != nil {
+		return "", errors.Wrap(err, "failed to read archive")
+	}
+	return d.client.UploadObject(ctx, backupKeyPrefix+name, "application/zip", archive)
+}
+
+func (d *s3BackupDestination) list(ctx context.Context) ([]string, error) {
+	objects, err := d.client.ListObjects(ctx, backupKeyPrefix+backupNamePrefix)
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, object := range objects {
+		if name := strings.TrimPrefix(object.Key, backupKeyPrefix); isBackupName(name) {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+func (d *s3BackupDestination) delete(ctx context.Context, name string) error {
+	return d.client.DeleteObject(ctx, backupKeyPrefix+name)
+}
+
+func isBackupName(name string) bool {
+	return strings.HasPrefix(name, backupNamePrefix) && strings.HasSuffix(name, backupNameSuffix) && !strings.Contains(name, "/")
+}
+
+func (s *APIV1Service) CreateBackup(ctx context.Context, request *v1pb.CreateBackupRequest) (*v1pb.Backup, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if user.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	return s.RunBackup(ctx, storepb.InstanceBackupSetting_Destination(request.Destination), time.Now())
+}
+
+// RunScheduledBackup creates a backup when the schedule of the backup setting is due at the minute of now.
+func (s *APIV1Service) RunScheduledBackup(ctx context.Context, now time.Time) error {
+	backupSetting, err := s.Store.GetInstanceBackupSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get backup setting")
+	}
+	if backupSetting.Schedule == "" {
+		return nil
+	}
+	schedule, err := scheduler.ParseCronExpression(backupSetting.Schedule)
+	if err != nil {
+		return errors.Wrap(err, "invalid backup schedule")
+	}
+	minute := now.Truncate(time.Minute)
+	if !schedule.Next(minute.Add(-time.Second)).Equal(minute) {
+		return nil
+	}
+	_, err = s.RunBackup(ctx, storepb.InstanceBackupSetting_DESTINATION_UNSPECIFIED, now)
+	return err
+}
+
+// RunBackup writes a backup archive of the instance to the destination, then deletes the oldest backups of the
+// destination beyond the retention count of the backup setting. The destination defaults to the one of the setting.
+func (s *APIV1Service) RunBackup(ctx context.Context, destination storepb.InstanceBackupSetting_Destination, now time.Time) (*v1pb.Backup, error) {
+	backupSetting, err := s.Store.GetInstanceBackupSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get backup setting: %v", err)
+	}
+	if destination == storepb.InstanceBackupSetting_DESTINATION_UNSPECIFIED {
+		destination = backupSetting.Destination
+	}
+	if destination == storepb.InstanceBackupSetting_DESTINATION_UNSPECIFIED {
+		destination = storepb.InstanceBackupSetting_LOCAL
+	}
+	target, err := s.getBackupDestination(ctx, destination)
+	if err != nil {
+		return nil, err
+	}
+
+	// The archive is written to a temporary file first, so that a failed backup leaves no partial archive behind.
+	dir := filepath.Join(s.Profile.Data, BackupDirName)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create the backup directory: %v", err)
+	}
+	archive, err := os.CreateTemp(dir, ".memos-backup-*.tmp")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create archive: %v", err)
+	}
+	defer os.Remove(archive.Name())
+	defer archive.Close()
+	manifest, err := s.Store.WriteBackup(ctx, archive)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write backup: %v", err)
+	}
+	info, err := archive.Stat()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write backup: %v", err)
+	}
+
+	name := newBackupName(now)
+	location, err := target.save(ctx, name, archive)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save backup: %v", err)
+	}
+	deleted, err := pruneBackups(ctx, target, int(backupSetting.RetentionCount))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete old backups: %v", err)
+	}
+	return &v1pb.Backup{
+		Name:           fmt.Sprintf("instance/backups/%s", name),
+		Destination:    v1pb.Backup_Destination(destination),
+		Location:       location,
+		Size:           info.Size(),
+		CreateTime:     timestamppb.New(time.Unix(manifest.CreatedTs, 0)),
+		FileCount:      int32(len(manifest.Files)),
+		DeletedBackups: deleted,
+	}, nil
+}
+
+func (s *APIV1Service) getBackupDestination(ctx context.Context, destination storepb.InstanceBackupSetting_Destination) (backupDestination, error) {
+	switch destination {
+	case storepb.InstanceBackupSetting_LOCAL:
+		return &localBackupDestination{dir: filepath.Join(s.Profile.Data, BackupDirName)}, nil
+	case storepb.InstanceBackupSetting_S3:
+		instanceStorageSetting, err := s.Store.GetInstanceStorageSetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get instance storage setting: %v", err)
+		}
+		if instanceStorageSetting.S3Config == nil || instanceStorageSetting.S3Config.Bucket == "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "S3 storage is not configured")
+		}
+		client, err := s3.NewClient(ctx, instanceStorageSetting.S3Config)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create s3 client: %v", err)
+		}
+		return &s3BackupDestination{client: client}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported backup destination %s", destination)
+	}
+}
+
+// pruneBackups deletes the oldest backups of the destination, keeping the given number. It keeps all of them when
+// keep is not positive.
+func pruneBackups(ctx context.Context, destination backupDestination, keep int) ([]string, error) {
+	if keep <= 0 {
+		return nil, nil
+	}
+	names, err := destination.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) <= keep {
+		return nil, nil
+	}
+	slices.Sort(names)
+	deleted := names[:len(names)-keep]
+	for _, name := range deleted {
+		if err := destination.delete(ctx, name); err != nil {
+			return nil, errors.Wrapf(err, "failed to delete %s", name)
+		}
+	}
+	return deleted, nil
+}
+
+func (s *APIV1Service) upsertInstanceBackupSetting(ctx context.Context, setting *v1pb.InstanceSetting_BackupSetting) (*storepb.InstanceSetting, error) {
+	backupSetting := convertInstanceBackupSettingToStore(setting)
+	if backupSetting == nil {
+		backupSetting = &storepb.InstanceBackupSetting{}
+	}
+	if backupSetting.Schedule != "" {
+		if _, err := scheduler.ParseCronExpression(backupSetting.Schedule); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid schedule: %v", err)
+		}
+	}
+	if backupSetting.RetentionCount < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "retention_count must not be negative")
+	}
+	return s.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_BACKUP,
+		Value: &storepb.InstanceSetting_BackupSetting{BackupSetting: backupSetting},
+	})
+}
+
+func convertInstanceBackupSettingFromStore(setting *storepb.InstanceBackupSetting) *v1pb.InstanceSetting_BackupSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.InstanceSetting_BackupSetting{
+		Schedule:       setting.Schedule,
+		Destination:    v1pb.Backup_Destination(setting.Destination),
+		RetentionCount: setting.RetentionCount,
+	}
+}
+
+func convertInstanceBackupSettingToStore(setting *v1pb.InstanceSetting_BackupSetting) *storepb.InstanceBackupSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.InstanceBackupSetting{
+		Schedule:       strings.TrimSpace(setting.Schedule),
+		Destination:    storepb.InstanceBackupSetting_Destination(setting.Destination),
+		RetentionCount: setting.RetentionCount,
+	}
+}
@@ -0,0 +1,185 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	openAICompletionModelEnv  = "MEMOS_OPENAI_COMPLETION_MODEL"
+	defaultCompletionModel    = "gpt-4o-mini"
+	openAICompletionUserAgent = "memos-enrichment/1.0"
+	openAICompletionBodyLimit = 1 << 20
+)
+
+// CompletionMessage is a message of a chat completion conversation.
+type CompletionMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// CompletionClient abstracts chat completion generation.
+type CompletionClient interface {
+	// Complete returns the content of the reply to the messages, which is requested as a JSON object.
+	Complete(ctx context.Context, messages []CompletionMessage) (string, error)
+	Model() string
+}
+
+type openAICompletionClient struct {
+	baseURL    string
+	apiKey     string
+	model      string
+	httpClient *http.Client
+	maxRetry   int
+	backoff    time.Duration
+}
+
+func newOpenAICompletionClient(config *openAIEmbeddingConfig, model string) (*openAICompletionClient, error) {
+	if config == nil {
+		return nil, errors.New("openai config is required")
+	}
+
+	apiKey := strings.TrimSpace(config.apiKey)
+	if apiKey == "" {
+		return nil, errors.New("openai api key is not configured")
+	}
+	model = strings.TrimSpace(model)
+	if model == "" {
+		model = defaultCompletionModel
+	}
+	// The retry policy is shared with embedding requests to the same provider.
+	maxRetry, backoff := resolveOpenAIEmbeddingRetryConfig(config.maxRetry, config.backoffMs)
+
+	return &openAICompletionClient{
+		baseURL: normalizeOpenAIBaseURL(config.baseURL),
+		apiKey:  apiKey,
+		model:   model,
+		httpClient: &http.Client{
+			Timeout: 60 * time.Second,
+		},
+		maxRetry: maxRetry,
+		backoff:  backoff,
+	}, nil
+}
+
+// getCompletionClient returns the completion client configured by the instance AI setting.
+// The OpenAI base URL and API key are shared with the embedding provider.
+func (s *APIV1Service) getCompletionClient(ctx context.Context) (CompletionClient, error) {
+	aiSetting, err := s.Store.GetInstanceAISetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance ai setting")
+	}
+	config, err := s.getOpenAIEmbeddingConfig(aiSetting)
+	if err != nil {
+		return nil, err
+	}
+	model := strings.TrimSpace(aiSetting.GetOpenaiCompletionModel())
+	if model == "" {
+		model = strings.TrimSpace(os.Getenv(openAICompletionModelEnv))
+	}
+	return newOpenAICompletionClient(config, model)
+}
+
+func (c *openAICompletionClient) Complete(ctx context.Context, messages []CompletionMessage) (string, error) {
+	if len(messages) == 0 {
+		return "", errors.New("completion messages cannot be empty")
+	}
+
+	requestBody := struct {
+		Model          string              `json:"model"`
+		Messages       []CompletionMessage `json:"messages"`
+		Temperature    float64             `json:"temperature"`
+		ResponseFormat struct {
+			Type string `json:"type"`
+		} `json:"response_format"`
+	}{
+		Model:       c.model,
+		Messages:    messages,
+		Temperature: 0.2,
+	}
+	requestBody.ResponseFormat.Type = "json_object"
+	body, err := json.Marshal(requestBody)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal openai completion request")
+	}
+
+	for attempt := 0; ; attempt++ {
+		content, retryable, err := c.completeOnce(ctx, body)
+		if err == nil {
+			return content, nil
+		}
+		if !retryable || attempt >= c.maxRetry {
+			return "", err
+		}
+
+		timer := time.NewTimer(c.backoff * time.Duration(1<<attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return "", errors.Wrap(ctx.Err(), "openai completion request canceled")
+		case <-timer.C:
+		}
+	}
+}
+
+func (c *openAICompletionClient) Model() string {
+	return c.model
+}
+
+func (c *openAICompletionClient) completeOnce(ctx context.Context, body []byte) (string, bool, error) {
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return "", false, errors.Wrap(err, "failed to create openai completion request")
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("Authorization", "Bearer "+c.apiKey)
+	httpRequest.Header.Set("User-Agent", openAICompletionUserAgent)
+
+	httpResponse, err := c.httpClient.Do(httpRequest)
+	if err != nil {
+		if ctx.Err() != nil {
+			return "", false, errors.Wrap(ctx.Err(), "openai completion request canceled")
+		}
+		return "", true, errors.Wrap(err, "failed to call openai completion api")
+	}
+	defer httpResponse.Body.Close()
+
+	responseBody, err := io.ReadAll(io.LimitReader(httpResponse.Body, openAICompletionBodyLimit))
+	if err != nil {
+		return "", true, errors.Wrap(err, "failed to read openai completion response")
+	}
+
+	response := struct {
+		Choices []struct {
+			Message struct {
+				Content string `json:"content"`
+			} `json:"message"`
+		} `json:"choices"`
+		Error *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}{}
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return "", isRetryableOpenAIStatus(httpResponse.StatusCode), errors.Wrap(err, "failed to decode openai completion response")
+	}
+
+	if httpResponse.StatusCode >= http.StatusBadRequest {
+		if response.Error != nil && response.Error.Message != "" {
+			return "", isRetryableOpenAIStatus(httpResponse.StatusCode), errors.Errorf("openai completion request failed: %s", response.Error.Message)
+		}
+		return "", isRetryableOpenAIStatus(httpResponse.StatusCode), errors.Errorf("openai completion request failed with status %d", httpResponse.StatusCode)
+	}
+	if len(response.Choices) == 0 || strings.TrimSpace(response.Choices[0].Message.Content) == "" {
+		return "", false, errors.New("openai completion response is empty")
+	}
+
+	return response.Choices[0].Message.Content, false, nil
+}
@@ -0,0 +1,84 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAICompletionClientComplete(t *testing.T) {
+	t.Parallel()
+
+	var attemptCount int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempt := atomic.AddInt32(&attemptCount, 1)
+		require.Equal(t, "/v1/chat/completions", r.URL.Path)
+		require.Equal(t, "Bearer sk-test", r.Header.Get("Authorization"))
+
+		var body struct {
+			Model          string              `json:"model"`
+			Messages       []CompletionMessage `json:"messages"`
+			ResponseFormat struct {
+				Type string `json:"type"`
+			} `json:"response_format"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, defaultCompletionModel, body.Model)
+		require.Equal(t, "json_object", body.ResponseFormat.Type)
+		require.Equal(t, []CompletionMessage{{Role: "user", Content: "hello"}}, body.Messages)
+
+		w.Header().Set("Content-Type", "application/json")
+		if attempt == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"error":{"message":"rate limited"}}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"choices": []map[string]any{
+				{"message": map[string]any{"role": "assistant", "content": `{"summary":"hi"}`}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := newOpenAICompletionClient(&openAIEmbeddingConfig{
+		baseURL:   server.URL + "/v1/",
+		apiKey:    "sk-test",
+		backoffMs: 1,
+	}, "")
+	require.NoError(t, err)
+	require.Equal(t, defaultCompletionModel, client.Model())
+
+	reply, err := client.Complete(context.Background(), []CompletionMessage{{Role: "user", Content: "hello"}})
+	require.NoError(t, err)
+	require.Equal(t, `{"summary":"hi"}`, reply)
+	require.Equal(t, int32(2), atomic.LoadInt32(&attemptCount))
+
+	_, err = newOpenAICompletionClient(&openAIEmbeddingConfig{apiKey: " "}, "")
+	require.ErrorContains(t, err, "openai api key is not configured")
+}
+
+func TestParseMemoEnrichment(t *testing.T) {
+	t.Parallel()
+
+	enrichment, err := parseMemoEnrichment("```json\n" + `{
+		"summary": "  Plans for the Kyoto trip.  ",
+		"tags": ["#Travel", "japan trip", "travel", "", "a/b!"],
+		"category": "Travel "
+	}` + "\n```")
+	require.NoError(t, err)
+	require.Equal(t, "Plans for the Kyoto trip.", enrichment.Summary)
+	require.Equal(t, []string{"travel", "japan-trip", "a/b"}, enrichment.SuggestedTags)
+	require.Equal(t, "travel", enrichment.Category)
+
+	_, err = parseMemoEnrichment("not json")
+	require.ErrorContains(t, err, "failed to decode memo enrichment")
+
+	require.Equal(t, "ab", truncateUTF8("ab", 3))
+	require.Equal(t, "a", truncateUTF8("a日", 3))
+}
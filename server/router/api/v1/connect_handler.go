@@ -42,6 +42,8 @@ func (s *ConnectServiceHandler) RegisterConnectHandlers(mux *http.ServeMux, opts
 		wrap(apiv1connect.NewShortcutServiceHandler(s, opts...)),
 		wrap(apiv1connect.NewActivityServiceHandler(s, opts...)),
 		wrap(apiv1connect.NewIdentityProviderServiceHandler(s, opts...)),
+		wrap(apiv1connect.NewJobServiceHandler(s, opts...)),
+		wrap(apiv1connect.NewEventServiceHandler(s, opts...)),
 	}
 
 	for _, h := range handlers {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"reflect"
 	"runtime/debug"
 
@@ -31,23 +32,7 @@ func NewMetadataInterceptor() *MetadataInterceptor {
 func (*MetadataInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
 		// Convert HTTP headers to gRPC metadata
-		header := req.Header()
-		md := metadata.MD{}
-
-		// Copy important headers for client info extraction
-		if ua := header.Get("User-Agent"); ua != "" {
-			md.Set("user-agent", ua)
-		}
-		if xff := header.Get("X-Forwarded-For"); xff != "" {
-			md.Set("x-forwarded-for", xff)
-		}
-		if xri := header.Get("X-Real-Ip"); xri != "" {
-			md.Set("x-real-ip", xri)
-		}
-		// Forward Cookie header for authentication methods that need it (e.g., RefreshToken)
-		if cookie := header.Get("Cookie"); cookie != "" {
-			md.Set("cookie", cookie)
-		}
+		md := incomingMetadata(req.Header())
 
 		// Set metadata in context so services can use metadata.FromIncomingContext()
 		ctx = metadata.NewIncomingContext(ctx, md)
@@ -58,15 +43,40 @@ func (*MetadataInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc
 		// Prevent browser caching of API responses to avoid stale data issues
 		// See: https://github.com/usememos/memos/issues/5470
 		if !isNilAnyResponse(resp) && resp.Header() != nil {
-			resp.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
-			resp.Header().Set("Pragma", "no-cache")
-			resp.Header().Set("Expires", "0")
+			setNoCacheHeaders(resp.Header())
 		}
 
 		return resp, err
 	}
 }
 
+// incomingMetadata copies the headers services read to gRPC metadata.
+func incomingMetadata(header http.Header) metadata.MD {
+	md := metadata.MD{}
+
+	// Copy important headers for client info extraction
+	if ua := header.Get("User-Agent"); ua != "" {
+		md.Set("user-agent", ua)
+	}
+	if xff := header.Get("X-Forwarded-For"); xff != "" {
+		md.Set("x-forwarded-for", xff)
+	}
+	if xri := header.Get("X-Real-Ip"); xri != "" {
+		md.Set("x-real-ip", xri)
+	}
+	// Forward Cookie header for authentication methods that need it (e.g., RefreshToken)
+	if cookie := header.Get("Cookie"); cookie != "" {
+		md.Set("cookie", cookie)
+	}
+	return md
+}
+
+func setNoCacheHeaders(header http.Header) {
+	header.Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	header.Set("Pragma", "no-cache")
+	header.Set("Expires", "0")
+}
+
 func isNilAnyResponse(resp connect.AnyResponse) bool {
 	if resp == nil {
 		return true
@@ -80,7 +90,12 @@ func (*MetadataInterceptor) WrapStreamingClient(next connect.StreamingClientFunc
 }
 
 func (*MetadataInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
-	return next
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx = metadata.NewIncomingContext(ctx, incomingMetadata(conn.RequestHeader()))
+		// Headers of streams are sent with the first message, so they are set before the handler runs.
+		setNoCacheHeaders(conn.ResponseHeader())
+		return next(ctx, conn)
+	}
 }
 
 // LoggingInterceptor logs Connect RPC requests with appropriate log levels.
@@ -109,8 +124,12 @@ func (*LoggingInterceptor) WrapStreamingClient(next connect.StreamingClientFunc)
 	return next // No-op for server-side interceptor
 }
 
-func (*LoggingInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
-	return next // Streaming not used in this service
+func (in *LoggingInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		err := next(ctx, conn)
+		in.log(conn.Spec().Procedure, err)
+		return err
+	}
 }
 
 func (in *LoggingInterceptor) log(procedure string, err error) {
@@ -180,8 +199,16 @@ func (*RecoveryInterceptor) WrapStreamingClient(next connect.StreamingClientFunc
 	return next
 }
 
-func (*RecoveryInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
-	return next
+func (in *RecoveryInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				in.logPanic(conn.Spec().Procedure, r)
+				err = connect.NewError(connect.CodeInternal, pkgerrors.New("internal server error"))
+			}
+		}()
+		return next(ctx, conn)
+	}
 }
 
 func (in *RecoveryInterceptor) logPanic(procedure string, panicValue any) {
@@ -212,36 +239,49 @@ func NewAuthInterceptor(store *store.Store, secret string) *AuthInterceptor {
 
 func (in *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-		header := req.Header()
-		authHeader := header.Get("Authorization")
+		ctx, err := in.authenticate(ctx, req.Spec().Procedure, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
 
-		result := in.authenticator.Authenticate(ctx, authHeader)
+// authenticate sets the authenticated user in the context.
+func (in *AuthInterceptor) authenticate(ctx context.Context, procedure string, header http.Header) (context.Context, error) {
+	authHeader := header.Get("Authorization")
 
-		// Enforce authentication for non-public methods
-		if result == nil && !IsPublicMethod(req.Spec().Procedure) {
-			return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("authentication required"))
-		}
+	result := in.authenticator.Authenticate(ctx, authHeader)
 
-		// Set context based on auth result
-		if result != nil {
-			if result.Claims != nil {
-				// Access Token V2 - stateless, use claims
-				ctx = auth.SetUserClaimsInContext(ctx, result.Claims)
-				ctx = context.WithValue(ctx, auth.UserIDContextKey, result.Claims.UserID)
-			} else if result.User != nil {
-				// PAT - have full user
-				ctx = auth.SetUserInContext(ctx, result.User, result.AccessToken)
-			}
-		}
+	// Enforce authentication for non-public methods
+	if result == nil && !IsPublicMethod(procedure) {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("authentication required"))
+	}
 
-		return next(ctx, req)
+	// Set context based on auth result
+	if result != nil {
+		if result.Claims != nil {
+			// Access Token V2 - stateless, use claims
+			ctx = auth.SetUserClaimsInContext(ctx, result.Claims)
+			ctx = context.WithValue(ctx, auth.UserIDContextKey, result.Claims.UserID)
+		} else if result.User != nil {
+			// PAT - have full user
+			ctx = auth.SetUserInContext(ctx, result.User, result.AccessToken)
+		}
 	}
+	return ctx, nil
 }
 
 func (*AuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
 	return next
 }
 
-func (*AuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
-	return next
+func (in *AuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := in.authenticate(ctx, conn.Spec().Procedure, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
 }
@@ -39,6 +39,22 @@ func (s *ConnectServiceHandler) UpdateInstanceSetting(ctx context.Context, req *
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) SendTestEmail(ctx context.Context, req *connect.Request[v1pb.SendTestEmailRequest]) (*connect.Response[emptypb.Empty], error) {
+	resp, err := s.APIV1Service.SendTestEmail(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CreateBackup(ctx context.Context, req *connect.Request[v1pb.CreateBackupRequest]) (*connect.Response[v1pb.Backup], error) {
+	resp, err := s.APIV1Service.CreateBackup(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // AuthService
 //
 // Auth service methods need special handling for response headers (cookies).
@@ -207,6 +223,22 @@ func (s *ConnectServiceHandler) DeleteUserWebhook(ctx context.Context, req *conn
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) ListUserWebhookDeliveries(ctx context.Context, req *connect.Request[v1pb.ListUserWebhookDeliveriesRequest]) (*connect.Response[v1pb.ListUserWebhookDeliveriesResponse], error) {
+	resp, err := s.APIV1Service.ListUserWebhookDeliveries(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RedeliverUserWebhookDelivery(ctx context.Context, req *connect.Request[v1pb.RedeliverUserWebhookDeliveryRequest]) (*connect.Response[v1pb.UserWebhookDelivery], error) {
+	resp, err := s.APIV1Service.RedeliverUserWebhookDelivery(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (s *ConnectServiceHandler) ListUserNotifications(ctx context.Context, req *connect.Request[v1pb.ListUserNotificationsRequest]) (*connect.Response[v1pb.ListUserNotificationsResponse], error) {
 	resp, err := s.APIV1Service.ListUserNotifications(ctx, req.Msg)
 	if err != nil {
@@ -231,6 +263,30 @@ func (s *ConnectServiceHandler) DeleteUserNotification(ctx context.Context, req
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) ExportUserData(ctx context.Context, req *connect.Request[v1pb.ExportUserDataRequest]) (*connect.Response[v1pb.ExportUserDataResponse], error) {
+	resp, err := s.APIV1Service.ExportUserData(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ImportUserData(ctx context.Context, req *connect.Request[v1pb.ImportUserDataRequest]) (*connect.Response[v1pb.ImportUserDataResponse], error) {
+	resp, err := s.APIV1Service.ImportUserData(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ImportNotes(ctx context.Context, req *connect.Request[v1pb.ImportNotesRequest]) (*connect.Response[v1pb.ImportNotesResponse], error) {
+	resp, err := s.APIV1Service.ImportNotes(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // MemoService
 
 func (s *ConnectServiceHandler) CreateMemo(ctx context.Context, req *connect.Request[v1pb.CreateMemoRequest]) (*connect.Response[v1pb.Memo], error) {
@@ -257,6 +313,14 @@ func (s *ConnectServiceHandler) SearchMemosSemantic(ctx context.Context, req *co
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) SearchMemos(ctx context.Context, req *connect.Request[v1pb.SearchMemosRequest]) (*connect.Response[v1pb.SearchMemosResponse], error) {
+	resp, err := s.APIV1Service.SearchMemos(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (s *ConnectServiceHandler) GetMemo(ctx context.Context, req *connect.Request[v1pb.GetMemoRequest]) (*connect.Response[v1pb.Memo], error) {
 	resp, err := s.APIV1Service.GetMemo(ctx, req.Msg)
 	if err != nil {
@@ -313,6 +377,46 @@ func (s *ConnectServiceHandler) ListMemoRelations(ctx context.Context, req *conn
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) ListRelatedMemos(ctx context.Context, req *connect.Request[v1pb.ListRelatedMemosRequest]) (*connect.Response[v1pb.ListRelatedMemosResponse], error) {
+	resp, err := s.APIV1Service.ListRelatedMemos(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListDuplicateMemoClusters(ctx context.Context, req *connect.Request[v1pb.ListDuplicateMemoClustersRequest]) (*connect.Response[v1pb.ListDuplicateMemoClustersResponse], error) {
+	resp, err := s.APIV1Service.ListDuplicateMemoClusters(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) MergeMemos(ctx context.Context, req *connect.Request[v1pb.MergeMemosRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.MergeMemos(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) AcceptMemoEnrichment(ctx context.Context, req *connect.Request[v1pb.AcceptMemoEnrichmentRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.AcceptMemoEnrichment(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RejectMemoEnrichment(ctx context.Context, req *connect.Request[v1pb.RejectMemoEnrichmentRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.RejectMemoEnrichment(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (s *ConnectServiceHandler) CreateMemoComment(ctx context.Context, req *connect.Request[v1pb.CreateMemoCommentRequest]) (*connect.Response[v1pb.Memo], error) {
 	resp, err := s.APIV1Service.CreateMemoComment(ctx, req.Msg)
 	if err != nil {
@@ -353,6 +457,46 @@ func (s *ConnectServiceHandler) DeleteMemoReaction(ctx context.Context, req *con
 	return connect.NewResponse(resp), nil
 }
 
+func (s *ConnectServiceHandler) ListTasks(ctx context.Context, req *connect.Request[v1pb.ListTasksRequest]) (*connect.Response[v1pb.ListTasksResponse], error) {
+	resp, err := s.APIV1Service.ListTasks(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ToggleTask(ctx context.Context, req *connect.Request[v1pb.ToggleTaskRequest]) (*connect.Response[v1pb.Task], error) {
+	resp, err := s.APIV1Service.ToggleTask(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) ListMemoRevisions(ctx context.Context, req *connect.Request[v1pb.ListMemoRevisionsRequest]) (*connect.Response[v1pb.ListMemoRevisionsResponse], error) {
+	resp, err := s.APIV1Service.ListMemoRevisions(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) GetMemoRevision(ctx context.Context, req *connect.Request[v1pb.GetMemoRevisionRequest]) (*connect.Response[v1pb.MemoRevision], error) {
+	resp, err := s.APIV1Service.GetMemoRevision(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RestoreMemoRevision(ctx context.Context, req *connect.Request[v1pb.RestoreMemoRevisionRequest]) (*connect.Response[v1pb.Memo], error) {
+	resp, err := s.APIV1Service.RestoreMemoRevision(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // AttachmentService
 
 func (s *ConnectServiceHandler) CreateAttachment(ctx context.Context, req *connect.Request[v1pb.CreateAttachmentRequest]) (*connect.Response[v1pb.Attachment], error) {
@@ -496,3 +640,35 @@ func (s *ConnectServiceHandler) DeleteIdentityProvider(ctx context.Context, req
 	}
 	return connect.NewResponse(resp), nil
 }
+
+// JobService
+
+func (s *ConnectServiceHandler) ListJobs(ctx context.Context, req *connect.Request[v1pb.ListJobsRequest]) (*connect.Response[v1pb.ListJobsResponse], error) {
+	resp, err := s.APIV1Service.ListJobs(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) RetryJob(ctx context.Context, req *connect.Request[v1pb.RetryJobRequest]) (*connect.Response[v1pb.Job], error) {
+	resp, err := s.APIV1Service.RetryJob(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ConnectServiceHandler) CancelJob(ctx context.Context, req *connect.Request[v1pb.CancelJobRequest]) (*connect.Response[v1pb.Job], error) {
+	resp, err := s.APIV1Service.CancelJob(ctx, req.Msg)
+	if err != nil {
+		return nil, convertGRPCError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// EventService
+
+func (s *ConnectServiceHandler) StreamEvents(ctx context.Context, req *connect.Request[v1pb.StreamEventsRequest], stream *connect.ServerStream[v1pb.Event]) error {
+	return convertGRPCError(s.APIV1Service.StreamEvents(ctx, req.Msg, stream.Send))
+}
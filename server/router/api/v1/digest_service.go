@@ -0,0 +1,501 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	digestJobTimeout = 2 * time.Minute
+	// digestSectionLimit bounds the number of items of each digest section.
+	digestSectionLimit = 20
+	// digestSnippetMaxLength bounds the length of the memo snippets in digests.
+	digestSnippetMaxLength = 100
+)
+
+// EnqueueDigests enqueues a digest job for every user subscribed to digests of the frequency.
+// A digest covers the day or the week before now.
+func (s *APIV1Service) EnqueueDigests(ctx context.Context, frequency storepb.DigestUserSetting_Frequency, now time.Time) error {
+	var period time.Duration
+	switch frequency {
+	case storepb.DigestUserSetting_DAILY:
+		period = 24 * time.Hour
+	case storepb.DigestUserSetting_WEEKLY:
+		period = 7 * 24 * time.Hour
+	default:
+		return errors.Errorf("unsupported digest frequency %s", frequency)
+	}
+
+	userSettings, err := s.Store.ListUserSettings(ctx, &store.FindUserSetting{Key: storepb.UserSetting_DIGEST})
+	if err != nil {
+		return errors.Wrap(err, "failed to list digest settings")
+	}
+	endTs := now.Unix()
+	for _, userSetting := range userSettings {
+		digestSetting := userSetting.GetDigest()
+		if digestSetting.GetFrequency() != frequency || len(digestSetting.GetChannels()) == 0 {
+			continue
+		}
+		// The key prevents enqueuing the same digest twice while it is pending.
+		key := fmt.Sprintf("digest/%d/%d", userSetting.UserId, endTs)
+		if _, err := s.enqueueJob(ctx, store.JobTypeDigest, key, &storepb.JobPayload{
+			Payload: &storepb.JobPayload_Digest_{Digest: &storepb.JobPayload_Digest{
+				UserId:  userSetting.UserId,
+				StartTs: now.Add(-period).Unix(),
+				EndTs:   endTs,
+			}},
+		}); err != nil {
+			return errors.Wrap(err, "failed to enqueue digest")
+		}
+	}
+	return nil
+}
+
+// digestSection is a titled list of Markdown items of a digest.
+type digestSection struct {
+	title string
+	items []string
+}
+
+// runDigestJob builds the digest of a user and delivers it to the channels of the user digest setting.
+// Delivery failures are logged rather than retried, so that a retry does not deliver the digest twice.
+func (s *APIV1Service) runDigestJob(ctx context.Context, job *store.Job) error {
+	payload := job.Payload.GetDigest()
+	userID := payload.GetUserId()
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	if user == nil || user.RowStatus == store.Archived {
+		return nil
+	}
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_DIGEST,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user digest setting")
+	}
+	digestSetting := userSetting.GetDigest()
+	if digestSetting.GetFrequency() == storepb.DigestUserSetting_FREQUENCY_UNSPECIFIED || len(digestSetting.GetChannels()) == 0 {
+		return nil
+	}
+
+	sections, err := s.buildDigestSections(ctx, user, digestSetting, payload.GetStartTs(), payload.GetEndTs())
+	if err != nil {
+		return err
+	}
+	if len(sections) == 0 {
+		return nil
+	}
+
+	startTime, endTime := time.Unix(payload.GetStartTs(), 0), time.Unix(payload.GetEndTs(), 0)
+	title := "Your daily memos digest"
+	if digestSetting.GetFrequency() == storepb.DigestUserSetting_WEEKLY {
+		title = "Your weekly memos digest"
+	}
+	markdown := renderDigestMarkdown(title, startTime, endTime, sections)
+	html, err := s.MarkdownService.RenderHTML([]byte(markdown))
+	if err != nil {
+		return errors.Wrap(err, "failed to render digest")
+	}
+
+	for _, channel := range digestSetting.GetChannels() {
+		switch channel {
+		case storepb.DigestUserSetting_INBOX:
+			if _, err := s.createInbox(ctx, &store.Inbox{
+				SenderID:   user.ID,
+				ReceiverID: user.ID,
+				Status:     store.UNREAD,
+				Message: &storepb.InboxMessage{
+					Type: storepb.InboxMessage_DIGEST,
+					Digest: &storepb.InboxMessage_Digest{
+						Title:   title,
+						Html:    html,
+						StartTs: payload.GetStartTs(),
+						EndTs:   payload.GetEndTs(),
+					},
+				},
+			}); err != nil {
+				slog.Warn("failed to create digest inbox", "userID", user.ID, "error", err)
+			}
+		case storepb.DigestUserSetting_EMAIL:
+			if user.Email == "" {
+				continue
+			}
+			emailSetting, err := s.Store.GetInstanceEmailSetting(ctx)
+			if err != nil {
+				return errors.Wrap(err, "failed to get instance email setting")
+			}
+			if emailSetting.SmtpHost == "" {
+				continue
+			}
+			if err := s.enqueueEmail(ctx, user.Email, title, html, true); err != nil {
+				slog.Warn("failed to enqueue digest email", "userID", user.ID, "error", err)
+			}
+		case storepb.DigestUserSetting_WEBHOOK:
+			if err := s.dispatchWebhook(ctx, user.ID, &webhook.WebhookRequestPayload{
+				ActivityType: webhook.ActivityTypeDigestCreated,
+				Creator:      fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
+				Digest: &webhook.Digest{
+					Title:     title,
+					Markdown:  markdown,
+					HTML:      html,
+					StartTime: startTime,
+					EndTime:   endTime,
+				},
+			}); err != nil {
+				slog.Warn("failed to dispatch digest webhook", "userID", user.ID, "error", err)
+			}
+		default:
+		}
+	}
+	return nil
+}
+
+// buildDigestSections returns the non-empty sections of the digest of the user for the period [startTs, endTs).
+func (s *APIV1Service) buildDigestSections(ctx context.Context, user *store.User, digestSetting *storepb.DigestUserSetting, startTs, endTs int64) ([]*digestSection, error) {
+	normal := store.Normal
+	limit := digestSectionLimit
+	periodFilter := fmt.Sprintf("created_ts >= %d && created_ts < %d", startTs, endTs)
+	sections := []*digestSection{}
+
+	// Memos the user wrote in the period.
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &user.ID,
+		RowStatus:       &normal,
+		ExcludeComments: true,
+		Filters:         []string{periodFilter},
+		Limit:           &limit,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memos")
+	}
+	sections = append(sections, &digestSection{title: "Memos you wrote", items: s.renderDigestMemoItems(memos)})
+
+	// Comments received in the period, found from the comment notifications of the user.
+	commentItems, err := s.buildDigestCommentItems(ctx, user.ID, startTs, endTs)
+	if err != nil {
+		return nil, err
+	}
+	sections = append(sections, &digestSection{title: "Comments received", items: commentItems})
+
+	// Reactions of other users to the memos of the user in the period.
+	reactionItems, err := s.buildDigestReactionItems(ctx, user.ID, startTs, endTs)
+	if err != nil {
+		return nil, err
+	}
+	sections = append(sections, &digestSection{title: "Reactions received", items: reactionItems})
+
+	// Memos visible to the user created in the period matching the shortcut.
+	if shortcutID := digestSetting.GetShortcutId(); shortcutID != "" {
+		shortcut, err := s.getUserShortcut(ctx, user.ID, shortcutID)
+		if err != nil {
+			return nil, err
+		}
+		if shortcut != nil {
+			memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+				RowStatus:       &normal,
+				ExcludeComments: true,
+				Filters: []string{
+					periodFilter,
+					shortcut.Filter,
+					fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, user.ID),
+				},
+				Limit: &limit,
+			})
+			if err != nil {
+				slog.Warn("failed to list digest shortcut memos", "userID", user.ID, "shortcut", shortcutID, "error", err)
+			} else {
+				sections = append(sections, &digestSection{title: shortcut.Title, items: s.renderDigestMemoItems(memos)})
+			}
+		}
+	}
+
+	// Incomplete tasks of the user, regardless of the period.
+	memos, err = s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &user.ID,
+		RowStatus:       &normal,
+		ExcludeComments: true,
+		Filters:         []string{"has_incomplete_tasks"},
+		Limit:           &limit,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memos with incomplete tasks")
+	}
+	sections = append(sections, &digestSection{title: "Incomplete tasks", items: s.renderDigestMemoItems(memos)})
+
+	return slices.DeleteFunc(sections, func(section *digestSection) bool {
+		return len(section.items) == 0
+	}), nil
+}
+
+func (s *APIV1Service) buildDigestCommentItems(ctx context.Context, userID int32, startTs, endTs int64) ([]string, error) {
+	messageType := storepb.InboxMessage_MEMO_COMMENT
+	inboxes, err := s.Store.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID:  &userID,
+		MessageType: &messageType,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list inboxes")
+	}
+	items := []string{}
+	// Inboxes are listed newest first.
+	for _, inbox := range inboxes {
+		if inbox.CreatedTs >= endTs {
+			continue
+		}
+		if inbox.CreatedTs < startTs || len(items) >= digestSectionLimit {
+			break
+		}
+		if inbox.Message.ActivityId == nil {
+			continue
+		}
+		activity, err := s.Store.GetActivity(ctx, &store.FindActivity{ID: inbox.Message.ActivityId})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get activity")
+		}
+		if activity == nil || activity.Payload.GetMemoComment() == nil {
+			continue
+		}
+		commentID := activity.Payload.GetMemoComment().GetMemoId()
+		comment, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &commentID})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get comment")
+		}
+		relatedMemoID := activity.Payload.GetMemoComment().GetRelatedMemoId()
+		relatedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &relatedMemoID})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get memo")
+		}
+		if comment == nil || relatedMemo == nil {
+			continue
+		}
+		items = append(items, fmt.Sprintf("%s on %s: %s", s.getDigestUserName(ctx, inbox.SenderID), s.renderDigestMemoLink(relatedMemo), s.getDigestSnippet(comment.Content)))
+	}
+	return items, nil
+}
+
+func (s *APIV1Service) buildDigestReactionItems(ctx context.Context, userID int32, startTs, endTs int64) ([]string, error) {
+	normal := store.Normal
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &userID,
+		RowStatus:       &normal,
+		ExcludeContent:  true,
+		ExcludeComments: true,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memos")
+	}
+	if len(memos) == 0 {
+		return []string{}, nil
+	}
+	memoMap := make(map[string]*store.Memo, len(memos))
+	contentIDList := make([]string, 0, len(memos))
+	for _, memo := range memos {
+		contentID := MemoNamePrefix + memo.UID
+		memoMap[contentID] = memo
+		contentIDList = append(contentIDList, contentID)
+	}
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentIDList: contentIDList})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list reactions")
+	}
+	items := []string{}
+	for _, reaction := range reactions {
+		if reaction.CreatorID == userID || reaction.CreatedTs < startTs || reaction.CreatedTs >= endTs {
+			continue
+		}
+		if len(items) >= digestSectionLimit {
+			break
+		}
+		memoUID := memoMap[reaction.ContentID].UID
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get memo")
+		}
+		if memo == nil {
+			continue
+		}
+		items = append(items, fmt.Sprintf("%s from %s on %s", reaction.ReactionType, s.getDigestUserName(ctx, reaction.CreatorID), s.renderDigestMemoLink(memo)))
+	}
+	return items, nil
+}
+
+// getUserShortcut returns the shortcut of the user, or nil when it does not exist.
+func (s *APIV1Service) getUserShortcut(ctx context.Context, userID int32, shortcutID string) (*storepb.ShortcutsUserSetting_Shortcut, error) {
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_SHORTCUTS,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user shortcuts")
+	}
+	for _, shortcut := range userSetting.GetShortcuts().GetShortcuts() {
+		if shortcut.Id == shortcutID {
+			return shortcut, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *APIV1Service) renderDigestMemoItems(memos []*store.Memo) []string {
+	items := make([]string, 0, len(memos))
+	for _, memo := range memos {
+		items = append(items, s.renderDigestMemoLink(memo))
+	}
+	return items
+}
+
+// renderDigestMemoLink renders the snippet of the memo, linked to the memo when the instance URL is configured.
+func (s *APIV1Service) renderDigestMemoLink(memo *store.Memo) string {
+	snippet := s.getDigestSnippet(memo.Content)
+	if link := s.getMemoLink(memo.UID); link != "" {
+		return fmt.Sprintf("[%s](%s)", strings.NewReplacer("[", "\\[", "]", "\\]").Replace(snippet), link)
+	}
+	return snippet
+}
+
+func (s *APIV1Service) getDigestSnippet(content string) string {
+	snippet, err := s.MarkdownService.GenerateSnippet([]byte(content), digestSnippetMaxLength)
+	if err != nil || snippet == "" {
+		return "(empty)"
+	}
+	return snippet
+}
+
+func (s *APIV1Service) getDigestUserName(ctx context.Context, userID int32) string {
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil || user == nil {
+		return fmt.Sprintf("%s%d", UserNamePrefix, userID)
+	}
+	if user.Nickname != "" {
+		return user.Nickname
+	}
+	return user.Username
+}
+
+func renderDigestMarkdown(title string, startTime, endTime time.Time, sections []*digestSection) string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "# %s\n\n%s – %s\n", title, startTime.Format("Jan 2, 2006 15:04"), endTime.Format("Jan 2, 2006 15:04"))
+	for _, section := range sections {
+		fmt.Fprintf(&builder, "\n## %s (%d)\n\n", section.title, len(section.items))
+		for _, item := range section.items {
+			fmt.Fprintf(&builder, "- %s\n", item)
+		}
+	}
+	return builder.String()
+}
+
+// updateUserDigestSetting applies the fields of the update mask to the digest setting of the user.
+func (s *APIV1Service) updateUserDigestSetting(ctx context.Context, userID int32, setting *v1pb.UserSetting_DigestSetting, paths []string) error {
+	if setting == nil {
+		return status.Errorf(codes.InvalidArgument, "digest setting is required")
+	}
+	existingUserSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_DIGEST,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user digest setting: %v", err)
+	}
+	digestSetting := &storepb.DigestUserSetting{}
+	if existing := existingUserSetting.GetDigest(); existing != nil {
+		digestSetting = existing
+	}
+
+	incoming, err := convertDigestSettingToStore(setting, userID)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid digest setting: %v", err)
+	}
+	for _, path := range paths {
+		switch path {
+		case "frequency":
+			digestSetting.Frequency = incoming.Frequency
+		case "channels":
+			digestSetting.Channels = incoming.Channels
+		case "shortcut":
+			if incoming.ShortcutId != "" {
+				shortcut, err := s.getUserShortcut(ctx, userID, incoming.ShortcutId)
+				if err != nil {
+					return status.Errorf(codes.Internal, "failed to get shortcut: %v", err)
+				}
+				if shortcut == nil {
+					return status.Errorf(codes.NotFound, "shortcut not found")
+				}
+			}
+			digestSetting.ShortcutId = incoming.ShortcutId
+		default:
+			return status.Errorf(codes.InvalidArgument, "unsupported update mask path: %s", path)
+		}
+	}
+
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_DIGEST,
+		Value:  &storepb.UserSetting_Digest{Digest: digestSetting},
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+	return nil
+}
+
+func convertDigestSettingFromStore(setting *storepb.DigestUserSetting, userID int32) *v1pb.UserSetting_DigestSetting {
+	digestSetting := &v1pb.UserSetting_DigestSetting{
+		Frequency: v1pb.UserSetting_DigestSetting_Frequency(setting.GetFrequency()),
+		Channels:  make([]v1pb.UserSetting_DigestSetting_Channel, 0, len(setting.GetChannels())),
+	}
+	for _, channel := range setting.GetChannels() {
+		digestSetting.Channels = append(digestSetting.Channels, v1pb.UserSetting_DigestSetting_Channel(channel))
+	}
+	if setting.GetShortcutId() != "" {
+		digestSetting.Shortcut = constructShortcutName(userID, setting.GetShortcutId())
+	}
+	return digestSetting
+}
+
+func convertDigestSettingToStore(setting *v1pb.UserSetting_DigestSetting, userID int32) (*storepb.DigestUserSetting, error) {
+	if _, ok := v1pb.UserSetting_DigestSetting_Frequency_name[int32(setting.Frequency)]; !ok {
+		return nil, errors.Errorf("invalid frequency %d", setting.Frequency)
+	}
+	digestSetting := &storepb.DigestUserSetting{
+		Frequency: storepb.DigestUserSetting_Frequency(setting.Frequency),
+		Channels:  []storepb.DigestUserSetting_Channel{},
+	}
+	for _, channel := range setting.Channels {
+		if channel == v1pb.UserSetting_DigestSetting_CHANNEL_UNSPECIFIED {
+			return nil, errors.New("channel is required")
+		}
+		if _, ok := v1pb.UserSetting_DigestSetting_Channel_name[int32(channel)]; !ok {
+			return nil, errors.Errorf("invalid channel %d", channel)
+		}
+		storeChannel := storepb.DigestUserSetting_Channel(channel)
+		if !slices.Contains(digestSetting.Channels, storeChannel) {
+			digestSetting.Channels = append(digestSetting.Channels, storeChannel)
+		}
+	}
+	if setting.Shortcut != "" {
+		shortcutUserID, shortcutID, err := extractUserAndShortcutIDFromName(setting.Shortcut)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid shortcut name")
+		}
+		if shortcutUserID != userID {
+			return nil, errors.New("shortcut must belong to the user")
+		}
+		digestSetting.ShortcutId = shortcutID
+	}
+	return digestSetting, nil
+}
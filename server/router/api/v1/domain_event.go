@@ -0,0 +1,286 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usememos/memos/plugin/eventbus"
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// The events below are published on the event bus of the service once the change is stored.
+// Side effects such as webhooks, notifications and embeddings subscribe to them in registerEventHandlers,
+// so that every handler making the change triggers them alike.
+// The Message of an event is the memo as returned by the API.
+
+// MemoCreated is published when a memo or a comment is created.
+type MemoCreated struct {
+	Memo    *store.Memo
+	Message *v1pb.Memo
+}
+
+// MemoUpdated is published when a memo is updated.
+type MemoUpdated struct {
+	// Previous is the memo before the update.
+	Previous *store.Memo
+	Memo     *store.Memo
+	Message  *v1pb.Memo
+}
+
+// MemoDeleted is published when a memo is deleted.
+type MemoDeleted struct {
+	Memo    *store.Memo
+	Message *v1pb.Memo
+	// WebhookFilters reports by filter whether the memo matched the webhook filters of its creator before it was deleted.
+	WebhookFilters map[string]bool
+}
+
+// MemoCommentCreated is published when a comment is created, after the MemoCreated event of the comment.
+type MemoCommentCreated struct {
+	// Memo is the commented memo.
+	Memo           *store.Memo
+	Message        *v1pb.Memo
+	Comment        *store.Memo
+	CommentMessage *v1pb.Memo
+}
+
+// MemoRelationsUpdated is published when the relations of a memo are set.
+type MemoRelationsUpdated struct {
+	Memo    *store.Memo
+	Message *v1pb.Memo
+}
+
+// MemoPublished is published when a scheduled memo is published.
+type MemoPublished struct {
+	Memo    *store.Memo
+	Message *v1pb.Memo
+}
+
+// MemoReminded is published when the reminder of a memo is due.
+type MemoReminded struct {
+	Memo    *store.Memo
+	Message *v1pb.Memo
+}
+
+// ReactionAdded is published when a reaction is added to a memo.
+type ReactionAdded struct {
+	Memo     *store.Memo
+	Message  *v1pb.Memo
+	Reaction *store.Reaction
+}
+
+// ReactionRemoved is published when a reaction is removed from a memo.
+type ReactionRemoved struct {
+	Memo     *store.Memo
+	Message  *v1pb.Memo
+	Reaction *store.Reaction
+}
+
+// AttachmentUploaded is published when an attachment is uploaded, with the memo it was uploaded to, if any.
+type AttachmentUploaded struct {
+	Attachment *store.Attachment
+	Memo       *store.Memo
+	Message    *v1pb.Memo
+}
+
+// NotificationCreated is published when a notification is created in the inbox of a user.
+type NotificationCreated struct {
+	Inbox *store.Inbox
+}
+
+// EventBus returns the event bus of the service, with the side effects of the events subscribed.
+// The bus is created on first use, so that services built without NewAPIV1Service have one too.
+func (s *APIV1Service) EventBus() *eventbus.Bus {
+	s.eventBusOnce.Do(func() {
+		s.eventBus = eventbus.New()
+		s.registerEventHandlers(s.eventBus)
+	})
+	return s.eventBus
+}
+
+// publishEvent publishes the event on the event bus of the service.
+func publishEvent[T any](ctx context.Context, s *APIV1Service, event T) {
+	eventbus.Publish(ctx, s.EventBus(), event)
+}
+
+// registerEventHandlers subscribes the side effects of the events.
+// Handlers run synchronously unless they only schedule background work.
+func (s *APIV1Service) registerEventHandlers(bus *eventbus.Bus) {
+	eventbus.Subscribe(bus, func(ctx context.Context, event MemoCreated) {
+		s.embedSavedMemo(ctx, event.Memo.ID, event.Memo.Content)
+		s.notifyMemoMentionsOnEvent(ctx, event.Memo, nil)
+		s.dispatchMemoWebhookOnEvent(ctx, event.Message, webhook.ActivityTypeMemoCreated)
+	})
+	eventbus.SubscribeAsync(bus, func(_ context.Context, event MemoCreated) {
+		s.scheduleMemoEnrichment(event.Memo.ID)
+	})
+
+	eventbus.Subscribe(bus, func(ctx context.Context, event MemoUpdated) {
+		if event.Memo.Content != event.Previous.Content {
+			s.embedSavedMemo(ctx, event.Memo.ID, event.Memo.Content)
+		}
+		// Users mentioned in a private memo were not notified, so they are notified once the memo is shared.
+		var notifiedMentions []string
+		if event.Previous.Visibility != store.Private {
+			notifiedMentions = event.Previous.Payload.GetMentions()
+		}
+		s.notifyMemoMentionsOnEvent(ctx, event.Memo, notifiedMentions)
+		s.dispatchMemoWebhookOnEvent(ctx, event.Message, webhook.ActivityTypeMemoUpdated)
+		if event.Memo.RowStatus != event.Previous.RowStatus {
+			activityType := webhook.ActivityTypeMemoRestored
+			if event.Memo.RowStatus == store.Archived {
+				activityType = webhook.ActivityTypeMemoArchived
+			}
+			s.dispatchMemoWebhookOnEvent(ctx, event.Message, activityType)
+		}
+	})
+	eventbus.SubscribeAsync(bus, func(_ context.Context, event MemoUpdated) {
+		if event.Memo.Content != event.Previous.Content {
+			s.scheduleMemoEnrichment(event.Memo.ID)
+		}
+	})
+
+	eventbus.Subscribe(bus, func(ctx context.Context, event MemoDeleted) {
+		if err := s.dispatchWebhookMatching(ctx, event.Memo.CreatorID, &webhook.WebhookRequestPayload{
+			ActivityType: webhook.ActivityTypeMemoDeleted,
+			Creator:      fmt.Sprintf("%s%d", UserNamePrefix, event.Memo.CreatorID),
+			Memo:         event.Message,
+		}, func(filter string) (bool, error) {
+			return event.WebhookFilters[filter], nil
+		}); err != nil {
+			slog.Warn("Failed to dispatch memo webhook", slog.String("activityType", webhook.ActivityTypeMemoDeleted), slog.Any("err", err))
+		}
+	})
+
+	eventbus.Subscribe(bus, func(ctx context.Context, event MemoCommentCreated) {
+		if event.Comment.Visibility != store.Private && event.Comment.CreatorID != event.Memo.CreatorID {
+			if err := s.notifyMemoComment(ctx, event.Memo, event.Comment, event.CommentMessage); err != nil {
+				slog.Warn("Failed to notify memo comment", slog.Any("err", err))
+			}
+		}
+		// Private comments of other users are not sent to the webhooks of the memo creator.
+		if event.Comment.Visibility != store.Private || event.Comment.CreatorID == event.Memo.CreatorID {
+			if err := s.dispatchMemoWebhook(ctx, event.Message, &webhook.WebhookRequestPayload{
+				ActivityType: webhook.ActivityTypeCommentCreated,
+				Comment:      event.CommentMessage,
+			}); err != nil {
+				slog.Warn("Failed to dispatch comment created webhook", slog.Any("err", err))
+			}
+		}
+	})
+
+	eventbus.Subscribe(bus, func(ctx context.Context, event MemoRelationsUpdated) {
+		s.dispatchMemoWebhookOnEvent(ctx, event.Message, webhook.ActivityTypeRelationsUpdated)
+	})
+
+	eventbus.Subscribe(bus, func(ctx context.Context, event MemoPublished) {
+		s.notifyMemoMentionsOnEvent(ctx, event.Memo, nil)
+		s.dispatchMemoWebhookOnEvent(ctx, event.Message, webhook.ActivityTypeMemoPublished)
+	})
+
+	eventbus.Subscribe(bus, func(ctx context.Context, event MemoReminded) {
+		if err := s.notifyMemoReminder(ctx, event.Memo); err != nil {
+			slog.Warn("Failed to notify memo reminder", slog.Any("err", err))
+		}
+		s.dispatchMemoWebhookOnEvent(ctx, event.Message, webhook.ActivityTypeMemoReminded)
+	})
+
+	eventbus.Subscribe(bus, func(ctx context.Context, event ReactionAdded) {
+		if err := s.dispatchMemoWebhook(ctx, event.Message, &webhook.WebhookRequestPayload{
+			ActivityType: webhook.ActivityTypeReactionUpserted,
+			Reaction:     convertReactionFromStore(event.Reaction),
+		}); err != nil {
+			slog.Warn("Failed to dispatch reaction upserted webhook", slog.Any("err", err))
+		}
+	})
+	eventbus.Subscribe(bus, func(ctx context.Context, event ReactionRemoved) {
+		if err := s.dispatchMemoWebhook(ctx, event.Message, &webhook.WebhookRequestPayload{
+			ActivityType: webhook.ActivityTypeReactionDeleted,
+			Reaction:     convertReactionFromStore(event.Reaction),
+		}); err != nil {
+			slog.Warn("Failed to dispatch reaction deleted webhook", slog.Any("err", err))
+		}
+	})
+
+	eventbus.Subscribe(bus, func(ctx context.Context, event AttachmentUploaded) {
+		// The activity is sent to the webhooks of the uploader, with the memo the attachment was uploaded to.
+		if err := s.dispatchWebhook(ctx, event.Attachment.CreatorID, &webhook.WebhookRequestPayload{
+			ActivityType: webhook.ActivityTypeAttachmentCreated,
+			Creator:      fmt.Sprintf("%s%d", UserNamePrefix, event.Attachment.CreatorID),
+			Memo:         event.Message,
+			Attachment:   convertAttachmentFromStore(event.Attachment),
+		}); err != nil {
+			slog.Warn("Failed to dispatch attachment created webhook", slog.Any("err", err))
+		}
+	})
+}
+
+func (s *APIV1Service) notifyMemoMentionsOnEvent(ctx context.Context, memo *store.Memo, notifiedMentions []string) {
+	if err := s.notifyMemoMentions(ctx, memo, notifiedMentions); err != nil {
+		slog.Warn("Failed to notify memo mentions", slog.Any("err", err))
+	}
+}
+
+func (s *APIV1Service) dispatchMemoWebhookOnEvent(ctx context.Context, memo *v1pb.Memo, activityType string) {
+	if err := s.dispatchMemoWebhook(ctx, memo, &webhook.WebhookRequestPayload{
+		ActivityType: activityType,
+	}); err != nil {
+		slog.Warn("Failed to dispatch memo webhook", slog.String("activityType", activityType), slog.Any("err", err))
+	}
+}
+
+// snapshotMemo copies the memo before it is changed, as the Previous memo of its MemoUpdated event.
+func snapshotMemo(memo *store.Memo) *store.Memo {
+	snapshot := *memo
+	snapshot.Payload = proto.Clone(memo.Payload).(*storepb.MemoPayload)
+	return &snapshot
+}
+
+// createInbox creates the notification and publishes it.
+func (s *APIV1Service) createInbox(ctx context.Context, create *store.Inbox) (*store.Inbox, error) {
+	inbox, err := s.Store.CreateInbox(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	publishEvent(ctx, s, NotificationCreated{Inbox: inbox})
+	return inbox, nil
+}
+
+// notifyMemoComment records the comment as an activity in the inbox of the memo creator and emails them.
+func (s *APIV1Service) notifyMemoComment(ctx context.Context, memo *store.Memo, comment *store.Memo, commentMessage *v1pb.Memo) error {
+	activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: comment.CreatorID,
+		Type:      store.ActivityTypeMemoComment,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoComment: &storepb.ActivityMemoCommentPayload{
+				MemoId:        comment.ID,
+				RelatedMemoId: memo.ID,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := s.createInbox(ctx, &store.Inbox{
+		SenderID:   comment.CreatorID,
+		ReceiverID: memo.CreatorID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       storepb.InboxMessage_MEMO_COMMENT,
+			ActivityId: &activity.ID,
+		},
+	}); err != nil {
+		return err
+	}
+	if err := s.notifyMemoCommentByEmail(ctx, comment.CreatorID, memo, commentMessage); err != nil {
+		slog.Warn("Failed to send memo comment email", slog.Any("err", err))
+	}
+	return nil
+}
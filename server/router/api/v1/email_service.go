@@ -0,0 +1,275 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/usememos/memos/plugin/email"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	emailJobTimeout = time.Minute
+	// emailSnippetMaxLength bounds the length of the memo snippet in notification emails.
+	emailSnippetMaxLength = 280
+)
+
+// emailNotification is a kind of email notification users opt in to in their general setting.
+type emailNotification int
+
+const (
+	emailNotificationComment emailNotification = iota
+	emailNotificationMention
+	emailNotificationReminder
+)
+
+func (n emailNotification) enabled(setting *storepb.GeneralUserSetting) bool {
+	switch n {
+	case emailNotificationComment:
+		return setting.GetEmailOnComment()
+	case emailNotificationMention:
+		return setting.GetEmailOnMention()
+	case emailNotificationReminder:
+		return setting.GetEmailOnReminder()
+	default:
+		return false
+	}
+}
+
+func (s *APIV1Service) SendTestEmail(ctx context.Context, request *v1pb.SendTestEmailRequest) (*emptypb.Empty, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if user.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	recipient := strings.TrimSpace(request.Recipient)
+	if recipient == "" {
+		recipient = user.Email
+	}
+	if recipient == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "recipient is required")
+	}
+	config, err := s.getEmailConfig(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get email config: %v", err)
+	}
+	if config == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "email is not configured")
+	}
+
+	// The test email is sent synchronously so that configuration errors are reported to the admin.
+	if err := email.Send(config, &email.Message{
+		To:      []string{recipient},
+		Subject: "Memos test email",
+		Body:    "This is a test email from memos. Your email setting works.",
+	}); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to send test email: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getEmailConfig returns the SMTP configuration of the instance, or nil when emails are not configured.
+func (s *APIV1Service) getEmailConfig(ctx context.Context) (*email.Config, error) {
+	setting, err := s.Store.GetInstanceEmailSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if setting.SmtpHost == "" {
+		return nil, nil
+	}
+	password, err := decryptSensitiveValue(s.Secret, setting.SmtpPasswordEncrypted)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt smtp password")
+	}
+	return &email.Config{
+		SMTPHost:     setting.SmtpHost,
+		SMTPPort:     int(setting.SmtpPort),
+		SMTPUsername: setting.SmtpUsername,
+		SMTPPassword: password,
+		FromEmail:    setting.FromEmail,
+		FromName:     setting.FromName,
+		UseTLS:       setting.UseTls,
+		UseSSL:       setting.UseSsl,
+	}, nil
+}
+
+// notifyUserByEmail enqueues an email to the user when emails are configured and the user opted in to the notification.
+func (s *APIV1Service) notifyUserByEmail(ctx context.Context, userID int32, notification emailNotification, subject, body string) error {
+	emailSetting, err := s.Store.GetInstanceEmailSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get instance email setting")
+	}
+	if emailSetting.SmtpHost == "" {
+		return nil
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	if user == nil || user.Email == "" {
+		return nil
+	}
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_GENERAL,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user general setting")
+	}
+	if !notification.enabled(userSetting.GetGeneral()) {
+		return nil
+	}
+	return s.enqueueEmail(ctx, user.Email, subject, body, false)
+}
+
+// enqueueEmail enqueues an email job. The email is sent with the SMTP configuration at the time of sending.
+func (s *APIV1Service) enqueueEmail(ctx context.Context, to, subject, body string, html bool) error {
+	if _, err := s.enqueueJob(ctx, store.JobTypeEmail, "", &storepb.JobPayload{
+		Payload: &storepb.JobPayload_Email_{Email: &storepb.JobPayload_Email{
+			To:      to,
+			Subject: subject,
+			Body:    body,
+			Html:    html,
+		}},
+	}); err != nil {
+		return errors.Wrap(err, "failed to enqueue email")
+	}
+	return nil
+}
+
+// notifyMemoCommentByEmail emails the memo creator about a new comment on the memo.
+func (s *APIV1Service) notifyMemoCommentByEmail(ctx context.Context, commenterID int32, relatedMemo *store.Memo, comment *v1pb.Memo) error {
+	commenter, err := s.Store.GetUser(ctx, &store.FindUser{ID: &commenterID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get commenter")
+	}
+	if commenter == nil {
+		return nil
+	}
+	name := commenter.Nickname
+	if name == "" {
+		name = commenter.Username
+	}
+	snippet, err := s.MarkdownService.GenerateSnippet([]byte(comment.Content), emailSnippetMaxLength)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate snippet")
+	}
+
+	body := fmt.Sprintf("%s commented on your memo:\n\n%s", name, snippet)
+	if link := s.getMemoLink(relatedMemo.UID); link != "" {
+		body += "\n\n" + link
+	}
+	return s.notifyUserByEmail(ctx, relatedMemo.CreatorID, emailNotificationComment, fmt.Sprintf("%s commented on your memo", name), body)
+}
+
+// getMemoLink returns the URL of the memo, or an empty string when the instance URL is not configured.
+func (s *APIV1Service) getMemoLink(memoUID string) string {
+	if s.Profile.InstanceURL == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s%s", strings.TrimSuffix(s.Profile.InstanceURL, "/"), MemoNamePrefix, memoUID)
+}
+
+// runEmailJob sends an email. The SMTP configuration is read on every attempt.
+func (s *APIV1Service) runEmailJob(ctx context.Context, job *store.Job) error {
+	config, err := s.getEmailConfig(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get email config")
+	}
+	if config == nil {
+		slog.Warn("dropped email because email is not configured", "jobID", job.ID)
+		return nil
+	}
+	payload := job.Payload.GetEmail()
+	return email.Send(config, &email.Message{
+		To:      []string{payload.GetTo()},
+		Subject: payload.GetSubject(),
+		Body:    payload.GetBody(),
+		IsHTML:  payload.GetHtml(),
+	})
+}
+
+func (s *APIV1Service) upsertInstanceEmailSetting(ctx context.Context, setting *v1pb.InstanceSetting_EmailSetting) (*storepb.InstanceSetting, error) {
+	existingSetting, err := s.Store.GetInstanceEmailSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get existing instance email setting")
+	}
+
+	updatedSetting := convertInstanceEmailSettingToStore(setting)
+	if updatedSetting == nil {
+		updatedSetting = &storepb.InstanceEmailSetting{}
+	}
+	updatedSetting.SmtpPasswordEncrypted = existingSetting.SmtpPasswordEncrypted
+	if setting != nil {
+		if updatedSetting.SmtpHost != "" {
+			if updatedSetting.SmtpPort <= 0 || updatedSetting.SmtpPort > 65535 {
+				return nil, status.Errorf(codes.InvalidArgument, "smtp_port must be between 1 and 65535")
+			}
+			if updatedSetting.FromEmail == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "from_email is required")
+			}
+		}
+		if setting.ClearSmtpPassword {
+			updatedSetting.SmtpPasswordEncrypted = ""
+		}
+		if password := strings.TrimSpace(setting.SmtpPassword); password != "" {
+			encryptedPassword, err := encryptSensitiveValue(s.Secret, password)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to encrypt smtp password")
+			}
+			updatedSetting.SmtpPasswordEncrypted = encryptedPassword
+		}
+	}
+
+	return s.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_EMAIL,
+		Value: &storepb.InstanceSetting_EmailSetting{EmailSetting: updatedSetting},
+	})
+}
+
+func convertInstanceEmailSettingFromStore(setting *storepb.InstanceEmailSetting) *v1pb.InstanceSetting_EmailSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.InstanceSetting_EmailSetting{
+		SmtpHost:        setting.SmtpHost,
+		SmtpPort:        setting.SmtpPort,
+		SmtpUsername:    setting.SmtpUsername,
+		SmtpPasswordSet: setting.SmtpPasswordEncrypted != "",
+		FromEmail:       setting.FromEmail,
+		FromName:        setting.FromName,
+		UseTls:          setting.UseTls,
+		UseSsl:          setting.UseSsl,
+	}
+}
+
+func convertInstanceEmailSettingToStore(setting *v1pb.InstanceSetting_EmailSetting) *storepb.InstanceEmailSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.InstanceEmailSetting{
+		SmtpHost:     strings.TrimSpace(setting.SmtpHost),
+		SmtpPort:     setting.SmtpPort,
+		SmtpUsername: strings.TrimSpace(setting.SmtpUsername),
+		FromEmail:    strings.TrimSpace(setting.FromEmail),
+		FromName:     strings.TrimSpace(setting.FromName),
+		UseTls:       setting.UseTls,
+		UseSsl:       setting.UseSsl,
+	}
+}
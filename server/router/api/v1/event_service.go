@@ -0,0 +1,137 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/eventbus"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// eventStreamBufferSize is the number of events an event stream can fall behind before it is closed.
+const eventStreamBufferSize = 64
+
+// streamEvent is an event waiting to be sent, along with the inbox of a notification that is yet to be converted.
+type streamEvent struct {
+	event *v1pb.Event
+	inbox *store.Inbox
+}
+
+// StreamEvents sends the events visible to the current user until the context is done.
+// The stream is closed with ResourceExhausted when the client falls behind, after which it should reload and reconnect.
+func (s *APIV1Service) StreamEvents(ctx context.Context, request *v1pb.StreamEventsRequest, send func(*v1pb.Event) error) error {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	events := make(chan streamEvent, eventStreamBufferSize)
+	overflow := make(chan struct{})
+	var overflowOnce sync.Once
+	enqueue := func(pending streamEvent) {
+		if len(request.Types) > 0 && !slices.Contains(request.Types, pending.event.Type) {
+			return
+		}
+		pending.event.CreateTime = timestamppb.New(time.Now())
+		select {
+		case events <- pending:
+		default:
+			overflowOnce.Do(func() { close(overflow) })
+		}
+	}
+	push := func(event *v1pb.Event) {
+		enqueue(streamEvent{event: event})
+	}
+	pushMemoEvent := func(eventType v1pb.Event_Type, memo *v1pb.Memo) {
+		if canUserSeeMemoMessage(user, memo) {
+			push(&v1pb.Event{Type: eventType, Memo: memo})
+		}
+	}
+
+	bus := s.EventBus()
+	unsubscribes := []func(){
+		eventbus.Subscribe(bus, func(_ context.Context, event MemoCreated) {
+			pushMemoEvent(v1pb.Event_MEMO_CREATED, event.Message)
+		}),
+		eventbus.Subscribe(bus, func(_ context.Context, event MemoPublished) {
+			pushMemoEvent(v1pb.Event_MEMO_CREATED, event.Message)
+		}),
+		eventbus.Subscribe(bus, func(_ context.Context, event MemoUpdated) {
+			// A memo made private is deleted for the users who could see it, without its content.
+			if !canUserSeeMemoMessage(user, event.Message) && canUserSeeMemo(user, event.Previous) {
+				push(&v1pb.Event{Type: v1pb.Event_MEMO_DELETED, Memo: &v1pb.Memo{Name: event.Message.GetName()}})
+				return
+			}
+			pushMemoEvent(v1pb.Event_MEMO_UPDATED, event.Message)
+		}),
+		eventbus.Subscribe(bus, func(_ context.Context, event MemoRelationsUpdated) {
+			pushMemoEvent(v1pb.Event_MEMO_UPDATED, event.Message)
+		}),
+		eventbus.Subscribe(bus, func(_ context.Context, event MemoDeleted) {
+			pushMemoEvent(v1pb.Event_MEMO_DELETED, event.Message)
+		}),
+		eventbus.Subscribe(bus, func(_ context.Context, event MemoCommentCreated) {
+			if canUserSeeMemoMessage(user, event.Message) && canUserSeeMemoMessage(user, event.CommentMessage) {
+				push(&v1pb.Event{Type: v1pb.Event_MEMO_COMMENT_CREATED, Memo: event.Message, Comment: event.CommentMessage})
+			}
+		}),
+		eventbus.Subscribe(bus, func(_ context.Context, event ReactionAdded) {
+			if canUserSeeMemoMessage(user, event.Message) {
+				push(&v1pb.Event{Type: v1pb.Event_REACTION_UPSERTED, Memo: event.Message, Reaction: convertReactionFromStore(event.Reaction)})
+			}
+		}),
+		eventbus.Subscribe(bus, func(_ context.Context, event ReactionRemoved) {
+			if canUserSeeMemoMessage(user, event.Message) {
+				push(&v1pb.Event{Type: v1pb.Event_REACTION_DELETED, Memo: event.Message, Reaction: convertReactionFromStore(event.Reaction)})
+			}
+		}),
+		eventbus.Subscribe(bus, func(_ context.Context, event NotificationCreated) {
+			// The notification is converted by the stream, not to slow down the request that created it.
+			if event.Inbox.ReceiverID == user.ID {
+				enqueue(streamEvent{event: &v1pb.Event{Type: v1pb.Event_NOTIFICATION_CREATED}, inbox: event.Inbox})
+			}
+		}),
+	}
+	defer func() {
+		for _, unsubscribe := range unsubscribes {
+			unsubscribe()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-overflow:
+			return status.Errorf(codes.ResourceExhausted, "event stream fell behind")
+		case pending := <-events:
+			if pending.inbox != nil {
+				notification, err := s.convertInboxToUserNotification(ctx, pending.inbox)
+				if err != nil {
+					slog.Warn("failed to convert notification", "userID", user.ID, "error", err)
+					continue
+				}
+				pending.event.Notification = notification
+			}
+			if err := send(pending.event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func canUserSeeMemoMessage(user *store.User, memo *v1pb.Memo) bool {
+	return memo != nil && (memo.Visibility != v1pb.Visibility_PRIVATE || memo.Creator == fmt.Sprintf("%s%d", UserNamePrefix, user.ID))
+}
@@ -25,7 +25,10 @@ func (s *APIV1Service) CreateIdentityProvider(ctx context.Context, request *v1pb
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
-	identityProvider, err := s.Store.CreateIdentityProvider(ctx, convertIdentityProviderToStore(request.IdentityProvider))
+	create := convertIdentityProviderToStore(request.IdentityProvider)
+	// The ID of a new identity provider is assigned by the database, whatever the name of the request.
+	create.Id = 0
+	identityProvider, err := s.Store.CreateIdentityProvider(ctx, create)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create identity provider, error: %+v", err)
 	}
@@ -49,6 +49,10 @@ func (s *APIV1Service) GetInstanceSetting(ctx context.Context, request *v1pb.Get
 		_, err = s.Store.GetInstanceStorageSetting(ctx)
 	case storepb.InstanceSettingKey_AI:
 		_, err = s.Store.GetInstanceAISetting(ctx)
+	case storepb.InstanceSettingKey_EMAIL:
+		_, err = s.Store.GetInstanceEmailSetting(ctx)
+	case storepb.InstanceSettingKey_BACKUP:
+		_, err = s.Store.GetInstanceBackupSetting(ctx)
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "unsupported instance setting key: %v", instanceSettingKey)
 	}
@@ -67,7 +71,7 @@ func (s *APIV1Service) GetInstanceSetting(ctx context.Context, request *v1pb.Get
 	}
 
 	// For sensitive settings, only admin can get it.
-	if instanceSetting.Key == storepb.InstanceSettingKey_STORAGE || instanceSetting.Key == storepb.InstanceSettingKey_AI {
+	if instanceSetting.Key == storepb.InstanceSettingKey_STORAGE || instanceSetting.Key == storepb.InstanceSettingKey_AI || instanceSetting.Key == storepb.InstanceSettingKey_EMAIL || instanceSetting.Key == storepb.InstanceSettingKey_BACKUP {
 		user, err := s.fetchCurrentUser(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
@@ -80,7 +84,7 @@ func (s *APIV1Service) GetInstanceSetting(ctx context.Context, request *v1pb.Get
 		}
 	}
 
-	return convertInstanceSettingFromStore(instanceSetting), nil
+	return s.convertInstanceSettingWithProgress(ctx, instanceSetting)
 }
 
 func (s *APIV1Service) UpdateInstanceSetting(ctx context.Context, request *v1pb.UpdateInstanceSettingRequest) (*v1pb.InstanceSetting, error) {
@@ -115,6 +119,10 @@ func (s *APIV1Service) UpdateInstanceSetting(ctx context.Context, request *v1pb.
 	switch settingKey {
 	case storepb.InstanceSettingKey_AI:
 		instanceSetting, err = s.upsertInstanceAISetting(ctx, request.Setting.GetAiSetting())
+	case storepb.InstanceSettingKey_EMAIL:
+		instanceSetting, err = s.upsertInstanceEmailSetting(ctx, request.Setting.GetEmailSetting())
+	case storepb.InstanceSettingKey_BACKUP:
+		instanceSetting, err = s.upsertInstanceBackupSetting(ctx, request.Setting.GetBackupSetting())
 	default:
 		updateSetting := convertInstanceSettingToStore(request.Setting)
 		instanceSetting, err = s.Store.UpsertInstanceSetting(ctx, updateSetting)
@@ -126,7 +134,18 @@ func (s *APIV1Service) UpdateInstanceSetting(ctx context.Context, request *v1pb.
 		return nil, status.Errorf(codes.Internal, "failed to upsert instance setting: %v", err)
 	}
 
-	return convertInstanceSettingFromStore(instanceSetting), nil
+	return s.convertInstanceSettingWithProgress(ctx, instanceSetting)
+}
+
+// convertInstanceSettingWithProgress converts the instance setting and adds the semantic reindex progress to the AI setting.
+func (s *APIV1Service) convertInstanceSettingWithProgress(ctx context.Context, setting *storepb.InstanceSetting) (*v1pb.InstanceSetting, error) {
+	instanceSetting := convertInstanceSettingFromStore(setting)
+	if aiSetting := instanceSetting.GetAiSetting(); aiSetting != nil {
+		if err := s.setSemanticReindexProgress(ctx, aiSetting); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get semantic reindex progress: %v", err)
+		}
+	}
+	return instanceSetting, nil
 }
 
 func convertInstanceSettingFromStore(setting *storepb.InstanceSetting) *v1pb.InstanceSetting {
@@ -150,6 +169,14 @@ func convertInstanceSettingFromStore(setting *storepb.InstanceSetting) *v1pb.Ins
 		instanceSetting.Value = &v1pb.InstanceSetting_AiSetting{
 			AiSetting: convertInstanceAISettingFromStore(setting.GetAiSetting()),
 		}
+	case *storepb.InstanceSetting_EmailSetting:
+		instanceSetting.Value = &v1pb.InstanceSetting_EmailSetting_{
+			EmailSetting: convertInstanceEmailSettingFromStore(setting.GetEmailSetting()),
+		}
+	case *storepb.InstanceSetting_BackupSetting:
+		instanceSetting.Value = &v1pb.InstanceSetting_BackupSetting_{
+			BackupSetting: convertInstanceBackupSettingFromStore(setting.GetBackupSetting()),
+		}
 	}
 	return instanceSetting
 }
@@ -179,6 +206,14 @@ func convertInstanceSettingToStore(setting *v1pb.InstanceSetting) *storepb.Insta
 		instanceSetting.Value = &storepb.InstanceSetting_AiSetting{
 			AiSetting: convertInstanceAISettingToStore(setting.GetAiSetting()),
 		}
+	case storepb.InstanceSettingKey_EMAIL:
+		instanceSetting.Value = &storepb.InstanceSetting_EmailSetting{
+			EmailSetting: convertInstanceEmailSettingToStore(setting.GetEmailSetting()),
+		}
+	case storepb.InstanceSettingKey_BACKUP:
+		instanceSetting.Value = &storepb.InstanceSetting_BackupSetting{
+			BackupSetting: convertInstanceBackupSettingToStore(setting.GetBackupSetting()),
+		}
 	default:
 		// Keep the default GeneralSetting value
 	}
@@ -286,6 +321,8 @@ func convertInstanceMemoRelatedSettingFromStore(setting *storepb.InstanceMemoRel
 		ContentLengthLimit:       setting.ContentLengthLimit,
 		EnableDoubleClickEdit:    setting.EnableDoubleClickEdit,
 		Reactions:                setting.Reactions,
+		DisableMemoRevisions:     setting.DisableMemoRevisions,
+		MemoRevisionLimit:        setting.MemoRevisionLimit,
 	}
 }
 
@@ -299,6 +336,8 @@ func convertInstanceMemoRelatedSettingToStore(setting *v1pb.InstanceSetting_Memo
 		ContentLengthLimit:       setting.ContentLengthLimit,
 		EnableDoubleClickEdit:    setting.EnableDoubleClickEdit,
 		Reactions:                setting.Reactions,
+		DisableMemoRevisions:     setting.DisableMemoRevisions,
+		MemoRevisionLimit:        setting.MemoRevisionLimit,
 	}
 }
 
@@ -308,6 +347,7 @@ func convertInstanceAISettingFromStore(setting *storepb.InstanceAISetting) *v1pb
 	}
 	models, selectedModel := normalizeEmbeddingModels(setting.OpenaiEmbeddingModels, setting.OpenaiEmbeddingModel)
 	return &v1pb.InstanceSetting_AISetting{
+		EmbeddingProvider:             setting.EmbeddingProvider,
 		OpenaiBaseUrl:                 setting.OpenaiBaseUrl,
 		OpenaiEmbeddingModel:          selectedModel,
 		OpenaiEmbeddingModels:         models,
@@ -315,13 +355,8 @@ func convertInstanceAISettingFromStore(setting *storepb.InstanceAISetting) *v1pb
 		OpenaiEmbeddingMaxRetry:       setting.OpenaiEmbeddingMaxRetry,
 		OpenaiEmbeddingRetryBackoffMs: setting.OpenaiEmbeddingRetryBackoffMs,
 		SemanticEmbeddingConcurrency:  setting.SemanticEmbeddingConcurrency,
-		SemanticReindexRunning:        setting.SemanticReindexRunning,
-		SemanticReindexTotal:          setting.SemanticReindexTotal,
-		SemanticReindexProcessed:      setting.SemanticReindexProcessed,
-		SemanticReindexFailed:         setting.SemanticReindexFailed,
-		SemanticReindexStartedTs:      setting.SemanticReindexStartedTs,
-		SemanticReindexUpdatedTs:      setting.SemanticReindexUpdatedTs,
-		SemanticReindexModel:          setting.SemanticReindexModel,
+		OpenaiCompletionModel:         setting.OpenaiCompletionModel,
+		MemoEnrichmentEnabled:         setting.MemoEnrichmentEnabled,
 	}
 }
 
@@ -331,19 +366,15 @@ func convertInstanceAISettingToStore(setting *v1pb.InstanceSetting_AISetting) *s
 	}
 	models, selectedModel := normalizeEmbeddingModels(setting.OpenaiEmbeddingModels, setting.OpenaiEmbeddingModel)
 	return &storepb.InstanceAISetting{
+		EmbeddingProvider:             setting.EmbeddingProvider,
 		OpenaiBaseUrl:                 setting.OpenaiBaseUrl,
 		OpenaiEmbeddingModel:          selectedModel,
 		OpenaiEmbeddingModels:         models,
 		OpenaiEmbeddingMaxRetry:       setting.OpenaiEmbeddingMaxRetry,
 		OpenaiEmbeddingRetryBackoffMs: setting.OpenaiEmbeddingRetryBackoffMs,
 		SemanticEmbeddingConcurrency:  setting.SemanticEmbeddingConcurrency,
-		SemanticReindexRunning:        setting.SemanticReindexRunning,
-		SemanticReindexTotal:          setting.SemanticReindexTotal,
-		SemanticReindexProcessed:      setting.SemanticReindexProcessed,
-		SemanticReindexFailed:         setting.SemanticReindexFailed,
-		SemanticReindexStartedTs:      setting.SemanticReindexStartedTs,
-		SemanticReindexUpdatedTs:      setting.SemanticReindexUpdatedTs,
-		SemanticReindexModel:          setting.SemanticReindexModel,
+		OpenaiCompletionModel:         setting.OpenaiCompletionModel,
+		MemoEnrichmentEnabled:         setting.MemoEnrichmentEnabled,
 	}
 }
 
@@ -356,25 +387,21 @@ func (s *APIV1Service) upsertInstanceAISetting(ctx context.Context, setting *v1p
 	updatedSetting := &storepb.InstanceAISetting{}
 	if existingSetting != nil {
 		updatedSetting.OpenaiApiKeyEncrypted = existingSetting.OpenaiApiKeyEncrypted
-		updatedSetting.SemanticReindexRunning = existingSetting.SemanticReindexRunning
-		updatedSetting.SemanticReindexTotal = existingSetting.SemanticReindexTotal
-		updatedSetting.SemanticReindexProcessed = existingSetting.SemanticReindexProcessed
-		updatedSetting.SemanticReindexFailed = existingSetting.SemanticReindexFailed
-		updatedSetting.SemanticReindexStartedTs = existingSetting.SemanticReindexStartedTs
-		updatedSetting.SemanticReindexUpdatedTs = existingSetting.SemanticReindexUpdatedTs
-		updatedSetting.SemanticReindexModel = existingSetting.SemanticReindexModel
 	}
 	if setting != nil {
 		if err := validateInstanceAISetting(setting); err != nil {
 			return nil, err
 		}
 		models, selectedModel := normalizeEmbeddingModels(setting.OpenaiEmbeddingModels, setting.OpenaiEmbeddingModel)
+		updatedSetting.EmbeddingProvider = strings.ToLower(strings.TrimSpace(setting.EmbeddingProvider))
 		updatedSetting.OpenaiBaseUrl = strings.TrimSpace(setting.OpenaiBaseUrl)
 		updatedSetting.OpenaiEmbeddingModel = selectedModel
 		updatedSetting.OpenaiEmbeddingModels = models
 		updatedSetting.OpenaiEmbeddingMaxRetry = setting.OpenaiEmbeddingMaxRetry
 		updatedSetting.OpenaiEmbeddingRetryBackoffMs = setting.OpenaiEmbeddingRetryBackoffMs
 		updatedSetting.SemanticEmbeddingConcurrency = setting.SemanticEmbeddingConcurrency
+		updatedSetting.OpenaiCompletionModel = strings.TrimSpace(setting.OpenaiCompletionModel)
+		updatedSetting.MemoEnrichmentEnabled = setting.MemoEnrichmentEnabled
 		if setting.ClearOpenaiApiKey {
 			updatedSetting.OpenaiApiKeyEncrypted = ""
 		}
@@ -411,6 +438,9 @@ func validateInstanceAISetting(setting *v1pb.InstanceSetting_AISetting) error {
 		return nil
 	}
 
+	if err := validateEmbeddingProvider(setting.EmbeddingProvider); err != nil {
+		return err
+	}
 	if setting.OpenaiEmbeddingMaxRetry < 0 {
 		return status.Errorf(codes.InvalidArgument, "openai_embedding_max_retry must be non-negative")
 	}
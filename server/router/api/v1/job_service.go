@@ -0,0 +1,317 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/jobqueue"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	memoEmbeddingJobTimeout = 45 * time.Second
+	webhookJobTimeout       = time.Minute
+)
+
+// RegisterJobHandlers registers the handlers of the background jobs enqueued by the service with the runner.
+func (s *APIV1Service) RegisterJobHandlers(runner *jobqueue.Runner) {
+	// Embedding and enrichment jobs additionally share the embedding semaphore with synchronous embeddings.
+	runner.Register(store.JobTypeMemoEmbedding, s.runMemoEmbeddingJob, jobqueue.HandlerOptions{
+		Concurrency: int(defaultEmbeddingRefreshConcurrency),
+		MaxAttempts: 5,
+		Timeout:     memoEmbeddingJobTimeout,
+	})
+	runner.Register(store.JobTypeMemoEnrichment, s.runMemoEnrichmentJob, jobqueue.HandlerOptions{
+		Concurrency: 2,
+		MaxAttempts: 3,
+		Timeout:     memoEnrichmentTimeout,
+	})
+	runner.Register(store.JobTypeSemanticReindex, s.runSemanticReindexJob, jobqueue.HandlerOptions{
+		Concurrency: 1,
+		MaxAttempts: 3,
+		Timeout:     semanticReindexTaskTimeout,
+	})
+	runner.Register(store.JobTypeWebhook, s.runWebhookJob, jobqueue.HandlerOptions{
+		Concurrency: 4,
+		MaxAttempts: 5,
+		Timeout:     webhookJobTimeout,
+	})
+	runner.Register(store.JobTypeEmail, s.runEmailJob, jobqueue.HandlerOptions{
+		Concurrency: 2,
+		MaxAttempts: 5,
+		Timeout:     emailJobTimeout,
+	})
+	runner.Register(store.JobTypeDigest, s.runDigestJob, jobqueue.HandlerOptions{
+		Concurrency: 1,
+		MaxAttempts: 3,
+		Timeout:     digestJobTimeout,
+	})
+	s.JobRunner = runner
+}
+
+// enqueueJob persists a background job. A job with a key is not enqueued again while it is pending.
+func (s *APIV1Service) enqueueJob(ctx context.Context, jobType store.JobType, key string, payload *storepb.JobPayload) (*store.Job, error) {
+	if s.JobRunner == nil {
+		return nil, errors.New("job runner is not configured")
+	}
+	return s.JobRunner.Enqueue(context.WithoutCancel(ctx), jobType, key, payload)
+}
+
+func (s *APIV1Service) runMemoEmbeddingJob(ctx context.Context, job *store.Job) error {
+	memoID := job.Payload.GetMemoEmbedding().GetMemoId()
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	// The content is embedded untrimmed so that chunk offsets match the stored memo content.
+	if memo == nil || strings.TrimSpace(memo.Content) == "" {
+		return nil
+	}
+
+	embeddingSemaphore := s.getEmbeddingSemaphore()
+	if embeddingSemaphore != nil {
+		if err := embeddingSemaphore.Acquire(ctx, 1); err != nil {
+			return errors.Wrap(err, "failed to acquire embedding semaphore")
+		}
+		defer embeddingSemaphore.Release(1)
+	}
+	return s.refreshMemoEmbedding(ctx, memo.ID, memo.Content)
+}
+
+func (s *APIV1Service) runMemoEnrichmentJob(ctx context.Context, job *store.Job) error {
+	// Completions go to the same provider as embeddings and share its concurrency limit.
+	embeddingSemaphore := s.getEmbeddingSemaphore()
+	if embeddingSemaphore != nil {
+		if err := embeddingSemaphore.Acquire(ctx, 1); err != nil {
+			return errors.Wrap(err, "failed to acquire embedding semaphore")
+		}
+		defer embeddingSemaphore.Release(1)
+	}
+	return s.enrichMemo(ctx, job.Payload.GetMemoEnrichment().GetMemoId())
+}
+
+func (s *APIV1Service) ListJobs(ctx context.Context, request *v1pb.ListJobsRequest) (*v1pb.ListJobsResponse, error) {
+	if err := s.checkJobAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	jobFind := &store.FindJob{}
+	if request.Type != "" {
+		jobType := store.JobType(request.Type)
+		jobFind.Type = &jobType
+	}
+	if request.State != v1pb.Job_STATE_UNSPECIFIED {
+		jobFind.StatusList = []store.JobStatus{convertJobStateToStore(request.State)}
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limit = min(limit, MaxPageSize)
+	limitPlusOne := limit + 1
+	jobFind.Limit = &limitPlusOne
+	jobFind.Offset = &offset
+	jobs, err := s.Store.ListJobs(ctx, jobFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list jobs: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(jobs) == limitPlusOne {
+		jobs = jobs[:limit]
+		nextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+
+	response := &v1pb.ListJobsResponse{
+		Jobs:          []*v1pb.Job{},
+		NextPageToken: nextPageToken,
+	}
+	for _, job := range jobs {
+		jobMessage, err := convertJobFromStore(job)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert job: %v", err)
+		}
+		response.Jobs = append(response.Jobs, jobMessage)
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) RetryJob(ctx context.Context, request *v1pb.RetryJobRequest) (*v1pb.Job, error) {
+	if err := s.checkJobAdmin(ctx); err != nil {
+		return nil, err
+	}
+	job, err := s.getJobByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != store.JobDead && job.Status != store.JobCanceled {
+		return nil, status.Errorf(codes.FailedPrecondition, "only dead or canceled jobs can be retried")
+	}
+
+	pending := store.JobPending
+	attempts := int32(0)
+	runAfterTs := time.Now().Unix()
+	lastError := ""
+	job, err = s.Store.UpdateJob(ctx, &store.UpdateJob{
+		ID:             job.ID,
+		ExpectedStatus: &job.Status,
+		Status:         &pending,
+		Attempts:       &attempts,
+		RunAfterTs:     &runAfterTs,
+		LastError:      &lastError,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update job: %v", err)
+	}
+	if job == nil {
+		return nil, status.Errorf(codes.Aborted, "job was updated concurrently")
+	}
+	if s.JobRunner != nil {
+		s.JobRunner.Notify()
+	}
+	return convertJobFromStoreWithStatus(job)
+}
+
+func (s *APIV1Service) CancelJob(ctx context.Context, request *v1pb.CancelJobRequest) (*v1pb.Job, error) {
+	if err := s.checkJobAdmin(ctx); err != nil {
+		return nil, err
+	}
+	job, err := s.getJobByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != store.JobPending && job.Status != store.JobRunning {
+		return nil, status.Errorf(codes.FailedPrecondition, "only pending or running jobs can be canceled")
+	}
+
+	// The status is updated before stopping the job, so that the worker does not record the interrupted attempt.
+	canceled := store.JobCanceled
+	job, err = s.Store.UpdateJob(ctx, &store.UpdateJob{
+		ID:             job.ID,
+		ExpectedStatus: &job.Status,
+		Status:         &canceled,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update job: %v", err)
+	}
+	if job == nil {
+		return nil, status.Errorf(codes.Aborted, "job was updated concurrently")
+	}
+	if s.JobRunner != nil {
+		s.JobRunner.Cancel(job.ID)
+	}
+	return convertJobFromStoreWithStatus(job)
+}
+
+func (s *APIV1Service) checkJobAdmin(ctx context.Context) error {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if user.Role != store.RoleAdmin {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+func (s *APIV1Service) getJobByName(ctx context.Context, name string) (*store.Job, error) {
+	jobID, err := ExtractJobIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid job name: %v", err)
+	}
+	job, err := s.Store.GetJob(ctx, &store.FindJob{ID: &jobID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get job: %v", err)
+	}
+	if job == nil {
+		return nil, status.Errorf(codes.NotFound, "job not found")
+	}
+	return job, nil
+}
+
+func convertJobFromStoreWithStatus(job *store.Job) (*v1pb.Job, error) {
+	jobMessage, err := convertJobFromStore(job)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert job: %v", err)
+	}
+	return jobMessage, nil
+}
+
+func convertJobFromStore(job *store.Job) (*v1pb.Job, error) {
+	payload, err := protojson.Marshal(job.Payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal job payload")
+	}
+	return &v1pb.Job{
+		Name:        fmt.Sprintf("%s%d", JobNamePrefix, job.ID),
+		Type:        job.Type.String(),
+		Key:         job.Key,
+		State:       convertJobStateFromStore(job.Status),
+		Attempts:    job.Attempts,
+		MaxAttempts: job.MaxAttempts,
+		LastError:   job.LastError,
+		Payload:     string(payload),
+		CreateTime:  timestamppb.New(time.Unix(job.CreatedTs, 0)),
+		UpdateTime:  timestamppb.New(time.Unix(job.UpdatedTs, 0)),
+		RunTime:     timestamppb.New(time.Unix(job.RunAfterTs, 0)),
+	}, nil
+}
+
+func convertJobStateFromStore(jobStatus store.JobStatus) v1pb.Job_State {
+	switch jobStatus {
+	case store.JobPending:
+		return v1pb.Job_PENDING
+	case store.JobRunning:
+		return v1pb.Job_RUNNING
+	case store.JobSucceeded:
+		return v1pb.Job_SUCCEEDED
+	case store.JobDead:
+		return v1pb.Job_DEAD
+	case store.JobCanceled:
+		return v1pb.Job_CANCELED
+	default:
+		return v1pb.Job_STATE_UNSPECIFIED
+	}
+}
+
+func convertJobStateToStore(state v1pb.Job_State) store.JobStatus {
+	switch state {
+	case v1pb.Job_RUNNING:
+		return store.JobRunning
+	case v1pb.Job_SUCCEEDED:
+		return store.JobSucceeded
+	case v1pb.Job_DEAD:
+		return store.JobDead
+	case v1pb.Job_CANCELED:
+		return store.JobCanceled
+	default:
+		return store.JobPending
+	}
+}
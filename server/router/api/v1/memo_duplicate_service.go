@@ -0,0 +1,450 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// duplicateSimilarityThreshold is the minimum cosine similarity for two memos to be near-duplicates.
+	duplicateSimilarityThreshold = 0.95
+	// possibleDuplicatesLimit bounds the number of possible duplicates returned when saving a memo.
+	possibleDuplicatesLimit = 5
+	// duplicateEmbeddingTimeout bounds how long saving a memo waits for its embedding.
+	duplicateEmbeddingTimeout = 5 * time.Second
+	// mergedMemoSeparator separates the contents of merged memos.
+	mergedMemoSeparator = "\n\n"
+	// duplicateClusterScanLimit bounds the number of memos scanned for duplicate clusters, the most recently updated
+	// first, as the embeddings of the memos of a creator are compared pairwise.
+	duplicateClusterScanLimit = 2000
+)
+
+// findPossibleDuplicateMemos returns the names of the memos of the same creator whose normalized content
+// hash equals the hash of the memo, or whose embedding is nearly identical to the current embedding of the memo.
+func (s *APIV1Service) findPossibleDuplicateMemos(ctx context.Context, memo *store.Memo) ([]string, error) {
+	normalStatus := store.Normal
+	limit := possibleDuplicatesLimit + 1
+	base := store.FindMemo{
+		CreatorID:       &memo.CreatorID,
+		RowStatus:       &normalStatus,
+		ExcludeComments: true,
+		ExcludeContent:  true,
+	}
+
+	memoIDList := []int32{}
+	if contentHash := memo.Payload.GetProperty().GetContentHash(); contentHash != "" {
+		find := base
+		find.Filters = []string{fmt.Sprintf("content_hash == %s", strconv.Quote(contentHash))}
+		find.Limit = &limit
+		memos, err := s.Store.ListMemos(ctx, &find)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list memos with the same content")
+		}
+		for _, candidate := range memos {
+			if candidate.ID != memo.ID {
+				memoIDList = append(memoIDList, candidate.ID)
+			}
+		}
+	}
+
+	embeddings, err := s.Store.ListMemoEmbeddings(ctx, &store.FindMemoEmbedding{MemoID: &memo.ID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get memo embedding")
+	}
+	// A stale embedding describes the previous content and is not compared.
+	if len(embeddings) > 0 && embeddings[0].ContentHash == memoEmbeddingContentHash(memo.Content) {
+		matches, err := s.Store.SearchMemoEmbeddings(ctx, &store.SearchMemoEmbedding{
+			Vector:   embeddings[0].Embedding,
+			MemoFind: &base,
+			Limit:    limit,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to search similar memos")
+		}
+		for _, match := range matches {
+			if match.MemoID != memo.ID && match.Score >= duplicateSimilarityThreshold && !slices.Contains(memoIDList, match.MemoID) {
+				memoIDList = append(memoIDList, match.MemoID)
+			}
+		}
+	}
+	if len(memoIDList) == 0 {
+		return nil, nil
+	}
+	if len(memoIDList) > possibleDuplicatesLimit {
+		memoIDList = memoIDList[:possibleDuplicatesLimit]
+	}
+
+	memos, err := s.listMemosInOrder(ctx, memoIDList)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(memos))
+	for _, duplicate := range memos {
+		names = append(names, fmt.Sprintf("%s%s", MemoNamePrefix, duplicate.UID))
+	}
+	return names, nil
+}
+
+func (s *APIV1Service) ListDuplicateMemoClusters(ctx context.Context, request *v1pb.ListDuplicateMemoClustersRequest) (*v1pb.ListDuplicateMemoClustersResponse, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	normalStatus := store.Normal
+	scanLimit := duplicateClusterScanLimit
+	find := &store.FindMemo{
+		CreatorID:        &user.ID,
+		RowStatus:        &normalStatus,
+		ExcludeComments:  true,
+		OrderByUpdatedTs: true,
+		Limit:            &scanLimit,
+	}
+	if request.Creator == UserNamePrefix+"-" {
+		if !isSuperUser(user) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		find.CreatorID = nil
+	} else if request.Creator != "" {
+		creatorID, err := ExtractUserIDFromName(request.Creator)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid creator name: %v", err)
+		}
+		if creatorID != user.ID && !isSuperUser(user) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		find.CreatorID = &creatorID
+	}
+	memos, err := s.Store.ListMemos(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	memoIDList := make([]int32, 0, len(memos))
+	for _, memo := range memos {
+		memoIDList = append(memoIDList, memo.ID)
+	}
+	embeddingMap, err := s.Store.ListMemoEmbeddingsByMemoIDs(ctx, memoIDList)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo embeddings: %v", err)
+	}
+
+	// The hashes are computed from the content, as memos saved before hashing was introduced have none stored.
+	clusters := newDuplicateClusters(len(memos))
+	contentHashes := make([]string, len(memos))
+	firstByContentHash := map[string]int{}
+	embeddedByCreator := map[int32][]int{}
+	for i, memo := range memos {
+		contentHashes[i] = memopayload.NormalizedContentHash(memo.Content)
+		if contentHashes[i] != "" {
+			key := fmt.Sprintf("%d/%s", memo.CreatorID, contentHashes[i])
+			if first, ok := firstByContentHash[key]; ok {
+				clusters.union(first, i, 1)
+			} else {
+				firstByContentHash[key] = i
+			}
+		}
+		if _, ok := embeddingMap[memo.ID]; ok {
+			embeddedByCreator[memo.CreatorID] = append(embeddedByCreator[memo.CreatorID], i)
+		}
+	}
+	// Embeddings are compared pairwise within each creator, which is quadratic in the number of scanned memos.
+	for _, embedded := range embeddedByCreator {
+		for x, i := range embedded {
+			for _, j := range embedded[x+1:] {
+				if contentHashes[i] != "" && contentHashes[i] == contentHashes[j] {
+					continue
+				}
+				similarity, ok := store.CosineSimilarity(embeddingMap[memos[i].ID], embeddingMap[memos[j].ID])
+				if ok && similarity >= duplicateSimilarityThreshold {
+					clusters.union(i, j, similarity)
+				}
+			}
+		}
+	}
+
+	groups := map[int][]int{}
+	for i := range memos {
+		root := clusters.find(i)
+		groups[root] = append(groups[root], i)
+	}
+	roots := []int{}
+	for root, members := range groups {
+		if len(members) > 1 {
+			roots = append(roots, root)
+		}
+	}
+	for _, root := range roots {
+		sort.Slice(groups[root], func(i, j int) bool {
+			a, b := memos[groups[root][i]], memos[groups[root][j]]
+			if a.UpdatedTs == b.UpdatedTs {
+				return a.ID > b.ID
+			}
+			return a.UpdatedTs > b.UpdatedTs
+		})
+	}
+	sort.Slice(roots, func(i, j int) bool {
+		a, b := groups[roots[i]], groups[roots[j]]
+		if len(a) == len(b) {
+			return memos[a[0]].ID > memos[b[0]].ID
+		}
+		return len(a) > len(b)
+	})
+
+	response := &v1pb.ListDuplicateMemoClustersResponse{
+		Clusters: make([]*v1pb.ListDuplicateMemoClustersResponse_DuplicateMemoCluster, 0, len(roots)),
+	}
+	for _, root := range roots {
+		clusterMemos := make([]*store.Memo, 0, len(groups[root]))
+		exact := true
+		for _, i := range groups[root] {
+			clusterMemos = append(clusterMemos, memos[i])
+			exact = exact && contentHashes[i] != "" && contentHashes[i] == contentHashes[groups[root][0]]
+		}
+		memoMessages, err := s.convertMemoListToMessages(ctx, clusterMemos)
+		if err != nil {
+			return nil, err
+		}
+		response.Clusters = append(response.Clusters, &v1pb.ListDuplicateMemoClustersResponse_DuplicateMemoCluster{
+			Memos:      memoMessages,
+			Exact:      exact,
+			Similarity: clusters.similarity[root],
+		})
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) MergeMemos(ctx context.Context, request *v1pb.MergeMemosRequest) (*v1pb.Memo, error) {
+	if len(request.MergedMemos) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "merged_memos is required")
+	}
+	memo, err := s.getEditableMemo(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	mergedMemos := make([]*store.Memo, 0, len(request.MergedMemos))
+	mergedMemoIDs := make([]int32, 0, len(request.MergedMemos))
+	contents := []string{memo.Content}
+	existingContents := []string{}
+	for _, content := range strings.Split(memo.Content, mergedMemoSeparator) {
+		existingContents = append(existingContents, strings.TrimSpace(content))
+	}
+	for _, name := range request.MergedMemos {
+		mergedUID, err := ExtractMemoUIDFromName(name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid merged memo name: %v", err)
+		}
+		mergedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &mergedUID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if mergedMemo == nil {
+			return nil, status.Errorf(codes.NotFound, "memo %q not found", name)
+		}
+		if mergedMemo.ID == memo.ID || slices.Contains(mergedMemoIDs, mergedMemo.ID) {
+			return nil, status.Errorf(codes.InvalidArgument, "memo %q is listed more than once", name)
+		}
+		if mergedMemo.CreatorID != memo.CreatorID {
+			return nil, status.Errorf(codes.InvalidArgument, "memos of different creators cannot be merged")
+		}
+		mergedMemos = append(mergedMemos, mergedMemo)
+		mergedMemoIDs = append(mergedMemoIDs, mergedMemo.ID)
+		// A content the survivor already holds is not appended again, so that a merge interrupted after the survivor
+		// was updated can be retried.
+		if content := strings.TrimSpace(mergedMemo.Content); content != "" && !slices.Contains(existingContents, content) {
+			contents = append(contents, content)
+			existingContents = append(existingContents, content)
+		}
+	}
+
+	content := strings.Join(contents, mergedMemoSeparator)
+	contentLengthLimit, err := s.getContentLengthLimit(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get content length limit")
+	}
+	if len(content) > contentLengthLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "merged content too long (max %d characters)", contentLengthLimit)
+	}
+	// The merge is not transactional, so every step can be repeated and the merged memos are only deleted once
+	// everything they hold was moved to the survivor. An interrupted merge is completed by retrying it with the
+	// memos left.
+	memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+	for _, mergedMemo := range mergedMemos {
+		if err := s.moveMemoRelations(ctx, mergedMemo.ID, memo.ID, mergedMemoIDs); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to move memo relations: %v", err)
+		}
+		attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &mergedMemo.ID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list attachments")
+		}
+		for _, attachment := range attachments {
+			if err := s.Store.UpdateAttachment(ctx, &store.UpdateAttachment{
+				ID:     attachment.ID,
+				MemoID: &memo.ID,
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to move attachment: %v", err)
+			}
+		}
+		if err := s.moveMemoReactions(ctx, fmt.Sprintf("%s%s", MemoNamePrefix, mergedMemo.UID), memoName); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to move reactions: %v", err)
+		}
+	}
+
+	previousMemo := snapshotMemo(memo)
+	memo.Content = content
+	if err := memopayload.RebuildMemoPayload(memo, s.MarkdownService); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
+	}
+	update := &store.UpdateMemo{
+		ID:      memo.ID,
+		Content: &memo.Content,
+		Payload: memo.Payload,
+	}
+	if err := s.recordMemoRevision(ctx, previousMemo, update); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record memo revision: %v", err)
+	}
+	if err := s.Store.UpdateMemo(ctx, update); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update memo")
+	}
+
+	for _, mergedMemo := range mergedMemos {
+		mergedMemoMessage, err := s.convertMemoFromStore(ctx, mergedMemo, nil, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert memo")
+		}
+		webhookFilters := s.matchMemoWebhookFilters(ctx, mergedMemo.CreatorID, mergedMemoMessage, webhook.ActivityTypeMemoDeleted)
+		if err := s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: mergedMemo.ID}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to delete merged memo")
+		}
+		publishEvent(ctx, s, MemoDeleted{Memo: mergedMemo, Message: mergedMemoMessage, WebhookFilters: webhookFilters})
+	}
+
+	return s.publishMemoUpdated(ctx, previousMemo, memo.ID)
+}
+
+// moveMemoRelations re-points the relations of a merged memo to the surviving memo.
+// Relations between the memos being merged are dropped, and a merged comment does not turn the survivor into a comment.
+func (s *APIV1Service) moveMemoRelations(ctx context.Context, fromID, toID int32, mergedMemoIDs []int32) error {
+	merging := func(memoID int32) bool {
+		return memoID == toID || slices.Contains(mergedMemoIDs, memoID)
+	}
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &fromID})
+	if err != nil {
+		return err
+	}
+	for _, relation := range relations {
+		if merging(relation.RelatedMemoID) || relation.Type == store.MemoRelationComment {
+			continue
+		}
+		if _, err := s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
+			MemoID:        toID,
+			RelatedMemoID: relation.RelatedMemoID,
+			Type:          relation.Type,
+		}); err != nil {
+			return err
+		}
+	}
+	relations, err = s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &fromID})
+	if err != nil {
+		return err
+	}
+	for _, relation := range relations {
+		if merging(relation.MemoID) {
+			continue
+		}
+		if _, err := s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
+			MemoID:        relation.MemoID,
+			RelatedMemoID: toID,
+			Type:          relation.Type,
+		}); err != nil {
+			return err
+		}
+	}
+	// The relations of the merged memo itself are removed when it is deleted.
+	return nil
+}
+
+// moveMemoReactions re-points the reactions of a merged memo to the surviving memo,
+// dropping the ones the same user already left on the survivor.
+func (s *APIV1Service) moveMemoReactions(ctx context.Context, fromName, toName string) error {
+	existing, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentID: &toName})
+	if err != nil {
+		return err
+	}
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentID: &fromName})
+	if err != nil {
+		return err
+	}
+	for _, reaction := range reactions {
+		duplicated := slices.ContainsFunc(existing, func(r *store.Reaction) bool {
+			return r.CreatorID == reaction.CreatorID && r.ReactionType == reaction.ReactionType
+		})
+		if !duplicated {
+			moved, err := s.Store.UpsertReaction(ctx, &store.Reaction{
+				CreatorID:    reaction.CreatorID,
+				ContentID:    toName,
+				ReactionType: reaction.ReactionType,
+			})
+			if err != nil {
+				return err
+			}
+			existing = append(existing, moved)
+		}
+		if err := s.Store.DeleteReaction(ctx, &store.DeleteReaction{ID: reaction.ID}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// duplicateClusters is a union-find over memo indexes that tracks the lowest similarity linking each cluster.
+type duplicateClusters struct {
+	parent     []int
+	similarity []float64
+}
+
+func newDuplicateClusters(size int) *duplicateClusters {
+	clusters := &duplicateClusters{
+		parent:     make([]int, size),
+		similarity: make([]float64, size),
+	}
+	for i := range clusters.parent {
+		clusters.parent[i] = i
+		clusters.similarity[i] = 1
+	}
+	return clusters
+}
+
+func (c *duplicateClusters) find(i int) int {
+	for c.parent[i] != i {
+		c.parent[i] = c.parent[c.parent[i]]
+		i = c.parent[i]
+	}
+	return i
+}
+
+func (c *duplicateClusters) union(i, j int, similarity float64) {
+	rootI, rootJ := c.find(i), c.find(j)
+	lowest := min(c.similarity[rootI], c.similarity[rootJ], similarity)
+	if rootI != rootJ {
+		c.parent[rootJ] = rootI
+	}
+	c.similarity[rootI] = lowest
+}
@@ -0,0 +1,319 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	memoEnrichmentTimeout = 60 * time.Second
+	// memoEnrichmentMaxInputLength bounds the number of content bytes sent to the model.
+	memoEnrichmentMaxInputLength    = 8000
+	memoEnrichmentMaxTags           = 5
+	memoEnrichmentMaxTagLength      = 50
+	memoEnrichmentMaxSummaryLength  = 500
+	memoEnrichmentMaxCategoryLength = 32
+	// memoEnrichmentUpdateAttempts bounds the number of writes retried after a concurrent edit of the memo.
+	memoEnrichmentUpdateAttempts = 3
+)
+
+const memoEnrichmentSystemPrompt = `You help organize personal notes written in Markdown.
+Reply with a JSON object with the following keys:
+- "summary": one or two sentences summarizing the note, in the language of the note.
+- "tags": up to 5 short tags for the note, lowercase, without "#" and without spaces.
+- "category": a single lowercase English word classifying the note, such as work, personal, idea, reading, travel or todo.`
+
+func (s *APIV1Service) scheduleMemoEnrichment(memoID int32) {
+	aiSetting, err := s.Store.GetInstanceAISetting(context.Background())
+	if err != nil || !aiSetting.GetMemoEnrichmentEnabled() {
+		return
+	}
+
+	if _, err := s.enqueueJob(context.Background(), store.JobTypeMemoEnrichment, fmt.Sprintf("%s%d", MemoNamePrefix, memoID), &storepb.JobPayload{
+		Payload: &storepb.JobPayload_MemoEnrichment_{MemoEnrichment: &storepb.JobPayload_MemoEnrichment{MemoId: memoID}},
+	}); err != nil {
+		slog.Warn("failed to enqueue memo enrichment", "memoID", memoID, "error", err)
+	}
+}
+
+// enrichMemo asks the language model for a summary, tags and a category of the memo and stores them as suggestions.
+// Memos whose content did not change since the last enrichment are skipped.
+func (s *APIV1Service) enrichMemo(ctx context.Context, memoID int32) error {
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil || strings.TrimSpace(memo.Content) == "" {
+		return nil
+	}
+	contentHash := memoEmbeddingContentHash(memo.Content)
+	if memo.Payload.GetEnrichment().GetContentHash() == contentHash {
+		return nil
+	}
+
+	client, err := s.getCompletionClient(ctx)
+	if err != nil {
+		return err
+	}
+	prompt := truncateUTF8(memo.Content, memoEnrichmentMaxInputLength)
+	if tags := memo.Payload.GetTags(); len(tags) > 0 {
+		prompt = fmt.Sprintf("Existing tags, do not suggest them again: %s\n\n%s", strings.Join(tags, ", "), prompt)
+	}
+	reply, err := client.Complete(ctx, []CompletionMessage{
+		{Role: "system", Content: memoEnrichmentSystemPrompt},
+		{Role: "user", Content: prompt},
+	})
+	if err != nil {
+		return err
+	}
+	enrichment, err := parseMemoEnrichment(reply)
+	if err != nil {
+		return err
+	}
+
+	// The memo is read again before every write, as it may have been edited while waiting for the model.
+	// The write only goes through while the payload is unchanged, so that concurrent edits are never overwritten.
+	for range memoEnrichmentUpdateAttempts {
+		memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+		if err != nil {
+			return errors.Wrap(err, "failed to get memo")
+		}
+		if memo == nil || memoEmbeddingContentHash(memo.Content) != contentHash {
+			return nil
+		}
+		if memo.Payload == nil {
+			memo.Payload = &storepb.MemoPayload{}
+		}
+		expectedPayload := proto.Clone(memo.Payload).(*storepb.MemoPayload)
+		previous := memo.Payload.Enrichment
+		update := proto.Clone(enrichment).(*storepb.MemoPayload_Enrichment)
+		update.SuggestedTags = slices.DeleteFunc(update.SuggestedTags, func(tag string) bool {
+			return containsTagFold(memo.Payload.Tags, tag) || containsTagFold(previous.GetRejectedTags(), tag)
+		})
+		update.ContentHash = contentHash
+		update.RejectedTags = previous.GetRejectedTags()
+		update.SummaryAccepted = previous.GetSummaryAccepted() && previous.GetSummary() == update.Summary
+		update.CategoryAccepted = previous.GetCategoryAccepted() && previous.GetCategory() == update.Category
+		memo.Payload.Enrichment = update
+		err = s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:              memo.ID,
+			Payload:         memo.Payload,
+			ExpectedPayload: expectedPayload,
+		})
+		if !errors.Is(err, store.ErrMemoPayloadChanged) {
+			return err
+		}
+	}
+	return errors.New("memo kept changing while storing its enrichment")
+}
+
+// parseMemoEnrichment parses and sanitizes the JSON reply of the language model.
+func parseMemoEnrichment(reply string) (*storepb.MemoPayload_Enrichment, error) {
+	reply = strings.TrimSpace(reply)
+	// Some models wrap JSON in a Markdown code fence despite the requested response format.
+	reply = strings.TrimPrefix(reply, "```json")
+	reply = strings.TrimPrefix(reply, "```")
+	reply = strings.TrimSuffix(reply, "```")
+
+	result := struct {
+		Summary  string   `json:"summary"`
+		Tags     []string `json:"tags"`
+		Category string   `json:"category"`
+	}{}
+	if err := json.Unmarshal([]byte(reply), &result); err != nil {
+		return nil, errors.Wrap(err, "failed to decode memo enrichment")
+	}
+
+	enrichment := &storepb.MemoPayload_Enrichment{
+		Summary:       truncateUTF8(strings.TrimSpace(result.Summary), memoEnrichmentMaxSummaryLength),
+		SuggestedTags: []string{},
+		Category:      truncateUTF8(normalizeSuggestedTag(result.Category), memoEnrichmentMaxCategoryLength),
+	}
+	for _, tag := range result.Tags {
+		tag = normalizeSuggestedTag(tag)
+		if tag != "" && !containsTagFold(enrichment.SuggestedTags, tag) {
+			enrichment.SuggestedTags = append(enrichment.SuggestedTags, tag)
+		}
+		if len(enrichment.SuggestedTags) == memoEnrichmentMaxTags {
+			break
+		}
+	}
+	return enrichment, nil
+}
+
+// normalizeSuggestedTag turns a suggested tag into a valid lowercase hashtag body.
+func normalizeSuggestedTag(tag string) string {
+	tag = strings.TrimLeft(strings.TrimSpace(strings.ToLower(tag)), "#")
+	var builder strings.Builder
+	for _, r := range tag {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsNumber(r) || r == '_' || r == '-' || r == '/':
+			builder.WriteRune(r)
+		case unicode.IsSpace(r):
+			builder.WriteRune('-')
+		}
+	}
+	return truncateUTF8(strings.Trim(builder.String(), "-/"), memoEnrichmentMaxTagLength)
+}
+
+func containsTagFold(tags []string, tag string) bool {
+	return slices.ContainsFunc(tags, func(t string) bool {
+		return strings.EqualFold(t, tag)
+	})
+}
+
+// truncateUTF8 shortens text to at most maxLength bytes without splitting a character.
+func truncateUTF8(text string, maxLength int) string {
+	if len(text) <= maxLength {
+		return text
+	}
+	for maxLength > 0 && !utf8.RuneStart(text[maxLength]) {
+		maxLength--
+	}
+	return text[:maxLength]
+}
+
+func (s *APIV1Service) AcceptMemoEnrichment(ctx context.Context, request *v1pb.AcceptMemoEnrichmentRequest) (*v1pb.Memo, error) {
+	memo, err := s.getEditableMemo(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	previousMemo := snapshotMemo(memo)
+	enrichment := memo.Payload.GetEnrichment()
+	if enrichment == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "memo has no enrichment")
+	}
+
+	update := &store.UpdateMemo{ID: memo.ID}
+	if request.Summary {
+		if enrichment.Summary == "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "memo has no suggested summary")
+		}
+		enrichment.SummaryAccepted = true
+	}
+	if request.Category {
+		if enrichment.Category == "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "memo has no suggested category")
+		}
+		enrichment.CategoryAccepted = true
+	}
+	if len(request.Tags) > 0 {
+		hashtags := make([]string, 0, len(request.Tags))
+		for _, tag := range request.Tags {
+			if !slices.Contains(enrichment.SuggestedTags, tag) {
+				return nil, status.Errorf(codes.InvalidArgument, "tag %q is not suggested", tag)
+			}
+			hashtags = append(hashtags, "#"+tag)
+		}
+		content := strings.TrimRightFunc(memo.Content, unicode.IsSpace) + "\n\n" + strings.Join(hashtags, " ")
+		contentLengthLimit, err := s.getContentLengthLimit(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get content length limit")
+		}
+		if len(content) > contentLengthLimit {
+			return nil, status.Errorf(codes.InvalidArgument, "content too long (max %d characters)", contentLengthLimit)
+		}
+		memo.Content = content
+		if err := memopayload.RebuildMemoPayload(memo, s.MarkdownService); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
+		}
+		enrichment.SuggestedTags = slices.DeleteFunc(enrichment.SuggestedTags, func(tag string) bool {
+			return slices.Contains(request.Tags, tag)
+		})
+		// The accepted tags do not call for new suggestions.
+		enrichment.ContentHash = memoEmbeddingContentHash(memo.Content)
+		update.Content = &memo.Content
+	}
+	update.Payload = memo.Payload
+	if err := s.recordMemoRevision(ctx, previousMemo, update); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record memo revision: %v", err)
+	}
+	if err := s.Store.UpdateMemo(ctx, update); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update memo")
+	}
+	return s.publishMemoUpdated(ctx, previousMemo, memo.ID)
+}
+
+func (s *APIV1Service) RejectMemoEnrichment(ctx context.Context, request *v1pb.RejectMemoEnrichmentRequest) (*v1pb.Memo, error) {
+	memo, err := s.getEditableMemo(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	previousMemo := snapshotMemo(memo)
+	enrichment := memo.Payload.GetEnrichment()
+	if enrichment == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "memo has no enrichment")
+	}
+
+	if request.Summary {
+		enrichment.Summary = ""
+		enrichment.SummaryAccepted = false
+	}
+	if request.Category {
+		enrichment.Category = ""
+		enrichment.CategoryAccepted = false
+	}
+	for _, tag := range request.Tags {
+		if !slices.Contains(enrichment.SuggestedTags, tag) {
+			return nil, status.Errorf(codes.InvalidArgument, "tag %q is not suggested", tag)
+		}
+	}
+	enrichment.SuggestedTags = slices.DeleteFunc(enrichment.SuggestedTags, func(tag string) bool {
+		return slices.Contains(request.Tags, tag)
+	})
+	for _, tag := range request.Tags {
+		if !containsTagFold(enrichment.RejectedTags, tag) {
+			enrichment.RejectedTags = append(enrichment.RejectedTags, tag)
+		}
+	}
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:      memo.ID,
+		Payload: memo.Payload,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update memo")
+	}
+	return s.publishMemoUpdated(ctx, previousMemo, memo.ID)
+}
+
+// getEditableMemo returns the memo with the given name if the current user is its creator or an admin.
+func (s *APIV1Service) getEditableMemo(ctx context.Context, name string) (*store.Memo, error) {
+	memoUID, err := ExtractMemoUIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return memo, nil
+}
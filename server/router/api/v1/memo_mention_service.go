@@ -0,0 +1,120 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// notifyMemoMentions notifies the users mentioned in the memo who can see it.
+// Mentions listed in notifiedMentions were notified before and are skipped.
+func (s *APIV1Service) notifyMemoMentions(ctx context.Context, memo *store.Memo, notifiedMentions []string) error {
+	mentions := memo.Payload.GetMentions()
+	if len(mentions) == 0 || memo.RowStatus == store.Archived {
+		return nil
+	}
+	// Mentions in comments are only notified to users who can also see the commented memo.
+	var parentMemo *store.Memo
+	commentType := store.MemoRelationComment
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+		MemoID: &memo.ID,
+		Type:   &commentType,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo relations")
+	}
+	if len(relations) > 0 {
+		parentMemo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &relations[0].RelatedMemoID})
+		if err != nil {
+			return errors.Wrap(err, "failed to get commented memo")
+		}
+	}
+
+	for _, username := range mentions {
+		if slices.Contains(notifiedMentions, username) {
+			continue
+		}
+		user, err := s.Store.GetUser(ctx, &store.FindUser{Username: &username})
+		if err != nil {
+			return errors.Wrap(err, "failed to get mentioned user")
+		}
+		if user == nil || user.ID == memo.CreatorID || user.RowStatus == store.Archived {
+			continue
+		}
+		if !canUserSeeMemo(user, memo) || (parentMemo != nil && !canUserSeeMemo(user, parentMemo)) {
+			continue
+		}
+		if err := s.notifyMemoMention(ctx, memo, user); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// notifyMemoMention records the mention as an activity in the inbox of the mentioned user and emails them.
+func (s *APIV1Service) notifyMemoMention(ctx context.Context, memo *store.Memo, user *store.User) error {
+	activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: memo.CreatorID,
+		Type:      store.ActivityTypeMemoMention,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoMention: &storepb.ActivityMemoMentionPayload{
+				MemoId: memo.ID,
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	if _, err := s.createInbox(ctx, &store.Inbox{
+		SenderID:   memo.CreatorID,
+		ReceiverID: user.ID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       storepb.InboxMessage_MEMO_MENTION,
+			ActivityId: &activity.ID,
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create inbox")
+	}
+	if err := s.notifyMemoMentionByEmail(ctx, memo, user.ID); err != nil {
+		slog.Warn("Failed to send memo mention email", slog.Any("err", err))
+	}
+	return nil
+}
+
+// notifyMemoMentionByEmail emails the mentioned user about the memo.
+func (s *APIV1Service) notifyMemoMentionByEmail(ctx context.Context, memo *store.Memo, userID int32) error {
+	creator, err := s.Store.GetUser(ctx, &store.FindUser{ID: &memo.CreatorID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo creator")
+	}
+	if creator == nil {
+		return nil
+	}
+	name := creator.Nickname
+	if name == "" {
+		name = creator.Username
+	}
+	snippet, err := s.MarkdownService.GenerateSnippet([]byte(memo.Content), emailSnippetMaxLength)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate snippet")
+	}
+
+	body := fmt.Sprintf("%s mentioned you in a memo:\n\n%s", name, snippet)
+	if link := s.getMemoLink(memo.UID); link != "" {
+		body += "\n\n" + link
+	}
+	return s.notifyUserByEmail(ctx, userID, emailNotificationMention, fmt.Sprintf("%s mentioned you in a memo", name), body)
+}
+
+// canUserSeeMemo reports whether the signed-in user can see the memo.
+func canUserSeeMemo(user *store.User, memo *store.Memo) bool {
+	return memo.Visibility != store.Private || memo.CreatorID == user.ID
+}
@@ -0,0 +1,253 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	relatedMemosDefaultLimit = 10
+	relatedMemosMaxLimit     = 50
+	// relatedMemosCandidateDepth bounds the number of candidates taken from each signal.
+	relatedMemosCandidateDepth = 100
+
+	// Weights of the signals in the relatedness score. The semantic score is a cosine similarity,
+	// the tag score is the Jaccard index of the tag sets and a reference counts as 1.
+	relatedSemanticWeight  = 1.0
+	relatedTagWeight       = 0.5
+	relatedReferenceWeight = 0.5
+	// relatedSuggestionMinScore is the minimum score for a related memo to be suggested as a reference.
+	relatedSuggestionMinScore = 0.5
+)
+
+// relatedHit is a memo related to the requested memo together with its signals.
+type relatedHit struct {
+	memoID        int32
+	score         float64
+	semanticScore float64
+	sharedTags    []string
+	tagScore      float64
+	referenced    bool
+}
+
+func (s *APIV1Service) ListRelatedMemos(ctx context.Context, request *v1pb.ListRelatedMemosRequest) (*v1pb.ListRelatedMemosResponse, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if memo.Visibility != store.Public {
+		if currentUser == nil {
+			return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+		}
+		if memo.Visibility == store.Private && memo.CreatorID != currentUser.ID {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+	}
+
+	limit := int(request.Limit)
+	if limit <= 0 {
+		limit = relatedMemosDefaultLimit
+	}
+	limit = min(limit, relatedMemosMaxLimit)
+	memoFind, err := s.buildMemoSearchFind(ctx, v1pb.State_NORMAL, "")
+	if err != nil {
+		return nil, err
+	}
+
+	hitMap := make(map[int32]*relatedHit)
+	getHit := func(memoID int32) *relatedHit {
+		hit, ok := hitMap[memoID]
+		if !ok {
+			hit = &relatedHit{memoID: memoID}
+			hitMap[memoID] = hit
+		}
+		return hit
+	}
+
+	embeddingMap, err := s.Store.ListMemoEmbeddingsByMemoIDs(ctx, []int32{memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo embedding: %v", err)
+	}
+	if vector, ok := embeddingMap[memo.ID]; ok {
+		matches, err := s.Store.SearchMemoEmbeddings(ctx, &store.SearchMemoEmbedding{
+			Vector:   vector,
+			MemoFind: memoFind,
+			Limit:    relatedMemosCandidateDepth + 1,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to search similar memos: %v", err)
+		}
+		for _, match := range matches {
+			if match.MemoID != memo.ID {
+				getHit(match.MemoID).semanticScore = match.Score
+			}
+		}
+	}
+
+	if tags := memo.Payload.GetTags(); len(tags) > 0 {
+		quotedTags := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			quotedTags = append(quotedTags, strconv.Quote(tag))
+		}
+		candidateLimit := relatedMemosCandidateDepth + 1
+		find := *memoFind
+		find.Filters = append(slices.Clone(memoFind.Filters), fmt.Sprintf("tag in [%s]", strings.Join(quotedTags, ", ")))
+		find.Limit = &candidateLimit
+		candidates, err := s.Store.ListMemos(ctx, &find)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list memos with shared tags: %v", err)
+		}
+		for _, candidate := range candidates {
+			if candidate.ID == memo.ID {
+				continue
+			}
+			sharedTags, tagScore := compareTags(tags, candidate.Payload.GetTags())
+			if len(sharedTags) == 0 {
+				continue
+			}
+			hit := getHit(candidate.ID)
+			hit.sharedTags = sharedTags
+			hit.tagScore = tagScore
+		}
+	}
+
+	referenceType := store.MemoRelationReference
+	for _, find := range []*store.FindMemoRelation{
+		{MemoID: &memo.ID, Type: &referenceType},
+		{RelatedMemoID: &memo.ID, Type: &referenceType},
+	} {
+		relations, err := s.Store.ListMemoRelations(ctx, find)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list memo relations: %v", err)
+		}
+		for _, relation := range relations {
+			relatedMemoID := relation.RelatedMemoID
+			if relatedMemoID == memo.ID {
+				relatedMemoID = relation.MemoID
+			}
+			if relatedMemoID != memo.ID {
+				getHit(relatedMemoID).referenced = true
+			}
+		}
+	}
+
+	// Only memos visible to the caller are returned, whichever signal found them.
+	memoIDList := make([]int32, 0, len(hitMap))
+	for memoID := range hitMap {
+		memoIDList = append(memoIDList, memoID)
+	}
+	visibleMemos := []*store.Memo{}
+	if len(memoIDList) > 0 {
+		find := *memoFind
+		find.IDList = memoIDList
+		visibleMemos, err = s.Store.ListMemos(ctx, &find)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list related memos: %v", err)
+		}
+	}
+	hits := make([]*relatedHit, 0, len(visibleMemos))
+	memoMap := make(map[int32]*store.Memo, len(visibleMemos))
+	for _, visibleMemo := range visibleMemos {
+		hit := hitMap[visibleMemo.ID]
+		hit.score = relatedSemanticWeight*max(hit.semanticScore, 0) + relatedTagWeight*hit.tagScore
+		if hit.referenced {
+			hit.score += relatedReferenceWeight
+		}
+		if hit.score <= 0 {
+			// Memos pointing away from the requested memo are not related.
+			continue
+		}
+		hits = append(hits, hit)
+		memoMap[visibleMemo.ID] = visibleMemo
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].score == hits[j].score {
+			return hits[i].memoID > hits[j].memoID
+		}
+		return hits[i].score > hits[j].score
+	})
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+
+	selectedMemos := make([]*store.Memo, 0, len(hits))
+	for _, hit := range hits {
+		selectedMemos = append(selectedMemos, memoMap[hit.memoID])
+	}
+	memoMessages, err := s.convertMemoListToMessages(ctx, selectedMemos)
+	if err != nil {
+		return nil, err
+	}
+
+	canEdit := currentUser != nil && (memo.CreatorID == currentUser.ID || isSuperUser(currentUser))
+	relatedMemos := make([]*v1pb.ListRelatedMemosResponse_RelatedMemo, 0, len(hits))
+	for i, hit := range hits {
+		relatedMemo := &v1pb.ListRelatedMemosResponse_RelatedMemo{
+			Memo:          memoMessages[i],
+			Score:         hit.score,
+			SemanticScore: hit.semanticScore,
+			SharedTags:    hit.sharedTags,
+			Referenced:    hit.referenced,
+		}
+		if canEdit && !hit.referenced && hit.score >= relatedSuggestionMinScore {
+			snippet, err := s.getMemoContentSnippet(selectedMemos[i].Content)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get memo content snippet: %v", err)
+			}
+			relatedMemo.SuggestedRelation = &v1pb.MemoRelation{
+				Memo: &v1pb.MemoRelation_Memo{
+					Name: request.Name,
+				},
+				RelatedMemo: &v1pb.MemoRelation_Memo{
+					Name:    memoMessages[i].Name,
+					Snippet: snippet,
+				},
+				Type: v1pb.MemoRelation_REFERENCE,
+			}
+		}
+		relatedMemos = append(relatedMemos, relatedMemo)
+	}
+	return &v1pb.ListRelatedMemosResponse{
+		RelatedMemos: relatedMemos,
+	}, nil
+}
+
+// compareTags returns the tags two memos have in common and the Jaccard index of their tag sets.
+func compareTags(tags, otherTags []string) ([]string, float64) {
+	shared := []string{}
+	union := slices.Clone(tags)
+	for _, tag := range otherTags {
+		if slices.Contains(tags, tag) {
+			if !slices.Contains(shared, tag) {
+				shared = append(shared, tag)
+			}
+		} else if !slices.Contains(union, tag) {
+			union = append(union, tag)
+		}
+	}
+	if len(union) == 0 {
+		return shared, 0
+	}
+	return shared, float64(len(shared)) / float64(len(union))
+}
@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"log/slog"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
@@ -70,6 +71,11 @@ func (s *APIV1Service) SetMemoRelations(ctx context.Context, request *v1pb.SetMe
 			return nil, status.Errorf(codes.Internal, "failed to upsert memo relation")
 		}
 	}
+	if memoMessage, err := s.convertMemoFromStore(ctx, memo, nil, nil); err == nil {
+		publishEvent(ctx, s, MemoRelationsUpdated{Memo: memo, Message: memoMessage})
+	} else {
+		slog.Warn("Failed to convert memo", slog.Any("err", err))
+	}
 
 	return &emptypb.Empty{}, nil
 }
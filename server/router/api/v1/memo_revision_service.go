@@ -0,0 +1,212 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// memoRevisionDiffContext is the number of unchanged lines around the changes of a revision diff.
+const memoRevisionDiffContext = 3
+
+func (s *APIV1Service) ListMemoRevisions(ctx context.Context, request *v1pb.ListMemoRevisionsRequest) (*v1pb.ListMemoRevisionsResponse, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.getMemoForRevisions(ctx, memoUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limit = min(limit, MaxPageSize)
+	limitPlusOne := limit + 1
+	revisions, err := s.Store.ListMemoRevisions(ctx, &store.FindMemoRevision{
+		MemoID: &memo.ID,
+		Limit:  &limitPlusOne,
+		Offset: &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo revisions: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(revisions) == limitPlusOne {
+		revisions = revisions[:limit]
+		nextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+
+	response := &v1pb.ListMemoRevisionsResponse{
+		Revisions:     []*v1pb.MemoRevision{},
+		NextPageToken: nextPageToken,
+	}
+	for _, revision := range revisions {
+		response.Revisions = append(response.Revisions, convertMemoRevisionFromStore(memo.UID, revision))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetMemoRevision(ctx context.Context, request *v1pb.GetMemoRevisionRequest) (*v1pb.MemoRevision, error) {
+	memo, revision, err := s.getMemoRevision(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	revisionMessage := convertMemoRevisionFromStore(memo.UID, revision)
+	revisionMessage.Diff, err = difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitMemoContentLines(revision.Content),
+		B:        splitMemoContentLines(memo.Content),
+		FromFile: revisionMessage.Name,
+		ToFile:   fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+		Context:  memoRevisionDiffContext,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to diff memo revision: %v", err)
+	}
+	return revisionMessage, nil
+}
+
+func (s *APIV1Service) RestoreMemoRevision(ctx context.Context, request *v1pb.RestoreMemoRevisionRequest) (*v1pb.Memo, error) {
+	memo, revision, err := s.getMemoRevision(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// The version is restored through UpdateMemo, which records the replaced version as a revision of its own, so that
+	// a restore can be undone as well.
+	return s.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:       fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+			Content:    revision.Content,
+			Visibility: convertVisibilityFromStore(revision.Visibility),
+			Location:   convertLocationFromStore(revision.Payload.GetLocation()),
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content", "visibility", "location"}},
+	})
+}
+
+// getMemoForRevisions returns the memo if the current user may access its revisions. As revisions may hold content
+// the memo no longer shows, only the creator of the memo and admins can access them.
+func (s *APIV1Service) getMemoForRevisions(ctx context.Context, memoUID string) (*store.Memo, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return memo, nil
+}
+
+func (s *APIV1Service) getMemoRevision(ctx context.Context, name string) (*store.Memo, *store.MemoRevision, error) {
+	memoUID, revisionID, err := ExtractMemoRevisionIDFromName(name)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid revision name: %v", err)
+	}
+	memo, err := s.getMemoForRevisions(ctx, memoUID)
+	if err != nil {
+		return nil, nil, err
+	}
+	revision, err := s.Store.GetMemoRevision(ctx, &store.FindMemoRevision{
+		ID:     &revisionID,
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get memo revision: %v", err)
+	}
+	if revision == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "memo revision not found")
+	}
+	return memo, revision, nil
+}
+
+// recordMemoRevision records the version of the memo before an update as a revision, when the update changes its
+// content, visibility or location. The oldest revisions of the memo beyond the limit of the memo related setting are
+// deleted.
+func (s *APIV1Service) recordMemoRevision(ctx context.Context, memo *store.Memo, update *store.UpdateMemo) error {
+	changed := (update.Content != nil && *update.Content != memo.Content) ||
+		(update.Visibility != nil && *update.Visibility != memo.Visibility) ||
+		(update.Payload != nil && !proto.Equal(update.Payload.GetLocation(), memo.Payload.GetLocation()))
+	if !changed {
+		return nil
+	}
+	instanceMemoRelatedSetting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get instance memo related setting")
+	}
+	if instanceMemoRelatedSetting.DisableMemoRevisions {
+		return nil
+	}
+
+	if _, err := s.Store.CreateMemoRevision(ctx, &store.MemoRevision{
+		MemoID:     memo.ID,
+		Content:    memo.Content,
+		Visibility: memo.Visibility,
+		Payload:    memo.Payload,
+		CreatedTs:  memo.UpdatedTs,
+	}); err != nil {
+		return errors.Wrap(err, "failed to create memo revision")
+	}
+	if err := s.Store.PruneMemoRevisions(ctx, memo.ID, int(instanceMemoRelatedSetting.MemoRevisionLimit)); err != nil {
+		return errors.Wrap(err, "failed to delete old memo revisions")
+	}
+	return nil
+}
+
+// splitMemoContentLines splits the content into newline terminated lines for a diff, without the empty line that
+// difflib.SplitLines adds after a trailing newline.
+func splitMemoContentLines(content string) []string {
+	if content == "" {
+		return nil
+	}
+	return difflib.SplitLines(strings.TrimSuffix(content, "\n"))
+}
+
+func convertMemoRevisionFromStore(memoUID string, revision *store.MemoRevision) *v1pb.MemoRevision {
+	return &v1pb.MemoRevision{
+		Name:       fmt.Sprintf("%s%s/%s%d", MemoNamePrefix, memoUID, RevisionNamePrefix, revision.ID),
+		Content:    revision.Content,
+		Visibility: convertVisibilityFromStore(revision.Visibility),
+		Location:   convertLocationFromStore(revision.Payload.GetLocation()),
+		CreateTime: timestamppb.New(time.Unix(revision.CreatedTs, 0)),
+	}
+}
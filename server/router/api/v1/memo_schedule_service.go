@@ -0,0 +1,172 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// setMemoPublishVisibility sets the visibility a scheduled memo is published with.
+func (s *APIV1Service) setMemoPublishVisibility(ctx context.Context, payload *storepb.MemoPayload, visibility v1pb.Visibility) error {
+	if visibility != v1pb.Visibility_PUBLIC && visibility != v1pb.Visibility_PROTECTED {
+		return status.Errorf(codes.InvalidArgument, "publish_visibility must be PUBLIC or PROTECTED")
+	}
+	instanceMemoRelatedSetting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get instance memo related setting")
+	}
+	if instanceMemoRelatedSetting.DisallowPublicVisibility && visibility == v1pb.Visibility_PUBLIC {
+		return status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
+	}
+	payload.PublishVisibility = convertVisibilityToStore(visibility).String()
+	return nil
+}
+
+// RunMemoSchedules sends the reminders and publishes the scheduled memos that are due at now.
+func (s *APIV1Service) RunMemoSchedules(ctx context.Context, now time.Time) error {
+	normalStatus := store.Normal
+	dueMemos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		RowStatus: &normalStatus,
+		Filters:   []string{fmt.Sprintf("publish_ts > 0 && publish_ts <= %d", now.Unix())},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list scheduled memos")
+	}
+	for _, memo := range dueMemos {
+		if err := s.publishScheduledMemo(ctx, memo); err != nil {
+			slog.Error("failed to publish scheduled memo", "memoID", memo.ID, "error", err)
+		}
+	}
+
+	dueMemos, err = s.Store.ListMemos(ctx, &store.FindMemo{
+		RowStatus: &normalStatus,
+		Filters:   []string{fmt.Sprintf("remind_ts > 0 && remind_ts <= %d", now.Unix())},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memos with due reminders")
+	}
+	for _, memo := range dueMemos {
+		if err := s.sendMemoReminder(ctx, memo); err != nil {
+			slog.Error("failed to send memo reminder", "memoID", memo.ID, "error", err)
+		}
+	}
+	return nil
+}
+
+// publishScheduledMemo switches the memo to its publish visibility and publishes its MemoPublished event.
+// Memos edited since they were listed are left to the next run.
+func (s *APIV1Service) publishScheduledMemo(ctx context.Context, memo *store.Memo) error {
+	visibility := store.Visibility(memo.Payload.PublishVisibility)
+	if visibility != store.Public && visibility != store.Protected {
+		visibility = store.Private
+	}
+	createdTs := memo.Payload.PublishTs
+	expectedPayload := proto.Clone(memo.Payload).(*storepb.MemoPayload)
+	memo.Payload.PublishTs = 0
+	memo.Payload.PublishVisibility = ""
+	// The memo shows up in the timeline at the time it is published.
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:              memo.ID,
+		CreatedTs:       &createdTs,
+		Visibility:      &visibility,
+		Payload:         memo.Payload,
+		ExpectedPayload: expectedPayload,
+	}); err != nil {
+		if errors.Is(err, store.ErrMemoPayloadChanged) {
+			// The memo was edited since it was listed; the next run sees its current schedule.
+			return nil
+		}
+		return errors.Wrap(err, "failed to update memo")
+	}
+	memo.CreatedTs = createdTs
+	memo.Visibility = visibility
+
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert memo")
+	}
+	publishEvent(ctx, s, MemoPublished{Memo: memo, Message: memoMessage})
+	return nil
+}
+
+// sendMemoReminder clears the reminder of the memo and publishes its MemoReminded event.
+// The reminder is cleared before it is delivered so that it is never sent twice.
+func (s *APIV1Service) sendMemoReminder(ctx context.Context, memo *store.Memo) error {
+	expectedPayload := proto.Clone(memo.Payload).(*storepb.MemoPayload)
+	memo.Payload.RemindTs = 0
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:              memo.ID,
+		Payload:         memo.Payload,
+		ExpectedPayload: expectedPayload,
+	}); err != nil {
+		if errors.Is(err, store.ErrMemoPayloadChanged) {
+			// The memo was edited since it was listed; the next run sees its current reminder.
+			return nil
+		}
+		return errors.Wrap(err, "failed to clear memo reminder")
+	}
+
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert memo")
+	}
+	publishEvent(ctx, s, MemoReminded{Memo: memo, Message: memoMessage})
+	return nil
+}
+
+// notifyMemoReminder notifies the creator of the memo in their inbox and by email when opted in.
+func (s *APIV1Service) notifyMemoReminder(ctx context.Context, memo *store.Memo) error {
+	activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: memo.CreatorID,
+		Type:      store.ActivityTypeMemoReminder,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoReminder: &storepb.ActivityMemoReminderPayload{
+				MemoId: memo.ID,
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	if _, err := s.createInbox(ctx, &store.Inbox{
+		SenderID:   memo.CreatorID,
+		ReceiverID: memo.CreatorID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       storepb.InboxMessage_MEMO_REMINDER,
+			ActivityId: &activity.ID,
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create inbox")
+	}
+
+	if err := s.notifyMemoReminderByEmail(ctx, memo); err != nil {
+		slog.Warn("Failed to send memo reminder email", slog.Any("err", err))
+	}
+	return nil
+}
+
+// notifyMemoReminderByEmail emails the memo creator about the reminder.
+func (s *APIV1Service) notifyMemoReminderByEmail(ctx context.Context, memo *store.Memo) error {
+	snippet, err := s.MarkdownService.GenerateSnippet([]byte(memo.Content), emailSnippetMaxLength)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate snippet")
+	}
+
+	body := fmt.Sprintf("You asked to be reminded about this memo:\n\n%s", snippet)
+	if link := s.getMemoLink(memo.UID); link != "" {
+		body += "\n\n" + link
+	}
+	return s.notifyUserByEmail(ctx, memo.CreatorID, emailNotificationReminder, "Memo reminder", body)
+}
@@ -0,0 +1,353 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// searchRRFConstant is the k constant of reciprocal rank fusion, dampening the weight of top ranks.
+	searchRRFConstant = 60
+	// searchHybridDepth is the minimum number of matches taken from each ranking before fusion.
+	searchHybridDepth = 100
+	// searchKeywordPageSize is the number of matching memos loaded at a time for keyword scoring.
+	searchKeywordPageSize = 1000
+	// searchSnippetLength is the maximum length of a result snippet, in characters.
+	searchSnippetLength = 160
+
+	// BM25 term frequency saturation and length normalization parameters.
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// searchHit is a memo found by SearchMemos together with its score breakdown.
+type searchHit struct {
+	memoID        int32
+	score         float64
+	keywordRank   int
+	keywordScore  float64
+	semanticRank  int
+	semanticScore float64
+	// semanticChunk is the index of the best matching chunk of the memo.
+	semanticChunk int32
+	matchedTerms  []string
+}
+
+func (s *APIV1Service) SearchMemos(ctx context.Context, request *v1pb.SearchMemosRequest) (*v1pb.SearchMemosResponse, error) {
+	query := strings.TrimSpace(request.Query)
+	if query == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "query is required")
+	}
+	mode := request.Mode
+	if mode == v1pb.SearchMemosRequest_MODE_UNSPECIFIED {
+		mode = v1pb.SearchMemosRequest_HYBRID
+	}
+
+	memoFind, err := s.buildMemoSearchFind(ctx, request.State, request.Filter)
+	if err != nil {
+		return nil, err
+	}
+	limit, offset, err := parseSearchPageToken(request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	// Fetch one extra match to know whether there is a next page.
+	depth := offset + limit + 1
+	if mode == v1pb.SearchMemosRequest_HYBRID {
+		depth = max(depth, searchHybridDepth)
+	}
+
+	hitMap := make(map[int32]*searchHit)
+	getHit := func(memoID int32) *searchHit {
+		hit, ok := hitMap[memoID]
+		if !ok {
+			hit = &searchHit{memoID: memoID}
+			hitMap[memoID] = hit
+		}
+		return hit
+	}
+
+	if mode == v1pb.SearchMemosRequest_KEYWORD || mode == v1pb.SearchMemosRequest_HYBRID {
+		keywordHits, err := s.searchMemosByKeyword(ctx, memoFind, searchTerms(query))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to search memos by keyword: %v", err)
+		}
+		for i, keywordHit := range keywordHits {
+			hit := getHit(keywordHit.memoID)
+			hit.keywordRank = i + 1
+			hit.keywordScore = keywordHit.keywordScore
+			hit.matchedTerms = keywordHit.matchedTerms
+		}
+	}
+
+	if mode == v1pb.SearchMemosRequest_SEMANTIC || mode == v1pb.SearchMemosRequest_HYBRID {
+		matches, err := s.searchMemosBySemantic(ctx, memoFind, query, depth)
+		if err != nil {
+			if mode == v1pb.SearchMemosRequest_HYBRID && status.Code(err) == codes.FailedPrecondition {
+				// Hybrid search degrades to keyword search when embeddings are not configured.
+				slog.Debug("semantic search unavailable for hybrid search", "error", err)
+			} else {
+				return nil, err
+			}
+		}
+		for i, match := range matches {
+			hit := getHit(match.MemoID)
+			hit.semanticRank = i + 1
+			hit.semanticScore = match.Score
+			hit.semanticChunk = match.ChunkIndex
+		}
+	}
+
+	hits := make([]*searchHit, 0, len(hitMap))
+	for _, hit := range hitMap {
+		switch mode {
+		case v1pb.SearchMemosRequest_KEYWORD:
+			hit.score = hit.keywordScore
+		case v1pb.SearchMemosRequest_SEMANTIC:
+			hit.score = hit.semanticScore
+		default:
+			hit.score = reciprocalRankFusion(hit.keywordRank, hit.semanticRank)
+		}
+		hits = append(hits, hit)
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].score == hits[j].score {
+			return hits[i].memoID > hits[j].memoID
+		}
+		return hits[i].score > hits[j].score
+	})
+	if len(hits) > depth {
+		hits = hits[:depth]
+	}
+	if offset >= len(hits) {
+		return &v1pb.SearchMemosResponse{
+			Results:       []*v1pb.SearchMemosResponse_Result{},
+			NextPageToken: "",
+		}, nil
+	}
+
+	end := min(offset+limit, len(hits))
+	memoIDList := make([]int32, 0, end-offset)
+	for _, hit := range hits[offset:end] {
+		memoIDList = append(memoIDList, hit.memoID)
+	}
+	selectedMemos, err := s.listMemosInOrder(ctx, memoIDList)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list search results: %v", err)
+	}
+	memoMessages, err := s.convertMemoListToMessages(ctx, selectedMemos)
+	if err != nil {
+		return nil, err
+	}
+	chunkMap, err := s.listSemanticChunks(ctx, hitMap, memoIDList)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list matched passages: %v", err)
+	}
+
+	results := make([]*v1pb.SearchMemosResponse_Result, 0, len(selectedMemos))
+	for i, memo := range selectedMemos {
+		hit := hitMap[memo.ID]
+		passage := ""
+		if chunk, ok := chunkMap[memo.ID]; ok {
+			passage = memoPassage(memo.Content, chunk)
+		}
+		snippetSource := memo.Content
+		if len(hit.matchedTerms) == 0 && passage != "" {
+			snippetSource = passage
+		}
+		snippet, err := s.MarkdownService.GenerateMatchSnippet([]byte(snippetSource), hit.matchedTerms, searchSnippetLength)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate snippet: %v", err)
+		}
+		results = append(results, &v1pb.SearchMemosResponse_Result{
+			Memo:          memoMessages[i],
+			Score:         hit.score,
+			KeywordRank:   int32(hit.keywordRank),
+			KeywordScore:  hit.keywordScore,
+			SemanticRank:  int32(hit.semanticRank),
+			SemanticScore: hit.semanticScore,
+			MatchedTerms:  hit.matchedTerms,
+			Snippet:       snippet,
+			Passage:       passage,
+		})
+	}
+
+	nextPageToken := ""
+	if end < len(hits) {
+		nextPageToken, err = getPageToken(limit, end)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token: %v", err)
+		}
+	}
+	return &v1pb.SearchMemosResponse{
+		Results:       results,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// searchMemosByKeyword ranks the memos containing any of the terms with BM25.
+// Document frequencies are taken over the matching memos, which is enough to weight rare terms higher.
+func (s *APIV1Service) searchMemosByKeyword(ctx context.Context, memoFind *store.FindMemo, terms []string) ([]*searchHit, error) {
+	if len(terms) == 0 {
+		return []*searchHit{}, nil
+	}
+
+	conditions := make([]string, 0, len(terms))
+	for _, term := range terms {
+		conditions = append(conditions, fmt.Sprintf("content.contains(%s)", strconv.Quote(term)))
+	}
+	find := *memoFind
+	find.Filters = append(slices.Clone(memoFind.Filters), strings.Join(conditions, " || "))
+
+	type document struct {
+		memoID int32
+		length int
+		tf     []int
+	}
+	documents := []*document{}
+	documentFrequency := make([]int, len(terms))
+	totalLength := 0
+	// Every matching memo is scored, a page at a time, so that older memos are not cut off before ranking.
+	for offset := 0; ; offset += searchKeywordPageSize {
+		limit, pageOffset := searchKeywordPageSize, offset
+		find.Limit, find.Offset = &limit, &pageOffset
+		memos, err := s.Store.ListMemos(ctx, &find)
+		if err != nil {
+			return nil, err
+		}
+		for _, memo := range memos {
+			content := strings.ToLower(memo.Content)
+			doc := &document{
+				memoID: memo.ID,
+				length: utf8.RuneCountInString(content),
+				tf:     make([]int, len(terms)),
+			}
+			matched := false
+			for i, term := range terms {
+				doc.tf[i] = strings.Count(content, term)
+				if doc.tf[i] > 0 {
+					documentFrequency[i]++
+					matched = true
+				}
+			}
+			if !matched {
+				continue
+			}
+			documents = append(documents, doc)
+			totalLength += doc.length
+		}
+		if len(memos) < searchKeywordPageSize {
+			break
+		}
+	}
+	if len(documents) == 0 {
+		return []*searchHit{}, nil
+	}
+
+	n := float64(len(documents))
+	averageLength := float64(totalLength) / n
+	hits := make([]*searchHit, 0, len(documents))
+	for _, doc := range documents {
+		hit := &searchHit{memoID: doc.memoID}
+		for i, term := range terms {
+			if doc.tf[i] == 0 {
+				continue
+			}
+			df := float64(documentFrequency[i])
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			tf := float64(doc.tf[i])
+			norm := 1 - bm25B + bm25B*float64(doc.length)/averageLength
+			hit.keywordScore += idf * tf * (bm25K1 + 1) / (tf + bm25K1*norm)
+			hit.matchedTerms = append(hit.matchedTerms, term)
+		}
+		hits = append(hits, hit)
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].keywordScore == hits[j].keywordScore {
+			return hits[i].memoID > hits[j].memoID
+		}
+		return hits[i].keywordScore > hits[j].keywordScore
+	})
+	return hits, nil
+}
+
+// searchMemosBySemantic ranks the memos by embedding similarity to the query.
+// It returns a FailedPrecondition error when semantic search is not configured.
+func (s *APIV1Service) searchMemosBySemantic(ctx context.Context, memoFind *store.FindMemo, query string, limit int) ([]*store.VectorMatch, error) {
+	embeddingClient, err := s.getSemanticEmbeddingClient(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "semantic search is not configured: %v", err)
+	}
+	queryEmbedding, err := embeddingClient.Embed(withEmbeddingTask(ctx, embeddingTaskQuery), query)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate query embedding: %v", err)
+	}
+	matches, err := s.Store.SearchMemoEmbeddings(ctx, &store.SearchMemoEmbedding{
+		Vector:   queryEmbedding,
+		MemoFind: memoFind,
+		Limit:    limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search semantic embeddings: %v", err)
+	}
+	return matches, nil
+}
+
+// listSemanticChunks returns the best matching chunk of each semantically matched memo, keyed by memo id.
+func (s *APIV1Service) listSemanticChunks(ctx context.Context, hitMap map[int32]*searchHit, memoIDList []int32) (map[int32]*store.MemoEmbeddingChunk, error) {
+	matchedIDList := make([]int32, 0, len(memoIDList))
+	for _, memoID := range memoIDList {
+		if hitMap[memoID].semanticRank > 0 {
+			matchedIDList = append(matchedIDList, memoID)
+		}
+	}
+	chunkMap := make(map[int32]*store.MemoEmbeddingChunk, len(matchedIDList))
+	if len(matchedIDList) == 0 {
+		return chunkMap, nil
+	}
+	chunks, err := s.Store.ListMemoEmbeddingChunks(ctx, &store.FindMemoEmbeddingChunk{MemoIDList: matchedIDList})
+	if err != nil {
+		return nil, err
+	}
+	for _, chunk := range chunks {
+		if chunk.ChunkIndex == hitMap[chunk.MemoID].semanticChunk {
+			chunkMap[chunk.MemoID] = chunk
+		}
+	}
+	return chunkMap, nil
+}
+
+// searchTerms splits a query into unique lower-cased terms.
+func searchTerms(query string) []string {
+	terms := []string{}
+	for _, field := range strings.Fields(strings.ToLower(query)) {
+		if !slices.Contains(terms, field) {
+			terms = append(terms, field)
+		}
+	}
+	return terms
+}
+
+// reciprocalRankFusion combines 1-based ranks from several rankings; a rank of 0 means not ranked.
+func reciprocalRankFusion(ranks ...int) float64 {
+	var score float64
+	for _, rank := range ranks {
+		if rank > 0 {
+			score += 1 / float64(searchRRFConstant+rank)
+		}
+	}
+	return score
+}
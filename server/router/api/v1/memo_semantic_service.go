@@ -4,29 +4,33 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
 	"log/slog"
 	"math"
-	"sort"
 	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
-const semanticSearchBatchSize = 2000
+const (
+	semanticSearchBatchSize = 2000
+	// semanticChunkMaxLength is the maximum length of an embedded passage, in characters.
+	semanticChunkMaxLength = 1000
+)
 
 func (s *APIV1Service) SearchMemosSemantic(ctx context.Context, request *v1pb.SearchMemosSemanticRequest) (*v1pb.ListMemosResponse, error) {
 	query := strings.TrimSpace(request.Query)
 	if query == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "query is required")
 	}
-	if !s.semanticStorageEnabled() {
-		return nil, status.Errorf(codes.FailedPrecondition, "semantic search only supports postgres driver")
-	}
 
 	embeddingClient, err := s.getSemanticEmbeddingClient(ctx)
 	if err != nil {
@@ -37,90 +41,93 @@ func (s *APIV1Service) SearchMemosSemantic(ctx context.Context, request *v1pb.Se
 		return nil, status.Errorf(codes.Internal, "failed to generate query embedding: %v", err)
 	}
 
-	memoFind := &store.FindMemo{
-		ExcludeComments: true,
-	}
-	if request.State == v1pb.State_ARCHIVED {
-		state := store.Archived
-		memoFind.RowStatus = &state
-	} else {
-		state := store.Normal
-		memoFind.RowStatus = &state
-	}
-	if request.Filter != "" {
-		if err := s.validateFilter(ctx, request.Filter); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
-		}
-		memoFind.Filters = append(memoFind.Filters, request.Filter)
+	memoFind, err := s.buildMemoSearchFind(ctx, request.State, request.Filter)
+	if err != nil {
+		return nil, err
 	}
-	if err := s.applyMemoVisibilityFilter(ctx, memoFind); err != nil {
+	limit, offset, err := parseSearchPageToken(request.PageSize, request.PageToken)
+	if err != nil {
 		return nil, err
 	}
 
-	memos, err := s.listMemosForSemanticSearch(ctx, memoFind)
+	// Fetch one extra match to know whether there is a next page.
+	matches, err := s.Store.SearchMemoEmbeddings(ctx, &store.SearchMemoEmbedding{
+		Vector:   queryEmbedding,
+		MemoFind: memoFind,
+		Limit:    offset + limit + 1,
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list semantic candidates: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to search semantic embeddings: %v", err)
 	}
-	if len(memos) == 0 {
+	if offset >= len(matches) {
 		return &v1pb.ListMemosResponse{
 			Memos:         []*v1pb.Memo{},
 			NextPageToken: "",
 		}, nil
 	}
 
-	memoIDList := make([]int32, 0, len(memos))
-	for _, memo := range memos {
-		memoIDList = append(memoIDList, memo.ID)
+	end := min(offset+limit, len(matches))
+	memoIDList := make([]int32, 0, end-offset)
+	for _, match := range matches[offset:end] {
+		memoIDList = append(memoIDList, match.MemoID)
 	}
-	embeddingMap, err := s.Store.ListMemoEmbeddingsByMemoIDs(ctx, memoIDList)
+	selectedMemos, err := s.listMemosInOrder(ctx, memoIDList)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to load semantic embeddings: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list semantic matches: %v", err)
 	}
-
-	type scoredMemo struct {
-		memo  *store.Memo
-		score float64
+	memoMessages, err := s.convertMemoListToMessages(ctx, selectedMemos)
+	if err != nil {
+		return nil, err
 	}
-	scoredMemos := make([]scoredMemo, 0, len(memos))
-	for _, memo := range memos {
-		embedding, ok := embeddingMap[memo.ID]
-		if !ok {
-			continue
-		}
-		score, ok := cosineSimilarity(queryEmbedding, embedding)
-		if !ok {
-			continue
+
+	nextPageToken := ""
+	if end < len(matches) {
+		nextPageToken, err = getPageToken(limit, end)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token: %v", err)
 		}
-		scoredMemos = append(scoredMemos, scoredMemo{
-			memo:  memo,
-			score: score,
-		})
-	}
-	if len(scoredMemos) == 0 {
-		return &v1pb.ListMemosResponse{
-			Memos:         []*v1pb.Memo{},
-			NextPageToken: "",
-		}, nil
 	}
+	return &v1pb.ListMemosResponse{
+		Memos:         memoMessages,
+		NextPageToken: nextPageToken,
+	}, nil
+}
 
-	sort.Slice(scoredMemos, func(i, j int) bool {
-		if scoredMemos[i].score == scoredMemos[j].score {
-			if scoredMemos[i].memo.UpdatedTs == scoredMemos[j].memo.UpdatedTs {
-				return scoredMemos[i].memo.ID > scoredMemos[j].memo.ID
-			}
-			return scoredMemos[i].memo.UpdatedTs > scoredMemos[j].memo.UpdatedTs
+// buildMemoSearchFind builds the memo condition shared by the search methods:
+// top-level memos in the given state that match the filter and are visible to the current user.
+func (s *APIV1Service) buildMemoSearchFind(ctx context.Context, state v1pb.State, filter string) (*store.FindMemo, error) {
+	memoFind := &store.FindMemo{
+		ExcludeComments: true,
+	}
+	if state == v1pb.State_ARCHIVED {
+		rowStatus := store.Archived
+		memoFind.RowStatus = &rowStatus
+	} else {
+		rowStatus := store.Normal
+		memoFind.RowStatus = &rowStatus
+	}
+	if filter != "" {
+		if err := s.validateFilter(ctx, filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 		}
-		return scoredMemos[i].score > scoredMemos[j].score
-	})
+		memoFind.Filters = append(memoFind.Filters, filter)
+	}
+	if err := s.applyMemoVisibilityFilter(ctx, memoFind); err != nil {
+		return nil, err
+	}
+	return memoFind, nil
+}
 
-	limit, offset := int(request.PageSize), 0
-	if request.PageToken != "" {
-		var pageToken v1pb.PageToken
-		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+// parseSearchPageToken returns the page limit and offset of a search request.
+func parseSearchPageToken(pageSize int32, pageToken string) (int, int, error) {
+	limit, offset := int(pageSize), 0
+	if pageToken != "" {
+		var token v1pb.PageToken
+		if err := unmarshalPageToken(pageToken, &token); err != nil {
+			return 0, 0, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
 		}
-		limit = int(pageToken.Limit)
-		offset = int(pageToken.Offset)
+		limit = int(token.Limit)
+		offset = int(token.Offset)
 	}
 	if limit <= 0 {
 		limit = DefaultPageSize
@@ -128,38 +135,30 @@ func (s *APIV1Service) SearchMemosSemantic(ctx context.Context, request *v1pb.Se
 	if limit > MaxPageSize {
 		limit = MaxPageSize
 	}
-	if offset >= len(scoredMemos) {
-		return &v1pb.ListMemosResponse{
-			Memos:         []*v1pb.Memo{},
-			NextPageToken: "",
-		}, nil
-	}
+	return limit, offset, nil
+}
 
-	end := offset + limit
-	if end > len(scoredMemos) {
-		end = len(scoredMemos)
-	}
-	selectedMemos := make([]*store.Memo, 0, end-offset)
-	for _, item := range scoredMemos[offset:end] {
-		selectedMemos = append(selectedMemos, item.memo)
+// listMemosInOrder loads the memos with the given ids, keeping the order of the ids.
+// Memos that no longer exist are skipped.
+func (s *APIV1Service) listMemosInOrder(ctx context.Context, memoIDList []int32) ([]*store.Memo, error) {
+	if len(memoIDList) == 0 {
+		return []*store.Memo{}, nil
 	}
-
-	memoMessages, err := s.convertMemoListToMessages(ctx, selectedMemos)
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{IDList: memoIDList})
 	if err != nil {
 		return nil, err
 	}
-
-	nextPageToken := ""
-	if end < len(scoredMemos) {
-		nextPageToken, err = getPageToken(limit, end)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get next page token: %v", err)
+	memoMap := make(map[int32]*store.Memo, len(memos))
+	for _, memo := range memos {
+		memoMap[memo.ID] = memo
+	}
+	selectedMemos := make([]*store.Memo, 0, len(memoIDList))
+	for _, memoID := range memoIDList {
+		if memo, ok := memoMap[memoID]; ok {
+			selectedMemos = append(selectedMemos, memo)
 		}
 	}
-	return &v1pb.ListMemosResponse{
-		Memos:         memoMessages,
-		NextPageToken: nextPageToken,
-	}, nil
+	return selectedMemos, nil
 }
 
 func (s *APIV1Service) listMemosForSemanticSearch(ctx context.Context, base *store.FindMemo) ([]*store.Memo, error) {
@@ -187,33 +186,7 @@ func (s *APIV1Service) listMemosForSemanticSearch(ctx context.Context, base *sto
 	return allMemos, nil
 }
 
-func cosineSimilarity(a, b []float64) (float64, bool) {
-	if len(a) == 0 || len(b) == 0 || len(a) != len(b) {
-		return 0, false
-	}
-
-	var dotProduct float64
-	var normA float64
-	var normB float64
-	for i := range a {
-		dotProduct += a[i] * b[i]
-		normA += a[i] * a[i]
-		normB += b[i] * b[i]
-	}
-	if normA == 0 || normB == 0 {
-		return 0, false
-	}
-	return dotProduct / (math.Sqrt(normA) * math.Sqrt(normB)), true
-}
-
-func (s *APIV1Service) semanticStorageEnabled() bool {
-	return s.Profile != nil && strings.EqualFold(s.Profile.Driver, "postgres")
-}
-
 func (s *APIV1Service) semanticIndexingEnabled() bool {
-	if !s.semanticStorageEnabled() {
-		return false
-	}
 	_, err := s.getSemanticEmbeddingClient(context.Background())
 	return err == nil
 }
@@ -223,42 +196,54 @@ func (s *APIV1Service) scheduleMemoEmbeddingSync(memoID int32, content string) {
 		return
 	}
 
-	content = strings.TrimSpace(content)
-	if content == "" {
+	// The content is embedded untrimmed so that chunk offsets match the stored memo content.
+	if strings.TrimSpace(content) == "" {
 		return
 	}
 
-	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 45*time.Second)
-		defer cancel()
-
-		embeddingSemaphore := s.getEmbeddingSemaphore()
-		if embeddingSemaphore != nil {
-			if err := embeddingSemaphore.Acquire(ctx, 1); err != nil {
-				slog.Warn("failed to acquire embedding semaphore", "memoID", memoID, "error", err)
-				return
-			}
-			defer embeddingSemaphore.Release(1)
-		}
+	if _, err := s.enqueueJob(context.Background(), store.JobTypeMemoEmbedding, fmt.Sprintf("%s%d", MemoNamePrefix, memoID), &storepb.JobPayload{
+		Payload: &storepb.JobPayload_MemoEmbedding_{MemoEmbedding: &storepb.JobPayload_MemoEmbedding{MemoId: memoID}},
+	}); err != nil {
+		slog.Warn("failed to enqueue memo embedding refresh", "memoID", memoID, "error", err)
+	}
+}
 
-		if err := s.refreshMemoEmbedding(ctx, memoID, content); err != nil {
-			slog.Warn("failed to refresh memo embedding", "memoID", memoID, "error", err)
-		}
-	}()
+// embedSavedMemo embeds a created or updated memo. An in process client embeds it right away, so that its possible
+// duplicates are found with its embedding. A remote client embeds it in the background, so that saving the memo does not
+// wait on the network, and its duplicates are found by content hash until its embedding is refreshed.
+func (s *APIV1Service) embedSavedMemo(ctx context.Context, memoID int32, content string) {
+	embeddingClient, err := s.getSemanticEmbeddingClient(ctx)
+	if err != nil {
+		return
+	}
+	if inProcessClient, ok := embeddingClient.(InProcessEmbeddingClient); ok && inProcessClient.InProcess() {
+		s.syncMemoEmbedding(ctx, memoID, content, duplicateEmbeddingTimeout)
+		return
+	}
+	s.scheduleMemoEmbeddingSync(memoID, content)
 }
 
-func (s *APIV1Service) scheduleMemoEmbeddingDelete(memoID int32) {
-	if !s.semanticStorageEnabled() {
+// syncMemoEmbedding embeds the memo content before returning, so that the caller can search with it.
+// The embedding falls back to the background sync when it fails or takes longer than the timeout.
+func (s *APIV1Service) syncMemoEmbedding(ctx context.Context, memoID int32, content string, timeout time.Duration) {
+	if !s.semanticIndexingEnabled() || strings.TrimSpace(content) == "" {
 		return
 	}
 
-	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		defer cancel()
-		if err := s.Store.DeleteMemoEmbeddingByMemoID(ctx, memoID); err != nil {
-			slog.Warn("failed to delete memo embedding", "memoID", memoID, "error", err)
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+	defer cancel()
+	embeddingSemaphore := s.getEmbeddingSemaphore()
+	if embeddingSemaphore != nil {
+		if err := embeddingSemaphore.Acquire(ctx, 1); err != nil {
+			s.scheduleMemoEmbeddingSync(memoID, content)
+			return
 		}
-	}()
+		defer embeddingSemaphore.Release(1)
+	}
+	if err := s.refreshMemoEmbedding(ctx, memoID, content); err != nil {
+		slog.Warn("failed to refresh memo embedding, retrying in background", "memoID", memoID, "error", err)
+		s.scheduleMemoEmbeddingSync(memoID, content)
+	}
 }
 
 func (s *APIV1Service) refreshMemoEmbedding(ctx context.Context, memoID int32, content string) error {
@@ -271,8 +256,7 @@ func (s *APIV1Service) refreshMemoEmbeddingWithOptions(ctx context.Context, memo
 		return err
 	}
 
-	contentHashBytes := sha256.Sum256([]byte(content))
-	contentHash := hex.EncodeToString(contentHashBytes[:])
+	contentHash := memoEmbeddingContentHash(content)
 
 	existingHash, err := s.Store.GetMemoEmbeddingContentHash(ctx, memoID)
 	if err != nil {
@@ -282,16 +266,97 @@ func (s *APIV1Service) refreshMemoEmbeddingWithOptions(ctx context.Context, memo
 		return nil
 	}
 
-	embedding, err := embeddingClient.Embed(withEmbeddingTask(ctx, embeddingTaskPassage), content)
+	chunks, err := s.MarkdownService.SplitChunks([]byte(content), semanticChunkMaxLength)
 	if err != nil {
 		return err
 	}
+	if len(chunks) <= 1 {
+		embedding, err := embeddingClient.Embed(withEmbeddingTask(ctx, embeddingTaskPassage), content)
+		if err != nil {
+			return err
+		}
+		return s.Store.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+			MemoID:      memoID,
+			Model:       embeddingClient.Model(),
+			Dimension:   int32(len(embedding)),
+			Embedding:   embedding,
+			ContentHash: contentHash,
+		})
+	}
 
+	// Long memos are embedded passage by passage; the memo embedding is the mean of the passages.
+	embeddingChunks := make([]*store.MemoEmbeddingChunk, 0, len(chunks))
+	vectors := make([][]float64, 0, len(chunks))
+	for _, chunk := range chunks {
+		vector, err := embeddingClient.Embed(withEmbeddingTask(ctx, embeddingTaskPassage), content[chunk.Start:chunk.End])
+		if err != nil {
+			return err
+		}
+		embeddingChunks = append(embeddingChunks, &store.MemoEmbeddingChunk{
+			StartOffset: int32(chunk.Start),
+			EndOffset:   int32(chunk.End),
+			Embedding:   vector,
+		})
+		vectors = append(vectors, vector)
+	}
+	embedding, err := meanVector(vectors)
+	if err != nil {
+		return err
+	}
 	return s.Store.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
 		MemoID:      memoID,
 		Model:       embeddingClient.Model(),
 		Dimension:   int32(len(embedding)),
 		Embedding:   embedding,
 		ContentHash: contentHash,
+		Chunks:      embeddingChunks,
 	})
 }
+
+// memoEmbeddingContentHash returns the hash of the embedded content, used to skip refreshing unchanged memos.
+func memoEmbeddingContentHash(content string) string {
+	hash := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(hash[:])
+}
+
+// meanVector returns the average of unit-normalized vectors, so that every passage weighs the same.
+func meanVector(vectors [][]float64) ([]float64, error) {
+	mean := make([]float64, len(vectors[0]))
+	for _, vector := range vectors {
+		if len(vector) != len(mean) {
+			return nil, errors.Errorf("embedding dimension mismatch: %d != %d", len(vector), len(mean))
+		}
+		var norm float64
+		for _, value := range vector {
+			norm += value * value
+		}
+		if norm == 0 {
+			continue
+		}
+		norm = math.Sqrt(norm)
+		for i, value := range vector {
+			mean[i] += value / norm
+		}
+	}
+	for i := range mean {
+		mean[i] /= float64(len(vectors))
+	}
+	return mean, nil
+}
+
+// memoPassage returns the passage of the content covered by a chunk.
+// Offsets that no longer fit the content fall back to the whole content, and offsets that fall inside a character
+// of content edited since it was embedded are moved to the next character boundary.
+func memoPassage(content string, chunk *store.MemoEmbeddingChunk) string {
+	start, end := int(chunk.StartOffset), int(chunk.EndOffset)
+	if end == 0 || end > len(content) || start > end {
+		return content
+	}
+	for start < end && !utf8.RuneStart(content[start]) {
+		start++
+	}
+	for end < len(content) && !utf8.RuneStart(content[end]) {
+		end++
+	}
+	return content[start:end]
+}
@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoPassageKeepsCharactersWhole(t *testing.T) {
+	content := "旅行の準備\n荷物リスト"
+	tests := []struct {
+		start, end int32
+		want       string
+	}{
+		{start: 0, end: 15, want: "旅行の準備"},
+		// Offsets of content edited since it was embedded can fall inside a character.
+		{start: 1, end: 14, want: "行の準備"},
+		{start: 16, end: 20, want: "荷物"},
+		{start: 0, end: 100, want: content},
+		{start: 0, end: 0, want: content},
+	}
+	for _, test := range tests {
+		passage := memoPassage(content, &store.MemoEmbeddingChunk{StartOffset: test.start, EndOffset: test.end})
+		if !utf8.ValidString(passage) {
+			t.Fatalf("passage %d-%d is not valid UTF-8: %q", test.start, test.end, passage)
+		}
+		if passage != test.want {
+			t.Fatalf("passage %d-%d = %q, want %q", test.start, test.end, passage, test.want)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
@@ -16,18 +17,29 @@ import (
 	"github.com/usememos/memos/internal/base"
 	"github.com/usememos/memos/plugin/webhook"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
-	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/server/runner/memopayload"
 	"github.com/usememos/memos/store"
 )
 
 func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoRequest) (*v1pb.Memo, error) {
+	memoMessage, memo, err := s.createMemo(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	publishEvent(ctx, s, MemoCreated{Memo: memo, Message: memoMessage})
+	s.setPossibleDuplicateMemos(ctx, memo, memoMessage)
+	return memoMessage, nil
+}
+
+// createMemo creates a memo without publishing its MemoCreated event.
+// Comments are published once they are related to the commented memo, so that mentions are checked against its visibility.
+func (s *APIV1Service) createMemo(ctx context.Context, request *v1pb.CreateMemoRequest) (*v1pb.Memo, *store.Memo, error) {
 	user, err := s.fetchCurrentUser(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get user")
+		return nil, nil, status.Errorf(codes.Internal, "failed to get user")
 	}
 	if user == nil {
-		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+		return nil, nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
 
 	// Use custom memo_id if provided, otherwise generate a new UUID
@@ -36,7 +48,7 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 		memoUID = shortuuid.New()
 	} else if !base.UIDMatcher.MatchString(memoUID) {
 		// Validate custom memo ID format
-		return nil, status.Errorf(codes.InvalidArgument, "invalid memo_id format: must be 1-32 characters, alphanumeric and hyphens only, cannot start or end with hyphen")
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid memo_id format: must be 1-32 characters, alphanumeric and hyphens only, cannot start or end with hyphen")
 	}
 
 	create := &store.Memo{
@@ -48,7 +60,7 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 
 	instanceMemoRelatedSetting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get instance memo related setting")
+		return nil, nil, status.Errorf(codes.Internal, "failed to get instance memo related setting")
 	}
 
 	// Handle display_time first: if provided, use it to set the appropriate timestamp
@@ -75,21 +87,40 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	}
 
 	if instanceMemoRelatedSetting.DisallowPublicVisibility && create.Visibility == store.Public {
-		return nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
+		return nil, nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
 	}
 	contentLengthLimit, err := s.getContentLengthLimit(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get content length limit")
+		return nil, nil, status.Errorf(codes.Internal, "failed to get content length limit")
 	}
 	if len(create.Content) > contentLengthLimit {
-		return nil, status.Errorf(codes.InvalidArgument, "content too long (max %d characters)", contentLengthLimit)
+		return nil, nil, status.Errorf(codes.InvalidArgument, "content too long (max %d characters)", contentLengthLimit)
 	}
 	if err := memopayload.RebuildMemoPayload(create, s.MarkdownService); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
+		return nil, nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
 	}
 	if request.Memo.Location != nil {
 		create.Payload.Location = convertLocationToStore(request.Memo.Location)
 	}
+	if request.Memo.RemindTime != nil {
+		create.Payload.RemindTs = request.Memo.RemindTime.AsTime().Unix()
+	}
+	if request.Memo.PublishTime != nil {
+		publishTime := request.Memo.PublishTime.AsTime()
+		if !publishTime.After(time.Now()) {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "publish_time must be in the future")
+		}
+		publishVisibility := request.Memo.PublishVisibility
+		if publishVisibility == v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+			publishVisibility = request.Memo.Visibility
+		}
+		if err := s.setMemoPublishVisibility(ctx, create.Payload, publishVisibility); err != nil {
+			return nil, nil, err
+		}
+		create.Payload.PublishTs = publishTime.Unix()
+		// Scheduled memos stay private until they are published.
+		create.Visibility = store.Private
+	}
 
 	memo, err := s.Store.CreateMemo(ctx, create)
 	if err != nil {
@@ -98,11 +129,10 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 		if strings.Contains(errMsg, "UNIQUE constraint failed") ||
 			strings.Contains(errMsg, "duplicate key") ||
 			strings.Contains(errMsg, "Duplicate entry") {
-			return nil, status.Errorf(codes.AlreadyExists, "memo with ID %q already exists", memoUID)
+			return nil, nil, status.Errorf(codes.AlreadyExists, "memo with ID %q already exists", memoUID)
 		}
-		return nil, err
+		return nil, nil, err
 	}
-	s.scheduleMemoEmbeddingSync(memo.ID, memo.Content)
 
 	attachments := []*store.Attachment{}
 
@@ -112,14 +142,14 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 			Attachments: request.Memo.Attachments,
 		})
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to set memo attachments")
+			return nil, nil, errors.Wrap(err, "failed to set memo attachments")
 		}
 
 		a, err := s.Store.ListAttachments(ctx, &store.FindAttachment{
 			MemoID: &memo.ID,
 		})
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to get memo attachments")
+			return nil, nil, errors.Wrap(err, "failed to get memo attachments")
 		}
 		attachments = a
 	}
@@ -129,20 +159,26 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 			Relations: request.Memo.Relations,
 		})
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to set memo relations")
+			return nil, nil, errors.Wrap(err, "failed to set memo relations")
 		}
 	}
 
 	memoMessage, err := s.convertMemoFromStore(ctx, memo, nil, attachments)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to convert memo")
-	}
-	// Try to dispatch webhook when memo is created.
-	if err := s.DispatchMemoCreatedWebhook(ctx, memoMessage); err != nil {
-		slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
+		return nil, nil, errors.Wrap(err, "failed to convert memo")
 	}
+	return memoMessage, memo, nil
+}
 
-	return memoMessage, nil
+// setPossibleDuplicateMemos sets the possible duplicates of the memo, once its MemoCreated or MemoUpdated event embedded it.
+// The duplicates are only a hint and the memo is already saved, so a failed lookup leaves them empty.
+func (s *APIV1Service) setPossibleDuplicateMemos(ctx context.Context, memo *store.Memo, memoMessage *v1pb.Memo) {
+	possibleDuplicates, err := s.findPossibleDuplicateMemos(ctx, memo)
+	if err != nil {
+		slog.Warn("failed to find possible duplicates", "memoID", memo.ID, "error", err)
+		return
+	}
+	memoMessage.PossibleDuplicates = possibleDuplicates
 }
 
 func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosRequest) (*v1pb.ListMemosResponse, error) {
@@ -318,6 +354,7 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	update := &store.UpdateMemo{
 		ID: memo.ID,
 	}
+	previousMemo := snapshotMemo(memo)
 	contentUpdated := false
 	for _, path := range request.UpdateMask.Paths {
 		if path == "content" {
@@ -374,6 +411,38 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 			payload := memo.Payload
 			payload.Location = convertLocationToStore(request.Memo.Location)
 			update.Payload = payload
+		} else if path == "remind_time" {
+			memo.Payload.RemindTs = 0
+			if request.Memo.RemindTime != nil {
+				memo.Payload.RemindTs = request.Memo.RemindTime.AsTime().Unix()
+			}
+			update.Payload = memo.Payload
+		} else if path == "publish_time" {
+			if request.Memo.PublishTime == nil {
+				// Clearing the publish time cancels the publication, the memo stays private.
+				memo.Payload.PublishTs = 0
+				memo.Payload.PublishVisibility = ""
+			} else {
+				publishTime := request.Memo.PublishTime.AsTime()
+				if !publishTime.After(time.Now()) {
+					return nil, status.Errorf(codes.InvalidArgument, "publish_time must be in the future")
+				}
+				memo.Payload.PublishTs = publishTime.Unix()
+				// Shared memos are published again with their current visibility by default.
+				visibility := memo.Visibility
+				if update.Visibility != nil {
+					visibility = *update.Visibility
+				}
+				if memo.Payload.PublishVisibility == "" && visibility != store.Private {
+					memo.Payload.PublishVisibility = visibility.String()
+				}
+			}
+			update.Payload = memo.Payload
+		} else if path == "publish_visibility" {
+			if err := s.setMemoPublishVisibility(ctx, memo.Payload, request.Memo.PublishVisibility); err != nil {
+				return nil, err
+			}
+			update.Payload = memo.Payload
 		} else if path == "attachments" {
 			_, err := s.SetMemoAttachments(ctx, &v1pb.SetMemoAttachmentsRequest{
 				Name:        request.Memo.Name,
@@ -393,12 +462,21 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 		}
 	}
 
+	if memo.Payload.GetPublishTs() > 0 {
+		if memo.Payload.PublishVisibility == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "publish_visibility is required")
+		}
+		// Scheduled memos stay private until they are published.
+		visibility := store.Private
+		update.Visibility = &visibility
+	}
+
+	if err := s.recordMemoRevision(ctx, previousMemo, update); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record memo revision: %v", err)
+	}
 	if err = s.Store.UpdateMemo(ctx, update); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update memo")
 	}
-	if contentUpdated {
-		s.scheduleMemoEmbeddingSync(memo.ID, memo.Content)
-	}
 
 	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{
 		ID: &memo.ID,
@@ -423,9 +501,9 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to convert memo")
 	}
-	// Try to dispatch webhook when memo is updated.
-	if err := s.DispatchMemoUpdatedWebhook(ctx, memoMessage); err != nil {
-		slog.Warn("Failed to dispatch memo updated webhook", slog.Any("err", err))
+	publishEvent(ctx, s, MemoUpdated{Previous: previousMemo, Memo: memo, Message: memoMessage})
+	if contentUpdated {
+		s.setPossibleDuplicateMemos(ctx, memo, memoMessage)
 	}
 
 	return memoMessage, nil
@@ -472,13 +550,15 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 		return nil, status.Errorf(codes.Internal, "failed to list attachments")
 	}
 
-	if memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments); err == nil {
-		// Try to dispatch webhook when memo is deleted.
-		if err := s.DispatchMemoDeletedWebhook(ctx, memoMessage); err != nil {
-			slog.Warn("Failed to dispatch memo deleted webhook", slog.Any("err", err))
-		}
+	// The memo is converted before it is deleted, for its MemoDeleted event.
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memo")
 	}
 
+	// A deleted memo no longer matches any filter in the database, so the webhook filters are matched beforehand.
+	webhookFilters := s.matchMemoWebhookFilters(ctx, memo.CreatorID, memoMessage, webhook.ActivityTypeMemoDeleted)
+
 	// Delete memo comments first (store.DeleteMemo handles their relations and attachments)
 	commentType := store.MemoRelationComment
 	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &memo.ID, Type: &commentType})
@@ -491,11 +571,11 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 		}
 	}
 
-	// Delete the memo (store.DeleteMemo handles relation and attachment cleanup)
+	// Delete the memo (store.DeleteMemo handles relation, attachment and embedding cleanup)
 	if err = s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete memo")
 	}
-	s.scheduleMemoEmbeddingDelete(memo.ID)
+	publishEvent(ctx, s, MemoDeleted{Memo: memo, Message: memoMessage, WebhookFilters: webhookFilters})
 
 	return &emptypb.Empty{}, nil
 }
@@ -526,21 +606,13 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 	}
 
 	// Create the memo comment first.
-	memoComment, err := s.CreateMemo(ctx, &v1pb.CreateMemoRequest{
+	memoComment, memo, err := s.createMemo(ctx, &v1pb.CreateMemoRequest{
 		Memo:   request.Comment,
 		MemoId: request.CommentId,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create memo")
 	}
-	memoUID, err = ExtractMemoUIDFromName(memoComment.Name)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
-	}
-	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get memo")
-	}
 
 	// Build the relation between the comment memo and the original memo.
 	_, err = s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
@@ -551,37 +623,13 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create memo relation")
 	}
-	creatorID, err := ExtractUserIDFromName(memoComment.Creator)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid memo creator")
-	}
-	if memoComment.Visibility != v1pb.Visibility_PRIVATE && creatorID != relatedMemo.CreatorID {
-		activity, err := s.Store.CreateActivity(ctx, &store.Activity{
-			CreatorID: creatorID,
-			Type:      store.ActivityTypeMemoComment,
-			Level:     store.ActivityLevelInfo,
-			Payload: &storepb.ActivityPayload{
-				MemoComment: &storepb.ActivityMemoCommentPayload{
-					MemoId:        memo.ID,
-					RelatedMemoId: relatedMemo.ID,
-				},
-			},
-		})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to create activity")
-		}
-		if _, err := s.Store.CreateInbox(ctx, &store.Inbox{
-			SenderID:   creatorID,
-			ReceiverID: relatedMemo.CreatorID,
-			Status:     store.UNREAD,
-			Message: &storepb.InboxMessage{
-				Type:       storepb.InboxMessage_MEMO_COMMENT,
-				ActivityId: &activity.ID,
-			},
-		}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to create inbox")
-		}
+	publishEvent(ctx, s, MemoCreated{Memo: memo, Message: memoComment})
+	relatedMemoMessage, err := s.convertMemoFromStore(ctx, relatedMemo, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memo")
 	}
+	publishEvent(ctx, s, MemoCommentCreated{Memo: relatedMemo, Message: relatedMemoMessage, Comment: memo, CommentMessage: memoComment})
+	s.setPossibleDuplicateMemos(ctx, memo, memoComment)
 
 	return memoComment, nil
 }
@@ -680,6 +728,48 @@ func (s *APIV1Service) ListMemoComments(ctx context.Context, request *v1pb.ListM
 	return response, nil
 }
 
+// getMemoMessage returns the memo message with its reactions and attachments.
+func (s *APIV1Service) getMemoMessage(ctx context.Context, memoID int32) (*v1pb.Memo, error) {
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	memoName := fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentID: &memoName})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list reactions")
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list attachments")
+	}
+	memoMessage, err := s.convertMemoFromStore(ctx, memo, reactions, attachments)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memo")
+	}
+	return memoMessage, nil
+}
+
+// publishMemoUpdated publishes the MemoUpdated event of the updated memo and returns the memo.
+func (s *APIV1Service) publishMemoUpdated(ctx context.Context, previousMemo *store.Memo, memoID int32) (*v1pb.Memo, error) {
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	memoMessage, err := s.getMemoMessage(ctx, memoID)
+	if err != nil {
+		return nil, err
+	}
+	publishEvent(ctx, s, MemoUpdated{Previous: previousMemo, Memo: memo, Message: memoMessage})
+	return memoMessage, nil
+}
+
 func (s *APIV1Service) getContentLengthLimit(ctx context.Context) (int, error) {
 	instanceMemoRelatedSetting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
 	if err != nil {
@@ -688,53 +778,135 @@ func (s *APIV1Service) getContentLengthLimit(ctx context.Context) (int, error) {
 	return int(instanceMemoRelatedSetting.ContentLengthLimit), nil
 }
 
-// DispatchMemoCreatedWebhook dispatches webhook when memo is created.
-func (s *APIV1Service) DispatchMemoCreatedWebhook(ctx context.Context, memo *v1pb.Memo) error {
-	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.created")
-}
-
-// DispatchMemoUpdatedWebhook dispatches webhook when memo is updated.
-func (s *APIV1Service) DispatchMemoUpdatedWebhook(ctx context.Context, memo *v1pb.Memo) error {
-	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.updated")
+// dispatchMemoWebhook dispatches the activity on the memo to the webhooks of the memo creator.
+func (s *APIV1Service) dispatchMemoWebhook(ctx context.Context, memo *v1pb.Memo, payload *webhook.WebhookRequestPayload) error {
+	creatorID, err := ExtractUserIDFromName(memo.Creator)
+	if err != nil {
+		return errors.Wrap(err, "invalid memo creator")
+	}
+	payload.Creator = fmt.Sprintf("%s%d", UserNamePrefix, creatorID)
+	payload.Memo = memo
+	return s.dispatchWebhook(ctx, creatorID, payload)
 }
 
-// DispatchMemoDeletedWebhook dispatches webhook when memo is deleted.
-func (s *APIV1Service) DispatchMemoDeletedWebhook(ctx context.Context, memo *v1pb.Memo) error {
-	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.deleted")
+// dispatchWebhook delivers the payload to the webhooks of the user subscribed to its activity type.
+// A webhook with a filter only receives the activities whose memo matches the filter.
+func (s *APIV1Service) dispatchWebhook(ctx context.Context, userID int32, payload *webhook.WebhookRequestPayload) error {
+	return s.dispatchWebhookMatching(ctx, userID, payload, func(filter string) (bool, error) {
+		return s.matchWebhookFilter(ctx, payload.Memo, filter)
+	})
 }
 
-func (s *APIV1Service) dispatchMemoRelatedWebhook(ctx context.Context, memo *v1pb.Memo, activityType string) error {
-	creatorID, err := ExtractUserIDFromName(memo.Creator)
-	if err != nil {
-		return status.Errorf(codes.InvalidArgument, "invalid memo creator")
-	}
-	webhooks, err := s.Store.GetUserWebhooks(ctx, creatorID)
+// dispatchWebhookMatching is dispatchWebhook with the filters of the webhooks matched by matchFilter.
+func (s *APIV1Service) dispatchWebhookMatching(ctx context.Context, userID int32, payload *webhook.WebhookRequestPayload, matchFilter func(filter string) (bool, error)) error {
+	webhooks, err := s.Store.GetUserWebhooks(ctx, userID)
 	if err != nil {
 		return err
 	}
+	var renderOptions *webhook.RenderOptions
 	for _, hook := range webhooks {
-		payload, err := convertMemoToWebhookPayload(memo)
-		if err != nil {
-			return errors.Wrap(err, "failed to convert memo to webhook payload")
+		if len(hook.EventTypes) > 0 && !slices.Contains(hook.EventTypes, payload.ActivityType) {
+			continue
+		}
+		if hook.Filter != "" {
+			matched, err := matchFilter(hook.Filter)
+			if err != nil {
+				slog.Warn("failed to evaluate webhook filter", "webhookID", hook.Id, "error", err)
+				continue
+			}
+			if !matched {
+				continue
+			}
 		}
-		payload.ActivityType = activityType
 		payload.URL = hook.Url
+		if renderOptions == nil {
+			renderOptions = s.getWebhookRenderOptions(payload)
+		}
+		renderOptions.Format = hook.Format
+		renderOptions.Template = hook.Template
 
-		// Use asynchronous webhook dispatch
-		webhook.PostAsync(payload)
+		// The request body is persisted with the delivery, so that the delivery survives restarts.
+		body, err := webhook.Render(payload, *renderOptions)
+		if err != nil {
+			slog.Warn("failed to render webhook request", "webhookID", hook.Id, "error", err)
+			continue
+		}
+		if _, err := s.deliverUserWebhook(ctx, userID, hook, payload.ActivityType, string(body)); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
-func convertMemoToWebhookPayload(memo *v1pb.Memo) (*webhook.WebhookRequestPayload, error) {
-	creatorID, err := ExtractUserIDFromName(memo.Creator)
+// getWebhookRenderOptions renders the snippet and link of the activity for the chat formats.
+func (s *APIV1Service) getWebhookRenderOptions(payload *webhook.WebhookRequestPayload) *webhook.RenderOptions {
+	options := &webhook.RenderOptions{}
+	if payload.Digest != nil {
+		options.Snippet = payload.Digest.Markdown
+		return options
+	}
+	content := ""
+	if payload.Comment != nil {
+		content = payload.Comment.Content
+	} else if payload.Memo != nil {
+		content = payload.Memo.Content
+	}
+	if content != "" {
+		snippet, err := s.MarkdownService.GenerateSnippet([]byte(content), webhookSnippetMaxLength)
+		if err != nil {
+			slog.Warn("failed to generate webhook snippet", "error", err)
+			snippet = content
+		}
+		options.Snippet = snippet
+	}
+	if payload.Memo != nil {
+		options.Link = s.getMemoLink(strings.TrimPrefix(payload.Memo.Name, MemoNamePrefix))
+	}
+	return options
+}
+
+// matchWebhookFilter reports whether the stored memo matches the filter. Activities without a memo never match.
+func (s *APIV1Service) matchWebhookFilter(ctx context.Context, memo *v1pb.Memo, filter string) (bool, error) {
+	if memo == nil {
+		return false, nil
+	}
+	memoUID, err := ExtractMemoUIDFromName(memo.Name)
+	if err != nil {
+		return false, errors.Wrap(err, "invalid memo name")
+	}
+	matched, err := s.Store.GetMemo(ctx, &store.FindMemo{
+		UID:     &memoUID,
+		Filters: []string{filter},
+	})
+	if err != nil {
+		return false, err
+	}
+	return matched != nil, nil
+}
+
+// matchMemoWebhookFilters matches the memo against the filters of the webhooks of the user subscribed to the activity
+// type, and returns whether it matched by filter.
+func (s *APIV1Service) matchMemoWebhookFilters(ctx context.Context, userID int32, memo *v1pb.Memo, activityType string) map[string]bool {
+	webhooks, err := s.Store.GetUserWebhooks(ctx, userID)
 	if err != nil {
-		return nil, errors.Wrap(err, "invalid memo creator")
+		slog.Warn("failed to get user webhooks", "userID", userID, "error", err)
+		return nil
+	}
+	matches := map[string]bool{}
+	for _, hook := range webhooks {
+		if hook.Filter == "" || (len(hook.EventTypes) > 0 && !slices.Contains(hook.EventTypes, activityType)) {
+			continue
+		}
+		if _, ok := matches[hook.Filter]; ok {
+			continue
+		}
+		matched, err := s.matchWebhookFilter(ctx, memo, hook.Filter)
+		if err != nil {
+			slog.Warn("failed to evaluate webhook filter", "webhookID", hook.Id, "error", err)
+		}
+		matches[hook.Filter] = matched
 	}
-	return &webhook.WebhookRequestPayload{
-		Creator: fmt.Sprintf("%s%d", UserNamePrefix, creatorID),
-		Memo:    memo,
-	}, nil
+	return matches
 }
 
 func (s *APIV1Service) getMemoContentSnippet(content string) (string, error) {
@@ -788,8 +960,11 @@ func (*APIV1Service) parseMemoOrderBy(orderBy string, memoFind *store.FindMemo)
 		case "update_time":
 			memoFind.OrderByUpdatedTs = true
 			memoFind.OrderByTimeAsc = fieldDirection == "asc"
+		case "relevance":
+			memoFind.OrderByRelevance = true
+			// Note: relevance is always DESC (best match first) regardless of direction specified.
 		default:
-			return errors.Errorf("unsupported order field: %s, supported fields are: pinned, display_time, create_time, update_time, name", fieldName)
+			return errors.Errorf("unsupported order field: %s, supported fields are: pinned, display_time, create_time, update_time, name, relevance", fieldName)
 		}
 	}
 
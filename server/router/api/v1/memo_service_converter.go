@@ -37,8 +37,17 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 	}
 	if memo.Payload != nil {
 		memoMessage.Tags = memo.Payload.Tags
+		memoMessage.Mentions = memo.Payload.Mentions
 		memoMessage.Property = convertMemoPropertyFromStore(memo.Payload.Property)
 		memoMessage.Location = convertLocationFromStore(memo.Payload.Location)
+		memoMessage.Enrichment = convertMemoEnrichmentFromStore(memo.Payload.Enrichment)
+		if memo.Payload.RemindTs > 0 {
+			memoMessage.RemindTime = timestamppb.New(time.Unix(memo.Payload.RemindTs, 0))
+		}
+		if memo.Payload.PublishTs > 0 {
+			memoMessage.PublishTime = timestamppb.New(time.Unix(memo.Payload.PublishTs, 0))
+			memoMessage.PublishVisibility = convertVisibilityFromStore(store.Visibility(memo.Payload.PublishVisibility))
+		}
 	}
 
 	if memo.ParentUID != nil {
@@ -87,6 +96,19 @@ func convertMemoPropertyFromStore(property *storepb.MemoPayload_Property) *v1pb.
 	}
 }
 
+func convertMemoEnrichmentFromStore(enrichment *storepb.MemoPayload_Enrichment) *v1pb.Memo_Enrichment {
+	if enrichment == nil {
+		return nil
+	}
+	return &v1pb.Memo_Enrichment{
+		Summary:          enrichment.Summary,
+		SuggestedTags:    enrichment.SuggestedTags,
+		Category:         enrichment.Category,
+		SummaryAccepted:  enrichment.SummaryAccepted,
+		CategoryAccepted: enrichment.CategoryAccepted,
+	}
+}
+
 func convertLocationFromStore(location *storepb.MemoPayload_Location) *v1pb.Location {
 	if location == nil {
 		return nil
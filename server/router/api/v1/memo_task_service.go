@@ -0,0 +1,137 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListTasks(ctx context.Context, request *v1pb.ListTasksRequest) (*v1pb.ListTasksResponse, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if request.DueBefore != "" {
+		if _, err := time.Parse("2006-01-02", request.DueBefore); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "due_before must be in YYYY-MM-DD format")
+		}
+	}
+
+	normalStatus := store.Normal
+	memoFind := &store.FindMemo{
+		CreatorID: &user.ID,
+		RowStatus: &normalStatus,
+		Filters:   []string{"has_task_list"},
+	}
+	if request.Filter != "" {
+		if err := s.validateFilter(ctx, request.Filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		memoFind.Filters = append(memoFind.Filters, request.Filter)
+	}
+	instanceMemoRelatedSetting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance memo related setting")
+	}
+	if instanceMemoRelatedSetting.DisplayWithUpdateTime {
+		memoFind.OrderByUpdatedTs = true
+	}
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
+	tasks := []*v1pb.Task{}
+	for _, memo := range memos {
+		for index, task := range memo.Payload.GetTasks() {
+			if request.Checked != nil && task.Checked != *request.Checked {
+				continue
+			}
+			// Dates in YYYY-MM-DD format compare in date order.
+			if request.DueBefore != "" && (task.DueDate == "" || task.DueDate > request.DueBefore) {
+				continue
+			}
+			tasks = append(tasks, convertTaskFromStore(memo.UID, index, task))
+		}
+	}
+	return &v1pb.ListTasksResponse{
+		Tasks: tasks,
+	}, nil
+}
+
+func (s *APIV1Service) ToggleTask(ctx context.Context, request *v1pb.ToggleTaskRequest) (*v1pb.Task, error) {
+	user, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	memoUID, index, err := ExtractMemoTaskIndexFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if memo.CreatorID != user.ID && !isSuperUser(user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	tasks := memo.Payload.GetTasks()
+	if index >= len(tasks) {
+		return nil, status.Errorf(codes.NotFound, "task not found")
+	}
+	if request.Checked != nil && tasks[index].Checked == *request.Checked {
+		return convertTaskFromStore(memo.UID, index, tasks[index]), nil
+	}
+
+	content, err := s.MarkdownService.ToggleTask([]byte(memo.Content), index)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to toggle task: %v", err)
+	}
+	// The content is saved through UpdateMemo so that the payload, webhooks and notifications follow the change.
+	if _, err := s.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:    fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+			Content: content,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	}); err != nil {
+		return nil, err
+	}
+
+	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	tasks = memo.Payload.GetTasks()
+	if index >= len(tasks) {
+		return nil, status.Errorf(codes.Internal, "task not found after toggling")
+	}
+	return convertTaskFromStore(memo.UID, index, tasks[index]), nil
+}
+
+func convertTaskFromStore(memoUID string, index int, task *storepb.MemoPayload_Task) *v1pb.Task {
+	return &v1pb.Task{
+		Name:    fmt.Sprintf("%s%s/%s%d", MemoNamePrefix, memoUID, TaskNamePrefix, index),
+		Text:    task.Text,
+		Checked: task.Checked,
+		Line:    task.Line,
+		DueDate: task.DueDate,
+	}
+}
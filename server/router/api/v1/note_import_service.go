@@ -0,0 +1,229 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/lithammer/shortuuid/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/importer"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+var importNoteFormats = map[v1pb.ImportNotesRequest_Format]importer.Format{
+	v1pb.ImportNotesRequest_OBSIDIAN:    importer.FormatObsidian,
+	v1pb.ImportNotesRequest_FLOMO:       importer.FormatFlomo,
+	v1pb.ImportNotesRequest_GOOGLE_KEEP: importer.FormatGoogleKeep,
+	v1pb.ImportNotesRequest_EVERNOTE:    importer.FormatEvernote,
+}
+
+// importedNote is a note of an export to be imported as a memo.
+type importedNote struct {
+	memo        *store.Memo
+	note        *importer.Note
+	attachments []*importer.Attachment
+	// relatedNotes are the indexes of the notes the note links to.
+	relatedNotes []int
+}
+
+func (s *APIV1Service) ImportNotes(ctx context.Context, request *v1pb.ImportNotesRequest) (*v1pb.ImportNotesResponse, error) {
+	userID, err := s.checkUserDataAccess(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	format, ok := importNoteFormats[request.Format]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported format %s", request.Format)
+	}
+	if request.Filename == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "filename is required")
+	}
+
+	files := []*importer.File{{Path: request.Filename, Data: request.Content}}
+	if strings.HasSuffix(strings.ToLower(request.Filename), ".zip") {
+		files, err = importer.ReadZip(request.Content, importer.MaxExportSize)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to read %s: %v", request.Filename, err)
+		}
+	}
+	return s.ImportNoteFiles(ctx, userID, format, files, request.DryRun)
+}
+
+// ImportNoteFiles imports the notes of the files exported from another note tool as private memos of the user.
+// The links between the notes become reference relations. On a dry run, it only reports what would be imported.
+// Imported memos are not announced to webhooks or mentioned users, as they are not new.
+func (s *APIV1Service) ImportNoteFiles(ctx context.Context, userID int32, format importer.Format, files []*importer.File, dryRun bool) (*v1pb.ImportNotesResponse, error) {
+	result, err := importer.Parse(format, files)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse the export: %v", err)
+	}
+	notes, warnings, err := s.prepareImportedNotes(ctx, userID, result.Notes)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &v1pb.ImportNotesResponse{
+		MemoCount: int32(len(notes)),
+		Warnings:  append(result.Warnings, warnings...),
+	}
+	for _, note := range notes {
+		response.AttachmentCount += int32(len(note.attachments))
+		response.RelationCount += int32(len(note.relatedNotes))
+		for _, tag := range note.memo.Payload.GetTags() {
+			if !slices.Contains(response.Tags, tag) {
+				response.Tags = append(response.Tags, tag)
+			}
+		}
+	}
+	slices.Sort(response.Tags)
+	if dryRun {
+		return response, nil
+	}
+
+	for _, note := range notes {
+		memo, err := s.Store.CreateMemo(ctx, note.memo)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create memo: %v", err)
+		}
+		note.memo = memo
+		if note.note.Pinned || note.note.Archived {
+			update := &store.UpdateMemo{ID: memo.ID, Pinned: &note.note.Pinned, UpdatedTs: &memo.UpdatedTs}
+			if note.note.Archived {
+				archived := store.Archived
+				update.RowStatus = &archived
+			}
+			if err := s.Store.UpdateMemo(ctx, update); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update memo: %v", err)
+			}
+		}
+		for _, attachment := range note.attachments {
+			create := &store.Attachment{
+				UID:       shortuuid.New(),
+				CreatorID: userID,
+				Filename:  attachment.Filename,
+				Type:      attachment.Type,
+				Size:      int64(len(attachment.Data)),
+				Blob:      attachment.Data,
+				MemoID:    &memo.ID,
+			}
+			if err := SaveAttachmentBlob(ctx, s.Profile, s.Store, create); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to save attachment blob: %v", err)
+			}
+			if _, err := s.Store.CreateAttachment(ctx, create); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to create attachment: %v", err)
+			}
+		}
+		s.scheduleMemoEmbeddingSync(memo.ID, memo.Content)
+	}
+	for _, note := range notes {
+		for _, related := range note.relatedNotes {
+			if _, err := s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
+				MemoID:        note.memo.ID,
+				RelatedMemoID: notes[related].memo.ID,
+				Type:          store.MemoRelationReference,
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to create memo relation: %v", err)
+			}
+		}
+	}
+	return response, nil
+}
+
+// prepareImportedNotes builds the memos of the notes and resolves their links, leaving out what cannot be imported
+// with a warning.
+func (s *APIV1Service) prepareImportedNotes(ctx context.Context, userID int32, notes []*importer.Note) ([]*importedNote, []string, error) {
+	contentLengthLimit, err := s.getContentLengthLimit(ctx)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get content length limit")
+	}
+	instanceStorageSetting, err := s.Store.GetInstanceStorageSetting(ctx)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get instance storage setting: %v", err)
+	}
+	uploadSizeLimit := int(instanceStorageSetting.UploadSizeLimitMb) * MebiByte
+	if uploadSizeLimit == 0 {
+		uploadSizeLimit = MaxUploadBufferSizeBytes
+	}
+
+	warnings := []string{}
+	imported := []*importedNote{}
+	notesByTitle := map[string]int{}
+	for _, note := range notes {
+		name := note.Title
+		if name == "" {
+			name = firstLine(note.Content)
+		}
+		if len(note.Content) > contentLengthLimit {
+			warnings = append(warnings, fmt.Sprintf("%s: content too long (max %d characters), skipped", name, contentLengthLimit))
+			continue
+		}
+		attachments := []*importer.Attachment{}
+		for _, attachment := range note.Attachments {
+			if !validateFilename(attachment.Filename) {
+				warnings = append(warnings, fmt.Sprintf("%s: invalid attachment filename %q, skipped", name, attachment.Filename))
+				continue
+			}
+			if len(attachment.Data) > uploadSizeLimit {
+				warnings = append(warnings, fmt.Sprintf("%s: attachment %s exceeds the size limit, skipped", name, attachment.Filename))
+				continue
+			}
+			if !isValidMimeType(attachment.Type) {
+				attachment.Type = "application/octet-stream"
+			}
+			attachments = append(attachments, attachment)
+		}
+		if strings.TrimSpace(note.Content) == "" && len(attachments) == 0 {
+			continue
+		}
+
+		memo := &store.Memo{
+			UID:        shortuuid.New(),
+			CreatorID:  userID,
+			Content:    note.Content,
+			Visibility: store.Private,
+		}
+		if !note.CreateTime.IsZero() {
+			memo.CreatedTs = note.CreateTime.Unix()
+		}
+		if !note.UpdateTime.IsZero() {
+			memo.UpdatedTs = note.UpdateTime.Unix()
+		}
+		if err := memopayload.RebuildMemoPayload(memo, s.MarkdownService); err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
+		}
+		if note.Title != "" {
+			if _, ok := notesByTitle[strings.ToLower(note.Title)]; !ok {
+				notesByTitle[strings.ToLower(note.Title)] = len(imported)
+			}
+		}
+		imported = append(imported, &importedNote{memo: memo, note: note, attachments: attachments})
+	}
+
+	for i, note := range imported {
+		for _, link := range note.note.Links {
+			related, ok := notesByTitle[strings.ToLower(link)]
+			if !ok {
+				warnings = append(warnings, fmt.Sprintf("%s: linked note %q not found", note.note.Title, link))
+				continue
+			}
+			if related != i && !slices.Contains(note.relatedNotes, related) {
+				note.relatedNotes = append(note.relatedNotes, related)
+			}
+		}
+	}
+	return imported, warnings, nil
+}
+
+func firstLine(content string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(content), "\n")
+	if len([]rune(line)) > 40 {
+		line = string([]rune(line)[:40]) + "…"
+	}
+	return line
+}
@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -95,9 +96,13 @@ func (s *APIV1Service) UpsertMemoReaction(ctx context.Context, request *v1pb.Ups
 		return nil, status.Errorf(codes.Internal, "failed to upsert reaction")
 	}
 
-	reactionMessage := convertReactionFromStore(reaction)
+	if memoMessage, err := s.convertMemoFromStore(ctx, memo, nil, nil); err == nil {
+		publishEvent(ctx, s, ReactionAdded{Memo: memo, Message: memoMessage, Reaction: reaction})
+	} else {
+		slog.Warn("Failed to convert memo", slog.Any("err", err))
+	}
 
-	return reactionMessage, nil
+	return convertReactionFromStore(reaction), nil
 }
 
 func (s *APIV1Service) DeleteMemoReaction(ctx context.Context, request *v1pb.DeleteMemoReactionRequest) (*emptypb.Empty, error) {
@@ -135,6 +140,15 @@ func (s *APIV1Service) DeleteMemoReaction(ctx context.Context, request *v1pb.Del
 	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete reaction")
 	}
+	if memoUID, err := ExtractMemoUIDFromName(reaction.ContentID); err == nil {
+		if memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID}); err == nil && memo != nil {
+			if memoMessage, err := s.convertMemoFromStore(ctx, memo, nil, nil); err == nil {
+				publishEvent(ctx, s, ReactionRemoved{Memo: memo, Message: memoMessage, Reaction: reaction})
+			} else {
+				slog.Warn("Failed to convert memo", slog.Any("err", err))
+			}
+		}
+	}
 
 	return &emptypb.Empty{}, nil
 }
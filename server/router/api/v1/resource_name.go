@@ -15,10 +15,13 @@ const (
 	MemoNamePrefix             = "memos/"
 	AttachmentNamePrefix       = "attachments/"
 	ReactionNamePrefix         = "reactions/"
+	TaskNamePrefix             = "tasks/"
+	RevisionNamePrefix         = "revisions/"
 	InboxNamePrefix            = "inboxes/"
 	IdentityProviderNamePrefix = "identity-providers/"
 	ActivityNamePrefix         = "activities/"
 	WebhookNamePrefix          = "webhooks/"
+	JobNamePrefix              = "jobs/"
 )
 
 // GetNameParentTokens returns the tokens from a resource name.
@@ -120,6 +123,36 @@ func ExtractMemoReactionIDFromName(name string) (string, int32, error) {
 	return memoUID, reactionID, nil
 }
 
+// ExtractMemoTaskIndexFromName returns the memo UID and task index from a resource name.
+// e.g., "memos/abc/tasks/0" -> ("abc", 0).
+func ExtractMemoTaskIndexFromName(name string) (string, int, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, TaskNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	memoUID := tokens[0]
+	index, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil || index < 0 {
+		return "", 0, errors.Errorf("invalid task index %q", tokens[1])
+	}
+	return memoUID, int(index), nil
+}
+
+// ExtractMemoRevisionIDFromName returns the memo UID and revision ID from a resource name.
+// e.g., "memos/abc/revisions/123" -> ("abc", 123).
+func ExtractMemoRevisionIDFromName(name string) (string, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, RevisionNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	memoUID := tokens[0]
+	revisionID, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return "", 0, errors.Errorf("invalid revision ID %q", tokens[1])
+	}
+	return memoUID, revisionID, nil
+}
+
 // ExtractInboxIDFromName returns the inbox ID from a resource name.
 func ExtractInboxIDFromName(name string) (int32, error) {
 	tokens, err := GetNameParentTokens(name, InboxNamePrefix)
@@ -156,3 +189,16 @@ func ExtractActivityIDFromName(name string) (int32, error) {
 	}
 	return id, nil
 }
+
+// ExtractJobIDFromName returns the job ID from a resource name.
+func ExtractJobIDFromName(name string) (int32, error) {
+	tokens, err := GetNameParentTokens(name, JobNamePrefix)
+	if err != nil {
+		return 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, errors.Errorf("invalid job ID %q", tokens[0])
+	}
+	return id, nil
+}
@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/scheduler"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// RegisterScheduledJobs registers the periodic jobs of the service with the scheduler.
+// Daily digests are enqueued every day and weekly digests every Monday, at 08:00 in the scheduler time zone.
+// Memo reminders, scheduled memos and the schedule of the backup setting are checked every minute.
+func (s *APIV1Service) RegisterScheduledJobs(sched *scheduler.Scheduler) error {
+	jobs := []*scheduler.Job{
+		{
+			Name:        "memo-schedules",
+			Schedule:    "* * * * *",
+			Description: "Send the due memo reminders and publish the scheduled memos",
+			Handler: func(ctx context.Context) error {
+				return s.RunMemoSchedules(ctx, time.Now())
+			},
+		},
+		{
+			Name:        "daily-digest",
+			Schedule:    "0 8 * * *",
+			Description: "Enqueue the daily digests of the subscribed users",
+			Handler: func(ctx context.Context) error {
+				return s.EnqueueDigests(ctx, storepb.DigestUserSetting_DAILY, time.Now())
+			},
+		},
+		{
+			Name:        "weekly-digest",
+			Schedule:    "0 8 * * 1",
+			Description: "Enqueue the weekly digests of the subscribed users",
+			Handler: func(ctx context.Context) error {
+				return s.EnqueueDigests(ctx, storepb.DigestUserSetting_WEEKLY, time.Now())
+			},
+		},
+		{
+			Name:        "backup",
+			Schedule:    "* * * * *",
+			Description: "Back up the instance on the schedule of the backup setting and delete the old backups",
+			Handler: func(ctx context.Context) error {
+				return s.RunScheduledBackup(ctx, time.Now())
+			},
+		},
+	}
+	for _, job := range jobs {
+		if err := sched.Register(job); err != nil {
+			return errors.Wrapf(err, "failed to register job %q", job.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package v1
+
+import (
+	"context"
+	"hash/fnv"
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	localEmbeddingModel     = "memos-hash-384"
+	localEmbeddingDimension = 384
+	// localEmbeddingNgramWeight is the weight of character trigrams relative to whole words.
+	localEmbeddingNgramWeight = 0.5
+)
+
+// localEmbeddingStopWords are frequent English words that carry no meaning on their own.
+var localEmbeddingStopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true, "be": true, "by": true,
+	"for": true, "from": true, "in": true, "is": true, "it": true, "of": true, "on": true, "or": true,
+	"that": true, "the": true, "this": true, "to": true, "was": true, "with": true,
+}
+
+// localEmbeddingClient embeds text in process with the hashing trick, without any network access.
+// Words and their character trigrams are hashed into a fixed number of signed buckets, so texts sharing
+// words or word stems get similar vectors. It is deterministic and cheap, which suits air-gapped and
+// low-end hosts as well as tests, at the cost of not capturing synonyms like a trained model does.
+type localEmbeddingClient struct {
+	dimension int
+}
+
+func newLocalEmbeddingClient() *localEmbeddingClient {
+	return &localEmbeddingClient{
+		dimension: localEmbeddingDimension,
+	}
+}
+
+func (c *localEmbeddingClient) Embed(_ context.Context, text string) ([]float64, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, errors.New("embedding text cannot be empty")
+	}
+
+	features := make(map[string]float64)
+	for _, word := range localEmbeddingWords(text) {
+		if !localEmbeddingStopWords[word] {
+			features["w:"+word]++
+		}
+		runes := []rune("^" + word + "$")
+		for i := 0; i+3 <= len(runes); i++ {
+			features["c:"+string(runes[i:i+3])] += localEmbeddingNgramWeight
+		}
+	}
+
+	// Features are summed in a fixed order so that the vector is bit-for-bit reproducible.
+	names := make([]string, 0, len(features))
+	for feature := range features {
+		names = append(names, feature)
+	}
+	slices.Sort(names)
+
+	vector := make([]float64, c.dimension)
+	var norm float64
+	for _, feature := range names {
+		count := features[feature]
+		hash := fnv.New64a()
+		hash.Write([]byte(feature))
+		sum := hash.Sum64()
+		weight := 1 + math.Log(count)
+		if count < 1 {
+			weight = count
+		}
+		if sum>>63 == 1 {
+			weight = -weight
+		}
+		vector[sum%uint64(c.dimension)] += weight
+	}
+	for _, value := range vector {
+		norm += value * value
+	}
+	if norm == 0 {
+		return nil, errors.New("embedding text has no words")
+	}
+	norm = math.Sqrt(norm)
+	for i := range vector {
+		vector[i] /= norm
+	}
+	return vector, nil
+}
+
+func (*localEmbeddingClient) Model() string {
+	return localEmbeddingModel
+}
+
+func (*localEmbeddingClient) InProcess() bool {
+	return true
+}
+
+// localEmbeddingWords splits text into lower-cased runs of letters and digits.
+func localEmbeddingWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+func TestLocalEmbeddingClientEmbed(t *testing.T) {
+	t.Parallel()
+
+	client := newLocalEmbeddingClient()
+	ctx := context.Background()
+
+	query, err := client.Embed(ctx, "kubernetes cluster upgrade")
+	require.NoError(t, err)
+	require.Len(t, query, localEmbeddingDimension)
+	norm, ok := store.CosineSimilarity(query, query)
+	require.True(t, ok)
+	require.InDelta(t, 1.0, norm, 1e-9)
+
+	again, err := client.Embed(ctx, "kubernetes cluster upgrade")
+	require.NoError(t, err)
+	require.Equal(t, query, again)
+
+	related, err := client.Embed(ctx, "Upgrading the Kubernetes clusters tonight")
+	require.NoError(t, err)
+	unrelated, err := client.Embed(ctx, "Grocery list: apples, bread and milk")
+	require.NoError(t, err)
+	relatedScore, _ := store.CosineSimilarity(query, related)
+	unrelatedScore, _ := store.CosineSimilarity(query, unrelated)
+	require.Greater(t, relatedScore, unrelatedScore)
+	require.Greater(t, relatedScore, 0.3)
+
+	_, err = client.Embed(ctx, "   ")
+	require.ErrorContains(t, err, "embedding text cannot be empty")
+	_, err = client.Embed(ctx, "?!")
+	require.ErrorContains(t, err, "no words")
+	require.Equal(t, localEmbeddingModel, client.Model())
+}
+
+func TestResolveEmbeddingProvider(t *testing.T) {
+	t.Setenv(embeddingProviderEnv, "")
+	require.Equal(t, embeddingProviderOpenAI, resolveEmbeddingProvider(""))
+	require.Equal(t, embeddingProviderLocal, resolveEmbeddingProvider(" Local "))
+
+	t.Setenv(embeddingProviderEnv, "local")
+	require.Equal(t, embeddingProviderLocal, resolveEmbeddingProvider(""))
+	require.Equal(t, embeddingProviderOpenAI, resolveEmbeddingProvider("openai"))
+
+	require.NoError(t, validateEmbeddingProvider(""))
+	require.NoError(t, validateEmbeddingProvider("LOCAL"))
+	require.ErrorContains(t, validateEmbeddingProvider("onnx"), "embedding_provider must be one of local, openai")
+}
+
+func TestGetSemanticEmbeddingClientLocalProvider(t *testing.T) {
+	t.Setenv(embeddingProviderEnv, "")
+	t.Setenv(openAIAPIKeyEnv, "")
+	ctx := context.Background()
+	stores := teststore.NewTestingStore(ctx, t)
+	defer stores.Close()
+	service := &APIV1Service{Store: stores}
+
+	// The default OpenAI provider needs an API key.
+	_, err := service.getSemanticEmbeddingClient(ctx)
+	require.ErrorContains(t, err, "openai api key is not configured")
+
+	_, err = stores.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_AI,
+		Value: &storepb.InstanceSetting_AiSetting{
+			AiSetting: &storepb.InstanceAISetting{
+				EmbeddingProvider: embeddingProviderLocal,
+			},
+		},
+	})
+	require.NoError(t, err)
+	client, err := service.getSemanticEmbeddingClient(ctx)
+	require.NoError(t, err)
+	require.Equal(t, localEmbeddingModel, client.Model())
+	require.True(t, service.semanticIndexingEnabled())
+}
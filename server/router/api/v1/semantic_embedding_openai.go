@@ -14,6 +14,8 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
 )
 
 const (
@@ -43,6 +45,12 @@ type SemanticEmbeddingClient interface {
 	Model() string
 }
 
+// InProcessEmbeddingClient is implemented by embedding clients that embed without network access.
+// Memos are embedded as they are saved only with such clients, so that saving never waits on a remote API.
+type InProcessEmbeddingClient interface {
+	InProcess() bool
+}
+
 type openAIEmbeddingClient struct {
 	baseURL    string
 	apiKey     string
@@ -101,27 +109,8 @@ func normalizeOpenAIBaseURL(rawBaseURL string) string {
 	return strings.TrimRight(baseURL, "/")
 }
 
-func (s *APIV1Service) newOpenAIEmbeddingClient(ctx context.Context) (*openAIEmbeddingClient, error) {
-	config, err := s.getOpenAIEmbeddingConfig(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return newOpenAIEmbeddingClient(config)
-}
-
-func (s *APIV1Service) getSemanticEmbeddingClient(ctx context.Context) (SemanticEmbeddingClient, error) {
-	if s.EmbeddingClientFactory != nil {
-		return s.EmbeddingClientFactory(ctx)
-	}
-	return s.newOpenAIEmbeddingClient(ctx)
-}
-
-func (s *APIV1Service) getOpenAIEmbeddingConfig(ctx context.Context) (*openAIEmbeddingConfig, error) {
-	aiSetting, err := s.Store.GetInstanceAISetting(ctx)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get instance ai setting")
-	}
-
+// getOpenAIEmbeddingConfig resolves the OpenAI client config from the instance AI setting and environment fallback.
+func (s *APIV1Service) getOpenAIEmbeddingConfig(aiSetting *storepb.InstanceAISetting) (*openAIEmbeddingConfig, error) {
 	config := &openAIEmbeddingConfig{
 		baseURL:   strings.TrimSpace(aiSetting.GetOpenaiBaseUrl()),
 		model:     firstNonEmptyModel(strings.TrimSpace(aiSetting.GetOpenaiEmbeddingModel()), aiSetting.GetOpenaiEmbeddingModels()),
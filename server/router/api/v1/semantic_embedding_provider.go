@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"context"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+const (
+	embeddingProviderEnv     = "MEMOS_EMBEDDING_PROVIDER"
+	embeddingProviderOpenAI  = "openai"
+	embeddingProviderLocal   = "local"
+	defaultEmbeddingProvider = embeddingProviderOpenAI
+)
+
+// semanticEmbeddingProvider creates the embedding client of a provider from the instance AI setting.
+type semanticEmbeddingProvider func(ctx context.Context, s *APIV1Service, setting *storepb.InstanceAISetting) (SemanticEmbeddingClient, error)
+
+// semanticEmbeddingProviders is the registry of embedding providers selectable in the instance AI setting.
+var semanticEmbeddingProviders = map[string]semanticEmbeddingProvider{
+	embeddingProviderOpenAI: func(_ context.Context, s *APIV1Service, setting *storepb.InstanceAISetting) (SemanticEmbeddingClient, error) {
+		config, err := s.getOpenAIEmbeddingConfig(setting)
+		if err != nil {
+			return nil, err
+		}
+		return newOpenAIEmbeddingClient(config)
+	},
+	embeddingProviderLocal: func(context.Context, *APIV1Service, *storepb.InstanceAISetting) (SemanticEmbeddingClient, error) {
+		return newLocalEmbeddingClient(), nil
+	},
+}
+
+func (s *APIV1Service) getSemanticEmbeddingClient(ctx context.Context) (SemanticEmbeddingClient, error) {
+	if s.EmbeddingClientFactory != nil {
+		return s.EmbeddingClientFactory(ctx)
+	}
+
+	aiSetting, err := s.Store.GetInstanceAISetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance ai setting")
+	}
+	name := resolveEmbeddingProvider(aiSetting.GetEmbeddingProvider())
+	provider, ok := semanticEmbeddingProviders[name]
+	if !ok {
+		return nil, errors.Errorf("unknown embedding provider %q", name)
+	}
+	return provider(ctx, s, aiSetting)
+}
+
+// resolveEmbeddingProvider returns the provider name from the setting, the environment fallback or the default.
+func resolveEmbeddingProvider(settingProvider string) string {
+	if name := strings.ToLower(strings.TrimSpace(settingProvider)); name != "" {
+		return name
+	}
+	if name := strings.ToLower(strings.TrimSpace(os.Getenv(embeddingProviderEnv))); name != "" {
+		return name
+	}
+	return defaultEmbeddingProvider
+}
+
+func validateEmbeddingProvider(name string) error {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return nil
+	}
+	if _, ok := semanticEmbeddingProviders[name]; !ok {
+		names := make([]string, 0, len(semanticEmbeddingProviders))
+		for key := range semanticEmbeddingProviders {
+			names = append(names, key)
+		}
+		slices.Sort(names)
+		return status.Errorf(codes.InvalidArgument, "embedding_provider must be one of %s", strings.Join(names, ", "))
+	}
+	return nil
+}
@@ -9,8 +9,8 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/proto"
 
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
@@ -21,126 +21,77 @@ const (
 )
 
 func (s *APIV1Service) startSemanticReindexTask(ctx context.Context) error {
-	if !s.semanticStorageEnabled() {
-		return status.Errorf(codes.FailedPrecondition, "semantic search only supports postgres driver")
-	}
-	if _, err := s.getSemanticEmbeddingClient(ctx); err != nil {
+	embeddingClient, err := s.getSemanticEmbeddingClient(ctx)
+	if err != nil {
 		return status.Errorf(codes.FailedPrecondition, "semantic search is not configured: %v", err)
 	}
 
 	s.semanticReindexMu.Lock()
-	if s.semanticReindexRunning {
-		s.semanticReindexMu.Unlock()
-		return status.Errorf(codes.AlreadyExists, "semantic reindex is already running")
+	defer s.semanticReindexMu.Unlock()
+	active, err := s.getLatestSemanticReindexJob(ctx, store.JobPending, store.JobRunning)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get semantic reindex job: %v", err)
 	}
-	s.semanticReindexRunning = true
-	s.semanticReindexMu.Unlock()
-
-	go s.runSemanticReindexTask()
-	return nil
-}
-
-func (s *APIV1Service) resetStaleSemanticReindexState(ctx context.Context) {
-	aiSetting, err := s.Store.GetInstanceAISetting(ctx)
-	if err != nil || aiSetting == nil || !aiSetting.SemanticReindexRunning {
-		return
+	if active != nil {
+		return status.Errorf(codes.AlreadyExists, "semantic reindex is already running")
 	}
 
-	if updateErr := s.updateSemanticReindexState(ctx, func(setting *storepb.InstanceAISetting) {
-		setting.SemanticReindexRunning = false
-		setting.SemanticReindexUpdatedTs = time.Now().Unix()
-	}); updateErr != nil {
-		slog.Warn("failed to reset stale semantic reindex state", "error", updateErr)
+	if _, err := s.enqueueJob(ctx, store.JobTypeSemanticReindex, "", &storepb.JobPayload{
+		Payload: &storepb.JobPayload_SemanticReindex_{
+			SemanticReindex: &storepb.JobPayload_SemanticReindex{Model: embeddingClient.Model()},
+		},
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to enqueue semantic reindex: %v", err)
 	}
+	return nil
 }
 
-func (s *APIV1Service) runSemanticReindexTask() {
-	defer func() {
-		s.semanticReindexMu.Lock()
-		s.semanticReindexRunning = false
-		s.semanticReindexMu.Unlock()
-	}()
-
-	ctx, cancel := context.WithTimeout(context.Background(), semanticReindexTaskTimeout)
-	defer cancel()
-
+// runSemanticReindexJob re-embeds all memos and records the progress in the job payload.
+// Memos failing to embed are counted and logged without failing the job.
+func (s *APIV1Service) runSemanticReindexJob(ctx context.Context, job *store.Job) error {
 	embeddingClient, err := s.getSemanticEmbeddingClient(ctx)
 	if err != nil {
-		slog.Warn("failed to initialize semantic reindex", "error", err)
-		return
+		return errors.Wrap(err, "failed to initialize semantic reindex")
 	}
 
-	model := embeddingClient.Model()
-	startedTs := time.Now().Unix()
-	_ = s.updateSemanticReindexState(ctx, func(setting *storepb.InstanceAISetting) {
-		setting.SemanticReindexRunning = true
-		setting.SemanticReindexTotal = 0
-		setting.SemanticReindexProcessed = 0
-		setting.SemanticReindexFailed = 0
-		setting.SemanticReindexStartedTs = startedTs
-		setting.SemanticReindexUpdatedTs = startedTs
-		setting.SemanticReindexModel = model
-	})
+	progress := &storepb.JobPayload_SemanticReindex{Model: embeddingClient.Model()}
+	flushProgress := func() {
+		if _, err := s.Store.UpdateJob(ctx, &store.UpdateJob{
+			ID: job.ID,
+			Payload: &storepb.JobPayload{
+				Payload: &storepb.JobPayload_SemanticReindex_{SemanticReindex: progress},
+			},
+		}); err != nil {
+			slog.Warn("failed to update semantic reindex progress", "jobID", job.ID, "error", err)
+		}
+	}
 
 	memos, err := s.listMemosForSemanticReindex(ctx)
 	if err != nil {
-		slog.Warn("failed to list memos for semantic reindex", "error", err)
-		_ = s.updateSemanticReindexState(ctx, func(setting *storepb.InstanceAISetting) {
-			setting.SemanticReindexRunning = false
-			setting.SemanticReindexUpdatedTs = time.Now().Unix()
-			setting.SemanticReindexModel = model
-		})
-		return
+		return errors.Wrap(err, "failed to list memos for semantic reindex")
 	}
+	progress.Total = int32(len(memos))
+	flushProgress()
 
-	total := len(memos)
-	_ = s.updateSemanticReindexState(ctx, func(setting *storepb.InstanceAISetting) {
-		setting.SemanticReindexRunning = true
-		setting.SemanticReindexTotal = int32(total)
-		setting.SemanticReindexProcessed = 0
-		setting.SemanticReindexFailed = 0
-		setting.SemanticReindexStartedTs = startedTs
-		setting.SemanticReindexUpdatedTs = time.Now().Unix()
-		setting.SemanticReindexModel = model
-	})
-
-	processed := 0
-	failed := 0
 	for _, memo := range memos {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(err, "semantic reindex canceled")
+		}
 		content := strings.TrimSpace(memo.Content)
 		if content != "" {
 			embedCtx := withEmbeddingTask(ctx, embeddingTaskPassage)
 			if err := s.refreshMemoEmbeddingWithOptions(embedCtx, memo.ID, memo.Content, true); err != nil {
-				failed++
+				progress.Failed++
 				slog.Warn("semantic reindex failed for memo", "memoID", memo.ID, "error", err)
 			}
 		}
 
-		processed++
-		if processed%semanticReindexProgressFlushStep == 0 || processed == total {
-			processedSnapshot := processed
-			failedSnapshot := failed
-			_ = s.updateSemanticReindexState(ctx, func(setting *storepb.InstanceAISetting) {
-				setting.SemanticReindexRunning = true
-				setting.SemanticReindexTotal = int32(total)
-				setting.SemanticReindexProcessed = int32(processedSnapshot)
-				setting.SemanticReindexFailed = int32(failedSnapshot)
-				setting.SemanticReindexStartedTs = startedTs
-				setting.SemanticReindexUpdatedTs = time.Now().Unix()
-				setting.SemanticReindexModel = model
-			})
+		progress.Processed++
+		if progress.Processed%semanticReindexProgressFlushStep == 0 || progress.Processed == progress.Total {
+			flushProgress()
 		}
 	}
-
-	_ = s.updateSemanticReindexState(ctx, func(setting *storepb.InstanceAISetting) {
-		setting.SemanticReindexRunning = false
-		setting.SemanticReindexTotal = int32(total)
-		setting.SemanticReindexProcessed = int32(processed)
-		setting.SemanticReindexFailed = int32(failed)
-		setting.SemanticReindexStartedTs = startedTs
-		setting.SemanticReindexUpdatedTs = time.Now().Unix()
-		setting.SemanticReindexModel = model
-	})
+	return nil
 }
 
 func (s *APIV1Service) listMemosForSemanticReindex(ctx context.Context) ([]*store.Memo, error) {
@@ -162,24 +113,32 @@ func (s *APIV1Service) listMemosForSemanticReindex(ctx context.Context) ([]*stor
 	return result, nil
 }
 
-func (s *APIV1Service) updateSemanticReindexState(ctx context.Context, mutate func(*storepb.InstanceAISetting)) error {
-	aiSetting, err := s.Store.GetInstanceAISetting(ctx)
-	if err != nil {
-		return errors.Wrap(err, "failed to get current ai setting")
-	}
-	if aiSetting == nil {
-		aiSetting = &storepb.InstanceAISetting{}
-	}
-
-	next := proto.Clone(aiSetting).(*storepb.InstanceAISetting)
-	mutate(next)
-
-	_, err = s.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
-		Key:   storepb.InstanceSettingKey_AI,
-		Value: &storepb.InstanceSetting_AiSetting{AiSetting: next},
+func (s *APIV1Service) getLatestSemanticReindexJob(ctx context.Context, statusList ...store.JobStatus) (*store.Job, error) {
+	jobType := store.JobTypeSemanticReindex
+	limit := 1
+	return s.Store.GetJob(ctx, &store.FindJob{
+		Type:       &jobType,
+		StatusList: statusList,
+		Limit:      &limit,
 	})
+}
+
+// setSemanticReindexProgress reports the progress of the current or last semantic reindex job in the AI setting.
+func (s *APIV1Service) setSemanticReindexProgress(ctx context.Context, setting *v1pb.InstanceSetting_AISetting) error {
+	job, err := s.getLatestSemanticReindexJob(ctx)
 	if err != nil {
-		return errors.Wrap(err, "failed to persist semantic reindex state")
+		return errors.Wrap(err, "failed to get semantic reindex job")
+	}
+	if job == nil {
+		return nil
 	}
+	progress := job.Payload.GetSemanticReindex()
+	setting.SemanticReindexRunning = job.Status == store.JobPending || job.Status == store.JobRunning
+	setting.SemanticReindexTotal = progress.GetTotal()
+	setting.SemanticReindexProcessed = progress.GetProcessed()
+	setting.SemanticReindexFailed = progress.GetFailed()
+	setting.SemanticReindexStartedTs = job.CreatedTs
+	setting.SemanticReindexUpdatedTs = job.UpdatedTs
+	setting.SemanticReindexModel = progress.GetModel()
 	return nil
 }
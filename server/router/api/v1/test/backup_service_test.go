@@ -0,0 +1,122 @@
+package test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+)
+
+func TestCreateBackup(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	ts.Profile.Data = t.TempDir()
+	backupDir := filepath.Join(ts.Profile.Data, apiv1.BackupDirName)
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	t.Run("Only admins can back up", func(t *testing.T) {
+		_, err := ts.Service.CreateBackup(userCtx, &v1pb.CreateBackupRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("A backup is written to the data directory by default", func(t *testing.T) {
+		backup, err := ts.Service.CreateBackup(adminCtx, &v1pb.CreateBackupRequest{})
+		require.NoError(t, err)
+		require.Equal(t, v1pb.Backup_LOCAL, backup.Destination)
+		require.Equal(t, backupDir, filepath.Dir(backup.Location))
+		info, err := os.Stat(backup.Location)
+		require.NoError(t, err)
+		require.Equal(t, info.Size(), backup.Size)
+		require.NoError(t, os.Remove(backup.Location))
+	})
+
+	t.Run("S3 backups require the S3 storage config", func(t *testing.T) {
+		_, err := ts.Service.CreateBackup(adminCtx, &v1pb.CreateBackupRequest{Destination: v1pb.Backup_S3})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("The schedule must be a cron expression", func(t *testing.T) {
+		_, err := ts.Service.UpdateInstanceSetting(adminCtx, &v1pb.UpdateInstanceSettingRequest{
+			Setting: &v1pb.InstanceSetting{
+				Name:  "instance/settings/BACKUP",
+				Value: &v1pb.InstanceSetting_BackupSetting_{BackupSetting: &v1pb.InstanceSetting_BackupSetting{Schedule: "daily"}},
+			},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	setting, err := ts.Service.UpdateInstanceSetting(adminCtx, &v1pb.UpdateInstanceSettingRequest{
+		Setting: &v1pb.InstanceSetting{
+			Name: "instance/settings/BACKUP",
+			Value: &v1pb.InstanceSetting_BackupSetting_{BackupSetting: &v1pb.InstanceSetting_BackupSetting{
+				Schedule:       "0 3 * * *",
+				Destination:    v1pb.Backup_LOCAL,
+				RetentionCount: 2,
+			}},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "0 3 * * *", setting.GetBackupSetting().Schedule)
+
+	t.Run("The backup setting is only visible to admins", func(t *testing.T) {
+		_, err := ts.Service.GetInstanceSetting(userCtx, &v1pb.GetInstanceSettingRequest{Name: "instance/settings/BACKUP"})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Scheduled backups run when the schedule is due and keep the retention count", func(t *testing.T) {
+		day := time.Date(2026, 10, 1, 3, 0, 0, 0, time.Local)
+		require.NoError(t, ts.Service.RunScheduledBackup(ctx, day.Add(time.Minute)))
+		for i := 0; i < 3; i++ {
+			require.NoError(t, ts.Service.RunScheduledBackup(ctx, day.AddDate(0, 0, i)))
+		}
+		entries, err := os.ReadDir(backupDir)
+		require.NoError(t, err)
+		names := []string{}
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+		require.Len(t, names, 2)
+		require.Regexp(t, `^memos-backup-`+day.AddDate(0, 0, 1).UTC().Format("20060102T150405Z")+`-[0-9a-f]{8}\.zip$`, names[0])
+		require.Regexp(t, `^memos-backup-`+day.AddDate(0, 0, 2).UTC().Format("20060102T150405Z")+`-[0-9a-f]{8}\.zip$`, names[1])
+	})
+
+	t.Run("The retention deletes the oldest backups", func(t *testing.T) {
+		backup, err := ts.Service.RunBackup(ctx, storepb.InstanceBackupSetting_DESTINATION_UNSPECIFIED, time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Regexp(t, `^instance/backups/memos-backup-20261016T080000Z-[0-9a-f]{8}\.zip$`, backup.Name)
+		require.Len(t, backup.DeletedBackups, 1)
+		entries, err := os.ReadDir(backupDir)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+	})
+
+	t.Run("Backups taken within the same second are kept apart", func(t *testing.T) {
+		now := time.Date(2026, 10, 17, 8, 0, 0, 0, time.UTC)
+		first, err := ts.Service.RunBackup(ctx, storepb.InstanceBackupSetting_DESTINATION_UNSPECIFIED, now)
+		require.NoError(t, err)
+		second, err := ts.Service.RunBackup(ctx, storepb.InstanceBackupSetting_DESTINATION_UNSPECIFIED, now)
+		require.NoError(t, err)
+		require.NotEqual(t, first.Name, second.Name)
+		require.NotContains(t, second.DeletedBackups, strings.TrimPrefix(first.Name, "instance/backups/"))
+		entries, err := os.ReadDir(backupDir)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+	})
+}
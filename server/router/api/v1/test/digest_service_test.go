@@ -0,0 +1,180 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestUserDigests(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	smtpServer := newTestSMTPServer(t)
+	defer smtpServer.listener.Close()
+	var mu sync.Mutex
+	webhookBodies := []string{}
+	webhookServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		webhookBodies = append(webhookBodies, string(body))
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"code":0}`))
+	}))
+	defer webhookServer.Close()
+
+	hostUser, err := ts.CreateHostUser(ctx, "digest-admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	_, err = ts.Service.UpdateInstanceSetting(adminCtx, &v1pb.UpdateInstanceSettingRequest{
+		Setting: &v1pb.InstanceSetting{
+			Name: "instance/settings/EMAIL",
+			Value: &v1pb.InstanceSetting_EmailSetting_{EmailSetting: &v1pb.InstanceSetting_EmailSetting{
+				SmtpHost:  "127.0.0.1",
+				SmtpPort:  smtpServer.port(),
+				FromEmail: "memos@example.com",
+			}},
+		},
+	})
+	require.NoError(t, err)
+
+	user, err := ts.CreateRegularUser(ctx, "digest-owner")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	teammate, err := ts.CreateRegularUser(ctx, "digest-teammate")
+	require.NoError(t, err)
+	teammateCtx := ts.CreateUserContext(ctx, teammate.ID)
+	settingName := fmt.Sprintf("users/%d/settings/DIGEST", user.ID)
+
+	_, err = ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  "users/" + strconv.Itoa(int(user.ID)),
+		Webhook: &v1pb.UserWebhook{Url: webhookServer.URL, EventTypes: []string{webhook.ActivityTypeDigestCreated}},
+	})
+	require.NoError(t, err)
+	shortcut, err := ts.Service.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{
+		Parent:   "users/" + strconv.Itoa(int(user.ID)),
+		Shortcut: &v1pb.Shortcut{Title: "Team log", Filter: `tag in ["log"]`},
+	})
+	require.NoError(t, err)
+
+	t.Run("Digest setting is validated", func(t *testing.T) {
+		setting, err := ts.Service.GetUserSetting(userCtx, &v1pb.GetUserSettingRequest{Name: settingName})
+		require.NoError(t, err)
+		require.Equal(t, v1pb.UserSetting_DigestSetting_FREQUENCY_UNSPECIFIED, setting.GetDigestSetting().Frequency)
+
+		_, err = ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+			Setting: &v1pb.UserSetting{
+				Name: settingName,
+				Value: &v1pb.UserSetting_DigestSetting_{DigestSetting: &v1pb.UserSetting_DigestSetting{
+					Shortcut: fmt.Sprintf("users/%d/shortcuts/missing", user.ID),
+				}},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"shortcut"}},
+		})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	setting, err := ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+		Setting: &v1pb.UserSetting{
+			Name: settingName,
+			Value: &v1pb.UserSetting_DigestSetting_{DigestSetting: &v1pb.UserSetting_DigestSetting{
+				Frequency: v1pb.UserSetting_DigestSetting_WEEKLY,
+				Channels: []v1pb.UserSetting_DigestSetting_Channel{
+					v1pb.UserSetting_DigestSetting_EMAIL,
+					v1pb.UserSetting_DigestSetting_WEBHOOK,
+					v1pb.UserSetting_DigestSetting_INBOX,
+				},
+				Shortcut: shortcut.Name,
+			}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"frequency", "channels", "shortcut"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, shortcut.Name, setting.GetDigestSetting().Shortcut)
+	require.Len(t, setting.GetDigestSetting().Channels, 3)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Release checklist\n\n- [ ] Tag the release", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(teammateCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Deployed the API #log", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemoComment(teammateCtx, &v1pb.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &v1pb.Memo{Content: "I can tag it", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.UpsertMemoReaction(teammateCtx, &v1pb.UpsertMemoReactionRequest{
+		Name:     memo.Name,
+		Reaction: &v1pb.Reaction{ContentId: memo.Name, ReactionType: "🚀"},
+	})
+	require.NoError(t, err)
+
+	// Daily digests are not sent to weekly subscribers.
+	require.NoError(t, ts.Service.EnqueueDigests(ctx, storepb.DigestUserSetting_DAILY, time.Now().Add(time.Second)))
+	require.NoError(t, ts.Service.EnqueueDigests(ctx, storepb.DigestUserSetting_WEEKLY, time.Now().Add(time.Second)))
+
+	t.Run("Digest is created as a notification", func(t *testing.T) {
+		var digest *v1pb.UserNotification_Digest
+		require.Eventually(t, func() bool {
+			response, err := ts.Service.ListUserNotifications(userCtx, &v1pb.ListUserNotificationsRequest{Parent: "users/" + strconv.Itoa(int(user.ID))})
+			require.NoError(t, err)
+			for _, notification := range response.Notifications {
+				if notification.Type == v1pb.UserNotification_DIGEST {
+					require.Nil(t, digest, "expected a single digest")
+					digest = notification.Digest
+				}
+			}
+			return digest != nil
+		}, 5*time.Second, 20*time.Millisecond)
+		require.Equal(t, "Your weekly memos digest", digest.Title)
+		require.Contains(t, digest.Html, "Memos you wrote (1)")
+		require.Contains(t, digest.Html, "digest-teammate on")
+		require.Contains(t, digest.Html, "I can tag it")
+		require.Contains(t, digest.Html, "🚀 from digest-teammate")
+		require.Contains(t, digest.Html, "Team log (1)")
+		require.Contains(t, digest.Html, "Deployed the API")
+		require.Contains(t, digest.Html, "Incomplete tasks (1)")
+		require.Contains(t, digest.Html, "http://localhost:8080/"+memo.Name)
+	})
+
+	t.Run("Digest is sent by email as HTML", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			return len(smtpServer.received()) == 1
+		}, 5*time.Second, 20*time.Millisecond)
+		email := smtpServer.received()[0]
+		require.Equal(t, []string{user.Email}, email.to)
+		require.Contains(t, email.data, "Subject: Your weekly memos digest")
+		require.Contains(t, email.data, "text/html")
+	})
+
+	t.Run("Digest is sent to subscribed webhooks", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(webhookBodies) == 1
+		}, 5*time.Second, 20*time.Millisecond)
+		mu.Lock()
+		defer mu.Unlock()
+		require.Contains(t, webhookBodies[0], `"activityType":"memos.digest.created"`)
+		require.Contains(t, webhookBodies[0], "## Memos you wrote (1)")
+	})
+}
@@ -0,0 +1,256 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/eventbus"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// domainEventRecorder records the domain events published by the service, in order.
+type domainEventRecorder struct {
+	mu     sync.Mutex
+	events []any
+}
+
+func recordDomainEvents(t *testing.T, ts *TestService) *domainEventRecorder {
+	recorder := &domainEventRecorder{}
+	bus := ts.Service.EventBus()
+	for _, unsubscribe := range []func(){
+		recordDomainEvent[apiv1.MemoCreated](bus, recorder),
+		recordDomainEvent[apiv1.MemoUpdated](bus, recorder),
+		recordDomainEvent[apiv1.MemoDeleted](bus, recorder),
+		recordDomainEvent[apiv1.MemoCommentCreated](bus, recorder),
+		recordDomainEvent[apiv1.MemoRelationsUpdated](bus, recorder),
+		recordDomainEvent[apiv1.MemoPublished](bus, recorder),
+		recordDomainEvent[apiv1.MemoReminded](bus, recorder),
+		recordDomainEvent[apiv1.ReactionAdded](bus, recorder),
+		recordDomainEvent[apiv1.ReactionRemoved](bus, recorder),
+		recordDomainEvent[apiv1.AttachmentUploaded](bus, recorder),
+		recordDomainEvent[apiv1.NotificationCreated](bus, recorder),
+	} {
+		t.Cleanup(unsubscribe)
+	}
+	return recorder
+}
+
+func recordDomainEvent[T any](bus *eventbus.Bus, recorder *domainEventRecorder) func() {
+	return eventbus.Subscribe(bus, func(_ context.Context, event T) {
+		recorder.mu.Lock()
+		defer recorder.mu.Unlock()
+		recorder.events = append(recorder.events, event)
+	})
+}
+
+// take returns the events recorded since the last call.
+func (r *domainEventRecorder) take() []any {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	events := r.events
+	r.events = nil
+	return events
+}
+
+func domainEventTypes(events []any) []string {
+	types := make([]string, 0, len(events))
+	for _, event := range events {
+		types = append(types, fmt.Sprintf("%T", event))
+	}
+	return types
+}
+
+func TestDomainEvents(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+	recorder := recordDomainEvents(t, ts)
+
+	memo, err := ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "First draft", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+
+	t.Run("Creating a memo", func(t *testing.T) {
+		events := recorder.take()
+		require.Equal(t, []string{"v1.MemoCreated"}, domainEventTypes(events))
+		event := events[0].(apiv1.MemoCreated)
+		require.Equal(t, "First draft", event.Memo.Content)
+		require.Equal(t, memo.Name, event.Message.Name)
+	})
+
+	t.Run("Updating a memo", func(t *testing.T) {
+		_, err := ts.Service.UpdateMemo(aliceCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: "Second draft"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+		events := recorder.take()
+		require.Equal(t, []string{"v1.MemoUpdated"}, domainEventTypes(events))
+		event := events[0].(apiv1.MemoUpdated)
+		require.Equal(t, "First draft", event.Previous.Content)
+		require.Equal(t, "Second draft", event.Memo.Content)
+		require.Equal(t, "Second draft", event.Message.Content)
+
+		_, err = ts.Service.UpdateMemo(aliceCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, State: v1pb.State_ARCHIVED},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+		})
+		require.NoError(t, err)
+		events = recorder.take()
+		require.Equal(t, []string{"v1.MemoUpdated"}, domainEventTypes(events))
+		event = events[0].(apiv1.MemoUpdated)
+		require.Equal(t, store.Normal, event.Previous.RowStatus)
+		require.Equal(t, store.Archived, event.Memo.RowStatus)
+
+		_, err = ts.Service.UpdateMemo(aliceCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, State: v1pb.State_NORMAL},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"v1.MemoUpdated"}, domainEventTypes(recorder.take()))
+	})
+
+	t.Run("Commenting on a memo", func(t *testing.T) {
+		comment, err := ts.Service.CreateMemoComment(bobCtx, &v1pb.CreateMemoCommentRequest{
+			Name:    memo.Name,
+			Comment: &v1pb.Memo{Content: "Nice", Visibility: v1pb.Visibility_PROTECTED},
+		})
+		require.NoError(t, err)
+		events := recorder.take()
+		// The notification of the memo creator is published by a handler of the comment, before the recorder gets it.
+		require.Equal(t, []string{"v1.MemoCreated", "v1.NotificationCreated", "v1.MemoCommentCreated"}, domainEventTypes(events))
+		require.Equal(t, comment.Name, events[0].(apiv1.MemoCreated).Message.Name)
+		require.Equal(t, alice.ID, events[1].(apiv1.NotificationCreated).Inbox.ReceiverID)
+		event := events[2].(apiv1.MemoCommentCreated)
+		require.Equal(t, memo.Name, event.Message.Name)
+		require.Equal(t, comment.Name, event.CommentMessage.Name)
+
+		// Commenting on your own memo notifies nobody.
+		_, err = ts.Service.CreateMemoComment(aliceCtx, &v1pb.CreateMemoCommentRequest{
+			Name:    memo.Name,
+			Comment: &v1pb.Memo{Content: "Thanks", Visibility: v1pb.Visibility_PROTECTED},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"v1.MemoCreated", "v1.MemoCommentCreated"}, domainEventTypes(recorder.take()))
+	})
+
+	t.Run("Setting memo relations", func(t *testing.T) {
+		other, err := ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "Reference", Visibility: v1pb.Visibility_PROTECTED},
+		})
+		require.NoError(t, err)
+		recorder.take()
+
+		_, err = ts.Service.SetMemoRelations(aliceCtx, &v1pb.SetMemoRelationsRequest{
+			Name: memo.Name,
+			Relations: []*v1pb.MemoRelation{{
+				Memo:        &v1pb.MemoRelation_Memo{Name: memo.Name},
+				RelatedMemo: &v1pb.MemoRelation_Memo{Name: other.Name},
+				Type:        v1pb.MemoRelation_REFERENCE,
+			}},
+		})
+		require.NoError(t, err)
+		events := recorder.take()
+		require.Equal(t, []string{"v1.MemoRelationsUpdated"}, domainEventTypes(events))
+		require.Equal(t, memo.Name, events[0].(apiv1.MemoRelationsUpdated).Message.Name)
+	})
+
+	t.Run("Reacting to a memo", func(t *testing.T) {
+		reaction, err := ts.Service.UpsertMemoReaction(bobCtx, &v1pb.UpsertMemoReactionRequest{
+			Name:     memo.Name,
+			Reaction: &v1pb.Reaction{ContentId: memo.Name, ReactionType: "👍"},
+		})
+		require.NoError(t, err)
+		events := recorder.take()
+		require.Equal(t, []string{"v1.ReactionAdded"}, domainEventTypes(events))
+		added := events[0].(apiv1.ReactionAdded)
+		require.Equal(t, memo.Name, added.Message.Name)
+		require.Equal(t, "👍", added.Reaction.ReactionType)
+
+		_, err = ts.Service.DeleteMemoReaction(bobCtx, &v1pb.DeleteMemoReactionRequest{Name: reaction.Name})
+		require.NoError(t, err)
+		events = recorder.take()
+		require.Equal(t, []string{"v1.ReactionRemoved"}, domainEventTypes(events))
+		require.Equal(t, added.Reaction.ID, events[0].(apiv1.ReactionRemoved).Reaction.ID)
+	})
+
+	t.Run("Uploading an attachment", func(t *testing.T) {
+		attachment, err := ts.Service.CreateAttachment(aliceCtx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{Filename: "notes.txt", Content: []byte("notes"), Memo: &memo.Name},
+		})
+		require.NoError(t, err)
+		events := recorder.take()
+		require.Equal(t, []string{"v1.AttachmentUploaded"}, domainEventTypes(events))
+		event := events[0].(apiv1.AttachmentUploaded)
+		require.Equal(t, "notes.txt", event.Attachment.Filename)
+		require.Equal(t, memo.Name, event.Message.Name)
+		require.NotEmpty(t, attachment.Name)
+	})
+
+	t.Run("Merging memos", func(t *testing.T) {
+		merged, err := ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "Third draft", Visibility: v1pb.Visibility_PROTECTED},
+		})
+		require.NoError(t, err)
+		recorder.take()
+
+		_, err = ts.Service.MergeMemos(aliceCtx, &v1pb.MergeMemosRequest{Name: memo.Name, MergedMemos: []string{merged.Name}})
+		require.NoError(t, err)
+		events := recorder.take()
+		require.Equal(t, []string{"v1.MemoDeleted", "v1.MemoUpdated"}, domainEventTypes(events))
+		require.Equal(t, merged.Name, events[0].(apiv1.MemoDeleted).Message.Name)
+		updated := events[1].(apiv1.MemoUpdated)
+		require.Equal(t, "Second draft", updated.Previous.Content)
+		require.Equal(t, "Second draft\n\nThird draft", updated.Memo.Content)
+	})
+
+	t.Run("Running memo schedules", func(t *testing.T) {
+		now := time.Now()
+		scheduled, err := ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "Launch", Visibility: v1pb.Visibility_PROTECTED, PublishTime: timestamppb.New(now.Add(time.Hour))},
+		})
+		require.NoError(t, err)
+		reminded, err := ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "Renew", Visibility: v1pb.Visibility_PRIVATE, RemindTime: timestamppb.New(now.Add(time.Hour))},
+		})
+		require.NoError(t, err)
+		recorder.take()
+
+		require.NoError(t, ts.Service.RunMemoSchedules(ctx, now.Add(2*time.Hour)))
+		events := recorder.take()
+		require.Equal(t, []string{"v1.MemoPublished", "v1.NotificationCreated", "v1.MemoReminded"}, domainEventTypes(events))
+		require.Equal(t, scheduled.Name, events[0].(apiv1.MemoPublished).Message.Name)
+		require.Equal(t, alice.ID, events[1].(apiv1.NotificationCreated).Inbox.ReceiverID)
+		require.Equal(t, reminded.Name, events[2].(apiv1.MemoReminded).Message.Name)
+	})
+
+	t.Run("Deleting a memo", func(t *testing.T) {
+		_, err := ts.Service.DeleteMemo(aliceCtx, &v1pb.DeleteMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		events := recorder.take()
+		require.Equal(t, []string{"v1.MemoDeleted"}, domainEventTypes(events))
+		event := events[0].(apiv1.MemoDeleted)
+		require.Equal(t, memo.Name, event.Message.Name)
+		require.Equal(t, "Second draft\n\nThird draft", event.Memo.Content)
+	})
+
+	// The asynchronous handlers of the events are done once the bus is idle.
+	ts.Service.EventBus().Wait()
+}
@@ -0,0 +1,215 @@
+package test
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+type receivedEmail struct {
+	auth string
+	to   []string
+	data string
+}
+
+// testSMTPServer is a minimal SMTP server accepting every message.
+type testSMTPServer struct {
+	listener net.Listener
+	mu       sync.Mutex
+	emails   []receivedEmail
+}
+
+func newTestSMTPServer(t *testing.T) *testSMTPServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := &testSMTPServer{listener: listener}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.serve(conn)
+		}
+	}()
+	return server
+}
+
+func (s *testSMTPServer) port() int32 {
+	return int32(s.listener.Addr().(*net.TCPAddr).Port)
+}
+
+func (s *testSMTPServer) received() []receivedEmail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]receivedEmail{}, s.emails...)
+}
+
+func (s *testSMTPServer) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	reply := func(line string) {
+		_, _ = conn.Write([]byte(line + "\r\n"))
+	}
+	reply("220 localhost ESMTP")
+	email := receivedEmail{}
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		command := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250-localhost")
+			reply("250 AUTH PLAIN")
+		case strings.HasPrefix(command, "AUTH PLAIN "):
+			email.auth = line[len("AUTH PLAIN "):]
+			reply("235 Authentication successful")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			email.to = append(email.to, strings.Trim(line[len("RCPT TO:"):], "<>"))
+			reply("250 OK")
+		case command == "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(dataLine)
+			}
+			email.data = data.String()
+			s.mu.Lock()
+			s.emails = append(s.emails, email)
+			s.mu.Unlock()
+			email = receivedEmail{auth: email.auth}
+			reply("250 OK")
+		case command == "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func TestEmailNotifications(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	smtpServer := newTestSMTPServer(t)
+	defer smtpServer.listener.Close()
+
+	hostUser, err := ts.CreateHostUser(ctx, "email-admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	owner, err := ts.CreateRegularUser(ctx, "email-owner")
+	require.NoError(t, err)
+	ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+	commenter, err := ts.CreateRegularUser(ctx, "email-commenter")
+	require.NoError(t, err)
+	commenterCtx := ts.CreateUserContext(ctx, commenter.ID)
+
+	t.Run("SendTestEmail requires admin and configuration", func(t *testing.T) {
+		_, err := ts.Service.SendTestEmail(ownerCtx, &v1pb.SendTestEmailRequest{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = ts.Service.SendTestEmail(adminCtx, &v1pb.SendTestEmailRequest{})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("SMTP password is encrypted and write-only", func(t *testing.T) {
+		setting, err := ts.Service.UpdateInstanceSetting(adminCtx, &v1pb.UpdateInstanceSettingRequest{
+			Setting: &v1pb.InstanceSetting{
+				Name: "instance/settings/EMAIL",
+				Value: &v1pb.InstanceSetting_EmailSetting_{EmailSetting: &v1pb.InstanceSetting_EmailSetting{
+					SmtpHost:     "127.0.0.1",
+					SmtpPort:     smtpServer.port(),
+					SmtpUsername: "memos",
+					SmtpPassword: "smtp-password",
+					FromEmail:    "memos@example.com",
+				}},
+			},
+		})
+		require.NoError(t, err)
+		require.True(t, setting.GetEmailSetting().SmtpPasswordSet)
+		require.Empty(t, setting.GetEmailSetting().SmtpPassword)
+
+		storeSetting, err := ts.Store.GetInstanceEmailSetting(ctx)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(storeSetting.SmtpPasswordEncrypted, "enc:v1:"))
+
+		_, err = ts.Service.GetInstanceSetting(ownerCtx, &v1pb.GetInstanceSettingRequest{Name: "instance/settings/EMAIL"})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("SendTestEmail sends with the decrypted password", func(t *testing.T) {
+		_, err := ts.Service.SendTestEmail(adminCtx, &v1pb.SendTestEmailRequest{Recipient: "someone@example.com"})
+		require.NoError(t, err)
+
+		emails := smtpServer.received()
+		require.Len(t, emails, 1)
+		require.Equal(t, []string{"someone@example.com"}, emails[0].to)
+		require.Contains(t, emails[0].data, "Subject: Memos test email")
+		auth, err := base64.StdEncoding.DecodeString(emails[0].auth)
+		require.NoError(t, err)
+		require.Equal(t, "\x00memos\x00smtp-password", string(auth))
+	})
+
+	memo, err := ts.Service.CreateMemo(ownerCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Memo waiting for comments", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	comment := func(content string) {
+		_, err := ts.Service.CreateMemoComment(commenterCtx, &v1pb.CreateMemoCommentRequest{
+			Name:    memo.Name,
+			Comment: &v1pb.Memo{Content: content, Visibility: v1pb.Visibility_PUBLIC},
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("Comment emails require opting in", func(t *testing.T) {
+		comment("Comment before opting in")
+
+		_, err := ts.Service.UpdateUserSetting(ownerCtx, &v1pb.UpdateUserSettingRequest{
+			Setting: &v1pb.UserSetting{
+				Name: fmt.Sprintf("users/%d/settings/GENERAL", owner.ID),
+				Value: &v1pb.UserSetting_GeneralSetting_{GeneralSetting: &v1pb.UserSetting_GeneralSetting{
+					EmailOnComment: true,
+				}},
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"email_on_comment"}},
+		})
+		require.NoError(t, err)
+		comment("Comment after opting in")
+
+		require.Eventually(t, func() bool {
+			return len(smtpServer.received()) == 2
+		}, 5*time.Second, 20*time.Millisecond)
+		email := smtpServer.received()[1]
+		require.Equal(t, []string{owner.Email}, email.to)
+		require.Contains(t, email.data, "Subject: email-commenter commented on your memo")
+		require.Contains(t, email.data, "Comment after opting in")
+		require.Contains(t, email.data, "http://localhost:8080/"+memo.Name)
+		require.NotContains(t, email.data, "Comment before opting in")
+	})
+}
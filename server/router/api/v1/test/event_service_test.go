@@ -0,0 +1,190 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// testEventStream is an event stream of a user, started in the background.
+type testEventStream struct {
+	t      *testing.T
+	events chan *v1pb.Event
+}
+
+// startEventStream starts the stream and waits until it receives the events of the user.
+func startEventStream(t *testing.T, ts *TestService, userCtx context.Context, request *v1pb.StreamEventsRequest) *testEventStream {
+	streamCtx, cancel := context.WithCancel(userCtx)
+	t.Cleanup(cancel)
+	stream := &testEventStream{t: t, events: make(chan *v1pb.Event, 64)}
+	go func() {
+		_ = ts.Service.StreamEvents(streamCtx, request, func(event *v1pb.Event) error {
+			stream.events <- event
+			return nil
+		})
+	}()
+
+	// The stream is subscribed once it receives the creation of a private memo of the user.
+	require.Eventually(t, func() bool {
+		_, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "ping", Visibility: v1pb.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		select {
+		case <-stream.events:
+			return true
+		case <-time.After(50 * time.Millisecond):
+			return false
+		}
+	}, 5*time.Second, time.Millisecond)
+	return stream
+}
+
+// next returns the next event of the stream, skipping the events of the memos created to start it.
+func (s *testEventStream) next() *v1pb.Event {
+	for {
+		select {
+		case event := <-s.events:
+			if event.Memo != nil && event.Memo.Content == "ping" {
+				continue
+			}
+			return event
+		case <-time.After(5 * time.Second):
+			s.t.Fatal("timed out waiting for event")
+			return nil
+		}
+	}
+}
+
+// requireNoEvent checks that the stream received no other event than the ones of the memos created to start it.
+func (s *testEventStream) requireNoEvent() {
+	for {
+		select {
+		case event := <-s.events:
+			if event.Memo != nil && event.Memo.Content == "ping" {
+				continue
+			}
+			s.t.Fatalf("unexpected event %v", event)
+		default:
+			return
+		}
+	}
+}
+
+func TestStreamEvents(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+
+	t.Run("Stream requires authentication", func(t *testing.T) {
+		err := ts.Service.StreamEvents(ctx, &v1pb.StreamEventsRequest{}, func(*v1pb.Event) error { return nil })
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	aliceStream := startEventStream(t, ts, aliceCtx, &v1pb.StreamEventsRequest{})
+	bobStream := startEventStream(t, ts, bobCtx, &v1pb.StreamEventsRequest{
+		Types: []v1pb.Event_Type{v1pb.Event_MEMO_CREATED, v1pb.Event_MEMO_DELETED, v1pb.Event_REACTION_UPSERTED},
+	})
+
+	memo, err := ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Hello", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+
+	t.Run("Created memos are streamed to the users who can see them", func(t *testing.T) {
+		event := aliceStream.next()
+		require.Equal(t, v1pb.Event_MEMO_CREATED, event.Type)
+		require.Equal(t, memo.Name, event.Memo.Name)
+		event = bobStream.next()
+		require.Equal(t, v1pb.Event_MEMO_CREATED, event.Type)
+		require.Equal(t, memo.Name, event.Memo.Name)
+
+		privateMemo, err := ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "Secret", Visibility: v1pb.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		require.Equal(t, privateMemo.Name, aliceStream.next().Memo.Name)
+		bobStream.requireNoEvent()
+	})
+
+	t.Run("Comments notify the memo creator", func(t *testing.T) {
+		comment, err := ts.Service.CreateMemoComment(bobCtx, &v1pb.CreateMemoCommentRequest{
+			Name:    memo.Name,
+			Comment: &v1pb.Memo{Content: "Nice", Visibility: v1pb.Visibility_PROTECTED},
+		})
+		require.NoError(t, err)
+
+		event := aliceStream.next()
+		require.Equal(t, v1pb.Event_MEMO_CREATED, event.Type)
+		require.Equal(t, comment.Name, event.Memo.Name)
+		event = aliceStream.next()
+		require.Equal(t, v1pb.Event_NOTIFICATION_CREATED, event.Type)
+		require.Equal(t, v1pb.UserNotification_MEMO_COMMENT, event.Notification.Type)
+		event = aliceStream.next()
+		require.Equal(t, v1pb.Event_MEMO_COMMENT_CREATED, event.Type)
+		require.Equal(t, memo.Name, event.Memo.Name)
+		require.Equal(t, comment.Name, event.Comment.Name)
+
+		// Bob only streams the creation of his comment.
+		require.Equal(t, comment.Name, bobStream.next().Memo.Name)
+		bobStream.requireNoEvent()
+	})
+
+	t.Run("Reactions are streamed", func(t *testing.T) {
+		reaction, err := ts.Service.UpsertMemoReaction(bobCtx, &v1pb.UpsertMemoReactionRequest{
+			Name:     memo.Name,
+			Reaction: &v1pb.Reaction{ContentId: memo.Name, ReactionType: "👍"},
+		})
+		require.NoError(t, err)
+		for _, stream := range []*testEventStream{aliceStream, bobStream} {
+			event := stream.next()
+			require.Equal(t, v1pb.Event_REACTION_UPSERTED, event.Type)
+			require.Equal(t, reaction.Name, event.Reaction.Name)
+		}
+	})
+
+	t.Run("Memos made private are deleted for the users who could see them", func(t *testing.T) {
+		updateVisibility := func(visibility v1pb.Visibility) {
+			_, err := ts.Service.UpdateMemo(aliceCtx, &v1pb.UpdateMemoRequest{
+				Memo:       &v1pb.Memo{Name: memo.Name, Visibility: visibility},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+			})
+			require.NoError(t, err)
+		}
+		updateVisibility(v1pb.Visibility_PRIVATE)
+		event := aliceStream.next()
+		require.Equal(t, v1pb.Event_MEMO_UPDATED, event.Type)
+		require.Equal(t, "Hello", event.Memo.Content)
+		event = bobStream.next()
+		require.Equal(t, v1pb.Event_MEMO_DELETED, event.Type)
+		require.Equal(t, memo.Name, event.Memo.Name)
+		require.Empty(t, event.Memo.Content)
+
+		updateVisibility(v1pb.Visibility_PROTECTED)
+		require.Equal(t, v1pb.Event_MEMO_UPDATED, aliceStream.next().Type)
+	})
+
+	t.Run("Deleted memos are streamed", func(t *testing.T) {
+		_, err := ts.Service.DeleteMemo(aliceCtx, &v1pb.DeleteMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		for _, stream := range []*testEventStream{aliceStream, bobStream} {
+			event := stream.next()
+			require.Equal(t, v1pb.Event_MEMO_DELETED, event.Type)
+			require.Equal(t, memo.Name, event.Memo.Name)
+		}
+	})
+}
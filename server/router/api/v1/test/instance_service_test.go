@@ -364,8 +364,9 @@ func TestUpdateInstanceAISetting(t *testing.T) {
 		// Poll until background reindex updates state and finishes.
 		deadline := time.Now().Add(5 * time.Second)
 		for time.Now().Before(deadline) {
-			aiSetting, getErr := ts.Store.GetInstanceAISetting(ctx)
+			setting, getErr := ts.Service.GetInstanceSetting(userCtx, &v1pb.GetInstanceSettingRequest{Name: "instance/settings/AI"})
 			require.NoError(t, getErr)
+			aiSetting := setting.GetAiSetting()
 			if aiSetting.GetSemanticReindexStartedTs() > 0 && !aiSetting.GetSemanticReindexRunning() {
 				require.Equal(t, "jina-embeddings-v4", aiSetting.GetSemanticReindexModel())
 				require.Greater(t, aiSetting.GetSemanticReindexUpdatedTs(), int64(0))
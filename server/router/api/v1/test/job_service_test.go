@@ -0,0 +1,130 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestJobService(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	var failing atomic.Bool
+	failing.Store(true)
+	var deliveries int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&deliveries, 1)
+		if failing.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(`{"code":0}`))
+	}))
+	defer server.Close()
+
+	hostUser, err := ts.CreateHostUser(ctx, "job-admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "job-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	require.NoError(t, ts.Store.AddUserWebhook(ctx, user.ID, &storepb.WebhooksUserSetting_Webhook{
+		Id:    "hook",
+		Title: "hook",
+		Url:   server.URL,
+	}))
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "webhook memo", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+
+	listWebhookJobs := func(state v1pb.Job_State) []*v1pb.Job {
+		response, err := ts.Service.ListJobs(adminCtx, &v1pb.ListJobsRequest{
+			Type:  store.JobTypeWebhook.String(),
+			State: state,
+		})
+		require.NoError(t, err)
+		return response.Jobs
+	}
+
+	t.Run("Failing webhook job is dead after max attempts", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			return len(listWebhookJobs(v1pb.Job_DEAD)) == 1
+		}, 5*time.Second, 20*time.Millisecond)
+
+		job := listWebhookJobs(v1pb.Job_DEAD)[0]
+		require.Equal(t, int32(5), job.Attempts)
+		require.Equal(t, job.MaxAttempts, job.Attempts)
+		require.Contains(t, job.LastError, "status code: 500")
+		require.Contains(t, job.Payload, server.URL)
+		require.Equal(t, int32(5), atomic.LoadInt32(&deliveries))
+	})
+
+	t.Run("ListJobs requires admin", func(t *testing.T) {
+		_, err := ts.Service.ListJobs(userCtx, &v1pb.ListJobsRequest{})
+		require.Error(t, err)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("RetryJob runs a dead job again", func(t *testing.T) {
+		failing.Store(false)
+		job := listWebhookJobs(v1pb.Job_DEAD)[0]
+
+		_, err := ts.Service.RetryJob(userCtx, &v1pb.RetryJobRequest{Name: job.Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		retried, err := ts.Service.RetryJob(adminCtx, &v1pb.RetryJobRequest{Name: job.Name})
+		require.NoError(t, err)
+		require.Equal(t, int32(0), retried.Attempts)
+		require.Empty(t, retried.LastError)
+
+		require.Eventually(t, func() bool {
+			return len(listWebhookJobs(v1pb.Job_SUCCEEDED)) == 1
+		}, 5*time.Second, 20*time.Millisecond)
+
+		_, err = ts.Service.RetryJob(adminCtx, &v1pb.RetryJobRequest{Name: job.Name})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("CancelJob cancels a pending job", func(t *testing.T) {
+		// The job is scheduled far ahead so that it stays pending.
+		job, err := ts.Store.CreateJob(ctx, &store.Job{
+			Type:        store.JobTypeWebhook,
+			Status:      store.JobPending,
+			MaxAttempts: 5,
+			RunAfterTs:  time.Now().Add(time.Hour).Unix(),
+			Payload: &storepb.JobPayload{
+				Payload: &storepb.JobPayload_Webhook_{Webhook: &storepb.JobPayload_Webhook{Url: server.URL}},
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = ts.Service.CancelJob(adminCtx, &v1pb.CancelJobRequest{Name: "jobs/invalid"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		canceled, err := ts.Service.CancelJob(adminCtx, &v1pb.CancelJobRequest{Name: fmt.Sprintf("jobs/%d", job.ID)})
+		require.NoError(t, err)
+		require.Equal(t, v1pb.Job_CANCELED, canceled.State)
+
+		_, err = ts.Service.CancelJob(adminCtx, &v1pb.CancelJobRequest{Name: fmt.Sprintf("jobs/%d", job.ID)})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		_, err = ts.Service.CancelJob(adminCtx, &v1pb.CancelJobRequest{Name: fmt.Sprintf("jobs/%d", job.ID+100)})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
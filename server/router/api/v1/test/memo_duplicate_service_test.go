@@ -0,0 +1,325 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestCreateMemoPossibleDuplicates(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "duplicate-user")
+	require.NoError(t, err)
+	otherUser, err := ts.CreateRegularUser(ctx, "duplicate-other-user")
+	require.NoError(t, err)
+	ts.Service.EmbeddingClientFactory = func(context.Context) (apiv1.SemanticEmbeddingClient, error) {
+		return &fakeSemanticEmbeddingClient{
+			model: "fake-embedding-model",
+			vectors: map[string][]float64{
+				"Buy milk and eggs":                {1, 0},
+				"Buy  MILK and eggs\n":             {1, 0},
+				"Pick up milk and eggs on the way": {0.99, 0.05},
+				"Plan the team offsite":            {0, 1},
+			},
+		}, nil
+	}
+
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	createMemo := func(ctx context.Context, content string) *v1pb.Memo {
+		memo, err := ts.Service.CreateMemo(ctx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{
+				Content:    content,
+				Visibility: v1pb.Visibility_PRIVATE,
+			},
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	original := createMemo(userCtx, "Buy milk and eggs")
+	require.Empty(t, original.PossibleDuplicates)
+	// Another user's memo with the same content is not a duplicate.
+	otherMemo := createMemo(ts.CreateUserContext(ctx, otherUser.ID), "Buy milk and eggs")
+	require.Empty(t, otherMemo.PossibleDuplicates)
+
+	// The normalized content hash ignores case and spacing.
+	exact := createMemo(userCtx, "Buy  MILK and eggs\n")
+	require.Equal(t, []string{original.Name}, exact.PossibleDuplicates)
+
+	// A nearly identical embedding is a possible duplicate as well.
+	similar := createMemo(userCtx, "Pick up milk and eggs on the way")
+	require.ElementsMatch(t, []string{original.Name, exact.Name}, similar.PossibleDuplicates)
+
+	// Updating the content checks again, other updates do not.
+	updated, err := ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:    similar.Name,
+			Content: "Plan the team offsite",
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, updated.PossibleDuplicates)
+	updated, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:    exact.Name,
+			Content: "Buy milk and eggs",
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{original.Name}, updated.PossibleDuplicates)
+	updated, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:   exact.Name,
+			Pinned: true,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pinned"}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, updated.PossibleDuplicates)
+}
+
+// remoteSemanticEmbeddingClient is an embedding client that does not run in process, like an embedding API.
+// Its requests hang until released.
+type remoteSemanticEmbeddingClient struct {
+	apiv1.SemanticEmbeddingClient
+	released chan struct{}
+}
+
+func (c *remoteSemanticEmbeddingClient) Embed(ctx context.Context, text string) ([]float64, error) {
+	select {
+	case <-c.released:
+		return c.SemanticEmbeddingClient.Embed(ctx, text)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func TestCreateMemoWithRemoteEmbeddingClient(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "remote-embedding-user")
+	require.NoError(t, err)
+	released := make(chan struct{})
+	defer close(released)
+	ts.Service.EmbeddingClientFactory = func(context.Context) (apiv1.SemanticEmbeddingClient, error) {
+		return &remoteSemanticEmbeddingClient{
+			SemanticEmbeddingClient: &fakeSemanticEmbeddingClient{
+				model:   "fake-embedding-model",
+				vectors: map[string][]float64{"Buy milk and eggs": {1, 0}},
+			},
+			released: released,
+		}, nil
+	}
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	start := time.Now()
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Buy milk and eggs", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	duplicate, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Buy milk and eggs", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	// The duplicates are found by content hash while the memos wait for their embedding.
+	require.Equal(t, []string{memo.Name}, duplicate.PossibleDuplicates)
+
+	// Saving the memo does not wait on the remote client, the memo is embedded by a background job.
+	require.Less(t, time.Since(start), time.Second)
+	embeddings, err := ts.Store.ListMemoEmbeddings(ctx, &store.FindMemoEmbedding{})
+	require.NoError(t, err)
+	require.Empty(t, embeddings)
+	jobType := store.JobTypeMemoEmbedding
+	jobs, err := ts.Store.ListJobs(ctx, &store.FindJob{Type: &jobType})
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+}
+
+func TestListDuplicateMemoClusters(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin, err := ts.CreateHostUser(ctx, "duplicate-admin")
+	require.NoError(t, err)
+	user, err := ts.CreateRegularUser(ctx, "duplicate-cluster-user")
+	require.NoError(t, err)
+	otherUser, err := ts.CreateRegularUser(ctx, "duplicate-cluster-other")
+	require.NoError(t, err)
+
+	createMemo := func(uid string, creatorID int32, content string, embedding []float64) {
+		memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  creatorID,
+			Content:    content,
+			Visibility: store.Private,
+		})
+		require.NoError(t, err)
+		if embedding != nil {
+			require.NoError(t, ts.Store.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+				MemoID:      memo.ID,
+				Model:       "fake-embedding-model",
+				Embedding:   embedding,
+				ContentHash: uid,
+			}))
+		}
+	}
+	createMemo("cluster-a", user.ID, "Weekly review notes", nil)
+	createMemo("cluster-b", user.ID, "weekly   REVIEW notes", nil)
+	createMemo("cluster-c", user.ID, "Reading list", []float64{1, 0})
+	createMemo("cluster-d", user.ID, "Books to read", []float64{0.98, 0.1})
+	createMemo("cluster-e", user.ID, "Books I read", []float64{0.3, 0.95})
+	createMemo("cluster-f", otherUser.ID, "Weekly review notes", []float64{1, 0})
+
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	response, err := ts.Service.ListDuplicateMemoClusters(userCtx, &v1pb.ListDuplicateMemoClustersRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Clusters, 2)
+	clusterNames := func(cluster *v1pb.ListDuplicateMemoClustersResponse_DuplicateMemoCluster) []string {
+		names := []string{}
+		for _, memo := range cluster.Memos {
+			names = append(names, memo.Name)
+		}
+		return names
+	}
+	for _, cluster := range response.Clusters {
+		if cluster.Exact {
+			require.ElementsMatch(t, []string{"memos/cluster-a", "memos/cluster-b"}, clusterNames(cluster))
+			require.Equal(t, 1.0, cluster.Similarity)
+		} else {
+			require.ElementsMatch(t, []string{"memos/cluster-c", "memos/cluster-d"}, clusterNames(cluster))
+			require.InDelta(t, 0.98/0.9850888, cluster.Similarity, 1e-4)
+		}
+	}
+
+	// Regular users cannot scan other users, admins can scan everyone.
+	_, err = ts.Service.ListDuplicateMemoClusters(userCtx, &v1pb.ListDuplicateMemoClustersRequest{Creator: "users/-"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	response, err = ts.Service.ListDuplicateMemoClusters(adminCtx, &v1pb.ListDuplicateMemoClustersRequest{Creator: "users/-"})
+	require.NoError(t, err)
+	// Identical memos of different creators are not clustered.
+	require.Len(t, response.Clusters, 2)
+}
+
+func TestMergeMemos(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "merge-user")
+	require.NoError(t, err)
+	otherUser, err := ts.CreateRegularUser(ctx, "merge-other-user")
+	require.NoError(t, err)
+
+	createMemo := func(uid string, creatorID int32, content string) *store.Memo {
+		memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  creatorID,
+			Content:    content,
+			Visibility: store.Private,
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	survivor := createMemo("merge-survivor", user.ID, "First draft #idea")
+	merged := createMemo("merge-merged", user.ID, "Second draft #plan\n")
+	referenced := createMemo("merge-referenced", user.ID, "A referenced memo")
+	comment := createMemo("merge-comment", otherUser.ID, "A comment")
+	createMemo("merge-foreign", otherUser.ID, "Not mine")
+
+	_, err = ts.Store.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: merged.ID, RelatedMemoID: referenced.ID, Type: store.MemoRelationReference})
+	require.NoError(t, err)
+	_, err = ts.Store.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: comment.ID, RelatedMemoID: merged.ID, Type: store.MemoRelationComment})
+	require.NoError(t, err)
+	_, err = ts.Store.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: survivor.ID, RelatedMemoID: merged.ID, Type: store.MemoRelationReference})
+	require.NoError(t, err)
+	attachment, err := ts.Store.CreateAttachment(ctx, &store.Attachment{
+		UID:       "merge-attachment",
+		CreatorID: user.ID,
+		Filename:  "photo.png",
+		Type:      "image/png",
+		MemoID:    &merged.ID,
+	})
+	require.NoError(t, err)
+	for _, reaction := range []*store.Reaction{
+		{CreatorID: user.ID, ContentID: "memos/merge-survivor", ReactionType: "👍"},
+		{CreatorID: user.ID, ContentID: "memos/merge-merged", ReactionType: "👍"},
+		{CreatorID: otherUser.ID, ContentID: "memos/merge-merged", ReactionType: "🎉"},
+	} {
+		_, err = ts.Store.UpsertReaction(ctx, reaction)
+		require.NoError(t, err)
+	}
+
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	_, err = ts.Service.MergeMemos(userCtx, &v1pb.MergeMemosRequest{
+		Name:        "memos/merge-survivor",
+		MergedMemos: []string{"memos/merge-foreign"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.MergeMemos(userCtx, &v1pb.MergeMemosRequest{
+		Name:        "memos/merge-survivor",
+		MergedMemos: []string{"memos/merge-survivor"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.MergeMemos(ts.CreateUserContext(ctx, otherUser.ID), &v1pb.MergeMemosRequest{
+		Name:        "memos/merge-foreign",
+		MergedMemos: []string{"memos/merge-survivor"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	memo, err := ts.Service.MergeMemos(userCtx, &v1pb.MergeMemosRequest{
+		Name:        "memos/merge-survivor",
+		MergedMemos: []string{"memos/merge-merged"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "First draft #idea\n\nSecond draft #plan", memo.Content)
+	require.ElementsMatch(t, []string{"idea", "plan"}, memo.Tags)
+	require.Len(t, memo.Attachments, 1)
+	require.Equal(t, "attachments/"+attachment.UID, memo.Attachments[0].Name)
+	require.Len(t, memo.Reactions, 2)
+
+	mergedMemo, err := ts.Store.GetMemo(ctx, &store.FindMemo{ID: &merged.ID})
+	require.NoError(t, err)
+	require.Nil(t, mergedMemo)
+	relations, err := ts.Store.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &survivor.ID})
+	require.NoError(t, err)
+	require.Len(t, relations, 1)
+	require.Equal(t, referenced.ID, relations[0].RelatedMemoID)
+	relations, err = ts.Store.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &survivor.ID})
+	require.NoError(t, err)
+	require.Len(t, relations, 1)
+	require.Equal(t, comment.ID, relations[0].MemoID)
+	require.Equal(t, store.MemoRelationComment, relations[0].Type)
+	mergedName := "memos/merge-merged"
+	reactions, err := ts.Store.ListReactions(ctx, &store.FindReaction{ContentID: &mergedName})
+	require.NoError(t, err)
+	require.Empty(t, reactions)
+
+	// A merge interrupted after the survivor was updated is completed by retrying it, without appending the content again.
+	leftover := createMemo("merge-leftover", user.ID, "Second draft #plan")
+	memo, err = ts.Service.MergeMemos(userCtx, &v1pb.MergeMemosRequest{
+		Name:        "memos/merge-survivor",
+		MergedMemos: []string{"memos/merge-leftover"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "First draft #idea\n\nSecond draft #plan", memo.Content)
+	leftoverMemo, err := ts.Store.GetMemo(ctx, &store.FindMemo{ID: &leftover.ID})
+	require.NoError(t, err)
+	require.Nil(t, leftoverMemo)
+}
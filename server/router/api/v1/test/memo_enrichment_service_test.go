@@ -0,0 +1,145 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoEnrichment(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	var completionCount int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/chat/completions" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		atomic.AddInt32(&completionCount, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"choices": []map[string]any{
+				{"message": map[string]any{
+					"role":    "assistant",
+					"content": `{"summary":"Plans for the Kyoto trip.","tags":["#Travel","japan trip","kyoto"],"category":"Travel"}`,
+				}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	t.Setenv("MEMOS_OPENAI_API_KEY", "sk-test")
+	_, err := ts.Store.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_AI,
+		Value: &storepb.InstanceSetting_AiSetting{
+			AiSetting: &storepb.InstanceAISetting{
+				EmbeddingProvider:     "local",
+				OpenaiBaseUrl:         server.URL,
+				MemoEnrichmentEnabled: true,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	user, err := ts.CreateRegularUser(ctx, "enrichment-user")
+	require.NoError(t, err)
+	otherUser, err := ts.CreateRegularUser(ctx, "enrichment-other-user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    "Book the ryokan for the #kyoto trip",
+			Visibility: v1pb.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+	waitForEnrichment := func(count int32) *v1pb.Memo {
+		require.Eventually(t, func() bool {
+			return atomic.LoadInt32(&completionCount) >= count
+		}, 5*time.Second, 10*time.Millisecond)
+		var enriched *v1pb.Memo
+		require.Eventually(t, func() bool {
+			enriched, err = ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+			require.NoError(t, err)
+			return enriched.Enrichment != nil && enriched.Enrichment.Category != ""
+		}, 5*time.Second, 10*time.Millisecond)
+		return enriched
+	}
+	enriched := waitForEnrichment(1)
+	require.Equal(t, "Plans for the Kyoto trip.", enriched.Enrichment.Summary)
+	// Tags already in the content are not suggested.
+	require.Equal(t, []string{"travel", "japan-trip"}, enriched.Enrichment.SuggestedTags)
+	require.Equal(t, "travel", enriched.Enrichment.Category)
+	require.False(t, enriched.Enrichment.SummaryAccepted)
+
+	// The category is filterable.
+	memos, err := ts.Store.ListMemos(ctx, &store.FindMemo{Filters: []string{`category == "travel"`}})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+
+	// Accepted tags are appended to the content as hashtags.
+	accepted, err := ts.Service.AcceptMemoEnrichment(userCtx, &v1pb.AcceptMemoEnrichmentRequest{
+		Name:    memo.Name,
+		Summary: true,
+		Tags:    []string{"travel"},
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasSuffix(accepted.Content, "\n\n#travel"))
+	require.ElementsMatch(t, []string{"kyoto", "travel"}, accepted.Tags)
+	require.Equal(t, []string{"japan-trip"}, accepted.Enrichment.SuggestedTags)
+	require.True(t, accepted.Enrichment.SummaryAccepted)
+	require.False(t, accepted.Enrichment.CategoryAccepted)
+
+	_, err = ts.Service.AcceptMemoEnrichment(userCtx, &v1pb.AcceptMemoEnrichmentRequest{
+		Name: memo.Name,
+		Tags: []string{"unknown"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.AcceptMemoEnrichment(ts.CreateUserContext(ctx, otherUser.ID), &v1pb.AcceptMemoEnrichmentRequest{
+		Name:     memo.Name,
+		Category: true,
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	rejected, err := ts.Service.RejectMemoEnrichment(userCtx, &v1pb.RejectMemoEnrichmentRequest{
+		Name:     memo.Name,
+		Tags:     []string{"japan-trip"},
+		Category: true,
+	})
+	require.NoError(t, err)
+	require.Empty(t, rejected.Enrichment.SuggestedTags)
+	require.Empty(t, rejected.Enrichment.Category)
+	require.Equal(t, "Plans for the Kyoto trip.", rejected.Enrichment.Summary)
+
+	// Editing the content enriches the memo again, without suggesting rejected tags.
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name:    memo.Name,
+			Content: "Book the ryokan and the train for the #kyoto trip",
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	enriched = waitForEnrichment(2)
+	require.Equal(t, []string{"travel"}, enriched.Enrichment.SuggestedTags)
+	require.Equal(t, "travel", enriched.Enrichment.Category)
+	require.True(t, enriched.Enrichment.SummaryAccepted)
+	require.Equal(t, int32(2), atomic.LoadInt32(&completionCount))
+}
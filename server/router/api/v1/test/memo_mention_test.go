@@ -0,0 +1,114 @@
+package test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoMentions(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	author, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	authorCtx := ts.CreateUserContext(ctx, author.ID)
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+
+	listMentions := func(userCtx context.Context, userID int32) []*v1pb.UserNotification {
+		response, err := ts.Service.ListUserNotifications(userCtx, &v1pb.ListUserNotificationsRequest{
+			Parent: "users/" + strconv.Itoa(int(userID)),
+		})
+		require.NoError(t, err)
+		notifications := []*v1pb.UserNotification{}
+		for _, notification := range response.Notifications {
+			if notification.Type == v1pb.UserNotification_MEMO_MENTION {
+				notifications = append(notifications, notification)
+			}
+		}
+		return notifications
+	}
+
+	memo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Thanks @alice and @nobody, email@example.com", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+
+	t.Run("Mentions are extracted", func(t *testing.T) {
+		require.Equal(t, []string{"alice", "nobody"}, memo.Mentions)
+
+		response, err := ts.Service.ListMemos(aliceCtx, &v1pb.ListMemosRequest{Filter: `"alice" in mentions`})
+		require.NoError(t, err)
+		require.Len(t, response.Memos, 1)
+		require.Equal(t, memo.Name, response.Memos[0].Name)
+	})
+
+	t.Run("Mentioned users are notified", func(t *testing.T) {
+		notifications := listMentions(aliceCtx, alice.ID)
+		require.Len(t, notifications, 1)
+		require.Equal(t, "users/"+strconv.Itoa(int(author.ID)), notifications[0].Sender)
+
+		activity, err := ts.Service.GetActivity(aliceCtx, &v1pb.GetActivityRequest{
+			Name: "activities/" + strconv.Itoa(int(notifications[0].GetActivityId())),
+		})
+		require.NoError(t, err)
+		require.Equal(t, v1pb.Activity_MEMO_MENTION, activity.Type)
+		require.Equal(t, memo.Name, activity.Payload.GetMemoMention().Memo)
+	})
+
+	t.Run("Editing only notifies new mentions", func(t *testing.T) {
+		_, err := ts.Service.UpdateMemo(authorCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: "Thanks @alice and @bob"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+		require.Len(t, listMentions(aliceCtx, alice.ID), 1)
+		require.Len(t, listMentions(bobCtx, bob.ID), 1)
+	})
+
+	t.Run("Private memos notify once shared", func(t *testing.T) {
+		privateMemo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "Draft for @bob", Visibility: v1pb.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		require.Len(t, listMentions(bobCtx, bob.ID), 1)
+
+		_, err = ts.Service.UpdateMemo(authorCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: privateMemo.Name, Visibility: v1pb.Visibility_PROTECTED},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+		})
+		require.NoError(t, err)
+		require.Len(t, listMentions(bobCtx, bob.ID), 2)
+	})
+
+	t.Run("Comments on private memos do not notify other users", func(t *testing.T) {
+		privateMemo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "Private notes", Visibility: v1pb.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		_, err = ts.Service.CreateMemoComment(authorCtx, &v1pb.CreateMemoCommentRequest{
+			Name:    privateMemo.Name,
+			Comment: &v1pb.Memo{Content: "Ask @alice", Visibility: v1pb.Visibility_PROTECTED},
+		})
+		require.NoError(t, err)
+		require.Len(t, listMentions(aliceCtx, alice.ID), 1)
+
+		_, err = ts.Service.CreateMemoComment(authorCtx, &v1pb.CreateMemoCommentRequest{
+			Name:    memo.Name,
+			Comment: &v1pb.Memo{Content: "Ask @alice", Visibility: v1pb.Visibility_PROTECTED},
+		})
+		require.NoError(t, err)
+		require.Len(t, listMentions(aliceCtx, alice.ID), 2)
+	})
+}
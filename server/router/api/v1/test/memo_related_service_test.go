@@ -0,0 +1,140 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestListRelatedMemos(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "related-user")
+	require.NoError(t, err)
+	otherUser, err := ts.CreateRegularUser(ctx, "related-other-user")
+	require.NoError(t, err)
+
+	createMemo := func(uid string, creatorID int32, visibility store.Visibility, tags []string, embedding []float64) *store.Memo {
+		memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  creatorID,
+			Content:    uid,
+			Visibility: visibility,
+			Payload:    &storepb.MemoPayload{Tags: tags},
+		})
+		require.NoError(t, err)
+		if embedding != nil {
+			require.NoError(t, ts.Store.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+				MemoID:      memo.ID,
+				Model:       "fake-embedding-model",
+				Embedding:   embedding,
+				ContentHash: uid,
+			}))
+		}
+		return memo
+	}
+	source := createMemo("related-source", user.ID, store.Private, []string{"travel", "japan"}, []float64{1, 0})
+	similar := createMemo("related-similar", user.ID, store.Private, nil, []float64{0.9, 0.1})
+	tagged := createMemo("related-tagged", user.ID, store.Private, []string{"japan", "food"}, []float64{0, 1})
+	referenced := createMemo("related-referenced", user.ID, store.Private, nil, nil)
+	createMemo("related-unrelated", user.ID, store.Private, []string{"work"}, []float64{-1, 0})
+	createMemo("related-invisible", otherUser.ID, store.Private, []string{"travel", "japan"}, []float64{1, 0})
+
+	_, err = ts.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
+		MemoID:        referenced.ID,
+		RelatedMemoID: source.ID,
+		Type:          store.MemoRelationReference,
+	})
+	require.NoError(t, err)
+
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	response, err := ts.Service.ListRelatedMemos(userCtx, &v1pb.ListRelatedMemosRequest{
+		Name: "memos/" + source.UID,
+	})
+	require.NoError(t, err)
+	names := []string{}
+	for _, relatedMemo := range response.RelatedMemos {
+		names = append(names, relatedMemo.Memo.Name)
+	}
+	// The private memo of another user is never returned, even with identical tags and embedding,
+	// and a memo with an opposite embedding and no shared tag is not related.
+	require.Equal(t, []string{
+		"memos/" + similar.UID,
+		"memos/" + referenced.UID,
+		"memos/" + tagged.UID,
+	}, names)
+
+	similarResult := response.RelatedMemos[0]
+	require.InDelta(t, 0.9/0.9055385, similarResult.SemanticScore, 1e-4)
+	require.Empty(t, similarResult.SharedTags)
+	require.False(t, similarResult.Referenced)
+	require.NotNil(t, similarResult.SuggestedRelation)
+	require.Equal(t, "memos/"+source.UID, similarResult.SuggestedRelation.Memo.Name)
+	require.Equal(t, "memos/"+similar.UID, similarResult.SuggestedRelation.RelatedMemo.Name)
+	require.Equal(t, v1pb.MemoRelation_REFERENCE, similarResult.SuggestedRelation.Type)
+
+	referencedResult := response.RelatedMemos[1]
+	require.True(t, referencedResult.Referenced)
+	require.Nil(t, referencedResult.SuggestedRelation)
+
+	taggedResult := response.RelatedMemos[2]
+	require.Equal(t, []string{"japan"}, taggedResult.SharedTags)
+	require.InDelta(t, 0.5/3, taggedResult.Score, 1e-9)
+	require.Nil(t, taggedResult.SuggestedRelation)
+
+	// Accepting a suggestion writes it through SetMemoRelations.
+	_, err = ts.Service.SetMemoRelations(userCtx, &v1pb.SetMemoRelationsRequest{
+		Name:      "memos/" + source.UID,
+		Relations: []*v1pb.MemoRelation{similarResult.SuggestedRelation},
+	})
+	require.NoError(t, err)
+	response, err = ts.Service.ListRelatedMemos(userCtx, &v1pb.ListRelatedMemosRequest{
+		Name:  "memos/" + source.UID,
+		Limit: 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, response.RelatedMemos, 1)
+	require.True(t, response.RelatedMemos[0].Referenced)
+	require.Nil(t, response.RelatedMemos[0].SuggestedRelation)
+
+	// Other users cannot read the related memos of a private memo.
+	otherCtx := ts.CreateUserContext(ctx, otherUser.ID)
+	_, err = ts.Service.ListRelatedMemos(otherCtx, &v1pb.ListRelatedMemosRequest{
+		Name: "memos/" + source.UID,
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestListRelatedMemosWithoutSignals(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "related-lonely-user")
+	require.NoError(t, err)
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "related-lonely",
+		CreatorID:  user.ID,
+		Content:    "A memo without tags, embedding or relations.",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	response, err := ts.Service.ListRelatedMemos(ctx, &v1pb.ListRelatedMemosRequest{
+		Name: "memos/" + memo.UID,
+	})
+	require.NoError(t, err)
+	require.Empty(t, response.RelatedMemos)
+
+	_, err = ts.Service.ListRelatedMemos(ctx, &v1pb.ListRelatedMemosRequest{Name: "memos/missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,161 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoRevisions(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	admin, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, admin.ID)
+	user, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Shopping\n- milk\n- bread\n", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	updateMemo := func(memo *v1pb.Memo, paths ...string) {
+		_, err := ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{Memo: memo, UpdateMask: &fieldmaskpb.FieldMask{Paths: paths}})
+		require.NoError(t, err)
+	}
+	listRevisions := func() []*v1pb.MemoRevision {
+		response, err := ts.Service.ListMemoRevisions(userCtx, &v1pb.ListMemoRevisionsRequest{Name: memo.Name})
+		require.NoError(t, err)
+		return response.Revisions
+	}
+
+	t.Run("Updates record the prior version", func(t *testing.T) {
+		updateMemo(&v1pb.Memo{Name: memo.Name, Content: "Shopping\n- milk\n- eggs\n"}, "content")
+		updateMemo(&v1pb.Memo{Name: memo.Name, Visibility: v1pb.Visibility_PROTECTED}, "visibility")
+		// Pinning leaves the content as it is, so no revision is recorded.
+		updateMemo(&v1pb.Memo{Name: memo.Name, Pinned: true}, "pinned")
+
+		revisions := listRevisions()
+		require.Len(t, revisions, 2)
+		require.Equal(t, "Shopping\n- milk\n- eggs\n", revisions[0].Content)
+		require.Equal(t, v1pb.Visibility_PRIVATE, revisions[0].Visibility)
+		require.Equal(t, "Shopping\n- milk\n- bread\n", revisions[1].Content)
+		require.Empty(t, revisions[1].Diff)
+	})
+
+	t.Run("A revision has a diff against the current version", func(t *testing.T) {
+		revision, err := ts.Service.GetMemoRevision(userCtx, &v1pb.GetMemoRevisionRequest{Name: listRevisions()[1].Name})
+		require.NoError(t, err)
+		require.Equal(t, "--- "+revision.Name+"\n+++ "+memo.Name+"\n@@ -1,3 +1,3 @@\n Shopping\n - milk\n-- bread\n+- eggs\n", revision.Diff)
+	})
+
+	t.Run("Only the creator and admins can access the revisions", func(t *testing.T) {
+		_, err := ts.Service.ListMemoRevisions(otherCtx, &v1pb.ListMemoRevisionsRequest{Name: memo.Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.RestoreMemoRevision(otherCtx, &v1pb.RestoreMemoRevisionRequest{Name: listRevisions()[0].Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.GetMemoRevision(adminCtx, &v1pb.GetMemoRevisionRequest{Name: listRevisions()[0].Name})
+		require.NoError(t, err)
+		_, err = ts.Service.GetMemoRevision(userCtx, &v1pb.GetMemoRevisionRequest{Name: memo.Name + "/revisions/999"})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Restoring a revision can be undone", func(t *testing.T) {
+		restored, err := ts.Service.RestoreMemoRevision(userCtx, &v1pb.RestoreMemoRevisionRequest{Name: listRevisions()[1].Name})
+		require.NoError(t, err)
+		require.Equal(t, "Shopping\n- milk\n- bread\n", restored.Content)
+		require.Equal(t, v1pb.Visibility_PRIVATE, restored.Visibility)
+		require.True(t, restored.Pinned)
+
+		revisions := listRevisions()
+		require.Len(t, revisions, 3)
+		require.Equal(t, "Shopping\n- milk\n- eggs\n", revisions[0].Content)
+		require.Equal(t, v1pb.Visibility_PROTECTED, revisions[0].Visibility)
+	})
+
+	t.Run("The retention follows the memo related setting", func(t *testing.T) {
+		setting, err := ts.Service.GetInstanceSetting(adminCtx, &v1pb.GetInstanceSettingRequest{Name: "instance/settings/MEMO_RELATED"})
+		require.NoError(t, err)
+		memoRelatedSetting := setting.GetMemoRelatedSetting()
+		require.Equal(t, int32(50), memoRelatedSetting.MemoRevisionLimit)
+		memoRelatedSetting.MemoRevisionLimit = 2
+		_, err = ts.Service.UpdateInstanceSetting(adminCtx, &v1pb.UpdateInstanceSettingRequest{Setting: setting})
+		require.NoError(t, err)
+
+		updateMemo(&v1pb.Memo{Name: memo.Name, Content: "Shopping\n- tea\n"}, "content")
+		revisions := listRevisions()
+		require.Len(t, revisions, 2)
+		require.Equal(t, "Shopping\n- milk\n- bread\n", revisions[0].Content)
+
+		memoRelatedSetting.DisableMemoRevisions = true
+		_, err = ts.Service.UpdateInstanceSetting(adminCtx, &v1pb.UpdateInstanceSettingRequest{Setting: setting})
+		require.NoError(t, err)
+		updateMemo(&v1pb.Memo{Name: memo.Name, Content: "Shopping\n- coffee\n"}, "content")
+		require.Len(t, listRevisions(), 2)
+	})
+}
+
+func TestMemoRevisionsOfMergesAndEnrichments(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Trip to Kyoto", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	draft, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Book the ryokan", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	listRevisions := func() []*v1pb.MemoRevision {
+		response, err := ts.Service.ListMemoRevisions(userCtx, &v1pb.ListMemoRevisionsRequest{Name: memo.Name})
+		require.NoError(t, err)
+		return response.Revisions
+	}
+
+	t.Run("Merging records the prior version", func(t *testing.T) {
+		merged, err := ts.Service.MergeMemos(userCtx, &v1pb.MergeMemosRequest{Name: memo.Name, MergedMemos: []string{draft.Name}})
+		require.NoError(t, err)
+		require.Equal(t, "Trip to Kyoto\n\nBook the ryokan", merged.Content)
+
+		revisions := listRevisions()
+		require.Len(t, revisions, 1)
+		require.Equal(t, "Trip to Kyoto", revisions[0].Content)
+	})
+
+	t.Run("Accepting suggested tags records the prior version", func(t *testing.T) {
+		memoUID := memo.Name[len("memos/"):]
+		storeMemo, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+		require.NoError(t, err)
+		storeMemo.Payload.Enrichment = &storepb.MemoPayload_Enrichment{SuggestedTags: []string{"travel"}}
+		err = ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: storeMemo.ID, Payload: storeMemo.Payload})
+		require.NoError(t, err)
+
+		accepted, err := ts.Service.AcceptMemoEnrichment(userCtx, &v1pb.AcceptMemoEnrichmentRequest{Name: memo.Name, Tags: []string{"travel"}})
+		require.NoError(t, err)
+		require.Equal(t, "Trip to Kyoto\n\nBook the ryokan\n\n#travel", accepted.Content)
+
+		revisions := listRevisions()
+		require.Len(t, revisions, 2)
+		require.Equal(t, "Trip to Kyoto\n\nBook the ryokan", revisions[0].Content)
+	})
+}
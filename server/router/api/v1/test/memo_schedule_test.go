@@ -0,0 +1,188 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoSchedules(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	smtpServer := newTestSMTPServer(t)
+	defer smtpServer.listener.Close()
+
+	hostUser, err := ts.CreateHostUser(ctx, "schedule-admin")
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	_, err = ts.Service.UpdateInstanceSetting(adminCtx, &v1pb.UpdateInstanceSettingRequest{
+		Setting: &v1pb.InstanceSetting{
+			Name: "instance/settings/EMAIL",
+			Value: &v1pb.InstanceSetting_EmailSetting_{EmailSetting: &v1pb.InstanceSetting_EmailSetting{
+				SmtpHost:  "127.0.0.1",
+				SmtpPort:  smtpServer.port(),
+				FromEmail: "memos@example.com",
+			}},
+		},
+	})
+	require.NoError(t, err)
+
+	user, err := ts.CreateRegularUser(ctx, "scheduler")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	reader, err := ts.CreateRegularUser(ctx, "reader")
+	require.NoError(t, err)
+	readerCtx := ts.CreateUserContext(ctx, reader.ID)
+	_, err = ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+		Setting: &v1pb.UserSetting{
+			Name: fmt.Sprintf("users/%d/settings/GENERAL", user.ID),
+			Value: &v1pb.UserSetting_GeneralSetting_{GeneralSetting: &v1pb.UserSetting_GeneralSetting{
+				EmailOnReminder: true,
+			}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"email_on_reminder"}},
+	})
+	require.NoError(t, err)
+	hook, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  "users/" + strconv.Itoa(int(user.ID)),
+		Webhook: &v1pb.UserWebhook{Url: "http://127.0.0.1:1", EventTypes: []string{webhook.ActivityTypeMemoReminded, webhook.ActivityTypeMemoPublished}},
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	listMemos := func(userCtx context.Context, filter string) []*v1pb.Memo {
+		response, err := ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{Filter: filter})
+		require.NoError(t, err)
+		return response.Memos
+	}
+
+	t.Run("Publish time must be in the future", func(t *testing.T) {
+		_, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "Too late", Visibility: v1pb.Visibility_PUBLIC, PublishTime: timestamppb.New(now.Add(-time.Hour))},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "Nowhere to publish", Visibility: v1pb.Visibility_PRIVATE, PublishTime: timestamppb.New(now.Add(time.Hour))},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	scheduled, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Launch announcement", Visibility: v1pb.Visibility_PROTECTED, PublishTime: timestamppb.New(now.Add(time.Hour))},
+	})
+	require.NoError(t, err)
+	reminded, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Renew the certificate", Visibility: v1pb.Visibility_PRIVATE, RemindTime: timestamppb.New(now.Add(time.Hour))},
+	})
+	require.NoError(t, err)
+
+	t.Run("Scheduled memos stay private", func(t *testing.T) {
+		require.Equal(t, v1pb.Visibility_PRIVATE, scheduled.Visibility)
+		require.Equal(t, v1pb.Visibility_PROTECTED, scheduled.PublishVisibility)
+		require.Equal(t, now.Add(time.Hour).Unix(), scheduled.PublishTime.AsTime().Unix())
+		require.Empty(t, listMemos(readerCtx, ""))
+	})
+
+	t.Run("Schedules are filterable", func(t *testing.T) {
+		memos := listMemos(userCtx, "publish_ts > now()")
+		require.Len(t, memos, 1)
+		require.Equal(t, scheduled.Name, memos[0].Name)
+
+		memos = listMemos(userCtx, "remind_ts > 0")
+		require.Len(t, memos, 1)
+		require.Equal(t, reminded.Name, memos[0].Name)
+	})
+
+	t.Run("Nothing happens before the schedules are due", func(t *testing.T) {
+		require.NoError(t, ts.Service.RunMemoSchedules(ctx, now))
+		require.Empty(t, listMemos(readerCtx, ""))
+		response, err := ts.Service.ListUserNotifications(userCtx, &v1pb.ListUserNotificationsRequest{Parent: "users/" + strconv.Itoa(int(user.ID))})
+		require.NoError(t, err)
+		require.Empty(t, response.Notifications)
+	})
+
+	require.NoError(t, ts.Service.RunMemoSchedules(ctx, now.Add(2*time.Hour)))
+
+	t.Run("Scheduled memos are published", func(t *testing.T) {
+		memos := listMemos(readerCtx, "")
+		require.Len(t, memos, 1)
+		require.Equal(t, scheduled.Name, memos[0].Name)
+		require.Equal(t, v1pb.Visibility_PROTECTED, memos[0].Visibility)
+		require.Nil(t, memos[0].PublishTime)
+		require.Equal(t, now.Add(time.Hour).Unix(), memos[0].CreateTime.AsTime().Unix())
+	})
+
+	t.Run("Reminders are sent once", func(t *testing.T) {
+		require.NoError(t, ts.Service.RunMemoSchedules(ctx, now.Add(3*time.Hour)))
+
+		response, err := ts.Service.ListUserNotifications(userCtx, &v1pb.ListUserNotificationsRequest{Parent: "users/" + strconv.Itoa(int(user.ID))})
+		require.NoError(t, err)
+		require.Len(t, response.Notifications, 1)
+		require.Equal(t, v1pb.UserNotification_MEMO_REMINDER, response.Notifications[0].Type)
+		activity, err := ts.Service.GetActivity(userCtx, &v1pb.GetActivityRequest{
+			Name: "activities/" + strconv.Itoa(int(response.Notifications[0].GetActivityId())),
+		})
+		require.NoError(t, err)
+		require.Equal(t, reminded.Name, activity.Payload.GetMemoReminder().Memo)
+
+		memo, err := ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: reminded.Name})
+		require.NoError(t, err)
+		require.Nil(t, memo.RemindTime)
+	})
+
+	t.Run("Reminders are emailed to opted in users", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			return len(smtpServer.received()) == 1
+		}, 5*time.Second, 20*time.Millisecond)
+		email := smtpServer.received()[0]
+		require.Equal(t, []string{user.Email}, email.to)
+		require.Contains(t, email.data, "Subject: Memo reminder")
+		require.Contains(t, email.data, "Renew the certificate")
+	})
+
+	t.Run("Reminders and publications are sent to webhooks", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			response, err := ts.Service.ListUserWebhookDeliveries(userCtx, &v1pb.ListUserWebhookDeliveriesRequest{Parent: hook.Name})
+			require.NoError(t, err)
+			return len(response.Deliveries) == 2
+		}, 5*time.Second, 20*time.Millisecond)
+	})
+
+	t.Run("Scheduling can be updated and cancelled", func(t *testing.T) {
+		memo, err := ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: scheduled.Name, PublishTime: timestamppb.New(now.Add(time.Hour))},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"publish_time"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, v1pb.Visibility_PRIVATE, memo.Visibility)
+		require.Equal(t, v1pb.Visibility_PROTECTED, memo.PublishVisibility)
+
+		memo, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: scheduled.Name, PublishVisibility: v1pb.Visibility_PRIVATE},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"publish_visibility"}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		memo, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: scheduled.Name},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"publish_time"}},
+		})
+		require.NoError(t, err)
+		require.Nil(t, memo.PublishTime)
+		require.Equal(t, v1pb.Visibility_PRIVATE, memo.Visibility)
+	})
+}
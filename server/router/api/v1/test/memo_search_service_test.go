@@ -0,0 +1,300 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestSearchMemosKeyword(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "search-user")
+	require.NoError(t, err)
+	otherUser, err := ts.CreateRegularUser(ctx, "search-other-user")
+	require.NoError(t, err)
+
+	memoOnce, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "search-once",
+		CreatorID:  user.ID,
+		Content:    "Notes about the staging cluster and a short mention of Kubernetes at the end.",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	memoTwice, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "search-twice",
+		CreatorID:  user.ID,
+		Content:    "Kubernetes upgrade: drain nodes, then upgrade Kubernetes.",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	_, err = ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "search-unrelated",
+		CreatorID:  user.ID,
+		Content:    "Grocery list for the weekend.",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	_, err = ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "search-other-private",
+		CreatorID:  otherUser.ID,
+		Content:    "Kubernetes secrets of another user.",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	response, err := ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{
+		Query: "kubernetes",
+		Mode:  v1pb.SearchMemosRequest_KEYWORD,
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Results, 2)
+	require.Equal(t, "memos/"+memoTwice.UID, response.Results[0].Memo.Name)
+	require.Equal(t, "memos/"+memoOnce.UID, response.Results[1].Memo.Name)
+
+	first := response.Results[0]
+	require.Equal(t, int32(1), first.KeywordRank)
+	require.Greater(t, first.KeywordScore, response.Results[1].KeywordScore)
+	require.Equal(t, first.KeywordScore, first.Score)
+	require.Zero(t, first.SemanticRank)
+	require.Equal(t, []string{"kubernetes"}, first.MatchedTerms)
+	require.Contains(t, response.Results[1].Snippet, "Kubernetes")
+
+	// Paging keeps the ranking order.
+	response, err = ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{
+		Query:    "kubernetes",
+		Mode:     v1pb.SearchMemosRequest_KEYWORD,
+		PageSize: 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Results, 1)
+	require.Equal(t, "memos/"+memoTwice.UID, response.Results[0].Memo.Name)
+	require.NotEmpty(t, response.NextPageToken)
+	response, err = ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{
+		Query:     "kubernetes",
+		Mode:      v1pb.SearchMemosRequest_KEYWORD,
+		PageToken: response.NextPageToken,
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Results, 1)
+	require.Equal(t, "memos/"+memoOnce.UID, response.Results[0].Memo.Name)
+	require.Empty(t, response.NextPageToken)
+}
+
+func TestSearchMemosKeywordScoresOlderMemos(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "search-user")
+	require.NoError(t, err)
+
+	oldMemo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "search-old-best",
+		CreatorID:  user.ID,
+		Content:    "Kubernetes Kubernetes Kubernetes",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	// More newer weak matches than fit in a single page of candidates.
+	for i := 0; i < 1000; i++ {
+		_, err := ts.Store.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("search-newer-%d", i),
+			CreatorID:  user.ID,
+			Content:    "A long note about the staging cluster, the release checklist, and a passing mention of Kubernetes.",
+			Visibility: store.Private,
+		})
+		require.NoError(t, err)
+	}
+
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	response, err := ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{
+		Query:    "kubernetes",
+		Mode:     v1pb.SearchMemosRequest_KEYWORD,
+		PageSize: 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Results, 1)
+	require.Equal(t, "memos/"+oldMemo.UID, response.Results[0].Memo.Name)
+}
+
+func TestSearchMemosHybridFusesRankings(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "hybrid-user")
+	require.NoError(t, err)
+
+	exactMemo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "hybrid-exact",
+		CreatorID:  user.ID,
+		Content:    "Error code E1234 when saving settings.",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	similarMemo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "hybrid-similar",
+		CreatorID:  user.ID,
+		Content:    "Saving settings fails after the last upgrade.",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, ts.Store.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+		MemoID:      exactMemo.ID,
+		Model:       "fake-embedding-model",
+		Embedding:   []float64{0.2, 0.8},
+		ContentHash: "hash-exact",
+	}))
+	require.NoError(t, ts.Store.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+		MemoID:      similarMemo.ID,
+		Model:       "fake-embedding-model",
+		Embedding:   []float64{1, 0},
+		ContentHash: "hash-similar",
+	}))
+
+	ts.Service.EmbeddingClientFactory = func(context.Context) (apiv1.SemanticEmbeddingClient, error) {
+		return &fakeSemanticEmbeddingClient{
+			model: "fake-embedding-model",
+			vectors: map[string][]float64{
+				"e1234": {1, 0},
+			},
+		}, nil
+	}
+
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	response, err := ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{
+		Query: "e1234",
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Results, 2)
+
+	// The exact match is ranked by both rankings and wins the fusion.
+	exact := response.Results[0]
+	require.Equal(t, "memos/"+exactMemo.UID, exact.Memo.Name)
+	require.Equal(t, int32(1), exact.KeywordRank)
+	require.Equal(t, int32(2), exact.SemanticRank)
+	require.InDelta(t, 1.0/61+1.0/62, exact.Score, 1e-9)
+	require.Equal(t, []string{"e1234"}, exact.MatchedTerms)
+	require.Contains(t, exact.Snippet, "E1234")
+
+	similar := response.Results[1]
+	require.Equal(t, "memos/"+similarMemo.UID, similar.Memo.Name)
+	require.Zero(t, similar.KeywordRank)
+	require.Equal(t, int32(1), similar.SemanticRank)
+	require.InDelta(t, 1.0, similar.SemanticScore, 1e-6)
+	require.Empty(t, similar.MatchedTerms)
+	require.Equal(t, "Saving settings fails after the last upgrade.", similar.Snippet)
+}
+
+func TestSearchMemosWithoutEmbeddingConfig(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "search-no-embedding-user")
+	require.NoError(t, err)
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "search-no-embedding",
+		CreatorID:  user.ID,
+		Content:    "keyword only memo",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	ts.Service.EmbeddingClientFactory = func(context.Context) (apiv1.SemanticEmbeddingClient, error) {
+		return nil, errors.New("mock embedding unavailable")
+	}
+
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	response, err := ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{
+		Query: "keyword",
+		Mode:  v1pb.SearchMemosRequest_HYBRID,
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Results, 1)
+	require.Equal(t, "memos/"+memo.UID, response.Results[0].Memo.Name)
+
+	_, err = ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{
+		Query: "keyword",
+		Mode:  v1pb.SearchMemosRequest_SEMANTIC,
+	})
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.FailedPrecondition, st.Code())
+
+	_, err = ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{})
+	require.Error(t, err)
+	st, ok = status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestSearchMemosSemanticPassage(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "passage-user")
+	require.NoError(t, err)
+
+	content := "# Travel\nPack the passport and chargers.\n\n# Cooking\nSimmer the tomato sauce for an hour."
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "passage-memo",
+		CreatorID:  user.ID,
+		Content:    content,
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	cookingStart := strings.Index(content, "# Cooking")
+	require.NoError(t, ts.Store.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+		MemoID:      memo.ID,
+		Model:       "fake-embedding-model",
+		Embedding:   []float64{0.5, 0.5},
+		ContentHash: "hash-passage",
+		Chunks: []*store.MemoEmbeddingChunk{
+			{StartOffset: 0, EndOffset: int32(cookingStart - 2), Embedding: []float64{1, 0}},
+			{StartOffset: int32(cookingStart), EndOffset: int32(len(content)), Embedding: []float64{0, 1}},
+		},
+	}))
+
+	ts.Service.EmbeddingClientFactory = func(context.Context) (apiv1.SemanticEmbeddingClient, error) {
+		return &fakeSemanticEmbeddingClient{
+			model: "fake-embedding-model",
+			vectors: map[string][]float64{
+				"how long to cook sauce": {0, 1},
+			},
+		}, nil
+	}
+
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	response, err := ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{
+		Query: "how long to cook sauce",
+		Mode:  v1pb.SearchMemosRequest_SEMANTIC,
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Results, 1)
+
+	result := response.Results[0]
+	require.Equal(t, "memos/"+memo.UID, result.Memo.Name)
+	require.InDelta(t, 1.0, result.SemanticScore, 1e-6)
+	require.Equal(t, "# Cooking\nSimmer the tomato sauce for an hour.", result.Passage)
+	require.NotContains(t, result.Snippet, "passport")
+	require.Contains(t, result.Snippet, "Simmer the tomato sauce")
+}
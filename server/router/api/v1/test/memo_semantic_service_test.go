@@ -3,7 +3,6 @@ package test
 import (
 	"context"
 	"errors"
-	"os"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -32,11 +31,11 @@ func (c *fakeSemanticEmbeddingClient) Model() string {
 	return c.model
 }
 
-func TestSearchMemosSemanticPostgresRankingAndVisibility(t *testing.T) {
-	if os.Getenv("DRIVER") != "postgres" {
-		t.Skip("postgres only")
-	}
+func (*fakeSemanticEmbeddingClient) InProcess() bool {
+	return true
+}
 
+func TestSearchMemosSemanticRankingAndVisibility(t *testing.T) {
 	ctx := context.Background()
 	ts := NewTestService(t)
 	defer ts.Cleanup()
@@ -116,11 +115,7 @@ func TestSearchMemosSemanticPostgresRankingAndVisibility(t *testing.T) {
 	}
 }
 
-func TestSearchMemosSemanticPostgresEmbeddingConfigError(t *testing.T) {
-	if os.Getenv("DRIVER") != "postgres" {
-		t.Skip("postgres only")
-	}
-
+func TestSearchMemosSemanticEmbeddingConfigError(t *testing.T) {
 	ctx := context.Background()
 	ts := NewTestService(t)
 	defer ts.Cleanup()
@@ -0,0 +1,92 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoTasks(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "tasks")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "tasks-other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	content := "## Groceries #home\n\n| a | b |\n|---|---|\n| 1 | 2 |\n\n- [ ] Milk 📅 2026-11-01\n- [x] Bread\n"
+	groceries, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: content, Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "- [ ] Ship the release due:2026-10-20", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(otherCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "- [ ] Not mine", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+
+	listTasks := func(request *v1pb.ListTasksRequest) []*v1pb.Task {
+		response, err := ts.Service.ListTasks(userCtx, request)
+		require.NoError(t, err)
+		return response.Tasks
+	}
+
+	t.Run("Tasks are listed across the memos of the user", func(t *testing.T) {
+		tasks := listTasks(&v1pb.ListTasksRequest{})
+		require.Len(t, tasks, 3)
+
+		tasks = listTasks(&v1pb.ListTasksRequest{Filter: `tag in ["home"]`})
+		require.Len(t, tasks, 2)
+		require.Equal(t, &v1pb.Task{Name: groceries.Name + "/tasks/0", Text: "Milk", Line: 7, DueDate: "2026-11-01"}, tasks[0])
+		require.Equal(t, &v1pb.Task{Name: groceries.Name + "/tasks/1", Text: "Bread", Checked: true, Line: 8}, tasks[1])
+	})
+
+	t.Run("Tasks are filtered by state and due date", func(t *testing.T) {
+		tasks := listTasks(&v1pb.ListTasksRequest{Checked: proto.Bool(false)})
+		require.Len(t, tasks, 2)
+
+		tasks = listTasks(&v1pb.ListTasksRequest{DueBefore: "2026-10-31"})
+		require.Len(t, tasks, 1)
+		require.Equal(t, "Ship the release", tasks[0].Text)
+
+		_, err := ts.Service.ListTasks(userCtx, &v1pb.ListTasksRequest{DueBefore: "tomorrow"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Toggling rewrites only the checkbox", func(t *testing.T) {
+		task, err := ts.Service.ToggleTask(userCtx, &v1pb.ToggleTaskRequest{Name: groceries.Name + "/tasks/0"})
+		require.NoError(t, err)
+		require.True(t, task.Checked)
+
+		memo, err := ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: groceries.Name})
+		require.NoError(t, err)
+		require.Equal(t, "## Groceries #home\n\n| a | b |\n|---|---|\n| 1 | 2 |\n\n- [x] Milk 📅 2026-11-01\n- [x] Bread\n", memo.Content)
+		require.False(t, memo.Property.HasIncompleteTasks)
+
+		// Setting the current state leaves the memo unchanged.
+		task, err = ts.Service.ToggleTask(userCtx, &v1pb.ToggleTaskRequest{Name: groceries.Name + "/tasks/1", Checked: proto.Bool(true)})
+		require.NoError(t, err)
+		require.True(t, task.Checked)
+	})
+
+	t.Run("Only the creator can toggle tasks", func(t *testing.T) {
+		_, err := ts.Service.ToggleTask(otherCtx, &v1pb.ToggleTaskRequest{Name: groceries.Name + "/tasks/0"})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = ts.Service.ToggleTask(userCtx, &v1pb.ToggleTaskRequest{Name: groceries.Name + "/tasks/5"})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -0,0 +1,151 @@
+package test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func buildZip(t *testing.T, files map[string]string) []byte {
+	buffer := &bytes.Buffer{}
+	writer := zip.NewWriter(buffer)
+	for name, content := range files {
+		file, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = file.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	return buffer.Bytes()
+}
+
+func TestImportNotes(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+	aliceName := fmt.Sprintf("users/%d", alice.ID)
+
+	vault := buildZip(t, map[string]string{
+		"Vault/Travel/Lisbon.md":     "---\ntags: [travel]\ncreated: 2024-03-01 09:30\nupdated: 2024-03-04\n---\nSee [[Packing|the packing list]].\n\n![[itinerary.txt]]\n",
+		"Vault/Packing.md":           "- [x] Passport\n- [ ] Charger #travel\n",
+		"Vault/Ideas.md":             "Write about [[Lisbon]] and [[Missing note]].\n",
+		"Vault/assets/itinerary.txt": "TAP 1234",
+		"Vault/.obsidian/app.json":   "{}",
+	})
+	request := &v1pb.ImportNotesRequest{
+		Name:     aliceName,
+		Format:   v1pb.ImportNotesRequest_OBSIDIAN,
+		Filename: "Vault.zip",
+		Content:  vault,
+	}
+
+	t.Run("Only the user and admins can import", func(t *testing.T) {
+		_, err := ts.Service.ImportNotes(bobCtx, request)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("The format is required", func(t *testing.T) {
+		_, err := ts.Service.ImportNotes(aliceCtx, &v1pb.ImportNotesRequest{Name: aliceName, Filename: "Vault.zip", Content: vault})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("A dry run reports the import without creating memos", func(t *testing.T) {
+		dryRun := &v1pb.ImportNotesRequest{Name: aliceName, Format: request.Format, Filename: request.Filename, Content: vault, DryRun: true}
+		response, err := ts.Service.ImportNotes(aliceCtx, dryRun)
+		require.NoError(t, err)
+		require.Equal(t, int32(3), response.MemoCount)
+		require.Equal(t, int32(1), response.AttachmentCount)
+		require.Equal(t, int32(2), response.RelationCount)
+		require.Equal(t, []string{"travel"}, response.Tags)
+		require.Equal(t, []string{`Ideas: linked note "Missing note" not found`}, response.Warnings)
+
+		memos, err := ts.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &alice.ID})
+		require.NoError(t, err)
+		require.Empty(t, memos)
+	})
+
+	response, err := ts.Service.ImportNotes(aliceCtx, request)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), response.MemoCount)
+
+	memos, err := ts.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &alice.ID})
+	require.NoError(t, err)
+	require.Len(t, memos, 3)
+	memosByTitle := map[string]*store.Memo{}
+	for _, memo := range memos {
+		require.Equal(t, store.Private, memo.Visibility)
+		title, _, _ := strings.Cut(strings.TrimPrefix(memo.Content, "# "), "\n")
+		memosByTitle[title] = memo
+	}
+	lisbon, packing, ideas := memosByTitle["Lisbon"], memosByTitle["Packing"], memosByTitle["Ideas"]
+	require.NotNil(t, lisbon)
+	require.NotNil(t, packing)
+	require.NotNil(t, ideas)
+
+	t.Run("The notes keep their times and tags", func(t *testing.T) {
+		require.Equal(t, time.Date(2024, 3, 1, 9, 30, 0, 0, time.Local).Unix(), lisbon.CreatedTs)
+		require.Equal(t, time.Date(2024, 3, 4, 0, 0, 0, 0, time.Local).Unix(), lisbon.UpdatedTs)
+		require.Equal(t, []string{"travel"}, lisbon.Payload.GetTags())
+		require.True(t, packing.Payload.GetProperty().GetHasIncompleteTasks())
+	})
+
+	t.Run("The links become reference relations", func(t *testing.T) {
+		referenceType := store.MemoRelationReference
+		relations, err := ts.Store.ListMemoRelations(ctx, &store.FindMemoRelation{Type: &referenceType})
+		require.NoError(t, err)
+		related := map[int32]int32{}
+		for _, relation := range relations {
+			related[relation.MemoID] = relation.RelatedMemoID
+		}
+		require.Equal(t, map[int32]int32{lisbon.ID: packing.ID, ideas.ID: lisbon.ID}, related)
+	})
+
+	t.Run("The embedded files are attached", func(t *testing.T) {
+		attachments, err := ts.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &lisbon.ID, GetBlob: true})
+		require.NoError(t, err)
+		require.Len(t, attachments, 1)
+		require.Equal(t, "itinerary.txt", attachments[0].Filename)
+		require.Equal(t, alice.ID, attachments[0].CreatorID)
+		require.Equal(t, []byte("TAP 1234"), attachments[0].Blob)
+	})
+
+	t.Run("A single export file is imported without a zip", func(t *testing.T) {
+		enex := `<?xml version="1.0" encoding="UTF-8"?>
+<en-export><note><title>Quotes</title><created>20240303T070000Z</created>
+<content><![CDATA[<en-note><div>Simplicity&nbsp;is prerequisite for reliability.</div></en-note>]]></content>
+<tag>reading</tag></note></en-export>`
+		response, err := ts.Service.ImportNotes(bobCtx, &v1pb.ImportNotesRequest{
+			Name:     fmt.Sprintf("users/%d", bob.ID),
+			Format:   v1pb.ImportNotesRequest_EVERNOTE,
+			Filename: "Quotes.enex",
+			Content:  []byte(enex),
+		})
+		require.NoError(t, err)
+		require.Equal(t, int32(1), response.MemoCount)
+		require.Equal(t, []string{"reading"}, response.Tags)
+
+		memos, err := ts.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &bob.ID})
+		require.NoError(t, err)
+		require.Len(t, memos, 1)
+		require.Equal(t, time.Date(2024, 3, 3, 7, 0, 0, 0, time.UTC).Unix(), memos[0].CreatedTs)
+		require.Contains(t, memos[0].Content, "Simplicity is prerequisite for reliability.")
+	})
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/usememos/memos/internal/profile"
 	"github.com/usememos/memos/plugin/markdown"
 	"github.com/usememos/memos/server/auth"
 	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/server/runner/jobqueue"
 	"github.com/usememos/memos/store"
 	teststore "github.com/usememos/memos/store/test"
 )
@@ -19,6 +21,9 @@ type TestService struct {
 	Store   *store.Store
 	Profile *profile.Profile
 	Secret  string
+
+	cancelJobRunner context.CancelFunc
+	jobRunnerDone   chan struct{}
 }
 
 // NewTestService creates a new test service with the database selected by DRIVER env.
@@ -42,6 +47,7 @@ func NewTestService(tb testing.TB) *TestService {
 	secret := "test-secret"
 	markdownService := markdown.NewService(
 		markdown.WithTagExtension(),
+		markdown.WithMentionExtension(),
 	)
 	service := &apiv1.APIV1Service{
 		Secret:          secret,
@@ -50,11 +56,25 @@ func NewTestService(tb testing.TB) *TestService {
 		MarkdownService: markdownService,
 	}
 
+	// Retry failed jobs right away so that tests do not wait for the backoff.
+	jobRunner := jobqueue.NewRunner(testStore)
+	jobRunner.PollInterval = 50 * time.Millisecond
+	jobRunner.RetryBackoff = time.Millisecond
+	service.RegisterJobHandlers(jobRunner)
+	jobRunnerCtx, cancelJobRunner := context.WithCancel(ctx)
+	jobRunnerDone := make(chan struct{})
+	go func() {
+		defer close(jobRunnerDone)
+		jobRunner.Run(jobRunnerCtx)
+	}()
+
 	return &TestService{
-		Service: service,
-		Store:   testStore,
-		Profile: testProfile,
-		Secret:  secret,
+		Service:         service,
+		Store:           testStore,
+		Profile:         testProfile,
+		Secret:          secret,
+		cancelJobRunner: cancelJobRunner,
+		jobRunnerDone:   jobRunnerDone,
 	}
 }
 
@@ -68,6 +88,8 @@ func getDriverFromEnv() string {
 
 // Cleanup closes resources after test.
 func (ts *TestService) Cleanup() {
+	ts.cancelJobRunner()
+	<-ts.jobRunnerDone
 	ts.Store.Close()
 }
 
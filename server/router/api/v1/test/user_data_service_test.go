@@ -0,0 +1,235 @@
+package test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func readArchiveEntries(t *testing.T, content []byte) map[string]string {
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+	entries := map[string]string{}
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		reader.Close()
+		entries[file.Name] = string(data)
+	}
+	return entries
+}
+
+func TestUserDataExportImport(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	alice, err := ts.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	aliceCtx := ts.CreateUserContext(ctx, alice.ID)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+	aliceName := fmt.Sprintf("users/%d", alice.ID)
+
+	memo, err := ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    "Trip plan #travel",
+			Visibility: v1pb.Visibility_PROTECTED,
+			Location:   &v1pb.Location{Placeholder: "Lisbon", Latitude: 38.72, Longitude: -9.14},
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.UpdateMemo(aliceCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Pinned: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pinned"}},
+	})
+	require.NoError(t, err)
+	archived, err := ts.Service.CreateMemo(aliceCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Old packing list", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.UpdateMemo(aliceCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: archived.Name, State: v1pb.State_ARCHIVED},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.SetMemoRelations(aliceCtx, &v1pb.SetMemoRelationsRequest{
+		Name: memo.Name,
+		Relations: []*v1pb.MemoRelation{{
+			Memo:        &v1pb.MemoRelation_Memo{Name: memo.Name},
+			RelatedMemo: &v1pb.MemoRelation_Memo{Name: archived.Name},
+			Type:        v1pb.MemoRelation_REFERENCE,
+		}},
+	})
+	require.NoError(t, err)
+	comment, err := ts.Service.CreateMemoComment(aliceCtx, &v1pb.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &v1pb.Memo{Content: "Book the hotel", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	attachment, err := ts.Service.CreateAttachment(aliceCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "tickets.txt", Content: []byte("seat 12A"), Memo: &memo.Name},
+	})
+	require.NoError(t, err)
+	memoUID := strings.TrimPrefix(memo.Name, apiv1.MemoNamePrefix)
+	archivedUID := strings.TrimPrefix(archived.Name, apiv1.MemoNamePrefix)
+	commentUID := strings.TrimPrefix(comment.Name, apiv1.MemoNamePrefix)
+	attachmentUID := strings.TrimPrefix(attachment.Name, apiv1.AttachmentNamePrefix)
+
+	t.Run("Only the user and admins can export", func(t *testing.T) {
+		_, err := ts.Service.ExportUserData(bobCtx, &v1pb.ExportUserDataRequest{Name: aliceName})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.ExportUserData(ctx, &v1pb.ExportUserDataRequest{Name: aliceName})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	exported, err := ts.Service.ExportUserData(aliceCtx, &v1pb.ExportUserDataRequest{Name: aliceName})
+	require.NoError(t, err)
+
+	t.Run("The archive holds a Markdown file per memo and the attachment blobs", func(t *testing.T) {
+		entries := readArchiveEntries(t, exported.Content)
+		require.Len(t, entries, 4)
+
+		memoFile := entries["memos/"+memoUID+".md"]
+		require.True(t, strings.HasPrefix(memoFile, "---\nuid: "+memoUID+"\n"))
+		require.Contains(t, memoFile, "visibility: PROTECTED\n")
+		require.Contains(t, memoFile, "pinned: true\n")
+		require.Contains(t, memoFile, "tags:\n    - travel\n")
+		require.Contains(t, memoFile, "placeholder: Lisbon\n")
+		require.Contains(t, memoFile, "memo: "+archivedUID+"\n")
+		require.Contains(t, memoFile, "path: attachments/"+attachmentUID+"/tickets.txt\n")
+		require.True(t, strings.HasSuffix(memoFile, "\n---\nTrip plan #travel"))
+
+		require.Contains(t, entries["memos/"+archivedUID+".md"], "state: ARCHIVED\n")
+		require.Contains(t, entries["memos/"+commentUID+".md"], "parent: "+memoUID+"\n")
+		require.Equal(t, "seat 12A", entries["attachments/"+attachmentUID+"/tickets.txt"])
+	})
+
+	t.Run("Memos of another user are not overwritten", func(t *testing.T) {
+		_, err := ts.Service.ImportUserData(bobCtx, &v1pb.ImportUserDataRequest{
+			Name:    fmt.Sprintf("users/%d", bob.ID),
+			Content: exported.Content,
+		})
+		require.Equal(t, codes.AlreadyExists, status.Code(err))
+		memos, err := ts.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &bob.ID})
+		require.NoError(t, err)
+		require.Empty(t, memos)
+	})
+
+	t.Run("Imported memos only link the memos of other users they can see", func(t *testing.T) {
+		var buffer bytes.Buffer
+		writer := zip.NewWriter(&buffer)
+		file, err := writer.Create("memos/bob-reply.md")
+		require.NoError(t, err)
+		_, err = file.Write([]byte("---\nuid: bob-reply\nvisibility: PROTECTED\nparent: " + archivedUID +
+			"\nrelations:\n    - memo: " + memoUID + "\n      type: REFERENCE\n---\nReplying to a private memo"))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+
+		_, err = ts.Service.ImportUserData(bobCtx, &v1pb.ImportUserDataRequest{Name: fmt.Sprintf("users/%d", bob.ID), Content: buffer.Bytes()})
+		require.NoError(t, err)
+		replyUID := "bob-reply"
+		reply, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &replyUID})
+		require.NoError(t, err)
+		relations, err := ts.Store.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &reply.ID})
+		require.NoError(t, err)
+		// The private memo of Alice is not commented, while her protected memo is referenced.
+		require.Len(t, relations, 1)
+		require.Equal(t, store.MemoRelationReference, relations[0].Type)
+		_, err = ts.Service.DeleteMemo(bobCtx, &v1pb.DeleteMemoRequest{Name: apiv1.MemoNamePrefix + replyUID})
+		require.NoError(t, err)
+	})
+
+	t.Run("Invalid archives are rejected", func(t *testing.T) {
+		_, err := ts.Service.ImportUserData(aliceCtx, &v1pb.ImportUserDataRequest{Name: aliceName, Content: []byte("not a zip")})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	target := NewTestService(t)
+	defer target.Cleanup()
+	movedAlice, err := target.CreateRegularUser(ctx, "alice")
+	require.NoError(t, err)
+	movedAliceCtx := target.CreateUserContext(ctx, movedAlice.ID)
+	importRequest := &v1pb.ImportUserDataRequest{Name: fmt.Sprintf("users/%d", movedAlice.ID), Content: exported.Content}
+
+	t.Run("Importing moves the memos to another instance", func(t *testing.T) {
+		response, err := target.Service.ImportUserData(movedAliceCtx, importRequest)
+		require.NoError(t, err)
+		require.Equal(t, int32(3), response.CreatedMemoCount)
+		require.Equal(t, int32(0), response.UpdatedMemoCount)
+		require.Equal(t, int32(1), response.CreatedAttachmentCount)
+
+		imported, err := target.Service.GetMemo(movedAliceCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.Equal(t, "Trip plan #travel", imported.Content)
+		require.Equal(t, v1pb.Visibility_PROTECTED, imported.Visibility)
+		require.True(t, imported.Pinned)
+		require.Equal(t, []string{"travel"}, imported.Tags)
+		require.Equal(t, "Lisbon", imported.Location.Placeholder)
+		require.Equal(t, memo.CreateTime.AsTime().Unix(), imported.CreateTime.AsTime().Unix())
+		require.Len(t, imported.Relations, 2)
+		require.Len(t, imported.Attachments, 1)
+		require.Equal(t, attachment.Name, imported.Attachments[0].Name)
+
+		importedArchived, err := target.Service.GetMemo(movedAliceCtx, &v1pb.GetMemoRequest{Name: archived.Name})
+		require.NoError(t, err)
+		require.Equal(t, v1pb.State_ARCHIVED, importedArchived.State)
+
+		comments, err := target.Service.ListMemoComments(movedAliceCtx, &v1pb.ListMemoCommentsRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.Len(t, comments.Memos, 1)
+		require.Equal(t, comment.Name, comments.Memos[0].Name)
+
+		importedAttachment, err := target.Store.GetAttachment(ctx, &store.FindAttachment{UID: &attachmentUID, GetBlob: true})
+		require.NoError(t, err)
+		blob, err := target.Service.GetAttachmentBlob(importedAttachment)
+		require.NoError(t, err)
+		require.Equal(t, "seat 12A", string(blob))
+	})
+
+	t.Run("Importing again updates the memos", func(t *testing.T) {
+		_, err := target.Service.UpdateMemo(movedAliceCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: "Changed"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+
+		response, err := target.Service.ImportUserData(movedAliceCtx, importRequest)
+		require.NoError(t, err)
+		require.Equal(t, int32(0), response.CreatedMemoCount)
+		require.Equal(t, int32(3), response.UpdatedMemoCount)
+		require.Equal(t, int32(0), response.CreatedAttachmentCount)
+
+		memos, err := target.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &movedAlice.ID})
+		require.NoError(t, err)
+		require.Len(t, memos, 3)
+		imported, err := target.Service.GetMemo(movedAliceCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.Equal(t, "Trip plan #travel", imported.Content)
+		require.Len(t, imported.Relations, 2)
+		// The overwritten version stays in the history of the memo.
+		revisions, err := target.Service.ListMemoRevisions(movedAliceCtx, &v1pb.ListMemoRevisionsRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.NotEmpty(t, revisions.Revisions)
+		require.Equal(t, "Changed", revisions.Revisions[0].Content)
+		attachments, err := target.Store.ListAttachments(ctx, &store.FindAttachment{CreatorID: &movedAlice.ID})
+		require.NoError(t, err)
+		require.Len(t, attachments, 1)
+	})
+}
@@ -0,0 +1,170 @@
+package test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+}
+
+func TestUserWebhookDeliveries(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	// The receiver rejects the first two requests to exercise the retries.
+	var mu sync.Mutex
+	received := []receivedWebhook{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		received = append(received, receivedWebhook{header: r.Header.Clone(), body: body})
+		count := len(received)
+		mu.Unlock()
+		if count <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte("try again"))
+			return
+		}
+		_, _ = w.Write([]byte(`{"code":0}`))
+	}))
+	defer server.Close()
+
+	user, err := ts.CreateRegularUser(ctx, "webhook-owner")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	otherUser, err := ts.CreateRegularUser(ctx, "webhook-other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, otherUser.ID)
+
+	hook, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  "users/" + strconv.Itoa(int(user.ID)),
+		Webhook: &v1pb.UserWebhook{Url: server.URL, DisplayName: "receiver"},
+	})
+	require.NoError(t, err)
+	require.Len(t, hook.Secret, 64)
+
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "signed webhook memo", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+
+	listDeliveries := func() []*v1pb.UserWebhookDelivery {
+		response, err := ts.Service.ListUserWebhookDeliveries(userCtx, &v1pb.ListUserWebhookDeliveriesRequest{Parent: hook.Name})
+		require.NoError(t, err)
+		return response.Deliveries
+	}
+
+	t.Run("Delivery is retried and logged", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			deliveries := listDeliveries()
+			return len(deliveries) == 1 && deliveries[0].State == v1pb.UserWebhookDelivery_SUCCEEDED
+		}, 5*time.Second, 20*time.Millisecond)
+
+		delivery := listDeliveries()[0]
+		require.Equal(t, int32(3), delivery.Attempts)
+		require.Equal(t, int32(http.StatusOK), delivery.ResponseStatusCode)
+		require.Equal(t, `{"code":0}`, delivery.ResponseBody)
+		require.Empty(t, delivery.Error)
+		require.Equal(t, "memos.memo.created", delivery.ActivityType)
+		require.Contains(t, delivery.RequestBody, "signed webhook memo")
+	})
+
+	t.Run("Deliveries are signed", func(t *testing.T) {
+		mu.Lock()
+		defer mu.Unlock()
+		require.Len(t, received, 3)
+		for _, request := range received {
+			timestamp, err := strconv.ParseInt(request.header.Get(webhook.TimestampHeader), 10, 64)
+			require.NoError(t, err)
+			require.Equal(t, "sha256="+webhook.Sign(hook.Secret, timestamp, request.body), request.header.Get(webhook.SignatureHeader))
+			require.Equal(t, received[0].header.Get(webhook.DeliveryHeader), request.header.Get(webhook.DeliveryHeader))
+		}
+	})
+
+	t.Run("Redeliver sends the request as a new delivery", func(t *testing.T) {
+		original := listDeliveries()[0]
+		redelivery, err := ts.Service.RedeliverUserWebhookDelivery(userCtx, &v1pb.RedeliverUserWebhookDeliveryRequest{Name: original.Name})
+		require.NoError(t, err)
+		require.NotEqual(t, original.Name, redelivery.Name)
+		require.Equal(t, original.RequestBody, redelivery.RequestBody)
+
+		require.Eventually(t, func() bool {
+			deliveries := listDeliveries()
+			return len(deliveries) == 2 && deliveries[0].State == v1pb.UserWebhookDelivery_SUCCEEDED
+		}, 5*time.Second, 20*time.Millisecond)
+		require.Equal(t, int32(1), listDeliveries()[0].Attempts)
+
+		_, err = ts.Service.RedeliverUserWebhookDelivery(otherCtx, &v1pb.RedeliverUserWebhookDeliveryRequest{Name: original.Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.RedeliverUserWebhookDelivery(userCtx, &v1pb.RedeliverUserWebhookDeliveryRequest{Name: hook.Name + "/deliveries/999"})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("ListUserWebhookDeliveries requires the owner", func(t *testing.T) {
+		_, err := ts.Service.ListUserWebhookDeliveries(otherCtx, &v1pb.ListUserWebhookDeliveriesRequest{Parent: hook.Name})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		response, err := ts.Service.ListUserWebhookDeliveries(userCtx, &v1pb.ListUserWebhookDeliveriesRequest{Parent: hook.Name, PageSize: 1})
+		require.NoError(t, err)
+		require.Len(t, response.Deliveries, 1)
+		require.NotEmpty(t, response.NextPageToken)
+	})
+
+	t.Run("Updating the secret with an empty value rotates it", func(t *testing.T) {
+		updated, err := ts.Service.UpdateUserWebhook(userCtx, &v1pb.UpdateUserWebhookRequest{
+			Webhook:    &v1pb.UserWebhook{Name: hook.Name},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"secret"}},
+		})
+		require.NoError(t, err)
+		require.Len(t, updated.Secret, 64)
+		require.NotEqual(t, hook.Secret, updated.Secret)
+		require.Equal(t, server.URL, updated.Url)
+	})
+
+	t.Run("The secret is stored encrypted and only returned when set", func(t *testing.T) {
+		updated, err := ts.Service.UpdateUserWebhook(userCtx, &v1pb.UpdateUserWebhookRequest{
+			Webhook:    &v1pb.UserWebhook{Name: hook.Name, DisplayName: "renamed"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+		})
+		require.NoError(t, err)
+		require.Empty(t, updated.Secret)
+		response, err := ts.Service.ListUserWebhooks(userCtx, &v1pb.ListUserWebhooksRequest{Parent: "users/" + strconv.Itoa(int(user.ID))})
+		require.NoError(t, err)
+		require.Len(t, response.Webhooks, 1)
+		require.Empty(t, response.Webhooks[0].Secret)
+
+		webhooks, err := ts.Store.GetUserWebhooks(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, webhooks, 1)
+		require.True(t, strings.HasPrefix(webhooks[0].Secret, "enc:v1:"))
+	})
+
+	t.Run("Deleting the webhook deletes its deliveries", func(t *testing.T) {
+		_, err := ts.Service.DeleteUserWebhook(userCtx, &v1pb.DeleteUserWebhookRequest{Name: hook.Name})
+		require.NoError(t, err)
+
+		deliveries, err := ts.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{CreatorID: &user.ID})
+		require.NoError(t, err)
+		require.Empty(t, deliveries)
+	})
+}
@@ -0,0 +1,241 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestUserWebhookEvents(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"code":0}`))
+	}))
+	defer server.Close()
+
+	user, err := ts.CreateRegularUser(ctx, "webhook-events")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	parent := "users/" + strconv.Itoa(int(user.ID))
+
+	createWebhook := func(webhook *v1pb.UserWebhook) *v1pb.UserWebhook {
+		webhook.Url = server.URL
+		hook, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{Parent: parent, Webhook: webhook})
+		require.NoError(t, err)
+		return hook
+	}
+	listActivityTypes := func(hook *v1pb.UserWebhook) []string {
+		response, err := ts.Service.ListUserWebhookDeliveries(userCtx, &v1pb.ListUserWebhookDeliveriesRequest{Parent: hook.Name})
+		require.NoError(t, err)
+		activityTypes := []string{}
+		// Deliveries are listed newest first.
+		for i := len(response.Deliveries) - 1; i >= 0; i-- {
+			activityTypes = append(activityTypes, response.Deliveries[i].ActivityType)
+		}
+		return activityTypes
+	}
+
+	t.Run("Invalid event types and filters are rejected", func(t *testing.T) {
+		_, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+			Parent:  parent,
+			Webhook: &v1pb.UserWebhook{Url: server.URL, EventTypes: []string{"memos.memo.unknown"}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+			Parent:  parent,
+			Webhook: &v1pb.UserWebhook{Url: server.URL, Filter: "tag in ["},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	allHook := createWebhook(&v1pb.UserWebhook{DisplayName: "all"})
+	reactionHook := createWebhook(&v1pb.UserWebhook{
+		DisplayName: "reactions",
+		EventTypes:  []string{webhook.ActivityTypeReactionUpserted, webhook.ActivityTypeReactionUpserted},
+	})
+	require.Equal(t, []string{webhook.ActivityTypeReactionUpserted}, reactionHook.EventTypes)
+	releaseHook := createWebhook(&v1pb.UserWebhook{
+		DisplayName: "releases",
+		EventTypes:  []string{webhook.ActivityTypeMemoCreated, webhook.ActivityTypeMemoArchived},
+		Filter:      `tag in ["release"] && visibility == "PUBLIC"`,
+	})
+
+	releaseMemo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Shipped #release", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Private #release notes", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.UpsertMemoReaction(userCtx, &v1pb.UpsertMemoReactionRequest{
+		Name:     releaseMemo.Name,
+		Reaction: &v1pb.Reaction{ContentId: releaseMemo.Name, ReactionType: "👍"},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: releaseMemo.Name, State: v1pb.State_ARCHIVED},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+	})
+	require.NoError(t, err)
+
+	t.Run("Webhooks without event types receive all events", func(t *testing.T) {
+		expected := []string{
+			webhook.ActivityTypeMemoCreated,
+			webhook.ActivityTypeMemoCreated,
+			webhook.ActivityTypeReactionUpserted,
+			webhook.ActivityTypeMemoUpdated,
+			webhook.ActivityTypeMemoArchived,
+		}
+		require.Eventually(t, func() bool {
+			return len(listActivityTypes(allHook)) == len(expected)
+		}, 5*time.Second, 20*time.Millisecond)
+		require.Equal(t, expected, listActivityTypes(allHook))
+	})
+
+	t.Run("Webhooks only receive subscribed events", func(t *testing.T) {
+		require.Equal(t, []string{webhook.ActivityTypeReactionUpserted}, listActivityTypes(reactionHook))
+
+		response, err := ts.Service.ListUserWebhookDeliveries(userCtx, &v1pb.ListUserWebhookDeliveriesRequest{Parent: reactionHook.Name})
+		require.NoError(t, err)
+		require.Contains(t, response.Deliveries[0].RequestBody, "👍")
+	})
+
+	t.Run("Webhooks only receive events matching the filter", func(t *testing.T) {
+		activityTypes := listActivityTypes(releaseHook)
+		require.Equal(t, []string{webhook.ActivityTypeMemoCreated, webhook.ActivityTypeMemoArchived}, activityTypes)
+
+		response, err := ts.Service.ListUserWebhookDeliveries(userCtx, &v1pb.ListUserWebhookDeliveriesRequest{Parent: releaseHook.Name})
+		require.NoError(t, err)
+		for _, delivery := range response.Deliveries {
+			require.Contains(t, delivery.RequestBody, "Shipped #release")
+		}
+	})
+
+	t.Run("Filtered webhooks receive the deletion of matching memos", func(t *testing.T) {
+		deletionHook := createWebhook(&v1pb.UserWebhook{
+			DisplayName: "deleted releases",
+			EventTypes:  []string{webhook.ActivityTypeMemoDeleted},
+			Filter:      `tag in ["release"]`,
+		})
+		draftMemo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "Draft notes", Visibility: v1pb.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		_, err = ts.Service.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: draftMemo.Name})
+		require.NoError(t, err)
+		_, err = ts.Service.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: releaseMemo.Name})
+		require.NoError(t, err)
+
+		require.Equal(t, []string{webhook.ActivityTypeMemoDeleted}, listActivityTypes(deletionHook))
+		response, err := ts.Service.ListUserWebhookDeliveries(userCtx, &v1pb.ListUserWebhookDeliveriesRequest{Parent: deletionHook.Name})
+		require.NoError(t, err)
+		require.Contains(t, response.Deliveries[0].RequestBody, "Shipped #release")
+	})
+
+	t.Run("Event types and filter can be updated", func(t *testing.T) {
+		updated, err := ts.Service.UpdateUserWebhook(userCtx, &v1pb.UpdateUserWebhookRequest{
+			Webhook:    &v1pb.UserWebhook{Name: releaseHook.Name, EventTypes: []string{webhook.ActivityTypeCommentCreated}},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"event_types", "filter"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{webhook.ActivityTypeCommentCreated}, updated.EventTypes)
+		require.Empty(t, updated.Filter)
+
+		_, err = ts.Service.UpdateUserWebhook(userCtx, &v1pb.UpdateUserWebhookRequest{
+			Webhook:    &v1pb.UserWebhook{Name: releaseHook.Name, Filter: "visibility =="},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"filter"}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestUserWebhookFormats(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	// Chat platforms answer with plain text instead of a memos response.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	user, err := ts.CreateRegularUser(ctx, "webhook-formats")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	parent := "users/" + strconv.Itoa(int(user.ID))
+
+	t.Run("Template format requires a valid template", func(t *testing.T) {
+		_, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+			Parent:  parent,
+			Webhook: &v1pb.UserWebhook{Url: server.URL, Format: v1pb.UserWebhook_TEMPLATE},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+			Parent:  parent,
+			Webhook: &v1pb.UserWebhook{Url: server.URL, Format: v1pb.UserWebhook_TEMPLATE, Template: "{{.Snippet"},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	slackHook, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  parent,
+		Webhook: &v1pb.UserWebhook{Url: server.URL, Format: v1pb.UserWebhook_SLACK},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.UserWebhook_SLACK, slackHook.Format)
+	checkedHook, err := ts.Service.CreateUserWebhook(userCtx, &v1pb.CreateUserWebhookRequest{
+		Parent:  parent,
+		Webhook: &v1pb.UserWebhook{Url: server.URL, Format: v1pb.UserWebhook_TEMPLATE, Template: `{"text": {{json .Snippet}}}`, CheckResponseCode: true},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Hello **chat**", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+
+	getDelivery := func(hook *v1pb.UserWebhook, state v1pb.UserWebhookDelivery_State) *v1pb.UserWebhookDelivery {
+		var delivery *v1pb.UserWebhookDelivery
+		require.Eventually(t, func() bool {
+			response, err := ts.Service.ListUserWebhookDeliveries(userCtx, &v1pb.ListUserWebhookDeliveriesRequest{Parent: hook.Name})
+			require.NoError(t, err)
+			if len(response.Deliveries) != 1 || response.Deliveries[0].State != state {
+				return false
+			}
+			delivery = response.Deliveries[0]
+			return true
+		}, 5*time.Second, 20*time.Millisecond)
+		return delivery
+	}
+
+	t.Run("Slack deliveries are rendered blocks", func(t *testing.T) {
+		delivery := getDelivery(slackHook, v1pb.UserWebhookDelivery_SUCCEEDED)
+		require.Contains(t, delivery.RequestBody, `"blocks"`)
+		require.Contains(t, delivery.RequestBody, "Hello chat")
+		require.Contains(t, delivery.RequestBody, "http://localhost:8080/memos/")
+	})
+
+	t.Run("Response code check rejects plain text responses", func(t *testing.T) {
+		delivery := getDelivery(checkedHook, v1pb.UserWebhookDelivery_FAILED)
+		require.JSONEq(t, `{"text": "Hello chat"}`, delivery.RequestBody)
+		require.Contains(t, delivery.Error, "failed to unmarshal webhook response")
+	})
+}
@@ -0,0 +1,596 @@
+package v1
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+
+	"github.com/usememos/memos/internal/base"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+// The user data archive is a zip with a Markdown file per memo in userDataMemoDir, named after the memo uid,
+// and the attachment blobs in userDataAttachmentDir, at attachments/{uid}/{filename}.
+const (
+	userDataMemoDir       = "memos/"
+	userDataAttachmentDir = "attachments/"
+	frontMatterDelimiter  = "---\n"
+)
+
+// memoFrontMatter is the YAML front matter of a memo in the user data archive.
+type memoFrontMatter struct {
+	UID        string `yaml:"uid"`
+	Visibility string `yaml:"visibility"`
+	// State is ARCHIVED for archived memos and empty otherwise.
+	State             string                `yaml:"state,omitempty"`
+	Pinned            bool                  `yaml:"pinned"`
+	CreatedTs         int64                 `yaml:"created_ts"`
+	UpdatedTs         int64                 `yaml:"updated_ts"`
+	Tags              []string              `yaml:"tags,omitempty"`
+	Location          *memoArchiveLocation  `yaml:"location,omitempty"`
+	RemindTs          int64                 `yaml:"remind_ts,omitempty"`
+	PublishTs         int64                 `yaml:"publish_ts,omitempty"`
+	PublishVisibility string                `yaml:"publish_visibility,omitempty"`
+	Relations         []memoArchiveRelation `yaml:"relations,omitempty"`
+	// Parent is the uid of the memo commented by the memo.
+	Parent      string                  `yaml:"parent,omitempty"`
+	Attachments []memoArchiveAttachment `yaml:"attachments,omitempty"`
+}
+
+type memoArchiveLocation struct {
+	Placeholder string  `yaml:"placeholder,omitempty"`
+	Latitude    float64 `yaml:"latitude"`
+	Longitude   float64 `yaml:"longitude"`
+}
+
+type memoArchiveRelation struct {
+	Memo string `yaml:"memo"`
+	Type string `yaml:"type"`
+}
+
+type memoArchiveAttachment struct {
+	UID      string `yaml:"uid"`
+	Filename string `yaml:"filename"`
+	Type     string `yaml:"type"`
+	// Path is the path of the blob in the archive.
+	Path string `yaml:"path"`
+}
+
+// archivedMemo is a memo read from the user data archive.
+type archivedMemo struct {
+	frontMatter memoFrontMatter
+	content     string
+}
+
+func (s *APIV1Service) ExportUserData(ctx context.Context, request *v1pb.ExportUserDataRequest) (*v1pb.ExportUserDataResponse, error) {
+	userID, err := s.checkUserDataAccess(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	if err := s.WriteUserDataArchive(ctx, userID, &buffer); err != nil {
+		return nil, err
+	}
+	return &v1pb.ExportUserDataResponse{Content: buffer.Bytes()}, nil
+}
+
+func (s *APIV1Service) ImportUserData(ctx context.Context, request *v1pb.ImportUserDataRequest) (*v1pb.ImportUserDataResponse, error) {
+	userID, err := s.checkUserDataAccess(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(request.Content), int64(len(request.Content)))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid archive: %v", err)
+	}
+	return s.ImportUserDataArchive(ctx, userID, archive)
+}
+
+// checkUserDataAccess returns the id of the named user if the current user is that user or an admin.
+func (s *APIV1Service) checkUserDataAccess(ctx context.Context, name string) (int32, error) {
+	userID, err := ExtractUserIDFromName(name)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return 0, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID && currentUser.Role != store.RoleAdmin {
+		return 0, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return 0, status.Errorf(codes.NotFound, "user not found")
+	}
+	return userID, nil
+}
+
+// WriteUserDataArchive writes the memos of the user, including their comments, and the attachments of these memos
+// as a zip archive. Attachments that are not attached to a memo are left out.
+func (s *APIV1Service) WriteUserDataArchive(ctx context.Context, userID int32, w io.Writer) error {
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &userID})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	memoIDs := make([]int32, 0, len(memos))
+	for _, memo := range memos {
+		memoIDs = append(memoIDs, memo.ID)
+	}
+
+	relationsByMemoID := map[int32][]*store.MemoRelation{}
+	relatedMemoIDs := []int32{}
+	for _, memo := range memos {
+		relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &memo.ID})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list memo relations: %v", err)
+		}
+		relationsByMemoID[memo.ID] = relations
+		for _, relation := range relations {
+			relatedMemoIDs = append(relatedMemoIDs, relation.RelatedMemoID)
+		}
+	}
+	relatedMemoUIDs := map[int32]string{}
+	if len(relatedMemoIDs) > 0 {
+		relatedMemos, err := s.Store.ListMemos(ctx, &store.FindMemo{IDList: relatedMemoIDs, ExcludeContent: true})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list related memos: %v", err)
+		}
+		for _, relatedMemo := range relatedMemos {
+			relatedMemoUIDs[relatedMemo.ID] = relatedMemo.UID
+		}
+	}
+
+	attachmentsByMemoID := map[int32][]*store.Attachment{}
+	if len(memoIDs) > 0 {
+		attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoIDList: memoIDs})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list attachments: %v", err)
+		}
+		for _, attachment := range attachments {
+			attachmentsByMemoID[*attachment.MemoID] = append(attachmentsByMemoID[*attachment.MemoID], attachment)
+		}
+	}
+
+	archive := zip.NewWriter(w)
+	for _, memo := range memos {
+		frontMatter := memoFrontMatter{
+			UID:        memo.UID,
+			Visibility: memo.Visibility.String(),
+			Pinned:     memo.Pinned,
+			CreatedTs:  memo.CreatedTs,
+			UpdatedTs:  memo.UpdatedTs,
+			Tags:       memo.Payload.GetTags(),
+		}
+		if memo.RowStatus == store.Archived {
+			frontMatter.State = string(store.Archived)
+		}
+		if location := memo.Payload.GetLocation(); location != nil {
+			frontMatter.Location = &memoArchiveLocation{
+				Placeholder: location.Placeholder,
+				Latitude:    location.Latitude,
+				Longitude:   location.Longitude,
+			}
+		}
+		frontMatter.RemindTs = memo.Payload.GetRemindTs()
+		frontMatter.PublishTs = memo.Payload.GetPublishTs()
+		frontMatter.PublishVisibility = memo.Payload.GetPublishVisibility()
+		for _, relation := range relationsByMemoID[memo.ID] {
+			relatedMemoUID, ok := relatedMemoUIDs[relation.RelatedMemoID]
+			if !ok {
+				continue
+			}
+			if relation.Type == store.MemoRelationComment {
+				frontMatter.Parent = relatedMemoUID
+				continue
+			}
+			frontMatter.Relations = append(frontMatter.Relations, memoArchiveRelation{Memo: relatedMemoUID, Type: string(relation.Type)})
+		}
+
+		for _, attachment := range attachmentsByMemoID[memo.ID] {
+			// External attachments are links without a blob.
+			if attachment.StorageType == storepb.AttachmentStorageType_EXTERNAL {
+				continue
+			}
+			blobPath := userDataAttachmentDir + attachment.UID + "/" + attachment.Filename
+			if err := s.writeArchivedAttachment(ctx, archive, blobPath, attachment); err != nil {
+				return err
+			}
+			frontMatter.Attachments = append(frontMatter.Attachments, memoArchiveAttachment{
+				UID:      attachment.UID,
+				Filename: attachment.Filename,
+				Type:     attachment.Type,
+				Path:     blobPath,
+			})
+		}
+
+		header, err := yaml.Marshal(frontMatter)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to marshal front matter: %v", err)
+		}
+		file, err := archive.Create(userDataMemoDir + memo.UID + ".md")
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to write memo: %v", err)
+		}
+		if _, err := io.WriteString(file, frontMatterDelimiter+string(header)+frontMatterDelimiter+memo.Content); err != nil {
+			return status.Errorf(codes.Internal, "failed to write memo: %v", err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return status.Errorf(codes.Internal, "failed to write archive: %v", err)
+	}
+	return nil
+}
+
+func (s *APIV1Service) writeArchivedAttachment(ctx context.Context, archive *zip.Writer, blobPath string, attachment *store.Attachment) error {
+	// The attachments are listed without the blobs stored in the database, which are only loaded one at a time.
+	if attachment.StorageType == storepb.AttachmentStorageType_ATTACHMENT_STORAGE_TYPE_UNSPECIFIED {
+		withBlob, err := s.Store.GetAttachment(ctx, &store.FindAttachment{ID: &attachment.ID, GetBlob: true})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get attachment: %v", err)
+		}
+		if withBlob != nil {
+			attachment = withBlob
+		}
+	}
+	blob, err := s.GetAttachmentBlob(attachment)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get blob of attachment %s: %v", attachment.UID, err)
+	}
+	file, err := archive.Create(blobPath)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to write attachment: %v", err)
+	}
+	if _, err := file.Write(blob); err != nil {
+		return status.Errorf(codes.Internal, "failed to write attachment: %v", err)
+	}
+	return nil
+}
+
+// ImportUserDataArchive imports a user data archive into the memos of the user.
+// Memos and attachments are matched by uid: existing ones are updated and the others are created, so that the same
+// archive can be imported again. The archive is checked before anything is written.
+// Imported memos are not announced to webhooks or mentioned users, as they are not new.
+func (s *APIV1Service) ImportUserDataArchive(ctx context.Context, userID int32, archive *zip.Reader) (*v1pb.ImportUserDataResponse, error) {
+	files := map[string]*zip.File{}
+	for _, file := range archive.File {
+		files[file.Name] = file
+	}
+	archivedMemos := []*archivedMemo{}
+	for _, file := range archive.File {
+		if !strings.HasPrefix(file.Name, userDataMemoDir) || path.Ext(file.Name) != ".md" {
+			continue
+		}
+		memo, err := readArchivedMemo(file)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid memo %s: %v", file.Name, err)
+		}
+		archivedMemos = append(archivedMemos, memo)
+	}
+
+	existingMemos, existingAttachments, err := s.checkArchivedMemos(ctx, userID, archivedMemos, files)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &v1pb.ImportUserDataResponse{}
+	memoIDs := map[string]int32{}
+	for _, archived := range archivedMemos {
+		memo, created, err := s.importArchivedMemo(ctx, userID, archived, existingMemos[archived.frontMatter.UID])
+		if err != nil {
+			return nil, err
+		}
+		memoIDs[memo.UID] = memo.ID
+		if created {
+			response.CreatedMemoCount++
+		} else {
+			response.UpdatedMemoCount++
+		}
+	}
+
+	for _, archived := range archivedMemos {
+		memoID := memoIDs[archived.frontMatter.UID]
+		if err := s.importArchivedMemoRelations(ctx, userID, memoID, archived.frontMatter, memoIDs); err != nil {
+			return nil, err
+		}
+		for _, archivedAttachment := range archived.frontMatter.Attachments {
+			created, err := s.importArchivedAttachment(ctx, userID, memoID, archivedAttachment, files[archivedAttachment.Path], existingAttachments[archivedAttachment.UID])
+			if err != nil {
+				return nil, err
+			}
+			if created {
+				response.CreatedAttachmentCount++
+			}
+		}
+	}
+	return response, nil
+}
+
+// checkArchivedMemos checks the archived memos against the instance settings and returns the existing memos and
+// attachments of the user by uid.
+func (s *APIV1Service) checkArchivedMemos(ctx context.Context, userID int32, archivedMemos []*archivedMemo, files map[string]*zip.File) (map[string]*store.Memo, map[string]*store.Attachment, error) {
+	instanceMemoRelatedSetting, err := s.Store.GetInstanceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get instance memo related setting")
+	}
+	contentLengthLimit, err := s.getContentLengthLimit(ctx)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get content length limit")
+	}
+
+	memoUIDs := []string{}
+	seenMemoUIDs := map[string]bool{}
+	existingAttachments := map[string]*store.Attachment{}
+	for _, archived := range archivedMemos {
+		frontMatter := archived.frontMatter
+		if !base.UIDMatcher.MatchString(frontMatter.UID) {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "invalid memo uid %q", frontMatter.UID)
+		}
+		if seenMemoUIDs[frontMatter.UID] {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "duplicate memo uid %q", frontMatter.UID)
+		}
+		seenMemoUIDs[frontMatter.UID] = true
+		memoUIDs = append(memoUIDs, frontMatter.UID)
+
+		visibility := store.Visibility(frontMatter.Visibility)
+		if visibility != store.Public && visibility != store.Protected && visibility != store.Private {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "invalid visibility %q of memo %s", frontMatter.Visibility, frontMatter.UID)
+		}
+		if instanceMemoRelatedSetting.DisallowPublicVisibility && visibility == store.Public {
+			return nil, nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
+		}
+		if len(archived.content) > contentLengthLimit {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "content of memo %s too long (max %d characters)", frontMatter.UID, contentLengthLimit)
+		}
+		for _, relation := range frontMatter.Relations {
+			if store.MemoRelationType(relation.Type) != store.MemoRelationReference {
+				return nil, nil, status.Errorf(codes.InvalidArgument, "invalid relation type %q of memo %s", relation.Type, frontMatter.UID)
+			}
+		}
+
+		for _, archivedAttachment := range frontMatter.Attachments {
+			if !base.UIDMatcher.MatchString(archivedAttachment.UID) {
+				return nil, nil, status.Errorf(codes.InvalidArgument, "invalid attachment uid %q", archivedAttachment.UID)
+			}
+			if !validateFilename(archivedAttachment.Filename) {
+				return nil, nil, status.Errorf(codes.InvalidArgument, "invalid filename of attachment %s", archivedAttachment.UID)
+			}
+			if files[archivedAttachment.Path] == nil {
+				return nil, nil, status.Errorf(codes.InvalidArgument, "blob of attachment %s not found in the archive", archivedAttachment.UID)
+			}
+			attachment, err := s.Store.GetAttachment(ctx, &store.FindAttachment{UID: &archivedAttachment.UID})
+			if err != nil {
+				return nil, nil, status.Errorf(codes.Internal, "failed to get attachment: %v", err)
+			}
+			if attachment == nil {
+				continue
+			}
+			if attachment.CreatorID != userID {
+				return nil, nil, status.Errorf(codes.AlreadyExists, "attachment %s belongs to another user", archivedAttachment.UID)
+			}
+			existingAttachments[attachment.UID] = attachment
+		}
+	}
+
+	existingMemos := map[string]*store.Memo{}
+	if len(memoUIDs) > 0 {
+		memos, err := s.Store.ListMemos(ctx, &store.FindMemo{UIDList: memoUIDs})
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+		}
+		for _, memo := range memos {
+			if memo.CreatorID != userID {
+				return nil, nil, status.Errorf(codes.AlreadyExists, "memo %s belongs to another user", memo.UID)
+			}
+			existingMemos[memo.UID] = memo
+		}
+	}
+	return existingMemos, existingAttachments, nil
+}
+
+// importArchivedMemo creates the archived memo or updates the existing one, and reports whether it was created.
+func (s *APIV1Service) importArchivedMemo(ctx context.Context, userID int32, archived *archivedMemo, existing *store.Memo) (*store.Memo, bool, error) {
+	frontMatter := archived.frontMatter
+	memo := existing
+	var previousMemo *store.Memo
+	if memo == nil {
+		memo = &store.Memo{UID: frontMatter.UID, CreatorID: userID}
+	} else {
+		previousMemo = snapshotMemo(existing)
+	}
+	contentChanged := existing == nil || existing.Content != archived.content
+	memo.Content = archived.content
+	memo.Visibility = store.Visibility(frontMatter.Visibility)
+	if err := memopayload.RebuildMemoPayload(memo, s.MarkdownService); err != nil {
+		return nil, false, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
+	}
+	memo.Payload.Location = nil
+	if location := frontMatter.Location; location != nil {
+		memo.Payload.Location = &storepb.MemoPayload_Location{
+			Placeholder: location.Placeholder,
+			Latitude:    location.Latitude,
+			Longitude:   location.Longitude,
+		}
+	}
+	memo.Payload.RemindTs = frontMatter.RemindTs
+	memo.Payload.PublishTs = frontMatter.PublishTs
+	memo.Payload.PublishVisibility = frontMatter.PublishVisibility
+
+	if existing == nil {
+		memo.CreatedTs = frontMatter.CreatedTs
+		memo.UpdatedTs = frontMatter.UpdatedTs
+		created, err := s.Store.CreateMemo(ctx, memo)
+		if err != nil {
+			return nil, false, status.Errorf(codes.Internal, "failed to create memo: %v", err)
+		}
+		memo = created
+	}
+
+	rowStatus := store.Normal
+	if frontMatter.State == string(store.Archived) {
+		rowStatus = store.Archived
+	}
+	update := &store.UpdateMemo{
+		ID:         memo.ID,
+		Content:    &memo.Content,
+		Visibility: &memo.Visibility,
+		Pinned:     &frontMatter.Pinned,
+		RowStatus:  &rowStatus,
+		Payload:    memo.Payload,
+	}
+	if frontMatter.CreatedTs != 0 {
+		update.CreatedTs = &frontMatter.CreatedTs
+	}
+	if frontMatter.UpdatedTs != 0 {
+		update.UpdatedTs = &frontMatter.UpdatedTs
+	}
+	// Overwriting an existing memo keeps the replaced version in its history.
+	if previousMemo != nil {
+		if err := s.recordMemoRevision(ctx, previousMemo, update); err != nil {
+			return nil, false, status.Errorf(codes.Internal, "failed to record memo revision: %v", err)
+		}
+	}
+	if err := s.Store.UpdateMemo(ctx, update); err != nil {
+		return nil, false, status.Errorf(codes.Internal, "failed to update memo: %v", err)
+	}
+	if contentChanged {
+		s.scheduleMemoEmbeddingSync(memo.ID, memo.Content)
+	}
+	return memo, existing == nil, nil
+}
+
+// importArchivedMemoRelations sets the relations of the memo to the archived ones whose memos exist. Memos outside the
+// archive are only linked when the user can see them, like in SetMemoRelations and CreateMemoComment.
+func (s *APIV1Service) importArchivedMemoRelations(ctx context.Context, userID, memoID int32, frontMatter memoFrontMatter, importedMemoIDs map[string]int32) error {
+	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{MemoID: &memoID}); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete memo relations: %v", err)
+	}
+
+	relations := make([]memoArchiveRelation, 0, len(frontMatter.Relations)+1)
+	relations = append(relations, frontMatter.Relations...)
+	if frontMatter.Parent != "" {
+		relations = append(relations, memoArchiveRelation{Memo: frontMatter.Parent, Type: string(store.MemoRelationComment)})
+	}
+	for _, relation := range relations {
+		relationType := store.MemoRelationType(relation.Type)
+		if relationType != store.MemoRelationReference && relationType != store.MemoRelationComment {
+			return status.Errorf(codes.InvalidArgument, "invalid relation type %q of memo %s", relation.Type, frontMatter.UID)
+		}
+		relatedMemoID, ok := importedMemoIDs[relation.Memo]
+		if !ok {
+			// The related memo may belong to another user, who moved to this instance before.
+			relatedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &relation.Memo, ExcludeContent: true})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to get related memo: %v", err)
+			}
+			if relatedMemo == nil {
+				continue
+			}
+			if relatedMemo.Visibility == store.Private && relatedMemo.CreatorID != userID {
+				slog.Warn("skipped the relation of an imported memo to a private memo of another user", "memo", frontMatter.UID, "relatedMemo", relation.Memo)
+				continue
+			}
+			relatedMemoID = relatedMemo.ID
+		}
+		if _, err := s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
+			MemoID:        memoID,
+			RelatedMemoID: relatedMemoID,
+			Type:          relationType,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to create memo relation: %v", err)
+		}
+	}
+	return nil
+}
+
+// importArchivedAttachment creates the archived attachment of the memo, or moves the existing one to the memo,
+// and reports whether it was created.
+func (s *APIV1Service) importArchivedAttachment(ctx context.Context, userID, memoID int32, archived memoArchiveAttachment, file *zip.File, existing *store.Attachment) (bool, error) {
+	if existing != nil {
+		if err := s.Store.UpdateAttachment(ctx, &store.UpdateAttachment{ID: existing.ID, MemoID: &memoID}); err != nil {
+			return false, status.Errorf(codes.Internal, "failed to update attachment: %v", err)
+		}
+		return false, nil
+	}
+
+	blob, err := readArchiveFile(file)
+	if err != nil {
+		return false, status.Errorf(codes.InvalidArgument, "failed to read blob of attachment %s: %v", archived.UID, err)
+	}
+	attachmentType := archived.Type
+	if attachmentType == "" || !isValidMimeType(attachmentType) {
+		attachmentType = "application/octet-stream"
+	}
+	create := &store.Attachment{
+		UID:       archived.UID,
+		CreatorID: userID,
+		Filename:  archived.Filename,
+		Type:      attachmentType,
+		Size:      int64(len(blob)),
+		Blob:      blob,
+		MemoID:    &memoID,
+	}
+	if err := SaveAttachmentBlob(ctx, s.Profile, s.Store, create); err != nil {
+		return false, status.Errorf(codes.Internal, "failed to save attachment blob: %v", err)
+	}
+	if _, err := s.Store.CreateAttachment(ctx, create); err != nil {
+		return false, status.Errorf(codes.Internal, "failed to create attachment: %v", err)
+	}
+	return true, nil
+}
+
+// readArchivedMemo reads a Markdown file with YAML front matter of the user data archive.
+func readArchivedMemo(file *zip.File) (*archivedMemo, error) {
+	data, err := readArchiveFile(file)
+	if err != nil {
+		return nil, err
+	}
+	text := string(data)
+	if !strings.HasPrefix(text, frontMatterDelimiter) {
+		return nil, errors.New("missing front matter")
+	}
+	header, content, ok := strings.Cut(strings.TrimPrefix(text, frontMatterDelimiter), "\n"+frontMatterDelimiter)
+	if !ok {
+		return nil, errors.New("unterminated front matter")
+	}
+	memo := &archivedMemo{content: content}
+	if err := yaml.Unmarshal([]byte(header), &memo.frontMatter); err != nil {
+		return nil, errors.Wrap(err, "invalid front matter")
+	}
+	if memo.frontMatter.UID == "" {
+		memo.frontMatter.UID = strings.TrimSuffix(path.Base(file.Name), ".md")
+	}
+	return memo, nil
+}
+
+func readArchiveFile(file *zip.File) ([]byte, error) {
+	if file.UncompressedSize64 > uint64(MaxUploadBufferSizeBytes) {
+		return nil, errors.Errorf("file %s is too large", file.Name)
+	}
+	reader, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(io.LimitReader(reader, int64(MaxUploadBufferSizeBytes)+1))
+}
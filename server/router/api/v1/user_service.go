@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -21,6 +22,7 @@ import (
 
 	"github.com/usememos/memos/internal/base"
 	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/webhook"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/server/auth"
@@ -404,8 +406,14 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 		return nil, status.Errorf(codes.InvalidArgument, "invalid setting key: %v", err)
 	}
 
-	// Only GENERAL settings are supported via UpdateUserSetting
+	// Only GENERAL and DIGEST settings are supported via UpdateUserSetting
 	// Other setting types have dedicated service methods
+	if storeKey == storepb.UserSetting_DIGEST {
+		if err := s.updateUserDigestSetting(ctx, userID, request.Setting.GetDigestSetting(), request.UpdateMask.Paths); err != nil {
+			return nil, err
+		}
+		return s.GetUserSetting(ctx, &v1pb.GetUserSettingRequest{Name: request.Setting.Name})
+	}
 	if storeKey != storepb.UserSetting_GENERAL {
 		return nil, status.Errorf(codes.InvalidArgument, "setting type %s should not be updated via UpdateUserSetting", storeKey.String())
 	}
@@ -422,9 +430,12 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 	}
 
 	updatedGeneral := &v1pb.UserSetting_GeneralSetting{
-		MemoVisibility: generalSetting.GetMemoVisibility(),
-		Locale:         generalSetting.GetLocale(),
-		Theme:          generalSetting.GetTheme(),
+		MemoVisibility:  generalSetting.GetMemoVisibility(),
+		Locale:          generalSetting.GetLocale(),
+		Theme:           generalSetting.GetTheme(),
+		EmailOnComment:  generalSetting.GetEmailOnComment(),
+		EmailOnMention:  generalSetting.GetEmailOnMention(),
+		EmailOnReminder: generalSetting.GetEmailOnReminder(),
 	}
 
 	// Apply updates for fields specified in the update mask
@@ -437,6 +448,12 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 			updatedGeneral.Theme = incomingGeneral.Theme
 		case "locale":
 			updatedGeneral.Locale = incomingGeneral.Locale
+		case "email_on_comment":
+			updatedGeneral.EmailOnComment = incomingGeneral.EmailOnComment
+		case "email_on_mention":
+			updatedGeneral.EmailOnMention = incomingGeneral.EmailOnMention
+		case "email_on_reminder":
+			updatedGeneral.EmailOnReminder = incomingGeneral.EmailOnReminder
 		default:
 			// Ignore unsupported fields
 		}
@@ -736,11 +753,41 @@ func (s *APIV1Service) CreateUserWebhook(ctx context.Context, request *v1pb.Crea
 		return nil, status.Errorf(codes.InvalidArgument, "webhook URL is required")
 	}
 
+	eventTypes, err := normalizeUserWebhookEventTypes(request.Webhook.EventTypes)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid event types: %v", err)
+	}
+	filter := strings.TrimSpace(request.Webhook.Filter)
+	if filter != "" {
+		if err := s.validateFilter(ctx, filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+	}
+	format := storepb.WebhooksUserSetting_Webhook_Format(request.Webhook.Format)
+	if err := validateUserWebhookFormat(format, request.Webhook.Template); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid format: %v", err)
+	}
+
+	secret := strings.TrimSpace(request.Webhook.Secret)
+	if secret == "" {
+		secret = generateUserWebhookSecret()
+	}
+	encryptedSecret, err := encryptSensitiveValue(s.Secret, secret)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encrypt webhook secret: %v", err)
+	}
+
 	webhookID := generateUserWebhookID()
 	webhook := &storepb.WebhooksUserSetting_Webhook{
-		Id:    webhookID,
-		Title: request.Webhook.DisplayName,
-		Url:   strings.TrimSpace(request.Webhook.Url),
+		Id:                webhookID,
+		Title:             request.Webhook.DisplayName,
+		Url:               strings.TrimSpace(request.Webhook.Url),
+		Secret:            encryptedSecret,
+		EventTypes:        eventTypes,
+		Filter:            filter,
+		Format:            format,
+		Template:          request.Webhook.Template,
+		CheckResponseCode: request.Webhook.CheckResponseCode,
 	}
 
 	err = s.Store.AddUserWebhook(ctx, userID, webhook)
@@ -748,7 +795,10 @@ func (s *APIV1Service) CreateUserWebhook(ctx context.Context, request *v1pb.Crea
 		return nil, status.Errorf(codes.Internal, "failed to create webhook: %v", err)
 	}
 
-	return convertUserWebhookFromUserSetting(webhook, userID), nil
+	// The secret is only returned when it is set, so that the webhook receiver can be configured with it.
+	webhookMessage := convertUserWebhookFromUserSetting(webhook, userID)
+	webhookMessage.Secret = secret
+	return webhookMessage, nil
 }
 
 func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.UpdateUserWebhookRequest) (*v1pb.UserWebhook, error) {
@@ -793,11 +843,29 @@ func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.Upda
 
 	// Update the webhook
 	updatedWebhook := &storepb.WebhooksUserSetting_Webhook{
-		Id:    webhookID,
-		Title: targetWebhook.Title,
-		Url:   targetWebhook.Url,
+		Id:                webhookID,
+		Title:             targetWebhook.Title,
+		Url:               targetWebhook.Url,
+		Secret:            targetWebhook.Secret,
+		EventTypes:        targetWebhook.EventTypes,
+		Filter:            targetWebhook.Filter,
+		Format:            targetWebhook.Format,
+		Template:          targetWebhook.Template,
+		CheckResponseCode: targetWebhook.CheckResponseCode,
+	}
+
+	eventTypes, err := normalizeUserWebhookEventTypes(request.Webhook.EventTypes)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid event types: %v", err)
+	}
+	filter := strings.TrimSpace(request.Webhook.Filter)
+	if filter != "" {
+		if err := s.validateFilter(ctx, filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
 	}
 
+	secret := ""
 	if request.UpdateMask != nil {
 		for _, path := range request.UpdateMask.Paths {
 			switch path {
@@ -807,6 +875,22 @@ func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.Upda
 				}
 			case "display_name":
 				updatedWebhook.Title = request.Webhook.DisplayName
+			case "secret":
+				// An empty secret rotates the secret.
+				secret = strings.TrimSpace(request.Webhook.Secret)
+				if secret == "" {
+					secret = generateUserWebhookSecret()
+				}
+			case "event_types":
+				updatedWebhook.EventTypes = eventTypes
+			case "filter":
+				updatedWebhook.Filter = filter
+			case "format":
+				updatedWebhook.Format = storepb.WebhooksUserSetting_Webhook_Format(request.Webhook.Format)
+			case "template":
+				updatedWebhook.Template = request.Webhook.Template
+			case "check_response_code":
+				updatedWebhook.CheckResponseCode = request.Webhook.CheckResponseCode
 			default:
 				// Ignore unsupported fields
 			}
@@ -817,6 +901,22 @@ func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.Upda
 			updatedWebhook.Url = strings.TrimSpace(request.Webhook.Url)
 		}
 		updatedWebhook.Title = request.Webhook.DisplayName
+		secret = strings.TrimSpace(request.Webhook.Secret)
+		updatedWebhook.EventTypes = eventTypes
+		updatedWebhook.Filter = filter
+		updatedWebhook.Format = storepb.WebhooksUserSetting_Webhook_Format(request.Webhook.Format)
+		updatedWebhook.Template = request.Webhook.Template
+		updatedWebhook.CheckResponseCode = request.Webhook.CheckResponseCode
+	}
+	// The format is validated with the template after the update, as either can be updated alone.
+	if err := validateUserWebhookFormat(updatedWebhook.Format, updatedWebhook.Template); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid format: %v", err)
+	}
+	if secret != "" {
+		updatedWebhook.Secret, err = encryptSensitiveValue(s.Secret, secret)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encrypt webhook secret: %v", err)
+		}
 	}
 
 	err = s.Store.UpdateUserWebhook(ctx, userID, updatedWebhook)
@@ -824,7 +924,9 @@ func (s *APIV1Service) UpdateUserWebhook(ctx context.Context, request *v1pb.Upda
 		return nil, status.Errorf(codes.Internal, "failed to update webhook: %v", err)
 	}
 
-	return convertUserWebhookFromUserSetting(updatedWebhook, userID), nil
+	webhookMessage := convertUserWebhookFromUserSetting(updatedWebhook, userID)
+	webhookMessage.Secret = secret
+	return webhookMessage, nil
 }
 
 func (s *APIV1Service) DeleteUserWebhook(ctx context.Context, request *v1pb.DeleteUserWebhookRequest) (*emptypb.Empty, error) {
@@ -867,6 +969,9 @@ func (s *APIV1Service) DeleteUserWebhook(ctx context.Context, request *v1pb.Dele
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete webhook: %v", err)
 	}
+	if err := s.Store.DeleteWebhookDelivery(ctx, &store.DeleteWebhookDelivery{CreatorID: &userID, WebhookID: &webhookID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete webhook deliveries: %v", err)
+	}
 
 	return &emptypb.Empty{}, nil
 }
@@ -880,6 +985,46 @@ func generateUserWebhookID() string {
 	return hex.EncodeToString(b)
 }
 
+// generateUserWebhookSecret generates a secret to sign the deliveries of a webhook.
+func generateUserWebhookSecret() string {
+	b := make([]byte, 32)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// normalizeUserWebhookEventTypes validates the event types a webhook subscribes to and removes duplicates.
+// An empty list subscribes the webhook to all events.
+func normalizeUserWebhookEventTypes(eventTypes []string) ([]string, error) {
+	normalized := []string{}
+	for _, eventType := range eventTypes {
+		eventType = strings.TrimSpace(eventType)
+		if !slices.Contains(webhook.ActivityTypes, eventType) {
+			return nil, errors.Errorf("unknown event type %q", eventType)
+		}
+		if !slices.Contains(normalized, eventType) {
+			normalized = append(normalized, eventType)
+		}
+	}
+	return normalized, nil
+}
+
+// validateUserWebhookFormat validates the format of a webhook and its template.
+func validateUserWebhookFormat(format storepb.WebhooksUserSetting_Webhook_Format, template string) error {
+	if _, ok := storepb.WebhooksUserSetting_Webhook_Format_name[int32(format)]; !ok {
+		return errors.Errorf("unknown format %d", format)
+	}
+	if format != storepb.WebhooksUserSetting_Webhook_TEMPLATE {
+		return nil
+	}
+	if strings.TrimSpace(template) == "" {
+		return errors.New("template is required")
+	}
+	if _, err := webhook.ParseTemplate(template); err != nil {
+		return errors.Wrap(err, "failed to parse template")
+	}
+	return nil
+}
+
 // parseUserWebhookName parses a webhook name and returns the webhook ID and user ID.
 // Format: users/{user}/webhooks/{webhook}.
 func parseUserWebhookName(name string) (string, int32, error) {
@@ -897,11 +1042,17 @@ func parseUserWebhookName(name string) (string, int32, error) {
 }
 
 // convertUserWebhookFromUserSetting converts a storepb webhook to a v1pb UserWebhook.
+// The secret is left out, as it is only returned when it is set.
 func convertUserWebhookFromUserSetting(webhook *storepb.WebhooksUserSetting_Webhook, userID int32) *v1pb.UserWebhook {
 	return &v1pb.UserWebhook{
-		Name:        fmt.Sprintf("users/%d/webhooks/%s", userID, webhook.Id),
-		Url:         webhook.Url,
-		DisplayName: webhook.Title,
+		Name:              fmt.Sprintf("users/%d/webhooks/%s", userID, webhook.Id),
+		Url:               webhook.Url,
+		DisplayName:       webhook.Title,
+		EventTypes:        webhook.EventTypes,
+		Filter:            webhook.Filter,
+		Format:            v1pb.UserWebhook_Format(webhook.Format),
+		Template:          webhook.Template,
+		CheckResponseCode: webhook.CheckResponseCode,
 		// Note: create_time and update_time are not available in the user setting webhook structure
 		// This is a limitation of storing webhooks in user settings vs the dedicated webhook table
 	}
@@ -993,6 +1144,8 @@ func convertSettingKeyToStore(key string) (storepb.UserSetting_Key, error) {
 		return storepb.UserSetting_GENERAL, nil
 	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_WEBHOOKS)]:
 		return storepb.UserSetting_WEBHOOKS, nil
+	case v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_DIGEST)]:
+		return storepb.UserSetting_DIGEST, nil
 	default:
 		return storepb.UserSetting_KEY_UNSPECIFIED, errors.Errorf("unknown setting key: %s", key)
 	}
@@ -1007,6 +1160,8 @@ func convertSettingKeyFromStore(key storepb.UserSetting_Key) string {
 		return "SHORTCUTS" // Not defined in API proto
 	case storepb.UserSetting_WEBHOOKS:
 		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_WEBHOOKS)]
+	case storepb.UserSetting_DIGEST:
+		return v1pb.UserSetting_Key_name[int32(v1pb.UserSetting_DIGEST)]
 	default:
 		return "unknown"
 	}
@@ -1036,6 +1191,10 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32
 					Webhooks: []*v1pb.UserWebhook{},
 				},
 			}
+		case storepb.UserSetting_DIGEST:
+			setting.Value = &v1pb.UserSetting_DigestSetting_{
+				DigestSetting: &v1pb.UserSetting_DigestSetting{},
+			}
 		default:
 			return nil
 		}
@@ -1052,9 +1211,12 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32
 		if general := storeSetting.GetGeneral(); general != nil {
 			setting.Value = &v1pb.UserSetting_GeneralSetting_{
 				GeneralSetting: &v1pb.UserSetting_GeneralSetting{
-					Locale:         general.Locale,
-					MemoVisibility: general.MemoVisibility,
-					Theme:          general.Theme,
+					Locale:          general.Locale,
+					MemoVisibility:  general.MemoVisibility,
+					Theme:           general.Theme,
+					EmailOnComment:  general.EmailOnComment,
+					EmailOnMention:  general.EmailOnMention,
+					EmailOnReminder: general.EmailOnReminder,
 				},
 			}
 		} else {
@@ -1067,9 +1229,14 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32
 		apiWebhooks := make([]*v1pb.UserWebhook, 0, len(webhooks.Webhooks))
 		for _, webhook := range webhooks.Webhooks {
 			apiWebhook := &v1pb.UserWebhook{
-				Name:        fmt.Sprintf("users/%d/webhooks/%s", userID, webhook.Id),
-				Url:         webhook.Url,
-				DisplayName: webhook.Title,
+				Name:              fmt.Sprintf("users/%d/webhooks/%s", userID, webhook.Id),
+				Url:               webhook.Url,
+				DisplayName:       webhook.Title,
+				EventTypes:        webhook.EventTypes,
+				Filter:            webhook.Filter,
+				Format:            v1pb.UserWebhook_Format(webhook.Format),
+				Template:          webhook.Template,
+				CheckResponseCode: webhook.CheckResponseCode,
 			}
 			apiWebhooks = append(apiWebhooks, apiWebhook)
 		}
@@ -1078,6 +1245,10 @@ func convertUserSettingFromStore(storeSetting *storepb.UserSetting, userID int32
 				Webhooks: apiWebhooks,
 			},
 		}
+	case storepb.UserSetting_DIGEST:
+		setting.Value = &v1pb.UserSetting_DigestSetting_{
+			DigestSetting: convertDigestSettingFromStore(storeSetting.GetDigest(), userID),
+		}
 	default:
 		// Hide non-API settings from API responses.
 		return nil
@@ -1098,9 +1269,12 @@ func convertUserSettingToStore(apiSetting *v1pb.UserSetting, userID int32, key s
 		if general := apiSetting.GetGeneralSetting(); general != nil {
 			storeSetting.Value = &storepb.UserSetting_General{
 				General: &storepb.GeneralUserSetting{
-					Locale:         general.Locale,
-					MemoVisibility: general.MemoVisibility,
-					Theme:          general.Theme,
+					Locale:          general.Locale,
+					MemoVisibility:  general.MemoVisibility,
+					Theme:           general.Theme,
+					EmailOnComment:  general.EmailOnComment,
+					EmailOnMention:  general.EmailOnMention,
+					EmailOnReminder: general.EmailOnReminder,
 				},
 			}
 		} else {
@@ -1125,6 +1299,16 @@ func convertUserSettingToStore(apiSetting *v1pb.UserSetting, userID int32, key s
 		} else {
 			return nil, errors.Errorf("webhooks setting is required")
 		}
+	case storepb.UserSetting_DIGEST:
+		if digest := apiSetting.GetDigestSetting(); digest != nil {
+			digestSetting, err := convertDigestSettingToStore(digest, userID)
+			if err != nil {
+				return nil, err
+			}
+			storeSetting.Value = &storepb.UserSetting_Digest{Digest: digestSetting}
+		} else {
+			return nil, errors.Errorf("digest setting is required")
+		}
 	default:
 		return nil, errors.Errorf("unsupported setting key: %v", key)
 	}
@@ -1257,11 +1441,15 @@ func (s *APIV1Service) ListUserNotifications(ctx context.Context, request *v1pb.
 	}
 
 	// Fetch inbox items from storage
-	// Filter at database level to only include MEMO_COMMENT notifications (ignore legacy VERSION_UPDATE entries)
-	memoCommentType := storepb.InboxMessage_MEMO_COMMENT
+	// Filter at database level to only include memo and digest notifications (ignore legacy VERSION_UPDATE entries)
 	inboxes, err := s.Store.ListInboxes(ctx, &store.FindInbox{
-		ReceiverID:  &userID,
-		MessageType: &memoCommentType,
+		ReceiverID: &userID,
+		MessageTypeList: []storepb.InboxMessage_Type{
+			storepb.InboxMessage_MEMO_COMMENT,
+			storepb.InboxMessage_MEMO_MENTION,
+			storepb.InboxMessage_MEMO_REMINDER,
+			storepb.InboxMessage_DIGEST,
+		},
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list inboxes: %v", err)
@@ -1418,6 +1606,20 @@ func (*APIV1Service) convertInboxToUserNotification(_ context.Context, inbox *st
 		switch inbox.Message.Type {
 		case storepb.InboxMessage_MEMO_COMMENT:
 			notification.Type = v1pb.UserNotification_MEMO_COMMENT
+		case storepb.InboxMessage_MEMO_MENTION:
+			notification.Type = v1pb.UserNotification_MEMO_MENTION
+		case storepb.InboxMessage_MEMO_REMINDER:
+			notification.Type = v1pb.UserNotification_MEMO_REMINDER
+		case storepb.InboxMessage_DIGEST:
+			notification.Type = v1pb.UserNotification_DIGEST
+			if digest := inbox.Message.Digest; digest != nil {
+				notification.Digest = &v1pb.UserNotification_Digest{
+					Title:     digest.Title,
+					Html:      digest.Html,
+					StartTime: timestamppb.New(time.Unix(digest.StartTs, 0)),
+					EndTime:   timestamppb.New(time.Unix(digest.EndTs, 0)),
+				}
+			}
 		default:
 			notification.Type = v1pb.UserNotification_TYPE_UNSPECIFIED
 		}
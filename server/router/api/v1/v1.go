@@ -16,9 +16,11 @@ import (
 	"golang.org/x/sync/semaphore"
 
 	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/eventbus"
 	"github.com/usememos/memos/plugin/markdown"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	"github.com/usememos/memos/server/auth"
+	"github.com/usememos/memos/server/runner/jobqueue"
 	"github.com/usememos/memos/store"
 )
 
@@ -36,6 +38,7 @@ type APIV1Service struct {
 	v1pb.UnimplementedShortcutServiceServer
 	v1pb.UnimplementedActivityServiceServer
 	v1pb.UnimplementedIdentityProviderServiceServer
+	v1pb.UnimplementedJobServiceServer
 
 	Secret          string
 	Profile         *profile.Profile
@@ -44,6 +47,8 @@ type APIV1Service struct {
 	// EmbeddingClientFactory overrides semantic embedding client creation.
 	// Used by tests to avoid external API dependency.
 	EmbeddingClientFactory func(ctx context.Context) (SemanticEmbeddingClient, error)
+	// JobRunner runs the background jobs enqueued by the service, set by RegisterJobHandlers.
+	JobRunner *jobqueue.Runner
 
 	// thumbnailSemaphore limits concurrent thumbnail generation to prevent memory exhaustion
 	thumbnailSemaphore *semaphore.Weighted
@@ -51,14 +56,18 @@ type APIV1Service struct {
 	embeddingSemaphore *semaphore.Weighted
 	embeddingMu        sync.RWMutex
 
-	// semanticReindexRunning guards one semantic reindex task at a time.
-	semanticReindexMu      sync.Mutex
-	semanticReindexRunning bool
+	// semanticReindexMu guards enqueuing one semantic reindex job at a time.
+	semanticReindexMu sync.Mutex
+
+	// eventBus publishes the domain events to their side effects and the event streams, see EventBus.
+	eventBus     *eventbus.Bus
+	eventBusOnce sync.Once
 }
 
 func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store) *APIV1Service {
 	markdownService := markdown.NewService(
 		markdown.WithTagExtension(),
+		markdown.WithMentionExtension(),
 	)
 	embeddingConcurrency := resolveEmbeddingRefreshConcurrency(context.Background(), store)
 	service := &APIV1Service{
@@ -69,7 +78,7 @@ func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store
 		thumbnailSemaphore: semaphore.NewWeighted(3), // Limit to 3 concurrent thumbnail generations
 	}
 	service.setEmbeddingSemaphoreLimit(embeddingConcurrency)
-	service.resetStaleSemanticReindexState(context.Background())
+	service.RegisterJobHandlers(jobqueue.NewRunner(store))
 	return service
 }
 
@@ -196,6 +205,9 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 	if err := v1pb.RegisterIdentityProviderServiceHandlerServer(ctx, gwMux, s); err != nil {
 		return err
 	}
+	if err := v1pb.RegisterJobServiceHandlerServer(ctx, gwMux, s); err != nil {
+		return err
+	}
 	gwGroup := echoServer.Group("")
 	gwGroup.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: []string{"*"},
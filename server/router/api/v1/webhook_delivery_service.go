@@ -0,0 +1,311 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/webhook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// webhookDeliveryRetention is how long the deliveries of a webhook are kept.
+	webhookDeliveryRetention = 30 * 24 * time.Hour
+	// webhookDeliveryMaxResponseLength bounds the number of response body bytes kept in the delivery log.
+	webhookDeliveryMaxResponseLength = 1024
+	// webhookSnippetMaxLength bounds the length of the memo snippet in chat messages.
+	webhookSnippetMaxLength = 280
+)
+
+func (s *APIV1Service) ListUserWebhookDeliveries(ctx context.Context, request *v1pb.ListUserWebhookDeliveriesRequest) (*v1pb.ListUserWebhookDeliveriesResponse, error) {
+	webhookID, userID, err := parseUserWebhookName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+	}
+	if _, err := s.getUserWebhookForCurrentUser(ctx, userID, webhookID); err != nil {
+		return nil, err
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limit = min(limit, MaxPageSize)
+	limitPlusOne := limit + 1
+	deliveries, err := s.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		CreatorID: &userID,
+		WebhookID: &webhookID,
+		Limit:     &limitPlusOne,
+		Offset:    &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhook deliveries: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(deliveries) == limitPlusOne {
+		deliveries = deliveries[:limit]
+		nextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+
+	response := &v1pb.ListUserWebhookDeliveriesResponse{
+		Deliveries:    []*v1pb.UserWebhookDelivery{},
+		NextPageToken: nextPageToken,
+	}
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, convertUserWebhookDeliveryFromStore(delivery))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) RedeliverUserWebhookDelivery(ctx context.Context, request *v1pb.RedeliverUserWebhookDeliveryRequest) (*v1pb.UserWebhookDelivery, error) {
+	deliveryID, webhookID, userID, err := parseUserWebhookDeliveryName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delivery name: %v", err)
+	}
+	hook, err := s.getUserWebhookForCurrentUser(ctx, userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	delivery, err := s.Store.GetWebhookDelivery(ctx, &store.FindWebhookDelivery{
+		ID:        &deliveryID,
+		CreatorID: &userID,
+		WebhookID: &webhookID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get webhook delivery: %v", err)
+	}
+	if delivery == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook delivery not found")
+	}
+
+	// The request is sent again as a new delivery to the current URL of the webhook.
+	redelivery, err := s.deliverUserWebhook(ctx, userID, hook, delivery.ActivityType, delivery.RequestBody)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to redeliver webhook: %v", err)
+	}
+	return convertUserWebhookDeliveryFromStore(redelivery), nil
+}
+
+// getUserWebhookForCurrentUser returns the webhook of the user if the current user may manage it.
+func (s *APIV1Service) getUserWebhookForCurrentUser(ctx context.Context, userID int32, webhookID string) (*storepb.WebhooksUserSetting_Webhook, error) {
+	currentUser, err := s.fetchCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID && currentUser.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	hook, err := s.getUserWebhook(ctx, userID, webhookID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user webhooks: %v", err)
+	}
+	if hook == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook not found")
+	}
+	return hook, nil
+}
+
+func (s *APIV1Service) getUserWebhook(ctx context.Context, userID int32, webhookID string) (*storepb.WebhooksUserSetting_Webhook, error) {
+	webhooks, err := s.Store.GetUserWebhooks(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, hook := range webhooks {
+		if hook.Id == webhookID {
+			return hook, nil
+		}
+	}
+	return nil, nil
+}
+
+// deliverUserWebhook records a delivery of the request body and enqueues the job sending it.
+func (s *APIV1Service) deliverUserWebhook(ctx context.Context, userID int32, hook *storepb.WebhooksUserSetting_Webhook, activityType, body string) (*store.WebhookDelivery, error) {
+	// Webhooks created before signing was introduced get a secret on their first delivery, and the secrets stored
+	// before they were encrypted are encrypted.
+	if !strings.HasPrefix(hook.Secret, sensitiveValueCipherPrefix) {
+		secret := hook.Secret
+		if secret == "" {
+			secret = generateUserWebhookSecret()
+		}
+		encryptedSecret, err := encryptSensitiveValue(s.Secret, secret)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to encrypt webhook secret")
+		}
+		hook.Secret = encryptedSecret
+		if err := s.Store.UpdateUserWebhook(ctx, userID, hook); err != nil {
+			return nil, errors.Wrap(err, "failed to update webhook secret")
+		}
+	}
+
+	createdBefore := time.Now().Add(-webhookDeliveryRetention).Unix()
+	if err := s.Store.DeleteWebhookDelivery(ctx, &store.DeleteWebhookDelivery{
+		CreatorID:       &userID,
+		WebhookID:       &hook.Id,
+		CreatedBeforeTs: &createdBefore,
+	}); err != nil {
+		slog.Warn("failed to prune webhook deliveries", "webhookID", hook.Id, "error", err)
+	}
+
+	delivery, err := s.Store.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+		CreatorID:    userID,
+		WebhookID:    hook.Id,
+		URL:          hook.Url,
+		ActivityType: activityType,
+		RequestBody:  body,
+		Status:       store.WebhookDeliveryPending,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create webhook delivery")
+	}
+	if _, err := s.enqueueJob(ctx, store.JobTypeWebhook, "", &storepb.JobPayload{
+		Payload: &storepb.JobPayload_Webhook_{Webhook: &storepb.JobPayload_Webhook{
+			Url:          delivery.URL,
+			ActivityType: activityType,
+			DeliveryId:   delivery.ID,
+		}},
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to enqueue webhook")
+	}
+	return delivery, nil
+}
+
+// runWebhookJob sends a webhook delivery and records the attempt in the delivery log.
+func (s *APIV1Service) runWebhookJob(ctx context.Context, job *store.Job) error {
+	deliveryID := job.Payload.GetWebhook().GetDeliveryId()
+	delivery, err := s.Store.GetWebhookDelivery(ctx, &store.FindWebhookDelivery{ID: &deliveryID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get webhook delivery")
+	}
+	if delivery == nil {
+		// The webhook was deleted with its deliveries.
+		return nil
+	}
+	// The secret is read on every attempt, so that retries are signed with a rotated secret.
+	hook, err := s.getUserWebhook(ctx, delivery.CreatorID, delivery.WebhookID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get webhook")
+	}
+	if hook == nil {
+		return nil
+	}
+	secret, err := decryptSensitiveValue(s.Secret, hook.Secret)
+	if err != nil {
+		return errors.Wrap(err, "failed to decrypt webhook secret")
+	}
+
+	response, sendErr := webhook.Send(ctx, &webhook.Request{
+		URL:               delivery.URL,
+		Body:              []byte(delivery.RequestBody),
+		Secret:            secret,
+		DeliveryID:        strconv.Itoa(int(delivery.ID)),
+		CheckResponseCode: hook.CheckResponseCode,
+	})
+
+	deliveryStatus, attempts := store.WebhookDeliverySucceeded, job.Attempts
+	statusCode, responseBody, lastError := int32(0), "", ""
+	if response != nil {
+		statusCode = int32(response.StatusCode)
+		responseBody = truncateWebhookResponseBody(response.Body)
+	}
+	if sendErr != nil {
+		lastError = sendErr.Error()
+		deliveryStatus = store.WebhookDeliveryPending
+		if job.Attempts >= job.MaxAttempts {
+			deliveryStatus = store.WebhookDeliveryFailed
+		}
+	}
+	if _, err := s.Store.UpdateWebhookDelivery(context.WithoutCancel(ctx), &store.UpdateWebhookDelivery{
+		ID:                 delivery.ID,
+		Status:             &deliveryStatus,
+		Attempts:           &attempts,
+		ResponseStatusCode: &statusCode,
+		ResponseBody:       &responseBody,
+		Error:              &lastError,
+	}); err != nil {
+		slog.Warn("failed to update webhook delivery", "deliveryID", delivery.ID, "error", err)
+	}
+	return sendErr
+}
+
+func truncateWebhookResponseBody(body []byte) string {
+	if len(body) > webhookDeliveryMaxResponseLength {
+		body = body[:webhookDeliveryMaxResponseLength]
+	}
+	return strings.ToValidUTF8(string(body), "")
+}
+
+// parseUserWebhookDeliveryName parses a delivery name and returns the delivery ID, webhook ID and user ID.
+// Format: users/{user}/webhooks/{webhook}/deliveries/{delivery}.
+func parseUserWebhookDeliveryName(name string) (int32, string, int32, error) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 6 || parts[4] != "deliveries" {
+		return 0, "", 0, errors.New("invalid delivery name format")
+	}
+	webhookID, userID, err := parseUserWebhookName(strings.Join(parts[:4], "/"))
+	if err != nil {
+		return 0, "", 0, err
+	}
+	deliveryID, err := strconv.ParseInt(parts[5], 10, 32)
+	if err != nil {
+		return 0, "", 0, errors.New("invalid delivery ID in delivery name")
+	}
+	return int32(deliveryID), webhookID, userID, nil
+}
+
+func convertUserWebhookDeliveryFromStore(delivery *store.WebhookDelivery) *v1pb.UserWebhookDelivery {
+	return &v1pb.UserWebhookDelivery{
+		Name:               fmt.Sprintf("users/%d/webhooks/%s/deliveries/%d", delivery.CreatorID, delivery.WebhookID, delivery.ID),
+		Url:                delivery.URL,
+		ActivityType:       delivery.ActivityType,
+		State:              convertUserWebhookDeliveryStateFromStore(delivery.Status),
+		Attempts:           delivery.Attempts,
+		RequestBody:        delivery.RequestBody,
+		ResponseStatusCode: delivery.ResponseStatusCode,
+		ResponseBody:       delivery.ResponseBody,
+		Error:              delivery.Error,
+		CreateTime:         timestamppb.New(time.Unix(delivery.CreatedTs, 0)),
+		UpdateTime:         timestamppb.New(time.Unix(delivery.UpdatedTs, 0)),
+	}
+}
+
+func convertUserWebhookDeliveryStateFromStore(deliveryStatus store.WebhookDeliveryStatus) v1pb.UserWebhookDelivery_State {
+	switch deliveryStatus {
+	case store.WebhookDeliveryPending:
+		return v1pb.UserWebhookDelivery_PENDING
+	case store.WebhookDeliverySucceeded:
+		return v1pb.UserWebhookDelivery_SUCCEEDED
+	case store.WebhookDeliveryFailed:
+		return v1pb.UserWebhookDelivery_FAILED
+	default:
+		return v1pb.UserWebhookDelivery_STATE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,321 @@
+package jobqueue
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	defaultPollInterval    = 5 * time.Second
+	defaultRetryBackoff    = 10 * time.Second
+	defaultMaxRetryBackoff = time.Hour
+	defaultConcurrency     = 1
+	defaultMaxAttempts     = 5
+	defaultTimeout         = 5 * time.Minute
+	// dispatchBatchSize bounds the number of due jobs loaded per dispatch.
+	dispatchBatchSize = 100
+	// finishedJobRetention is how long succeeded and canceled jobs are kept. Dead jobs are kept until retried or canceled.
+	finishedJobRetention = 7 * 24 * time.Hour
+	pruneInterval        = time.Hour
+)
+
+// Handler runs a job. A returned error fails the attempt, which is retried until the job is dead.
+type Handler func(ctx context.Context, job *store.Job) error
+
+// HandlerOptions configures how jobs of a type run.
+type HandlerOptions struct {
+	// Concurrency is the maximum number of jobs of the type running at once. Defaults to 1.
+	Concurrency int
+	// MaxAttempts is the number of attempts after which a failing job is dead. Defaults to 5.
+	MaxAttempts int32
+	// Timeout bounds a single attempt. Defaults to 5 minutes.
+	Timeout time.Duration
+}
+
+type registration struct {
+	handler Handler
+	options HandlerOptions
+	running int
+}
+
+// Runner runs persisted jobs with registered handlers, retrying failed attempts with exponential backoff.
+type Runner struct {
+	Store *store.Store
+
+	// PollInterval is how often due jobs are polled when no job is enqueued.
+	PollInterval time.Duration
+	// RetryBackoff is the delay before the first retry, doubled on every further attempt up to MaxRetryBackoff.
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+
+	mu            sync.Mutex
+	registrations map[store.JobType]*registration
+	// cancelFuncs cancels the running jobs by id.
+	cancelFuncs map[int32]context.CancelFunc
+	wake        chan struct{}
+	wg          sync.WaitGroup
+}
+
+func NewRunner(s *store.Store) *Runner {
+	return &Runner{
+		Store:           s,
+		PollInterval:    defaultPollInterval,
+		RetryBackoff:    defaultRetryBackoff,
+		MaxRetryBackoff: defaultMaxRetryBackoff,
+		registrations:   map[store.JobType]*registration{},
+		cancelFuncs:     map[int32]context.CancelFunc{},
+		wake:            make(chan struct{}, 1),
+	}
+}
+
+// Register registers the handler of a job type. It must be called before Run.
+func (r *Runner) Register(jobType store.JobType, handler Handler, options HandlerOptions) {
+	if options.Concurrency <= 0 {
+		options.Concurrency = defaultConcurrency
+	}
+	if options.MaxAttempts <= 0 {
+		options.MaxAttempts = defaultMaxAttempts
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = defaultTimeout
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.registrations[jobType] = &registration{handler: handler, options: options}
+}
+
+// Enqueue persists a job to run as soon as a worker is free.
+// When a pending job of the same type and non-empty key exists, it is returned instead of enqueuing a duplicate.
+func (r *Runner) Enqueue(ctx context.Context, jobType store.JobType, key string, payload *storepb.JobPayload) (*store.Job, error) {
+	r.mu.Lock()
+	registration, ok := r.registrations[jobType]
+	r.mu.Unlock()
+	if !ok {
+		return nil, errors.Errorf("job type %s is not registered", jobType)
+	}
+
+	if key != "" {
+		pending, err := r.Store.GetJob(ctx, &store.FindJob{
+			Type:       &jobType,
+			Key:        &key,
+			StatusList: []store.JobStatus{store.JobPending},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find pending job")
+		}
+		if pending != nil {
+			return pending, nil
+		}
+	}
+
+	job, err := r.Store.CreateJob(ctx, &store.Job{
+		Type:        jobType,
+		Key:         key,
+		Status:      store.JobPending,
+		MaxAttempts: registration.options.MaxAttempts,
+		Payload:     payload,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create job")
+	}
+	r.Notify()
+	return job, nil
+}
+
+// Notify wakes the runner up to dispatch due jobs.
+func (r *Runner) Notify() {
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Cancel stops the job if it is running in this process. The caller updates the job status.
+func (r *Runner) Cancel(jobID int32) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if cancel, ok := r.cancelFuncs[jobID]; ok {
+		cancel()
+		delete(r.cancelFuncs, jobID)
+	}
+}
+
+// Run dispatches jobs until the context is canceled, then waits for the running jobs to return.
+func (r *Runner) Run(ctx context.Context) {
+	// Jobs left running by a previous process were interrupted and run again.
+	r.requeueInterruptedJobs(ctx)
+	r.prune(ctx)
+
+	pollTicker := time.NewTicker(r.PollInterval)
+	defer pollTicker.Stop()
+	pruneTicker := time.NewTicker(pruneInterval)
+	defer pruneTicker.Stop()
+
+	for {
+		r.dispatch(ctx)
+		select {
+		case <-ctx.Done():
+			r.wg.Wait()
+			return
+		case <-r.wake:
+		case <-pollTicker.C:
+		case <-pruneTicker.C:
+			r.prune(ctx)
+		}
+	}
+}
+
+func (r *Runner) requeueInterruptedJobs(ctx context.Context) {
+	jobs, err := r.Store.ListJobs(ctx, &store.FindJob{StatusList: []store.JobStatus{store.JobRunning}})
+	if err != nil {
+		slog.Warn("failed to list interrupted jobs", "error", err)
+		return
+	}
+	running, pending := store.JobRunning, store.JobPending
+	for _, job := range jobs {
+		if _, err := r.Store.UpdateJob(ctx, &store.UpdateJob{ID: job.ID, ExpectedStatus: &running, Status: &pending}); err != nil {
+			slog.Warn("failed to requeue interrupted job", "jobID", job.ID, "error", err)
+		}
+	}
+}
+
+func (r *Runner) prune(ctx context.Context) {
+	updatedBefore := time.Now().Add(-finishedJobRetention).Unix()
+	if err := r.Store.DeleteJob(ctx, &store.DeleteJob{
+		StatusList:      []store.JobStatus{store.JobSucceeded, store.JobCanceled},
+		UpdatedBeforeTs: &updatedBefore,
+	}); err != nil {
+		slog.Warn("failed to prune finished jobs", "error", err)
+	}
+}
+
+func (r *Runner) dispatch(ctx context.Context) {
+	if ctx.Err() != nil {
+		return
+	}
+	now := time.Now().Unix()
+	limit := dispatchBatchSize
+	jobs, err := r.Store.ListJobs(ctx, &store.FindJob{
+		StatusList:      []store.JobStatus{store.JobPending},
+		RunBeforeTs:     &now,
+		OrderByRunAfter: true,
+		Limit:           &limit,
+	})
+	if err != nil {
+		slog.Warn("failed to list due jobs", "error", err)
+		return
+	}
+
+	for _, job := range jobs {
+		r.mu.Lock()
+		registration, ok := r.registrations[job.Type]
+		if !ok || registration.running >= registration.options.Concurrency {
+			r.mu.Unlock()
+			continue
+		}
+		registration.running++
+		r.mu.Unlock()
+
+		claimed, err := r.claim(ctx, job)
+		if err != nil || claimed == nil {
+			if err != nil {
+				slog.Warn("failed to claim job", "jobID", job.ID, "error", err)
+			}
+			r.release(registration)
+			continue
+		}
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			defer r.release(registration)
+			r.runJob(ctx, registration, claimed)
+		}()
+	}
+}
+
+// claim marks the job running, or returns nil when the job is no longer pending.
+func (r *Runner) claim(ctx context.Context, job *store.Job) (*store.Job, error) {
+	pending, running := store.JobPending, store.JobRunning
+	attempts := job.Attempts + 1
+	return r.Store.UpdateJob(ctx, &store.UpdateJob{
+		ID:             job.ID,
+		ExpectedStatus: &pending,
+		Status:         &running,
+		Attempts:       &attempts,
+	})
+}
+
+func (r *Runner) release(registration *registration) {
+	r.mu.Lock()
+	registration.running--
+	r.mu.Unlock()
+	// A free slot may let a waiting job of the type run.
+	r.Notify()
+}
+
+func (r *Runner) runJob(ctx context.Context, registration *registration, job *store.Job) {
+	jobCtx, cancel := context.WithTimeout(ctx, registration.options.Timeout)
+	defer cancel()
+	r.mu.Lock()
+	r.cancelFuncs[job.ID] = cancel
+	r.mu.Unlock()
+	defer func() {
+		r.mu.Lock()
+		delete(r.cancelFuncs, job.ID)
+		r.mu.Unlock()
+	}()
+
+	err := runHandler(jobCtx, registration.handler, job)
+	if ctx.Err() != nil {
+		// The runner is shutting down; the job stays running and is requeued on the next start.
+		return
+	}
+
+	running := store.JobRunning
+	update := &store.UpdateJob{ID: job.ID, ExpectedStatus: &running}
+	if err == nil {
+		status, lastError := store.JobSucceeded, ""
+		update.Status, update.LastError = &status, &lastError
+	} else {
+		lastError := err.Error()
+		update.LastError = &lastError
+		if job.Attempts >= job.MaxAttempts {
+			status := store.JobDead
+			update.Status = &status
+			slog.Warn("job is dead after failed attempts", "jobID", job.ID, "type", job.Type, "attempts", job.Attempts, "error", err)
+		} else {
+			status := store.JobPending
+			runAfterTs := time.Now().Add(r.retryBackoff(job.Attempts)).Unix()
+			update.Status, update.RunAfterTs = &status, &runAfterTs
+			slog.Warn("job attempt failed, retrying", "jobID", job.ID, "type", job.Type, "attempts", job.Attempts, "error", err)
+		}
+	}
+	// A job canceled while running is no longer running and keeps its canceled status.
+	if _, err := r.Store.UpdateJob(context.WithoutCancel(ctx), update); err != nil {
+		slog.Warn("failed to update job", "jobID", job.ID, "error", err)
+	}
+}
+
+func runHandler(ctx context.Context, handler Handler, job *store.Job) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = errors.Errorf("job handler panicked: %v", recovered)
+		}
+	}()
+	return handler(ctx, job)
+}
+
+func (r *Runner) retryBackoff(attempts int32) time.Duration {
+	backoff := r.RetryBackoff
+	for i := int32(1); i < attempts && backoff < r.MaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, r.MaxRetryBackoff)
+}
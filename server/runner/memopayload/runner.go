@@ -2,7 +2,10 @@ package memopayload
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"log/slog"
+	"strings"
 
 	"github.com/pkg/errors"
 
@@ -83,6 +86,29 @@ func RebuildMemoPayload(memo *store.Memo, markdownService markdown.Service) erro
 	}
 
 	memo.Payload.Tags = data.Tags
+	memo.Payload.Mentions = data.Mentions
+	memo.Payload.Tasks = make([]*storepb.MemoPayload_Task, 0, len(data.Tasks))
+	for _, task := range data.Tasks {
+		memo.Payload.Tasks = append(memo.Payload.Tasks, &storepb.MemoPayload_Task{
+			Text:    task.Text,
+			Checked: task.Checked,
+			Line:    int32(task.Line),
+			Offset:  int32(task.Offset),
+			DueDate: task.DueDate,
+		})
+	}
 	memo.Payload.Property = data.Property
+	memo.Payload.Property.ContentHash = NormalizedContentHash(memo.Content)
 	return nil
 }
+
+// NormalizedContentHash returns the hex sha256 hash of the content with case folded and whitespace collapsed,
+// so that memos differing only in letter case or spacing get the same hash. Blank content has no hash.
+func NormalizedContentHash(content string) string {
+	words := strings.Fields(strings.ToLower(content))
+	if len(words) == 0 {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(strings.Join(words, " ")))
+	return hex.EncodeToString(hash[:])
+}
@@ -15,11 +15,13 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/scheduler"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	apiv1 "github.com/usememos/memos/server/router/api/v1"
 	"github.com/usememos/memos/server/router/fileserver"
 	"github.com/usememos/memos/server/router/frontend"
 	"github.com/usememos/memos/server/router/rss"
+	"github.com/usememos/memos/server/runner/jobqueue"
 	"github.com/usememos/memos/server/runner/s3presign"
 	"github.com/usememos/memos/store"
 )
@@ -31,6 +33,8 @@ type Server struct {
 
 	echoServer        *echo.Echo
 	httpServer        *http.Server
+	jobRunner         *jobqueue.Runner
+	scheduler         *scheduler.Scheduler
 	runnerCancelFuncs []context.CancelFunc
 }
 
@@ -66,6 +70,21 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	rootGroup := echoServer.Group("")
 
 	apiV1Service := apiv1.NewAPIV1Service(s.Secret, profile, store)
+	s.jobRunner = apiV1Service.JobRunner
+
+	// Periodic jobs are scheduled in the server time zone.
+	s.scheduler = scheduler.New(
+		scheduler.WithTimezone(time.Local.String()),
+		scheduler.WithMiddleware(
+			scheduler.Recovery(func(jobName string, recovered interface{}) {
+				slog.Error("scheduled job panicked", "job", jobName, "panic", recovered)
+			}),
+			scheduler.Logging(slog.Default()),
+		),
+	)
+	if err := apiV1Service.RegisterScheduledJobs(s.scheduler); err != nil {
+		return nil, errors.Wrap(err, "failed to register scheduled jobs")
+	}
 
 	// Register HTTP file server routes BEFORE gRPC-Gateway to ensure proper range request handling for Safari.
 	// This uses native HTTP serving (http.ServeContent) instead of gRPC for video/audio files.
@@ -121,6 +140,13 @@ func (s *Server) Shutdown(ctx context.Context) {
 		}
 	}
 
+	// Stop the scheduler before closing the database.
+	if s.scheduler != nil {
+		if err := s.scheduler.Stop(ctx); err != nil {
+			slog.Error("failed to stop scheduler", slog.String("error", err.Error()))
+		}
+	}
+
 	// Shutdown HTTP server.
 	if s.httpServer != nil {
 		if err := s.httpServer.Shutdown(ctx); err != nil {
@@ -154,6 +180,19 @@ func (s *Server) StartBackgroundRunners(ctx context.Context) {
 		slog.Info("s3presign runner stopped")
 	}()
 
+	// Start the job runner, which resumes the jobs left by a previous process.
+	jobContext, jobCancel := context.WithCancel(ctx)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, jobCancel)
+	go func() {
+		s.jobRunner.Run(jobContext)
+		slog.Info("job runner stopped")
+	}()
+
+	// Start the scheduler, which runs the periodic jobs such as digests, memo reminders and scheduled memos.
+	if err := s.scheduler.Start(); err != nil {
+		slog.Error("failed to start scheduler", "error", err)
+	}
+
 	// Log the number of goroutines running
 	slog.Info("background runners started", "goroutines", runtime.NumGoroutine())
 }
@@ -0,0 +1,141 @@
+package store
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+const (
+	// BackupManifestName is the name of the manifest entry of a backup archive.
+	BackupManifestName = "manifest.json"
+	// backupFilesDir is the directory of the local attachment files in a backup archive.
+	backupFilesDir = "files"
+)
+
+// BackupManifest describes the content of a backup archive.
+type BackupManifest struct {
+	Version       string `json:"version"`
+	SchemaVersion string `json:"schemaVersion"`
+	Driver        string `json:"driver"`
+	CreatedTs     int64  `json:"createdTs"`
+	// Database is the entry of the database snapshot: a SQLite database file, or a SQL dump for MySQL and Postgres.
+	Database string `json:"database"`
+	// Files are the local attachment files bundled in the archive.
+	Files []*BackupFile `json:"files"`
+	// MissingFiles are the references of the local attachments whose file was not found.
+	MissingFiles []string `json:"missingFiles,omitempty"`
+}
+
+// BackupFile is a local attachment file bundled in a backup archive.
+type BackupFile struct {
+	// Reference is the reference of the attachment, relative to the data directory unless absolute.
+	Reference string `json:"reference"`
+	// Path is the entry of the file in the archive.
+	Path string `json:"path"`
+}
+
+// BackupDatabaseName returns the name of the database snapshot in a backup archive.
+func (s *Store) BackupDatabaseName() string {
+	if s.DriverName() == "sqlite" {
+		return "memos.db"
+	}
+	return "memos.sql"
+}
+
+// WriteBackup writes a backup of the instance as a zip archive: a consistent snapshot of the database, the files of
+// the attachments stored on the local file system and a manifest. Attachments stored in the database are part of the
+// snapshot, while attachments stored in S3 are left out.
+func (s *Store) WriteBackup(ctx context.Context, w io.Writer) (*BackupManifest, error) {
+	basicSetting, err := s.GetInstanceBasicSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &BackupManifest{
+		Version:       s.profile.Version,
+		SchemaVersion: basicSetting.SchemaVersion,
+		Driver:        s.DriverName(),
+		CreatedTs:     time.Now().Unix(),
+		Database:      s.BackupDatabaseName(),
+		Files:         []*BackupFile{},
+	}
+
+	archive := zip.NewWriter(w)
+	database, err := archive.Create(manifest.Database)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create database entry")
+	}
+	if err := s.driver.Backup(ctx, database); err != nil {
+		return nil, errors.Wrap(err, "failed to back up the database")
+	}
+
+	// Attachments created after the snapshot are bundled as well, they are only left unreferenced.
+	localStorage := storepb.AttachmentStorageType_LOCAL
+	attachments, err := s.ListAttachments(ctx, &FindAttachment{StorageType: &localStorage})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list local attachments")
+	}
+	for _, attachment := range attachments {
+		file := &BackupFile{
+			Reference: attachment.Reference,
+			// Cleaning the reference as an absolute path keeps the entry inside the files directory.
+			Path: path.Join(backupFilesDir, path.Clean("/"+filepath.ToSlash(attachment.Reference))),
+		}
+		written, err := s.writeBackupFile(archive, file)
+		if err != nil {
+			return nil, err
+		}
+		if !written {
+			manifest.MissingFiles = append(manifest.MissingFiles, attachment.Reference)
+			continue
+		}
+		manifest.Files = append(manifest.Files, file)
+	}
+
+	manifestEntry, err := archive.Create(BackupManifestName)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create manifest entry")
+	}
+	encoder := json.NewEncoder(manifestEntry)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(manifest); err != nil {
+		return nil, errors.Wrap(err, "failed to write manifest")
+	}
+	if err := archive.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to write archive")
+	}
+	return manifest, nil
+}
+
+// writeBackupFile copies a local attachment file into the archive. It reports false if the file does not exist.
+func (s *Store) writeBackupFile(archive *zip.Writer, file *BackupFile) (bool, error) {
+	filePath := filepath.FromSlash(file.Reference)
+	if !filepath.IsAbs(filePath) {
+		filePath = filepath.Join(s.profile.Data, filePath)
+	}
+	source, err := os.Open(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to open %s", filePath)
+	}
+	defer source.Close()
+	entry, err := archive.Create(file.Path)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to create entry %s", file.Path)
+	}
+	if _, err := io.Copy(entry, source); err != nil {
+		return false, errors.Wrapf(err, "failed to copy %s", filePath)
+	}
+	return true, nil
+}
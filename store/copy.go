@@ -0,0 +1,397 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// copyBatchSize is the number of memos, and of memo embeddings, read at once while copying a database.
+const copyBatchSize = 500
+
+// copyTables are the tables copied from one database to another, in the order they are copied.
+var copyTables = []string{
+	"system_setting",
+	"user",
+	"user_setting",
+	"user_external_identity",
+	"idp",
+	"memo",
+	"memo_relation",
+	"memo_revision",
+	"attachment",
+	"memo_embedding",
+	"memo_embedding_chunk",
+	"activity",
+	"inbox",
+	"reaction",
+	"job",
+	"webhook_delivery",
+}
+
+// serialTables are the tables whose id is generated by the database.
+var serialTables = []string{"user", "idp", "memo", "memo_revision", "attachment", "activity", "inbox", "reaction", "job", "webhook_delivery"}
+
+// TableCount is the number of rows of a table in the source and target databases of a copy.
+type TableCount struct {
+	Table  string
+	Source int64
+	Target int64
+}
+
+// CopyTo copies all the data of the store into the database of the target store, keeping the IDs of the rows.
+// The target must be migrated to the current schema and hold no users nor memos. The rows are read and written through
+// the drivers, which convert them between the column types of the databases. The copy is verified by counting the
+// rows of every table on both sides, and the counts are returned.
+func (s *Store) CopyTo(ctx context.Context, target *Store) ([]*TableCount, error) {
+	if err := target.checkEmpty(ctx); err != nil {
+		return nil, err
+	}
+	if s.hasTable("user_external_identity") && !target.hasTable("user_external_identity") {
+		count, err := s.countRows(ctx, "user_external_identity")
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			return nil, errors.Errorf("the %d external identities can only be copied to a postgres database", count)
+		}
+	}
+	copyFuncs := []func(context.Context, *Store) error{
+		s.copyInstanceSettings,
+		s.copyUsers,
+		s.copyUserExternalIdentities,
+		s.copyIdentityProviders,
+		s.copyMemos,
+		s.copyMemoRevisions,
+		s.copyAttachments,
+		s.copyActivities,
+		s.copyInboxes,
+		s.copyReactions,
+		s.copyJobs,
+		s.copyWebhookDeliveries,
+	}
+	for _, copyFunc := range copyFuncs {
+		if err := copyFunc(ctx, target); err != nil {
+			return nil, err
+		}
+	}
+	if err := target.resetSequences(ctx); err != nil {
+		return nil, err
+	}
+
+	counts := []*TableCount{}
+	mismatches := []string{}
+	for _, table := range copyTables {
+		if !s.hasTable(table) || !target.hasTable(table) {
+			continue
+		}
+		count := &TableCount{Table: table}
+		var err error
+		if count.Source, err = s.countRows(ctx, table); err != nil {
+			return nil, err
+		}
+		if count.Target, err = target.countRows(ctx, table); err != nil {
+			return nil, err
+		}
+		if count.Source != count.Target {
+			mismatches = append(mismatches, fmt.Sprintf("%s (%d rows copied to %d)", table, count.Source, count.Target))
+		}
+		counts = append(counts, count)
+	}
+	if len(mismatches) > 0 {
+		return counts, errors.Errorf("row counts differ for %s", strings.Join(mismatches, ", "))
+	}
+	return counts, nil
+}
+
+// checkEmpty checks that the database has no data besides the instance settings written by the migration.
+func (s *Store) checkEmpty(ctx context.Context) error {
+	for _, table := range []string{"user", "memo", "attachment"} {
+		count, err := s.countRows(ctx, table)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return errors.Errorf("the target database is not empty: table %s has %d rows", table, count)
+		}
+	}
+	return nil
+}
+
+func (s *Store) copyInstanceSettings(ctx context.Context, target *Store) error {
+	settings, err := s.driver.ListInstanceSettings(ctx, &FindInstanceSetting{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list instance settings")
+	}
+	for _, setting := range settings {
+		if _, err := target.driver.UpsertInstanceSetting(ctx, setting); err != nil {
+			return errors.Wrapf(err, "failed to copy instance setting %s", setting.Name)
+		}
+	}
+	return nil
+}
+
+func (s *Store) copyUsers(ctx context.Context, target *Store) error {
+	users, err := s.driver.ListUsers(ctx, &FindUser{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list users")
+	}
+	for _, user := range users {
+		if _, err := target.driver.CreateUser(ctx, user); err != nil {
+			return errors.Wrapf(err, "failed to copy user %d", user.ID)
+		}
+	}
+	userSettings, err := s.driver.ListUserSettings(ctx, &FindUserSetting{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list user settings")
+	}
+	for _, userSetting := range userSettings {
+		if _, err := target.driver.UpsertUserSetting(ctx, userSetting); err != nil {
+			return errors.Wrapf(err, "failed to copy user setting %s of user %d", userSetting.Key, userSetting.UserID)
+		}
+	}
+	return nil
+}
+
+// copyUserExternalIdentities copies the external identities, which only Postgres databases store.
+func (s *Store) copyUserExternalIdentities(ctx context.Context, target *Store) error {
+	if !s.hasTable("user_external_identity") || !target.hasTable("user_external_identity") {
+		return nil
+	}
+	rows, err := s.driver.GetDB().QueryContext(ctx, "SELECT provider, subject, user_id, email, created_ts, updated_ts FROM user_external_identity")
+	if err != nil {
+		return errors.Wrap(err, "failed to list user external identities")
+	}
+	defer rows.Close()
+	identities := []*UserExternalIdentity{}
+	for rows.Next() {
+		identity := &UserExternalIdentity{}
+		if err := rows.Scan(&identity.Provider, &identity.Subject, &identity.UserID, &identity.Email, &identity.CreatedTs, &identity.UpdatedTs); err != nil {
+			return errors.Wrap(err, "failed to scan user external identity")
+		}
+		identities = append(identities, identity)
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(err, "failed to list user external identities")
+	}
+	for _, identity := range identities {
+		if _, err := target.driver.GetDB().ExecContext(ctx,
+			"INSERT INTO user_external_identity (provider, subject, user_id, email, created_ts, updated_ts) VALUES ($1, $2, $3, $4, $5, $6)",
+			identity.Provider, identity.Subject, identity.UserID, identity.Email, identity.CreatedTs, identity.UpdatedTs,
+		); err != nil {
+			return errors.Wrapf(err, "failed to copy external identity of user %d", identity.UserID)
+		}
+	}
+	return nil
+}
+
+func (s *Store) copyIdentityProviders(ctx context.Context, target *Store) error {
+	identityProviders, err := s.driver.ListIdentityProviders(ctx, &FindIdentityProvider{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list identity providers")
+	}
+	for _, identityProvider := range identityProviders {
+		if _, err := target.driver.CreateIdentityProvider(ctx, identityProvider); err != nil {
+			return errors.Wrapf(err, "failed to copy identity provider %d", identityProvider.ID)
+		}
+	}
+	return nil
+}
+
+// copyMemos copies the memos in batches, with their relations and embeddings.
+func (s *Store) copyMemos(ctx context.Context, target *Store) error {
+	limit := copyBatchSize
+	for offset := 0; ; offset += limit {
+		memos, err := s.driver.ListMemos(ctx, &FindMemo{Limit: &limit, Offset: &offset})
+		if err != nil {
+			return errors.Wrap(err, "failed to list memos")
+		}
+		memoIDs := []int32{}
+		for _, memo := range memos {
+			if _, err := target.driver.CreateMemo(ctx, memo); err != nil {
+				return errors.Wrapf(err, "failed to copy memo %d", memo.ID)
+			}
+			memoIDs = append(memoIDs, memo.ID)
+		}
+		if err := s.copyMemoEmbeddings(ctx, target, memoIDs); err != nil {
+			return err
+		}
+		if len(memos) < limit {
+			break
+		}
+	}
+
+	// The relations are copied once all the memos they link exist.
+	relations, err := s.driver.ListMemoRelations(ctx, &FindMemoRelation{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo relations")
+	}
+	for _, relation := range relations {
+		if _, err := target.driver.UpsertMemoRelation(ctx, relation); err != nil {
+			return errors.Wrapf(err, "failed to copy relation of memo %d", relation.MemoID)
+		}
+	}
+	return nil
+}
+
+func (s *Store) copyMemoEmbeddings(ctx context.Context, target *Store, memoIDs []int32) error {
+	if len(memoIDs) == 0 {
+		return nil
+	}
+	embeddings, err := s.driver.ListMemoEmbeddings(ctx, &FindMemoEmbedding{MemoIDList: memoIDs})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo embeddings")
+	}
+	chunks, err := s.driver.ListMemoEmbeddingChunks(ctx, &FindMemoEmbeddingChunk{MemoIDList: memoIDs})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo embedding chunks")
+	}
+	chunksByMemo := map[int32][]*MemoEmbeddingChunk{}
+	for _, chunk := range chunks {
+		chunksByMemo[chunk.MemoID] = append(chunksByMemo[chunk.MemoID], chunk)
+	}
+	for _, embedding := range embeddings {
+		embedding.Chunks = chunksByMemo[embedding.MemoID]
+		if err := target.driver.UpsertMemoEmbedding(ctx, embedding); err != nil {
+			return errors.Wrapf(err, "failed to copy embedding of memo %d", embedding.MemoID)
+		}
+	}
+	return nil
+}
+
+func (s *Store) copyMemoRevisions(ctx context.Context, target *Store) error {
+	revisions, err := s.driver.ListMemoRevisions(ctx, &FindMemoRevision{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo revisions")
+	}
+	for _, revision := range revisions {
+		if _, err := target.driver.CreateMemoRevision(ctx, revision); err != nil {
+			return errors.Wrapf(err, "failed to copy revision %d of memo %d", revision.ID, revision.MemoID)
+		}
+	}
+	return nil
+}
+
+// copyAttachments copies the attachments one at a time, as the blobs stored in the database may be large.
+func (s *Store) copyAttachments(ctx context.Context, target *Store) error {
+	attachments, err := s.driver.ListAttachments(ctx, &FindAttachment{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list attachments")
+	}
+	for _, attachment := range attachments {
+		withBlob, err := s.driver.ListAttachments(ctx, &FindAttachment{ID: &attachment.ID, GetBlob: true})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get attachment %d", attachment.ID)
+		}
+		if len(withBlob) != 1 {
+			return errors.Errorf("attachment %d not found", attachment.ID)
+		}
+		if _, err := target.driver.CreateAttachment(ctx, withBlob[0]); err != nil {
+			return errors.Wrapf(err, "failed to copy attachment %d", attachment.ID)
+		}
+	}
+	return nil
+}
+
+func (s *Store) copyActivities(ctx context.Context, target *Store) error {
+	activities, err := s.driver.ListActivities(ctx, &FindActivity{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list activities")
+	}
+	for _, activity := range activities {
+		if _, err := target.driver.CreateActivity(ctx, activity); err != nil {
+			return errors.Wrapf(err, "failed to copy activity %d", activity.ID)
+		}
+	}
+	return nil
+}
+
+func (s *Store) copyInboxes(ctx context.Context, target *Store) error {
+	inboxes, err := s.driver.ListInboxes(ctx, &FindInbox{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list inboxes")
+	}
+	for _, inbox := range inboxes {
+		if _, err := target.driver.CreateInbox(ctx, inbox); err != nil {
+			return errors.Wrapf(err, "failed to copy inbox %d", inbox.ID)
+		}
+	}
+	return nil
+}
+
+func (s *Store) copyReactions(ctx context.Context, target *Store) error {
+	reactions, err := s.driver.ListReactions(ctx, &FindReaction{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list reactions")
+	}
+	for _, reaction := range reactions {
+		if _, err := target.driver.UpsertReaction(ctx, reaction); err != nil {
+			return errors.Wrapf(err, "failed to copy reaction %d", reaction.ID)
+		}
+	}
+	return nil
+}
+
+func (s *Store) copyJobs(ctx context.Context, target *Store) error {
+	jobs, err := s.driver.ListJobs(ctx, &FindJob{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list jobs")
+	}
+	for _, job := range jobs {
+		if _, err := target.driver.CreateJob(ctx, job); err != nil {
+			return errors.Wrapf(err, "failed to copy job %d", job.ID)
+		}
+	}
+	return nil
+}
+
+func (s *Store) copyWebhookDeliveries(ctx context.Context, target *Store) error {
+	deliveries, err := s.driver.ListWebhookDeliveries(ctx, &FindWebhookDelivery{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list webhook deliveries")
+	}
+	for _, delivery := range deliveries {
+		if _, err := target.driver.CreateWebhookDelivery(ctx, delivery); err != nil {
+			return errors.Wrapf(err, "failed to copy webhook delivery %d", delivery.ID)
+		}
+	}
+	return nil
+}
+
+// resetSequences moves the id sequences of a Postgres database past the copied ids. SQLite and MySQL move their
+// autoincrement counters on their own when rows are inserted with an id.
+func (s *Store) resetSequences(ctx context.Context) error {
+	if s.DriverName() != "postgres" {
+		return nil
+	}
+	for _, table := range serialTables {
+		stmt := fmt.Sprintf("SELECT setval(pg_get_serial_sequence('%s', 'id'), COALESCE((SELECT MAX(id) FROM %s), 0) + 1, false)", s.quoteTable(table), s.quoteTable(table))
+		if _, err := s.driver.GetDB().ExecContext(ctx, stmt); err != nil {
+			return errors.Wrapf(err, "failed to reset the id sequence of %s", table)
+		}
+	}
+	return nil
+}
+
+// hasTable reports whether the schema of the driver has the table.
+func (s *Store) hasTable(table string) bool {
+	return table != "user_external_identity" || s.DriverName() == "postgres"
+}
+
+func (s *Store) countRows(ctx context.Context, table string) (int64, error) {
+	var count int64
+	if err := s.driver.GetDB().QueryRowContext(ctx, "SELECT COUNT(*) FROM "+s.quoteTable(table)).Scan(&count); err != nil {
+		return 0, errors.Wrapf(err, "failed to count the rows of %s", table)
+	}
+	return count, nil
+}
+
+// quoteTable quotes the user table, whose name is a reserved word in Postgres.
+func (s *Store) quoteTable(table string) string {
+	if table == "user" && s.DriverName() == "postgres" {
+		return `"user"`
+	}
+	return table
+}
@@ -23,6 +23,13 @@ func (d *DB) CreateActivity(ctx context.Context, create *store.Activity) (*store
 	fields := []string{"`creator_id`", "`type`", "`level`", "`payload`"}
 	placeholder := []string{"?", "?", "?", "?"}
 	args := []any{create.CreatorID, create.Type.String(), create.Level.String(), payloadString}
+	// Keep the ID and time of an activity copied from another database.
+	if create.ID != 0 {
+		fields, placeholder, args = append(fields, "`id`"), append(placeholder, "?"), append(args, create.ID)
+	}
+	if create.CreatedTs != 0 {
+		fields, placeholder, args = append(fields, "`created_ts`"), append(placeholder, "FROM_UNIXTIME(?)"), append(args, create.CreatedTs)
+	}
 
 	stmt := "INSERT INTO `activity` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
 	result, err := d.db.ExecContext(ctx, stmt, args...)
@@ -0,0 +1,179 @@
+package mysql
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// backupColumn is a column of a table dumped by Backup.
+type backupColumn struct {
+	name     string
+	dataType string
+}
+
+// Backup writes a logical SQL dump of the data of the database, like `mysqldump --no-create-info`.
+// The tables are read in a single repeatable read transaction, so InnoDB dumps them all from the same snapshot.
+// The dump empties the tables before inserting the rows, so it restores with the mysql client into a database that
+// has the schema of the same version, e.g. a database memos was started with once.
+func (d *DB) Backup(ctx context.Context, w io.Writer) error {
+	tx, err := d.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	// Timestamps are dumped in the time zone of the session, which the dump sets again on restore.
+	var timeZone string
+	if err := tx.QueryRowContext(ctx, "SELECT @@session.time_zone").Scan(&timeZone); err != nil {
+		return errors.Wrap(err, "failed to get the time zone")
+	}
+	tables, err := listBackupTables(ctx, tx)
+	if err != nil {
+		return err
+	}
+	writer := bufio.NewWriter(w)
+	fmt.Fprint(writer, "-- MySQL dump of memos\n\n")
+	fmt.Fprintf(writer, "SET NAMES utf8mb4;\nSET time_zone = %s;\n", quoteBackupString(timeZone))
+	fmt.Fprint(writer, "SET FOREIGN_KEY_CHECKS = 0;\nSET UNIQUE_CHECKS = 0;\nSET SQL_MODE = 'NO_AUTO_VALUE_ON_ZERO';\n\n")
+	for _, table := range tables {
+		if err := dumpBackupTable(ctx, tx, writer, table); err != nil {
+			return errors.Wrapf(err, "failed to dump table %s", table)
+		}
+	}
+	fmt.Fprint(writer, "SET FOREIGN_KEY_CHECKS = 1;\nSET UNIQUE_CHECKS = 1;\n")
+	return writer.Flush()
+}
+
+func listBackupTables(ctx context.Context, tx *sql.Tx) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT TABLE_NAME FROM information_schema.tables WHERE TABLE_SCHEMA = DATABASE() AND TABLE_TYPE = 'BASE TABLE' ORDER BY TABLE_NAME")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list tables")
+	}
+	defer rows.Close()
+	tables := []string{}
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+	return tables, rows.Err()
+}
+
+func listBackupColumns(ctx context.Context, tx *sql.Tx, table string) ([]*backupColumn, error) {
+	// Generated columns are computed again on insert.
+	rows, err := tx.QueryContext(ctx, `
+		SELECT COLUMN_NAME, DATA_TYPE
+		FROM information_schema.columns
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND EXTRA NOT LIKE '%GENERATED%'
+		ORDER BY ORDINAL_POSITION`, table)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list columns")
+	}
+	defer rows.Close()
+	columns := []*backupColumn{}
+	for rows.Next() {
+		column := &backupColumn{}
+		if err := rows.Scan(&column.name, &column.dataType); err != nil {
+			return nil, err
+		}
+		column.dataType = strings.ToLower(column.dataType)
+		columns = append(columns, column)
+	}
+	return columns, rows.Err()
+}
+
+// dumpBackupTable writes a TRUNCATE statement for the table and an INSERT statement for every row of it.
+// Binary values are read in hexadecimal and the others as text, which MySQL casts back to the column types on insert.
+func dumpBackupTable(ctx context.Context, tx *sql.Tx, w io.Writer, table string) error {
+	columns, err := listBackupColumns(ctx, tx, table)
+	if err != nil {
+		return err
+	}
+	quotedTable := quoteBackupIdentifier(table)
+	fmt.Fprintf(w, "TRUNCATE TABLE %s;\n", quotedTable)
+	if len(columns) == 0 {
+		fmt.Fprintln(w)
+		return nil
+	}
+	names := make([]string, 0, len(columns))
+	selects := make([]string, 0, len(columns))
+	for _, column := range columns {
+		name := quoteBackupIdentifier(column.name)
+		names = append(names, name)
+		if isBinaryBackupColumn(column.dataType) {
+			selects = append(selects, fmt.Sprintf("HEX(%s)", name))
+		} else {
+			selects = append(selects, name)
+		}
+	}
+
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf("SELECT %s FROM %s", strings.Join(selects, ", "), quotedTable))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	values := make([]sql.NullString, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	literals := make([]string, len(columns))
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return err
+		}
+		for i, value := range values {
+			literals[i] = formatBackupLiteral(columns[i].dataType, value)
+		}
+		fmt.Fprintf(w, "INSERT INTO %s (%s) VALUES (%s);\n", quotedTable, strings.Join(names, ", "), strings.Join(literals, ", "))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	fmt.Fprintln(w)
+	return nil
+}
+
+func isBinaryBackupColumn(dataType string) bool {
+	switch dataType {
+	case "binary", "varbinary", "tinyblob", "blob", "mediumblob", "longblob":
+		return true
+	default:
+		return false
+	}
+}
+
+func formatBackupLiteral(dataType string, value sql.NullString) string {
+	if !value.Valid {
+		return "NULL"
+	}
+	switch {
+	case isBinaryBackupColumn(dataType):
+		if value.String == "" {
+			return "''"
+		}
+		return "0x" + value.String
+	case dataType == "tinyint" || dataType == "smallint" || dataType == "mediumint" || dataType == "int" || dataType == "bigint":
+		return value.String
+	default:
+		return quoteBackupString(value.String)
+	}
+}
+
+var backupStringReplacer = strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\x00", `\0`, "\n", `\n`, "\r", `\r`, "\x1a", `\Z`)
+
+func quoteBackupString(s string) string {
+	return "'" + backupStringReplacer.Replace(s) + "'"
+}
+
+func quoteBackupIdentifier(s string) string {
+	return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+}
@@ -14,6 +14,10 @@ func (d *DB) CreateIdentityProvider(ctx context.Context, create *store.IdentityP
 	placeholders := []string{"?", "?", "?", "?"}
 	fields := []string{"`name`", "`type`", "`identifier_filter`", "`config`"}
 	args := []any{create.Name, create.Type.String(), create.IdentifierFilter, create.Config}
+	// Keep the ID of an identity provider copied from another database.
+	if create.ID != 0 {
+		fields, placeholders, args = append(fields, "`id`"), append(placeholders, "?"), append(args, create.ID)
+	}
 
 	stmt := "INSERT INTO `idp` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholders, ", ") + ")"
 	result, err := d.db.ExecContext(ctx, stmt, args...)
@@ -29,14 +29,24 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 	// Add custom timestamps if provided
 	if create.CreatedTs != 0 {
 		fields = append(fields, "`created_ts`")
-		placeholder = append(placeholder, "?")
+		placeholder = append(placeholder, "FROM_UNIXTIME(?)")
 		args = append(args, create.CreatedTs)
 	}
 	if create.UpdatedTs != 0 {
 		fields = append(fields, "`updated_ts`")
-		placeholder = append(placeholder, "?")
+		placeholder = append(placeholder, "FROM_UNIXTIME(?)")
 		args = append(args, create.UpdatedTs)
 	}
+	// Keep the ID and state of a memo copied from another database.
+	if create.ID != 0 {
+		fields, placeholder, args = append(fields, "`id`"), append(placeholder, "?"), append(args, create.ID)
+	}
+	if create.RowStatus != "" {
+		fields, placeholder, args = append(fields, "`row_status`"), append(placeholder, "?"), append(args, create.RowStatus)
+	}
+	if create.Pinned {
+		fields, placeholder, args = append(fields, "`pinned`"), append(placeholder, "?"), append(args, true)
+	}
 
 	stmt := "INSERT INTO `memo` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
 	result, err := d.db.ExecContext(ctx, stmt, args...)
@@ -121,6 +131,11 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.OrderByPinned {
 		orderBy = append(orderBy, "`pinned` DESC")
 	}
+	if find.OrderByRelevance {
+		if err := filter.AppendRelevance(ctx, engine, find.Filters, filter.DialectMySQL, &orderBy, &args); err != nil {
+			return nil, err
+		}
+	}
 	if find.OrderByUpdatedTs {
 		orderBy = append(orderBy, "`updated_ts` "+order)
 	} else {
@@ -246,12 +261,31 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	if len(set) == 0 {
 		return nil
 	}
+	where := []string{"`id` = ?"}
 	args = append(args, update.ID)
+	if v := update.ExpectedPayload; v != nil {
+		payloadBytes, err := protojson.Marshal(v)
+		if err != nil {
+			return err
+		}
+		where, args = append(where, "`payload` = CAST(? AS JSON)"), append(args, string(payloadBytes))
+	}
 
-	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE " + strings.Join(where, " AND ")
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
 		return err
 	}
+	if update.ExpectedPayload != nil {
+		// MySQL reports changed rows, which is reliable here because a guarded update changes the payload.
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return store.ErrMemoPayloadChanged
+		}
+	}
 	return nil
 }
 
@@ -13,6 +13,13 @@ func (d *DB) UpsertReaction(ctx context.Context, upsert *store.Reaction) (*store
 	fields := []string{"`creator_id`", "`content_id`", "`reaction_type`"}
 	placeholder := []string{"?", "?", "?"}
 	args := []interface{}{upsert.CreatorID, upsert.ContentID, upsert.ReactionType}
+	// Keep the ID and time of a reaction copied from another database.
+	if upsert.ID != 0 {
+		fields, placeholder, args = append(fields, "`id`"), append(placeholder, "?"), append(args, upsert.ID)
+	}
+	if upsert.CreatedTs != 0 {
+		fields, placeholder, args = append(fields, "`created_ts`"), append(placeholder, "FROM_UNIXTIME(?)"), append(args, upsert.CreatedTs)
+	}
 	stmt := "INSERT INTO `reaction` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
 	result, err := d.db.ExecContext(ctx, stmt, args...)
 	if err != nil {
@@ -0,0 +1,151 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateWebhookDelivery(ctx context.Context, create *store.WebhookDelivery) (*store.WebhookDelivery, error) {
+	// TEXT columns have no default value in MySQL.
+	fields := []string{"`creator_id`", "`webhook_id`", "`url`", "`activity_type`", "`request_body`", "`status`", "`attempts`", "`response_status_code`", "`response_body`", "`error`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?", "?", "?", "?"}
+	args := []any{create.CreatorID, create.WebhookID, create.URL, create.ActivityType, create.RequestBody, create.Status, create.Attempts, create.ResponseStatusCode, create.ResponseBody, create.Error}
+	// Keep the ID and timestamps of a delivery copied from another database.
+	if create.ID != 0 {
+		fields, placeholder, args = append(fields, "`id`"), append(placeholder, "?"), append(args, create.ID)
+	}
+	if create.CreatedTs != 0 {
+		fields, placeholder, args = append(fields, "`created_ts`"), append(placeholder, "FROM_UNIXTIME(?)"), append(args, create.CreatedTs)
+	}
+	if create.UpdatedTs != 0 {
+		fields, placeholder, args = append(fields, "`updated_ts`"), append(placeholder, "FROM_UNIXTIME(?)"), append(args, create.UpdatedTs)
+	}
+
+	stmt := "INSERT INTO `webhook_delivery` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	id32 := int32(id)
+	return d.getWebhookDelivery(ctx, id32)
+}
+
+func (d *DB) ListWebhookDeliveries(ctx context.Context, find *store.FindWebhookDelivery) ([]*store.WebhookDelivery, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.CreatorID != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *find.CreatorID)
+	}
+	if find.WebhookID != nil {
+		where, args = append(where, "`webhook_id` = ?"), append(args, *find.WebhookID)
+	}
+
+	query := "SELECT `id`, `creator_id`, `webhook_id`, `url`, `activity_type`, `request_body`, `status`, `attempts`, `response_status_code`, `response_body`, `error`, UNIX_TIMESTAMP(`created_ts`), UNIX_TIMESTAMP(`updated_ts`) FROM `webhook_delivery` WHERE " + strings.Join(where, " AND ") + " ORDER BY `id` DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.WebhookDelivery{}
+	for rows.Next() {
+		delivery := &store.WebhookDelivery{}
+		if err := rows.Scan(
+			&delivery.ID,
+			&delivery.CreatorID,
+			&delivery.WebhookID,
+			&delivery.URL,
+			&delivery.ActivityType,
+			&delivery.RequestBody,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.ResponseStatusCode,
+			&delivery.ResponseBody,
+			&delivery.Error,
+			&delivery.CreatedTs,
+			&delivery.UpdatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateWebhookDelivery(ctx context.Context, update *store.UpdateWebhookDelivery) (*store.WebhookDelivery, error) {
+	set, args := []string{"`updated_ts` = CURRENT_TIMESTAMP"}, []any{}
+	if v := update.Status; v != nil {
+		set, args = append(set, "`status` = ?"), append(args, *v)
+	}
+	if v := update.Attempts; v != nil {
+		set, args = append(set, "`attempts` = ?"), append(args, *v)
+	}
+	if v := update.ResponseStatusCode; v != nil {
+		set, args = append(set, "`response_status_code` = ?"), append(args, *v)
+	}
+	if v := update.ResponseBody; v != nil {
+		set, args = append(set, "`response_body` = ?"), append(args, *v)
+	}
+	if v := update.Error; v != nil {
+		set, args = append(set, "`error` = ?"), append(args, *v)
+	}
+	args = append(args, update.ID)
+
+	if _, err := d.db.ExecContext(ctx, "UPDATE `webhook_delivery` SET "+strings.Join(set, ", ")+" WHERE `id` = ?", args...); err != nil {
+		return nil, err
+	}
+	return d.getWebhookDelivery(ctx, update.ID)
+}
+
+func (d *DB) getWebhookDelivery(ctx context.Context, id int32) (*store.WebhookDelivery, error) {
+	list, err := d.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{ID: &id})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get webhook delivery")
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (d *DB) DeleteWebhookDelivery(ctx context.Context, delete *store.DeleteWebhookDelivery) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.CreatorID != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *delete.CreatorID)
+	}
+	if delete.WebhookID != nil {
+		where, args = append(where, "`webhook_id` = ?"), append(args, *delete.WebhookID)
+	}
+	if delete.CreatedBeforeTs != nil {
+		where, args = append(where, "`created_ts` < FROM_UNIXTIME(?)"), append(args, *delete.CreatedBeforeTs)
+	}
+	if _, err := d.db.ExecContext(ctx, "DELETE FROM `webhook_delivery` WHERE "+strings.Join(where, " AND "), args...); err != nil {
+		return err
+	}
+	return nil
+}
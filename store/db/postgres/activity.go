@@ -23,6 +23,13 @@ func (d *DB) CreateActivity(ctx context.Context, create *store.Activity) (*store
 
 	fields := []string{"creator_id", "type", "level", "payload"}
 	args := []any{create.CreatorID, create.Type.String(), create.Level.String(), payloadString}
+	// Keep the ID and time of an activity copied from another database.
+	if create.ID != 0 {
+		fields, args = append(fields, "id"), append(args, create.ID)
+	}
+	if create.CreatedTs != 0 {
+		fields, args = append(fields, "created_ts"), append(args, create.CreatedTs)
+	}
 	stmt := "INSERT INTO activity (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts"
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
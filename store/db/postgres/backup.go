@@ -0,0 +1,174 @@
+package postgres
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// backupColumn is a column of a table dumped by Backup.
+type backupColumn struct {
+	name     string
+	dataType string
+	serial   bool
+}
+
+// Backup writes a plain SQL dump of the data of the database, in the format of `pg_dump --data-only --inserts`.
+// The tables are read in a single repeatable read transaction, so they are all dumped from the same snapshot.
+// The dump empties the tables before inserting the rows, so it restores with psql into a database that has the
+// schema of the same version, e.g. a database memos was started with once.
+func (d *DB) Backup(ctx context.Context, w io.Writer) error {
+	tx, err := d.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	tables, err := listBackupTables(ctx, tx)
+	if err != nil {
+		return err
+	}
+	writer := bufio.NewWriter(w)
+	fmt.Fprint(writer, "--\n-- PostgreSQL database dump of memos\n--\n\n")
+	fmt.Fprint(writer, "SET statement_timeout = 0;\nSET client_encoding = 'UTF8';\nSET standard_conforming_strings = on;\n\n")
+	fmt.Fprint(writer, "BEGIN;\n\n")
+	if len(tables) > 0 {
+		quotedTables := make([]string, 0, len(tables))
+		for _, table := range tables {
+			quotedTables = append(quotedTables, pq.QuoteIdentifier(table))
+		}
+		fmt.Fprintf(writer, "TRUNCATE TABLE %s;\n\n", strings.Join(quotedTables, ", "))
+	}
+	for _, table := range tables {
+		if err := dumpBackupTable(ctx, tx, writer, table); err != nil {
+			return errors.Wrapf(err, "failed to dump table %s", table)
+		}
+	}
+	fmt.Fprint(writer, "COMMIT;\n")
+	return writer.Flush()
+}
+
+func listBackupTables(ctx context.Context, tx *sql.Tx) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT table_name FROM information_schema.tables WHERE table_schema = current_schema() AND table_type = 'BASE TABLE' ORDER BY table_name")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list tables")
+	}
+	defer rows.Close()
+	tables := []string{}
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+	return tables, rows.Err()
+}
+
+func listBackupColumns(ctx context.Context, tx *sql.Tx, table string) ([]*backupColumn, error) {
+	// Generated columns such as the full-text search vector of memos are computed again on insert.
+	rows, err := tx.QueryContext(ctx, `
+		SELECT column_name, data_type, COALESCE(column_default, '') LIKE 'nextval(%'
+		FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = $1 AND is_generated = 'NEVER'
+		ORDER BY ordinal_position`, table)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list columns")
+	}
+	defer rows.Close()
+	columns := []*backupColumn{}
+	for rows.Next() {
+		column := &backupColumn{}
+		if err := rows.Scan(&column.name, &column.dataType, &column.serial); err != nil {
+			return nil, err
+		}
+		columns = append(columns, column)
+	}
+	return columns, rows.Err()
+}
+
+// dumpBackupTable writes an INSERT statement for every row of the table, followed by the values of its id sequences.
+// The values are read as text, which Postgres casts back to the column types on insert.
+func dumpBackupTable(ctx context.Context, tx *sql.Tx, w io.Writer, table string) error {
+	columns, err := listBackupColumns(ctx, tx, table)
+	if err != nil {
+		return err
+	}
+	if len(columns) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(columns))
+	selects := make([]string, 0, len(columns))
+	for _, column := range columns {
+		name := pq.QuoteIdentifier(column.name)
+		names = append(names, name)
+		if column.dataType == "bytea" {
+			selects = append(selects, fmt.Sprintf("encode(%s, 'hex')", name))
+		} else {
+			selects = append(selects, name+"::text")
+		}
+	}
+
+	quotedTable := pq.QuoteIdentifier(table)
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf("SELECT %s FROM %s", strings.Join(selects, ", "), quotedTable))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	values := make([]sql.NullString, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	literals := make([]string, len(columns))
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return err
+		}
+		for i, value := range values {
+			literals[i] = formatBackupLiteral(columns[i].dataType, value)
+		}
+		fmt.Fprintf(w, "INSERT INTO %s (%s) VALUES (%s);\n", quotedTable, strings.Join(names, ", "), strings.Join(literals, ", "))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, column := range columns {
+		if !column.serial {
+			continue
+		}
+		var maxValue sql.NullInt64
+		if err := tx.QueryRowContext(ctx, fmt.Sprintf("SELECT MAX(%s) FROM %s", pq.QuoteIdentifier(column.name), quotedTable)).Scan(&maxValue); err != nil {
+			return err
+		}
+		sequence := fmt.Sprintf("pg_get_serial_sequence(%s, %s)", pq.QuoteLiteral(quotedTable), pq.QuoteLiteral(column.name))
+		if maxValue.Valid {
+			fmt.Fprintf(w, "SELECT pg_catalog.setval(%s, %d, true);\n", sequence, maxValue.Int64)
+		} else {
+			fmt.Fprintf(w, "SELECT pg_catalog.setval(%s, 1, false);\n", sequence)
+		}
+	}
+	fmt.Fprintln(w)
+	return nil
+}
+
+func formatBackupLiteral(dataType string, value sql.NullString) string {
+	if !value.Valid {
+		return "NULL"
+	}
+	switch dataType {
+	case "bytea":
+		return `'\x` + value.String + `'`
+	case "smallint", "integer", "bigint", "boolean":
+		return value.String
+	default:
+		return pq.QuoteLiteral(value.String)
+	}
+}
@@ -11,6 +11,10 @@ import (
 func (d *DB) CreateIdentityProvider(ctx context.Context, create *store.IdentityProvider) (*store.IdentityProvider, error) {
 	fields := []string{"name", "type", "identifier_filter", "config"}
 	args := []any{create.Name, create.Type.String(), create.IdentifierFilter, create.Config}
+	// Keep the ID of an identity provider copied from another database.
+	if create.ID != 0 {
+		fields, args = append(fields, "id"), append(args, create.ID)
+	}
 	stmt := "INSERT INTO idp (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id"
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(&create.ID); err != nil {
 		return nil, err
@@ -24,6 +24,13 @@ func (d *DB) CreateInbox(ctx context.Context, create *store.Inbox) (*store.Inbox
 
 	fields := []string{"sender_id", "receiver_id", "status", "message"}
 	args := []any{create.SenderID, create.ReceiverID, create.Status, messageString}
+	// Keep the ID and time of an inbox message copied from another database.
+	if create.ID != 0 {
+		fields, args = append(fields, "id"), append(args, create.ID)
+	}
+	if create.CreatedTs != 0 {
+		fields, args = append(fields, "created_ts"), append(args, create.CreatedTs)
+	}
 	stmt := "INSERT INTO inbox (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts"
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.ID,
@@ -61,6 +68,15 @@ func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.I
 		}
 	}
 
+	if len(find.MessageTypeList) > 0 {
+		list := make([]string, 0, len(find.MessageTypeList))
+		for _, messageType := range find.MessageTypeList {
+			args = append(args, messageType.String())
+			list = append(list, placeholder(len(args)))
+		}
+		where = append(where, "message::JSONB->>'type' IN ("+strings.Join(list, ", ")+")")
+	}
+
 	query := "SELECT id, created_ts, sender_id, receiver_id, status, message FROM inbox WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts DESC"
 	if find.Limit != nil {
 		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
@@ -34,6 +34,16 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 		fields = append(fields, "updated_ts")
 		args = append(args, create.UpdatedTs)
 	}
+	// Keep the ID and state of a memo copied from another database.
+	if create.ID != 0 {
+		fields, args = append(fields, "id"), append(args, create.ID)
+	}
+	if create.RowStatus != "" {
+		fields, args = append(fields, "row_status"), append(args, create.RowStatus)
+	}
+	if create.Pinned {
+		fields, args = append(fields, "pinned"), append(args, true)
+	}
 
 	stmt := "INSERT INTO memo (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts, row_status"
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
@@ -49,54 +59,10 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 }
 
 func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo, error) {
-	where, args := []string{"1 = 1"}, []any{}
-
-	engine, err := filter.DefaultEngine()
+	where, args, err := buildMemoFindCondition(ctx, find, []string{"1 = 1"}, []any{})
 	if err != nil {
 		return nil, err
 	}
-	if err := filter.AppendConditions(ctx, engine, find.Filters, filter.DialectPostgres, &where, &args); err != nil {
-		return nil, err
-	}
-	if v := find.ID; v != nil {
-		where, args = append(where, "memo.id = "+placeholder(len(args)+1)), append(args, *v)
-	}
-	if len(find.IDList) > 0 {
-		holders := make([]string, 0, len(find.IDList))
-		for _, id := range find.IDList {
-			holders = append(holders, placeholder(len(args)+1))
-			args = append(args, id)
-		}
-		where = append(where, "memo.id IN ("+strings.Join(holders, ", ")+")")
-	}
-	if v := find.UID; v != nil {
-		where, args = append(where, "memo.uid = "+placeholder(len(args)+1)), append(args, *v)
-	}
-	if len(find.UIDList) > 0 {
-		holders := make([]string, 0, len(find.UIDList))
-		for _, uid := range find.UIDList {
-			holders = append(holders, placeholder(len(args)+1))
-			args = append(args, uid)
-		}
-		where = append(where, "memo.uid IN ("+strings.Join(holders, ", ")+")")
-	}
-	if v := find.CreatorID; v != nil {
-		where, args = append(where, "memo.creator_id = "+placeholder(len(args)+1)), append(args, *v)
-	}
-	if v := find.RowStatus; v != nil {
-		where, args = append(where, "memo.row_status = "+placeholder(len(args)+1)), append(args, *v)
-	}
-	if v := find.VisibilityList; len(v) != 0 {
-		holders := []string{}
-		for _, visibility := range v {
-			holders = append(holders, placeholder(len(args)+1))
-			args = append(args, visibility.String())
-		}
-		where = append(where, fmt.Sprintf("memo.visibility in (%s)", strings.Join(holders, ", ")))
-	}
-	if find.ExcludeComments {
-		where = append(where, "memo_relation.related_memo_id IS NULL")
-	}
 
 	order := "DESC"
 	if find.OrderByTimeAsc {
@@ -106,6 +72,15 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.OrderByPinned {
 		orderBy = append(orderBy, "pinned DESC")
 	}
+	if find.OrderByRelevance {
+		engine, err := filter.DefaultEngine()
+		if err != nil {
+			return nil, err
+		}
+		if err := filter.AppendRelevance(ctx, engine, find.Filters, filter.DialectPostgres, &orderBy, &args); err != nil {
+			return nil, err
+		}
+	}
 	if find.OrderByUpdatedTs {
 		orderBy = append(orderBy, "updated_ts "+order)
 	} else {
@@ -232,11 +207,30 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 		return nil
 	}
 
-	stmt := `UPDATE memo SET ` + strings.Join(set, ", ") + ` WHERE id = ` + placeholder(len(args)+1)
+	where := []string{"id = " + placeholder(len(args)+1)}
 	args = append(args, update.ID)
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	if v := update.ExpectedPayload; v != nil {
+		payloadBytes, err := protojson.Marshal(v)
+		if err != nil {
+			return err
+		}
+		where, args = append(where, "payload = "+placeholder(len(args)+1)+"::jsonb"), append(args, string(payloadBytes))
+	}
+
+	stmt := `UPDATE memo SET ` + strings.Join(set, ", ") + ` WHERE ` + strings.Join(where, " AND ")
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
 		return err
 	}
+	if update.ExpectedPayload != nil {
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return store.ErrMemoPayloadChanged
+		}
+	}
 	return nil
 }
 
@@ -252,3 +246,55 @@ func (d *DB) DeleteMemo(ctx context.Context, delete *store.DeleteMemo) error {
 	}
 	return nil
 }
+
+// buildMemoFindCondition renders the WHERE conditions of a memo query.
+// The conditions expect memo_relation to be LEFT JOINed for comment exclusion.
+func buildMemoFindCondition(ctx context.Context, find *store.FindMemo, where []string, args []any) ([]string, []any, error) {
+	engine, err := filter.DefaultEngine()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := filter.AppendConditions(ctx, engine, find.Filters, filter.DialectPostgres, &where, &args); err != nil {
+		return nil, nil, err
+	}
+	if v := find.ID; v != nil {
+		where, args = append(where, "memo.id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if len(find.IDList) > 0 {
+		holders := make([]string, 0, len(find.IDList))
+		for _, id := range find.IDList {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, id)
+		}
+		where = append(where, "memo.id IN ("+strings.Join(holders, ", ")+")")
+	}
+	if v := find.UID; v != nil {
+		where, args = append(where, "memo.uid = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if len(find.UIDList) > 0 {
+		holders := make([]string, 0, len(find.UIDList))
+		for _, uid := range find.UIDList {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, uid)
+		}
+		where = append(where, "memo.uid IN ("+strings.Join(holders, ", ")+")")
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "memo.creator_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "memo.row_status = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.VisibilityList; len(v) != 0 {
+		holders := []string{}
+		for _, visibility := range v {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, visibility.String())
+		}
+		where = append(where, fmt.Sprintf("memo.visibility in (%s)", strings.Join(holders, ", ")))
+	}
+	if find.ExcludeComments {
+		where = append(where, "memo_relation.related_memo_id IS NULL")
+	}
+	return where, args, nil
+}
@@ -0,0 +1,350 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxHNSWIndexDimension is the largest vector dimension pgvector can build an HNSW index for.
+	maxHNSWIndexDimension = 2000
+	// chunkCandidateFactor is the number of nearest chunks read per requested memo, so that memos whose chunks crowd
+	// the nearest ones still leave enough distinct memos once deduplicated.
+	chunkCandidateFactor = 8
+	// defaultHNSWEfSearch and maxHNSWEfSearch are the default and largest candidate list of an HNSW scan in pgvector.
+	defaultHNSWEfSearch = 40
+	maxHNSWEfSearch     = 1000
+)
+
+func (d *DB) UpsertMemoEmbedding(ctx context.Context, upsert *store.MemoEmbedding) error {
+	vectorEnabled, err := d.isEmbeddingVectorEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	fields := []string{"memo_id", "model", "dimension", "embedding", "content_hash", "updated_ts"}
+	values := []string{"$1", "$2", "$3", "$4", "$5", "CAST(EXTRACT(EPOCH FROM NOW()) AS BIGINT)"}
+	if vectorEnabled {
+		fields, values = append(fields, "embedding_vector"), append(values, "$4::double precision[]::vector")
+	}
+	set := make([]string, 0, len(fields)-1)
+	for _, field := range fields[1:] {
+		set = append(set, field+" = EXCLUDED."+field)
+	}
+	stmt := "INSERT INTO memo_embedding (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(values, ", ") + ") " +
+		"ON CONFLICT (memo_id) DO UPDATE SET " + strings.Join(set, ", ")
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(
+		ctx,
+		stmt,
+		upsert.MemoID,
+		upsert.Model,
+		upsert.Dimension,
+		pq.Array(upsert.Embedding),
+		upsert.ContentHash,
+	); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM memo_embedding_chunk WHERE memo_id = $1", upsert.MemoID); err != nil {
+		return err
+	}
+	chunkFields := []string{"memo_id", "chunk_index", "start_offset", "end_offset", "dimension", "embedding"}
+	chunkValues := []string{"$1", "$2", "$3", "$4", "$5", "$6"}
+	if vectorEnabled {
+		chunkFields, chunkValues = append(chunkFields, "embedding_vector"), append(chunkValues, "$6::double precision[]::vector")
+	}
+	chunkStmt := "INSERT INTO memo_embedding_chunk (" + strings.Join(chunkFields, ", ") + ") VALUES (" + strings.Join(chunkValues, ", ") + ")"
+	for _, chunk := range upsert.Chunks {
+		if _, err := tx.ExecContext(ctx, chunkStmt, upsert.MemoID, chunk.ChunkIndex, chunk.StartOffset, chunk.EndOffset, chunk.Dimension, pq.Array(chunk.Embedding)); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	if vectorEnabled {
+		d.ensureEmbeddingVectorIndex(ctx, int(upsert.Dimension))
+	}
+	return nil
+}
+
+func (d *DB) ListMemoEmbeddings(ctx context.Context, find *store.FindMemoEmbedding) ([]*store.MemoEmbedding, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *find.MemoID)
+	}
+	if len(find.MemoIDList) > 0 {
+		queryIDs := make([]int64, 0, len(find.MemoIDList))
+		for _, id := range find.MemoIDList {
+			queryIDs = append(queryIDs, int64(id))
+		}
+		where, args = append(where, "memo_id = ANY("+placeholder(len(args)+1)+"::bigint[])"), append(args, pq.Array(queryIDs))
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			memo_id,
+			model,
+			dimension,
+			embedding,
+			content_hash,
+			updated_ts
+		FROM memo_embedding
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY memo_id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoEmbedding{}
+	for rows.Next() {
+		embedding := &store.MemoEmbedding{}
+		if err := rows.Scan(
+			&embedding.MemoID,
+			&embedding.Model,
+			&embedding.Dimension,
+			pq.Array(&embedding.Embedding),
+			&embedding.ContentHash,
+			&embedding.UpdatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, embedding)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteMemoEmbedding(ctx context.Context, delete *store.DeleteMemoEmbedding) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM memo_embedding_chunk WHERE memo_id = $1", delete.MemoID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM memo_embedding WHERE memo_id = $1", delete.MemoID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (d *DB) ListMemoEmbeddingChunks(ctx context.Context, find *store.FindMemoEmbeddingChunk) ([]*store.MemoEmbeddingChunk, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.MemoID != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *find.MemoID)
+	}
+	if len(find.MemoIDList) > 0 {
+		queryIDs := make([]int64, 0, len(find.MemoIDList))
+		for _, id := range find.MemoIDList {
+			queryIDs = append(queryIDs, int64(id))
+		}
+		where, args = append(where, "memo_id = ANY("+placeholder(len(args)+1)+"::bigint[])"), append(args, pq.Array(queryIDs))
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			memo_id,
+			chunk_index,
+			start_offset,
+			end_offset,
+			dimension,
+			embedding
+		FROM memo_embedding_chunk
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY memo_id ASC, chunk_index ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoEmbeddingChunk{}
+	for rows.Next() {
+		chunk := &store.MemoEmbeddingChunk{}
+		if err := rows.Scan(
+			&chunk.MemoID,
+			&chunk.ChunkIndex,
+			&chunk.StartOffset,
+			&chunk.EndOffset,
+			&chunk.Dimension,
+			pq.Array(&chunk.Embedding),
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, chunk)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// SearchMemoEmbeddings ranks memos by their best matching chunk inside the database with pgvector.
+// It reports false when the pgvector column is not available so the caller can fall back.
+func (d *DB) SearchMemoEmbeddings(ctx context.Context, search *store.SearchMemoEmbedding) ([]*store.VectorMatch, bool, error) {
+	vectorEnabled, err := d.isEmbeddingVectorEnabled(ctx)
+	if err != nil || !vectorEnabled {
+		return nil, false, err
+	}
+
+	find := search.MemoFind
+	if find == nil {
+		find = &store.FindMemo{}
+	}
+	if len(search.MemoIDList) > 0 {
+		scoped := *find
+		scoped.IDList = search.MemoIDList
+		find = &scoped
+	}
+	where, args, err := buildMemoFindCondition(ctx, find, []string{"1 = 1"}, []any{})
+	if err != nil {
+		return nil, false, err
+	}
+
+	dimension := len(search.Vector)
+	// Databases upgraded from the memo level index get the chunk index on their first search.
+	d.ensureEmbeddingVectorIndex(ctx, dimension)
+	vectorExpr := fmt.Sprintf("memo_embedding_chunk.embedding_vector::vector(%d)", dimension)
+	queryExpr := fmt.Sprintf("%s::vector(%d)", placeholder(len(args)+1), dimension)
+	args = append(args, formatVector(search.Vector))
+	where = append(where, fmt.Sprintf("memo_embedding_chunk.dimension = %d", dimension), "memo_embedding_chunk.embedding_vector IS NOT NULL")
+
+	// The nearest chunks are read in the order of the partial HNSW index of the dimension, then each memo is ranked by
+	// its closest chunk among them. Without a limit, all the chunks are scanned exactly.
+	nearestChunks := `SELECT memo_embedding_chunk.memo_id, memo_embedding_chunk.chunk_index, ` + vectorExpr + ` <=> ` + queryExpr + ` AS distance
+			FROM memo_embedding_chunk
+			JOIN memo ON memo.id = memo_embedding_chunk.memo_id
+			LEFT JOIN memo_relation ON memo.id = memo_relation.memo_id AND memo_relation.type = 'COMMENT'
+			WHERE ` + strings.Join(where, " AND ") + `
+			ORDER BY ` + vectorExpr + ` <=> ` + queryExpr
+	candidateLimit := 0
+	if search.Limit > 0 {
+		candidateLimit = search.Limit * chunkCandidateFactor
+		nearestChunks = fmt.Sprintf("%s LIMIT %d", nearestChunks, candidateLimit)
+	}
+	query := `SELECT memo_id, chunk_index, 1 - distance AS score FROM (
+			SELECT DISTINCT ON (memo_id) memo_id, chunk_index, distance
+			FROM (` + nearestChunks + `) AS nearest_chunk
+			ORDER BY memo_id, distance, chunk_index
+		) AS best_chunk
+		ORDER BY score DESC, memo_id DESC`
+	if search.Limit > 0 {
+		query = fmt.Sprintf("%s LIMIT %d", query, search.Limit)
+	}
+
+	tx, err := d.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, false, err
+	}
+	defer tx.Rollback()
+	// An HNSW scan returns at most ef_search rows, which must cover the candidate chunks.
+	if candidateLimit > 0 {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL hnsw.ef_search = %d", min(max(candidateLimit, defaultHNSWEfSearch), maxHNSWEfSearch))); err != nil {
+			return nil, false, err
+		}
+	}
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	list := []*store.VectorMatch{}
+	for rows.Next() {
+		match := &store.VectorMatch{}
+		if err := rows.Scan(&match.MemoID, &match.ChunkIndex, &match.Score); err != nil {
+			return nil, false, err
+		}
+		list = append(list, match)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+	return list, true, nil
+}
+
+// isEmbeddingVectorEnabled reports whether memo_embedding and memo_embedding_chunk have the pgvector columns added by migration.
+func (d *DB) isEmbeddingVectorEnabled(ctx context.Context) (bool, error) {
+	d.embeddingVectorMu.Lock()
+	defer d.embeddingVectorMu.Unlock()
+	if d.embeddingVectorChecked {
+		return d.embeddingVectorEnabled, nil
+	}
+
+	var count int
+	if err := d.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM information_schema.columns
+		WHERE table_schema = current_schema()
+			AND table_name IN ('memo_embedding', 'memo_embedding_chunk')
+			AND column_name = 'embedding_vector'`).Scan(&count); err != nil {
+		return false, errors.Wrap(err, "failed to detect pgvector support")
+	}
+	exists := count == 2
+	d.embeddingVectorChecked = true
+	d.embeddingVectorEnabled = exists
+	d.embeddingVectorIndexes = make(map[int]bool)
+	return exists, nil
+}
+
+// ensureEmbeddingVectorIndex creates the partial HNSW indexes of the memo embeddings and of their chunks for a vector
+// dimension. The columns are untyped so that models with different dimensions can coexist, hence one index per dimension.
+func (d *DB) ensureEmbeddingVectorIndex(ctx context.Context, dimension int) {
+	if dimension <= 0 || dimension > maxHNSWIndexDimension {
+		return
+	}
+	d.embeddingVectorMu.Lock()
+	defer d.embeddingVectorMu.Unlock()
+	if d.embeddingVectorIndexes[dimension] {
+		return
+	}
+
+	for _, table := range []string{"memo_embedding", "memo_embedding_chunk"} {
+		stmt := fmt.Sprintf(
+			"CREATE INDEX IF NOT EXISTS %s ON %s USING hnsw ((embedding_vector::vector(%d)) vector_cosine_ops) WHERE dimension = %d",
+			embeddingVectorIndexName(table, dimension), table, dimension, dimension,
+		)
+		if _, err := d.db.ExecContext(ctx, stmt); err != nil {
+			slog.Warn("failed to create memo embedding vector index", "table", table, "dimension", dimension, "error", err)
+			return
+		}
+	}
+	d.embeddingVectorIndexes[dimension] = true
+}
+
+// embeddingVectorIndexName returns the name of the partial HNSW index of the table for a vector dimension.
+func embeddingVectorIndexName(table string, dimension int) string {
+	return fmt.Sprintf("%s_vector_%d_idx", table, dimension)
+}
+
+// formatVector renders a vector in the pgvector text format.
+func formatVector(vector []float64) string {
+	values := make([]string, 0, len(vector))
+	for _, value := range vector {
+		values = append(values, strconv.FormatFloat(value, 'f', -1, 64))
+	}
+	return "[" + strings.Join(values, ",") + "]"
+}
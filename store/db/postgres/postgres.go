@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"sync"
 
 	// Import the PostgreSQL driver.
 	_ "github.com/lib/pq"
@@ -19,6 +20,12 @@ import (
 type DB struct {
 	db      *sql.DB
 	profile *profile.Profile
+
+	// pgvector support of memo_embedding, detected lazily.
+	embeddingVectorMu      sync.Mutex
+	embeddingVectorChecked bool
+	embeddingVectorEnabled bool
+	embeddingVectorIndexes map[int]bool
 }
 
 func NewDB(profile *profile.Profile) (store.Driver, error) {
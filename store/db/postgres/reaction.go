@@ -10,6 +10,13 @@ import (
 func (d *DB) UpsertReaction(ctx context.Context, upsert *store.Reaction) (*store.Reaction, error) {
 	fields := []string{"creator_id", "content_id", "reaction_type"}
 	args := []interface{}{upsert.CreatorID, upsert.ContentID, upsert.ReactionType}
+	// Keep the ID and time of a reaction copied from another database.
+	if upsert.ID != 0 {
+		fields, args = append(fields, "id"), append(args, upsert.ID)
+	}
+	if upsert.CreatedTs != 0 {
+		fields, args = append(fields, "created_ts"), append(args, upsert.CreatedTs)
+	}
 	stmt := "INSERT INTO reaction (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts"
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&upsert.ID,
@@ -0,0 +1,144 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateWebhookDelivery(ctx context.Context, create *store.WebhookDelivery) (*store.WebhookDelivery, error) {
+	fields := []string{"creator_id", "webhook_id", "url", "activity_type", "request_body", "status", "attempts", "response_status_code", "response_body", "error"}
+	args := []any{create.CreatorID, create.WebhookID, create.URL, create.ActivityType, create.RequestBody, create.Status, create.Attempts, create.ResponseStatusCode, create.ResponseBody, create.Error}
+	// Keep the ID and timestamps of a delivery copied from another database.
+	if create.ID != 0 {
+		fields, args = append(fields, "id"), append(args, create.ID)
+	}
+	if create.CreatedTs != 0 {
+		fields, args = append(fields, "created_ts"), append(args, create.CreatedTs)
+	}
+	if create.UpdatedTs != 0 {
+		fields, args = append(fields, "updated_ts"), append(args, create.UpdatedTs)
+	}
+
+	stmt := "INSERT INTO webhook_delivery (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, attempts, response_status_code, response_body, error, created_ts, updated_ts"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.Attempts,
+		&create.ResponseStatusCode,
+		&create.ResponseBody,
+		&create.Error,
+		&create.CreatedTs,
+		&create.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListWebhookDeliveries(ctx context.Context, find *store.FindWebhookDelivery) ([]*store.WebhookDelivery, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.CreatorID != nil {
+		where, args = append(where, "creator_id = "+placeholder(len(args)+1)), append(args, *find.CreatorID)
+	}
+	if find.WebhookID != nil {
+		where, args = append(where, "webhook_id = "+placeholder(len(args)+1)), append(args, *find.WebhookID)
+	}
+
+	query := "SELECT id, creator_id, webhook_id, url, activity_type, request_body, status, attempts, response_status_code, response_body, error, created_ts, updated_ts FROM webhook_delivery WHERE " + strings.Join(where, " AND ") + " ORDER BY id DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.WebhookDelivery{}
+	for rows.Next() {
+		delivery := &store.WebhookDelivery{}
+		if err := rows.Scan(
+			&delivery.ID,
+			&delivery.CreatorID,
+			&delivery.WebhookID,
+			&delivery.URL,
+			&delivery.ActivityType,
+			&delivery.RequestBody,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.ResponseStatusCode,
+			&delivery.ResponseBody,
+			&delivery.Error,
+			&delivery.CreatedTs,
+			&delivery.UpdatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateWebhookDelivery(ctx context.Context, update *store.UpdateWebhookDelivery) (*store.WebhookDelivery, error) {
+	set, args := []string{"updated_ts = EXTRACT(EPOCH FROM NOW())"}, []any{}
+	if v := update.Status; v != nil {
+		set, args = append(set, "status = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Attempts; v != nil {
+		set, args = append(set, "attempts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.ResponseStatusCode; v != nil {
+		set, args = append(set, "response_status_code = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.ResponseBody; v != nil {
+		set, args = append(set, "response_body = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Error; v != nil {
+		set, args = append(set, "error = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	args = append(args, update.ID)
+
+	if _, err := d.db.ExecContext(ctx, "UPDATE webhook_delivery SET "+strings.Join(set, ", ")+" WHERE id = "+placeholder(len(args)), args...); err != nil {
+		return nil, err
+	}
+	list, err := d.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{ID: &update.ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (d *DB) DeleteWebhookDelivery(ctx context.Context, delete *store.DeleteWebhookDelivery) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.CreatorID != nil {
+		where, args = append(where, "creator_id = "+placeholder(len(args)+1)), append(args, *delete.CreatorID)
+	}
+	if delete.WebhookID != nil {
+		where, args = append(where, "webhook_id = "+placeholder(len(args)+1)), append(args, *delete.WebhookID)
+	}
+	if delete.CreatedBeforeTs != nil {
+		where, args = append(where, "created_ts < "+placeholder(len(args)+1)), append(args, *delete.CreatedBeforeTs)
+	}
+	if _, err := d.db.ExecContext(ctx, "DELETE FROM webhook_delivery WHERE "+strings.Join(where, " AND "), args...); err != nil {
+		return err
+	}
+	return nil
+}
@@ -30,6 +30,16 @@ func (d *DB) CreateAttachment(ctx context.Context, create *store.Attachment) (*s
 		payloadString = string(bytes)
 	}
 	args := []any{create.UID, create.Filename, create.Blob, create.Type, create.Size, create.CreatorID, create.MemoID, storageType, create.Reference, payloadString}
+	// Keep the ID and timestamps of an attachment copied from another database.
+	if create.ID != 0 {
+		fields, placeholder, args = append(fields, "`id`"), append(placeholder, "?"), append(args, create.ID)
+	}
+	if create.CreatedTs != 0 {
+		fields, placeholder, args = append(fields, "`created_ts`"), append(placeholder, "?"), append(args, create.CreatedTs)
+	}
+	if create.UpdatedTs != 0 {
+		fields, placeholder, args = append(fields, "`updated_ts`"), append(placeholder, "?"), append(args, create.UpdatedTs)
+	}
 
 	stmt := "INSERT INTO `attachment` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`, `updated_ts`"
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(&create.ID, &create.CreatedTs, &create.UpdatedTs); err != nil {
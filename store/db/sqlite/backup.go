@@ -0,0 +1,34 @@
+package sqlite
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// Backup writes a copy of the database file made with VACUUM INTO, which reads the database in a single transaction
+// without blocking the writers of the WAL journal.
+func (d *DB) Backup(ctx context.Context, w io.Writer) error {
+	dir, err := os.MkdirTemp("", "memos-backup-")
+	if err != nil {
+		return errors.Wrap(err, "failed to create temporary directory")
+	}
+	defer os.RemoveAll(dir)
+
+	snapshotPath := filepath.Join(dir, "memos.db")
+	if _, err := d.db.ExecContext(ctx, "VACUUM INTO ?", snapshotPath); err != nil {
+		return errors.Wrap(err, "failed to vacuum the database into a snapshot")
+	}
+	snapshot, err := os.Open(snapshotPath)
+	if err != nil {
+		return errors.Wrap(err, "failed to open the snapshot")
+	}
+	defer snapshot.Close()
+	if _, err := io.Copy(w, snapshot); err != nil {
+		return errors.Wrap(err, "failed to write the snapshot")
+	}
+	return nil
+}
@@ -25,6 +25,13 @@ func (d *DB) CreateInbox(ctx context.Context, create *store.Inbox) (*store.Inbox
 	fields := []string{"`sender_id`", "`receiver_id`", "`status`", "`message`"}
 	placeholder := []string{"?", "?", "?", "?"}
 	args := []any{create.SenderID, create.ReceiverID, create.Status, messageString}
+	// Keep the ID and time of an inbox message copied from another database.
+	if create.ID != 0 {
+		fields, placeholder, args = append(fields, "`id`"), append(placeholder, "?"), append(args, create.ID)
+	}
+	if create.CreatedTs != 0 {
+		fields, placeholder, args = append(fields, "`created_ts`"), append(placeholder, "?"), append(args, create.CreatedTs)
+	}
 
 	stmt := "INSERT INTO `inbox` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`"
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
@@ -62,6 +69,15 @@ func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.I
 		}
 	}
 
+	if len(find.MessageTypeList) > 0 {
+		placeholders := make([]string, 0, len(find.MessageTypeList))
+		for _, messageType := range find.MessageTypeList {
+			placeholders = append(placeholders, "?")
+			args = append(args, messageType.String())
+		}
+		where = append(where, "JSON_EXTRACT(`message`, '$.type') IN ("+strings.Join(placeholders, ", ")+")")
+	}
+
 	query := "SELECT `id`, `created_ts`, `sender_id`, `receiver_id`, `status`, `message` FROM `inbox` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC"
 	if find.Limit != nil {
 		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
@@ -0,0 +1,198 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateJob(ctx context.Context, create *store.Job) (*store.Job, error) {
+	payloadString := "{}"
+	if create.Payload != nil {
+		bytes, err := protojson.Marshal(create.Payload)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal job payload")
+		}
+		payloadString = string(bytes)
+	}
+
+	fields := []string{"`type`", "`key`", "`status`", "`attempts`", "`max_attempts`", "`last_error`", "`payload`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?"}
+	args := []any{create.Type, create.Key, create.Status, create.Attempts, create.MaxAttempts, create.LastError, payloadString}
+	if create.RunAfterTs != 0 {
+		fields, placeholder, args = append(fields, "`run_after_ts`"), append(placeholder, "?"), append(args, create.RunAfterTs)
+	}
+	// Keep the ID and timestamps of a job copied from another database.
+	if create.ID != 0 {
+		fields, placeholder, args = append(fields, "`id`"), append(placeholder, "?"), append(args, create.ID)
+	}
+	if create.CreatedTs != 0 {
+		fields, placeholder, args = append(fields, "`created_ts`"), append(placeholder, "?"), append(args, create.CreatedTs)
+	}
+	if create.UpdatedTs != 0 {
+		fields, placeholder, args = append(fields, "`updated_ts`"), append(placeholder, "?"), append(args, create.UpdatedTs)
+	}
+
+	stmt := "INSERT INTO `job` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `attempts`, `run_after_ts`, `last_error`, `created_ts`, `updated_ts`"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.Attempts,
+		&create.RunAfterTs,
+		&create.LastError,
+		&create.CreatedTs,
+		&create.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListJobs(ctx context.Context, find *store.FindJob) ([]*store.Job, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.Type != nil {
+		where, args = append(where, "`type` = ?"), append(args, *find.Type)
+	}
+	if find.Key != nil {
+		where, args = append(where, "`key` = ?"), append(args, *find.Key)
+	}
+	if len(find.StatusList) > 0 {
+		placeholder := []string{}
+		for _, status := range find.StatusList {
+			placeholder, args = append(placeholder, "?"), append(args, status)
+		}
+		where = append(where, "`status` IN ("+strings.Join(placeholder, ", ")+")")
+	}
+	if find.RunBeforeTs != nil {
+		where, args = append(where, "`run_after_ts` <= ?"), append(args, *find.RunBeforeTs)
+	}
+
+	orderBy := "`id` DESC"
+	if find.OrderByRunAfter {
+		orderBy = "`run_after_ts` ASC, `id` ASC"
+	}
+	query := "SELECT `id`, `type`, `key`, `status`, `attempts`, `max_attempts`, `run_after_ts`, `last_error`, `payload`, `created_ts`, `updated_ts` FROM `job` WHERE " + strings.Join(where, " AND ") + " ORDER BY " + orderBy
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Job{}
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, job)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateJob(ctx context.Context, update *store.UpdateJob) (*store.Job, error) {
+	set, args := []string{"`updated_ts` = strftime('%s', 'now')"}, []any{}
+	if v := update.Status; v != nil {
+		set, args = append(set, "`status` = ?"), append(args, *v)
+	}
+	if v := update.Attempts; v != nil {
+		set, args = append(set, "`attempts` = ?"), append(args, *v)
+	}
+	if v := update.RunAfterTs; v != nil {
+		set, args = append(set, "`run_after_ts` = ?"), append(args, *v)
+	}
+	if v := update.LastError; v != nil {
+		set, args = append(set, "`last_error` = ?"), append(args, *v)
+	}
+	if v := update.Payload; v != nil {
+		bytes, err := protojson.Marshal(v)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal job payload")
+		}
+		set, args = append(set, "`payload` = ?"), append(args, string(bytes))
+	}
+
+	where := []string{"`id` = ?"}
+	args = append(args, update.ID)
+	if v := update.ExpectedStatus; v != nil {
+		where, args = append(where, "`status` = ?"), append(args, *v)
+	}
+
+	query := "UPDATE `job` SET " + strings.Join(set, ", ") + " WHERE " + strings.Join(where, " AND ") + " RETURNING `id`, `type`, `key`, `status`, `attempts`, `max_attempts`, `run_after_ts`, `last_error`, `payload`, `created_ts`, `updated_ts`"
+	job, err := scanJob(d.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return job, nil
+}
+
+func (d *DB) DeleteJob(ctx context.Context, delete *store.DeleteJob) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *delete.ID)
+	}
+	if len(delete.StatusList) > 0 {
+		placeholder := []string{}
+		for _, status := range delete.StatusList {
+			placeholder, args = append(placeholder, "?"), append(args, status)
+		}
+		where = append(where, "`status` IN ("+strings.Join(placeholder, ", ")+")")
+	}
+	if delete.UpdatedBeforeTs != nil {
+		where, args = append(where, "`updated_ts` < ?"), append(args, *delete.UpdatedBeforeTs)
+	}
+	if _, err := d.db.ExecContext(ctx, "DELETE FROM `job` WHERE "+strings.Join(where, " AND "), args...); err != nil {
+		return err
+	}
+	return nil
+}
+
+func scanJob(scanner interface{ Scan(...any) error }) (*store.Job, error) {
+	job := &store.Job{}
+	var payloadBytes []byte
+	if err := scanner.Scan(
+		&job.ID,
+		&job.Type,
+		&job.Key,
+		&job.Status,
+		&job.Attempts,
+		&job.MaxAttempts,
+		&job.RunAfterTs,
+		&job.LastError,
+		&payloadBytes,
+		&job.CreatedTs,
+		&job.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+	payload := &storepb.JobPayload{}
+	if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal job payload")
+	}
+	job.Payload = payload
+	return job, nil
+}
@@ -0,0 +1,204 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertMemoEmbedding(ctx context.Context, upsert *store.MemoEmbedding) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt := `
+		INSERT INTO memo_embedding (
+			memo_id,
+			model,
+			dimension,
+			embedding,
+			content_hash,
+			updated_ts
+		)
+		VALUES (?, ?, ?, ?, ?, strftime('%s', 'now'))
+		ON CONFLICT(memo_id) DO UPDATE SET
+			model = excluded.model,
+			dimension = excluded.dimension,
+			embedding = excluded.embedding,
+			content_hash = excluded.content_hash,
+			updated_ts = excluded.updated_ts
+	`
+	if _, err := tx.ExecContext(
+		ctx,
+		stmt,
+		upsert.MemoID,
+		upsert.Model,
+		upsert.Dimension,
+		store.EncodeEmbedding(upsert.Embedding),
+		upsert.ContentHash,
+	); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM memo_embedding_chunk WHERE memo_id = ?", upsert.MemoID); err != nil {
+		return err
+	}
+	for _, chunk := range upsert.Chunks {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO memo_embedding_chunk (
+				memo_id,
+				chunk_index,
+				start_offset,
+				end_offset,
+				dimension,
+				embedding
+			)
+			VALUES (?, ?, ?, ?, ?, ?)`,
+			upsert.MemoID,
+			chunk.ChunkIndex,
+			chunk.StartOffset,
+			chunk.EndOffset,
+			chunk.Dimension,
+			store.EncodeEmbedding(chunk.Embedding),
+		); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (d *DB) ListMemoEmbeddings(ctx context.Context, find *store.FindMemoEmbedding) ([]*store.MemoEmbedding, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.MemoID != nil {
+		where, args = append(where, "memo_id = ?"), append(args, *find.MemoID)
+	}
+	if len(find.MemoIDList) > 0 {
+		placeholders := make([]string, 0, len(find.MemoIDList))
+		for _, id := range find.MemoIDList {
+			placeholders = append(placeholders, "?")
+			args = append(args, id)
+		}
+		where = append(where, "memo_id IN ("+strings.Join(placeholders, ",")+")")
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			memo_id,
+			model,
+			dimension,
+			embedding,
+			content_hash,
+			updated_ts
+		FROM memo_embedding
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY memo_id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoEmbedding{}
+	for rows.Next() {
+		embedding := &store.MemoEmbedding{}
+		var data []byte
+		if err := rows.Scan(
+			&embedding.MemoID,
+			&embedding.Model,
+			&embedding.Dimension,
+			&data,
+			&embedding.ContentHash,
+			&embedding.UpdatedTs,
+		); err != nil {
+			return nil, err
+		}
+		vector, err := store.DecodeEmbedding(data)
+		if err != nil {
+			return nil, err
+		}
+		embedding.Embedding = vector
+		list = append(list, embedding)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteMemoEmbedding(ctx context.Context, delete *store.DeleteMemoEmbedding) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM memo_embedding_chunk WHERE memo_id = ?", delete.MemoID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM memo_embedding WHERE memo_id = ?", delete.MemoID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (d *DB) ListMemoEmbeddingChunks(ctx context.Context, find *store.FindMemoEmbeddingChunk) ([]*store.MemoEmbeddingChunk, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.MemoID != nil {
+		where, args = append(where, "memo_id = ?"), append(args, *find.MemoID)
+	}
+	if len(find.MemoIDList) > 0 {
+		placeholders := make([]string, 0, len(find.MemoIDList))
+		for _, id := range find.MemoIDList {
+			placeholders = append(placeholders, "?")
+			args = append(args, id)
+		}
+		where = append(where, "memo_id IN ("+strings.Join(placeholders, ",")+")")
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			memo_id,
+			chunk_index,
+			start_offset,
+			end_offset,
+			dimension,
+			embedding
+		FROM memo_embedding_chunk
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY memo_id ASC, chunk_index ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoEmbeddingChunk{}
+	for rows.Next() {
+		chunk := &store.MemoEmbeddingChunk{}
+		var data []byte
+		if err := rows.Scan(
+			&chunk.MemoID,
+			&chunk.ChunkIndex,
+			&chunk.StartOffset,
+			&chunk.EndOffset,
+			&chunk.Dimension,
+			&data,
+		); err != nil {
+			return nil, err
+		}
+		vector, err := store.DecodeEmbedding(data)
+		if err != nil {
+			return nil, err
+		}
+		chunk.Embedding = vector
+		list = append(list, chunk)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
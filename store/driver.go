@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"database/sql"
+	"io"
 )
 
 // Driver is an interface for store driver.
@@ -12,6 +13,8 @@ type Driver interface {
 	Close() error
 
 	IsInitialized(ctx context.Context) (bool, error)
+	// Backup writes a consistent snapshot of the database to w while the database stays writable.
+	Backup(ctx context.Context, w io.Writer) error
 
 	// Activity model related methods.
 	CreateActivity(ctx context.Context, create *Activity) (*Activity, error)
@@ -29,11 +32,34 @@ type Driver interface {
 	UpdateMemo(ctx context.Context, update *UpdateMemo) error
 	DeleteMemo(ctx context.Context, delete *DeleteMemo) error
 
+	// MemoRevision model related methods.
+	CreateMemoRevision(ctx context.Context, create *MemoRevision) (*MemoRevision, error)
+	ListMemoRevisions(ctx context.Context, find *FindMemoRevision) ([]*MemoRevision, error)
+	DeleteMemoRevision(ctx context.Context, delete *DeleteMemoRevision) error
+
+	// MemoEmbedding model related methods.
+	UpsertMemoEmbedding(ctx context.Context, upsert *MemoEmbedding) error
+	ListMemoEmbeddings(ctx context.Context, find *FindMemoEmbedding) ([]*MemoEmbedding, error)
+	DeleteMemoEmbedding(ctx context.Context, delete *DeleteMemoEmbedding) error
+	ListMemoEmbeddingChunks(ctx context.Context, find *FindMemoEmbeddingChunk) ([]*MemoEmbeddingChunk, error)
+
 	// MemoRelation model related methods.
 	UpsertMemoRelation(ctx context.Context, create *MemoRelation) (*MemoRelation, error)
 	ListMemoRelations(ctx context.Context, find *FindMemoRelation) ([]*MemoRelation, error)
 	DeleteMemoRelation(ctx context.Context, delete *DeleteMemoRelation) error
 
+	// Job model related methods.
+	CreateJob(ctx context.Context, create *Job) (*Job, error)
+	ListJobs(ctx context.Context, find *FindJob) ([]*Job, error)
+	UpdateJob(ctx context.Context, update *UpdateJob) (*Job, error)
+	DeleteJob(ctx context.Context, delete *DeleteJob) error
+
+	// WebhookDelivery model related methods.
+	CreateWebhookDelivery(ctx context.Context, create *WebhookDelivery) (*WebhookDelivery, error)
+	ListWebhookDeliveries(ctx context.Context, find *FindWebhookDelivery) ([]*WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, update *UpdateWebhookDelivery) (*WebhookDelivery, error)
+	DeleteWebhookDelivery(ctx context.Context, delete *DeleteWebhookDelivery) error
+
 	// InstanceSetting model related methods.
 	UpsertInstanceSetting(ctx context.Context, upsert *InstanceSetting) (*InstanceSetting, error)
 	ListInstanceSettings(ctx context.Context, find *FindInstanceSetting) ([]*InstanceSetting, error)
@@ -44,6 +44,8 @@ type FindInbox struct {
 	ReceiverID  *int32
 	Status      *InboxStatus
 	MessageType *storepb.InboxMessage_Type
+	// MessageTypeList finds inbox items of any of the message types.
+	MessageTypeList []storepb.InboxMessage_Type
 
 	// Pagination
 	Limit  *int
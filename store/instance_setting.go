@@ -39,6 +39,10 @@ func (s *Store) UpsertInstanceSetting(ctx context.Context, upsert *storepb.Insta
 		valueBytes, err = protojson.Marshal(upsert.GetMemoRelatedSetting())
 	} else if upsert.Key == storepb.InstanceSettingKey_AI {
 		valueBytes, err = protojson.Marshal(upsert.GetAiSetting())
+	} else if upsert.Key == storepb.InstanceSettingKey_EMAIL {
+		valueBytes, err = protojson.Marshal(upsert.GetEmailSetting())
+	} else if upsert.Key == storepb.InstanceSettingKey_BACKUP {
+		valueBytes, err = protojson.Marshal(upsert.GetBackupSetting())
 	} else {
 		return nil, errors.Errorf("unsupported instance setting key: %v", upsert.Key)
 	}
@@ -145,6 +149,9 @@ const DefaultContentLengthLimit = 8 * 1024
 // DefaultReactions is the default reactions for memo related setting.
 var DefaultReactions = []string{"👍", "👎", "❤️", "🎉", "😄", "😕", "😢", "😡"}
 
+// DefaultMemoRevisionLimit is the default number of revisions kept per memo.
+const DefaultMemoRevisionLimit = 50
+
 func (s *Store) GetInstanceMemoRelatedSetting(ctx context.Context) (*storepb.InstanceMemoRelatedSetting, error) {
 	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
 		Name: storepb.InstanceSettingKey_MEMO_RELATED.String(),
@@ -163,6 +170,9 @@ func (s *Store) GetInstanceMemoRelatedSetting(ctx context.Context) (*storepb.Ins
 	if len(instanceMemoRelatedSetting.Reactions) == 0 {
 		instanceMemoRelatedSetting.Reactions = append(instanceMemoRelatedSetting.Reactions, DefaultReactions...)
 	}
+	if instanceMemoRelatedSetting.MemoRevisionLimit <= 0 {
+		instanceMemoRelatedSetting.MemoRevisionLimit = DefaultMemoRevisionLimit
+	}
 	s.instanceSettingCache.Set(ctx, storepb.InstanceSettingKey_MEMO_RELATED.String(), &storepb.InstanceSetting{
 		Key:   storepb.InstanceSettingKey_MEMO_RELATED,
 		Value: &storepb.InstanceSetting_MemoRelatedSetting{MemoRelatedSetting: instanceMemoRelatedSetting},
@@ -223,6 +233,44 @@ func (s *Store) GetInstanceAISetting(ctx context.Context) (*storepb.InstanceAISe
 	return instanceAISetting, nil
 }
 
+func (s *Store) GetInstanceEmailSetting(ctx context.Context) (*storepb.InstanceEmailSetting, error) {
+	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
+		Name: storepb.InstanceSettingKey_EMAIL.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance email setting")
+	}
+
+	instanceEmailSetting := &storepb.InstanceEmailSetting{}
+	if instanceSetting != nil {
+		instanceEmailSetting = instanceSetting.GetEmailSetting()
+	}
+	s.instanceSettingCache.Set(ctx, storepb.InstanceSettingKey_EMAIL.String(), &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_EMAIL,
+		Value: &storepb.InstanceSetting_EmailSetting{EmailSetting: instanceEmailSetting},
+	})
+	return instanceEmailSetting, nil
+}
+
+func (s *Store) GetInstanceBackupSetting(ctx context.Context) (*storepb.InstanceBackupSetting, error) {
+	instanceSetting, err := s.GetInstanceSetting(ctx, &FindInstanceSetting{
+		Name: storepb.InstanceSettingKey_BACKUP.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance backup setting")
+	}
+
+	instanceBackupSetting := &storepb.InstanceBackupSetting{}
+	if instanceSetting != nil {
+		instanceBackupSetting = instanceSetting.GetBackupSetting()
+	}
+	s.instanceSettingCache.Set(ctx, storepb.InstanceSettingKey_BACKUP.String(), &storepb.InstanceSetting{
+		Key:   storepb.InstanceSettingKey_BACKUP,
+		Value: &storepb.InstanceSetting_BackupSetting{BackupSetting: instanceBackupSetting},
+	})
+	return instanceBackupSetting, nil
+}
+
 func convertInstanceSettingFromRaw(instanceSettingRaw *InstanceSetting) (*storepb.InstanceSetting, error) {
 	instanceSetting := &storepb.InstanceSetting{
 		Key: storepb.InstanceSettingKey(storepb.InstanceSettingKey_value[instanceSettingRaw.Name]),
@@ -258,6 +306,18 @@ func convertInstanceSettingFromRaw(instanceSettingRaw *InstanceSetting) (*storep
 			return nil, err
 		}
 		instanceSetting.Value = &storepb.InstanceSetting_AiSetting{AiSetting: aiSetting}
+	case storepb.InstanceSettingKey_EMAIL.String():
+		emailSetting := &storepb.InstanceEmailSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(instanceSettingRaw.Value), emailSetting); err != nil {
+			return nil, err
+		}
+		instanceSetting.Value = &storepb.InstanceSetting_EmailSetting{EmailSetting: emailSetting}
+	case storepb.InstanceSettingKey_BACKUP.String():
+		backupSetting := &storepb.InstanceBackupSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(instanceSettingRaw.Value), backupSetting); err != nil {
+			return nil, err
+		}
+		instanceSetting.Value = &storepb.InstanceSetting_BackupSetting{BackupSetting: backupSetting}
 	default:
 		// Skip unsupported instance setting key.
 		return nil, nil
@@ -0,0 +1,127 @@
+package store
+
+import (
+	"context"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// JobType is the type of a background job, which selects the handler that runs it.
+type JobType string
+
+const (
+	// JobTypeMemoEmbedding refreshes the embedding of a memo.
+	JobTypeMemoEmbedding JobType = "MEMO_EMBEDDING"
+	// JobTypeMemoEnrichment generates the enrichment suggestions of a memo.
+	JobTypeMemoEnrichment JobType = "MEMO_ENRICHMENT"
+	// JobTypeSemanticReindex re-embeds all memos.
+	JobTypeSemanticReindex JobType = "SEMANTIC_REINDEX"
+	// JobTypeWebhook posts a request to a user webhook.
+	JobTypeWebhook JobType = "WEBHOOK"
+	// JobTypeEmail sends an email.
+	JobTypeEmail JobType = "EMAIL"
+	// JobTypeDigest builds and delivers the digest of a user.
+	JobTypeDigest JobType = "DIGEST"
+)
+
+func (t JobType) String() string {
+	return string(t)
+}
+
+// JobStatus is the status of a background job.
+type JobStatus string
+
+const (
+	// JobPending is a job waiting for its run time.
+	JobPending JobStatus = "PENDING"
+	// JobRunning is a job being run by a worker.
+	JobRunning JobStatus = "RUNNING"
+	// JobSucceeded is a job that completed.
+	JobSucceeded JobStatus = "SUCCEEDED"
+	// JobDead is a job that failed on all attempts, kept for inspection and manual retry.
+	JobDead JobStatus = "DEAD"
+	// JobCanceled is a job canceled by an admin.
+	JobCanceled JobStatus = "CANCELED"
+)
+
+func (s JobStatus) String() string {
+	return string(s)
+}
+
+// Job is a persisted unit of background work.
+type Job struct {
+	ID   int32
+	Type JobType
+	// Key identifies what the job works on, e.g. a memo, and deduplicates pending jobs of the same type.
+	Key         string
+	Status      JobStatus
+	Attempts    int32
+	MaxAttempts int32
+	// RunAfterTs is the earliest time a pending job runs, pushed back by the retry backoff.
+	RunAfterTs int64
+	LastError  string
+	Payload    *storepb.JobPayload
+	CreatedTs  int64
+	UpdatedTs  int64
+}
+
+type FindJob struct {
+	ID         *int32
+	Type       *JobType
+	Key        *string
+	StatusList []JobStatus
+	// RunBeforeTs only finds jobs whose run time is not after the timestamp.
+	RunBeforeTs *int64
+
+	// OrderByRunAfter orders jobs by ascending run time instead of newest first.
+	OrderByRunAfter bool
+	Limit           *int
+	Offset          *int
+}
+
+type UpdateJob struct {
+	ID int32
+	// ExpectedStatus only updates the job when it is in the status.
+	ExpectedStatus *JobStatus
+
+	Status     *JobStatus
+	Attempts   *int32
+	RunAfterTs *int64
+	LastError  *string
+	Payload    *storepb.JobPayload
+}
+
+type DeleteJob struct {
+	ID         *int32
+	StatusList []JobStatus
+	// UpdatedBeforeTs only deletes jobs last updated before the timestamp.
+	UpdatedBeforeTs *int64
+}
+
+func (s *Store) CreateJob(ctx context.Context, create *Job) (*Job, error) {
+	return s.driver.CreateJob(ctx, create)
+}
+
+func (s *Store) ListJobs(ctx context.Context, find *FindJob) ([]*Job, error) {
+	return s.driver.ListJobs(ctx, find)
+}
+
+func (s *Store) GetJob(ctx context.Context, find *FindJob) (*Job, error) {
+	list, err := s.ListJobs(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// UpdateJob updates the job and returns it, or nil when the job does not exist or is not in the expected status.
+func (s *Store) UpdateJob(ctx context.Context, update *UpdateJob) (*Job, error) {
+	return s.driver.UpdateJob(ctx, update)
+}
+
+func (s *Store) DeleteJob(ctx context.Context, delete *DeleteJob) error {
+	return s.driver.DeleteJob(ctx, delete)
+}
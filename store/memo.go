@@ -76,7 +76,9 @@ type FindMemo struct {
 	Offset *int
 
 	// Ordering
-	OrderByPinned    bool
+	OrderByPinned bool
+	// OrderByRelevance ranks memos by how well they match the content.matches() filters, after pinned.
+	OrderByRelevance bool
 	OrderByUpdatedTs bool
 	OrderByTimeAsc   bool
 }
@@ -100,8 +102,13 @@ type UpdateMemo struct {
 	Visibility *Visibility
 	Pinned     *bool
 	Payload    *storepb.MemoPayload
+	// ExpectedPayload only updates the memo when its payload is still equal to it.
+	ExpectedPayload *storepb.MemoPayload
 }
 
+// ErrMemoPayloadChanged is returned by UpdateMemo when the payload of the memo is no longer the expected one.
+var ErrMemoPayloadChanged = errors.New("memo payload changed")
+
 type DeleteMemo struct {
 	ID int32
 }
@@ -145,6 +152,14 @@ func (s *Store) DeleteMemo(ctx context.Context, delete *DeleteMemo) error {
 	if err := s.driver.DeleteMemoRelation(ctx, &DeleteMemoRelation{RelatedMemoID: &delete.ID}); err != nil {
 		return err
 	}
+	// Clean up the semantic index entry of this memo.
+	if err := s.DeleteMemoEmbeddingByMemoID(ctx, delete.ID); err != nil {
+		return err
+	}
+	// Clean up the revision history of this memo.
+	if err := s.driver.DeleteMemoRevision(ctx, &DeleteMemoRevision{MemoID: &delete.ID}); err != nil {
+		return err
+	}
 	// Clean up attachments linked to this memo.
 	attachments, err := s.ListAttachments(ctx, &FindAttachment{MemoID: &delete.ID})
 	if err != nil {
@@ -2,13 +2,19 @@ package store
 
 import (
 	"context"
-	"database/sql"
-	"strings"
+	"encoding/binary"
+	"math"
 
-	"github.com/lib/pq"
 	"github.com/pkg/errors"
 )
 
+const (
+	// memoEmbeddingListBatchSize bounds the number of memo ids sent to the driver in a single query.
+	memoEmbeddingListBatchSize = 500
+	// memoEmbeddingCandidateBatchSize is the page size used when collecting candidate memos for the in-process index.
+	memoEmbeddingCandidateBatchSize = 2000
+)
+
 // MemoEmbedding stores vector indexing data for a memo.
 type MemoEmbedding struct {
 	MemoID      int32
@@ -16,111 +22,220 @@ type MemoEmbedding struct {
 	Dimension   int32
 	Embedding   []float64
 	ContentHash string
+	UpdatedTs   int64
+
+	// Chunks are the embeddings of the passages of the memo, used to rank semantic search.
+	// An upsert without chunks stores the memo embedding as a single chunk. Not populated by list queries.
+	Chunks []*MemoEmbeddingChunk
 }
 
-func (s *Store) ensurePostgresEmbeddingSupport() error {
-	if s.profile == nil || strings.ToLower(s.profile.Driver) != "postgres" {
-		return errors.New("memo embedding is only supported for postgres driver")
-	}
-	return nil
+// MemoEmbeddingChunk is the embedding of a passage of a memo.
+type MemoEmbeddingChunk struct {
+	MemoID     int32
+	ChunkIndex int32
+	// StartOffset and EndOffset are the byte offsets of the passage in the memo content.
+	// An EndOffset of zero means the chunk spans the whole content.
+	StartOffset int32
+	EndOffset   int32
+	Dimension   int32
+	Embedding   []float64
 }
 
-func (s *Store) GetMemoEmbeddingContentHash(ctx context.Context, memoID int32) (string, error) {
-	if err := s.ensurePostgresEmbeddingSupport(); err != nil {
-		return "", err
-	}
+type FindMemoEmbeddingChunk struct {
+	MemoID     *int32
+	MemoIDList []int32
+}
 
-	const stmt = "SELECT content_hash FROM memo_embedding WHERE memo_id = $1"
-	var contentHash string
-	if err := s.driver.GetDB().QueryRowContext(ctx, stmt, memoID).Scan(&contentHash); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return "", nil
-		}
+type FindMemoEmbedding struct {
+	MemoID     *int32
+	MemoIDList []int32
+}
+
+type DeleteMemoEmbedding struct {
+	MemoID int32
+}
+
+// MemoEmbeddingSearcher is implemented by drivers that can rank memo embeddings inside the database.
+type MemoEmbeddingSearcher interface {
+	// SearchMemoEmbeddings reports false when the database cannot serve the search,
+	// in which case the store falls back to the in-process vector index.
+	SearchMemoEmbeddings(ctx context.Context, search *SearchMemoEmbedding) ([]*VectorMatch, bool, error)
+}
+
+// SearchMemoEmbedding describes a nearest-neighbour query against stored memo embeddings.
+type SearchMemoEmbedding struct {
+	// Vector is the query embedding.
+	Vector []float64
+	// MemoIDList restricts the search to the given memos. Nil means all indexed memos.
+	MemoIDList []int32
+	// MemoFind restricts the search to memos matching the condition, e.g. visibility and CEL filters.
+	// Pagination and ordering fields are ignored.
+	MemoFind *FindMemo
+	// Limit is the maximum number of matches to return. Zero means no limit.
+	Limit int
+}
+
+func (s *Store) GetMemoEmbeddingContentHash(ctx context.Context, memoID int32) (string, error) {
+	list, err := s.driver.ListMemoEmbeddings(ctx, &FindMemoEmbedding{MemoID: &memoID})
+	if err != nil {
 		return "", errors.Wrap(err, "failed to query memo embedding content hash")
 	}
-	return contentHash, nil
+	if len(list) == 0 {
+		return "", nil
+	}
+	return list[0].ContentHash, nil
 }
 
 func (s *Store) UpsertMemoEmbedding(ctx context.Context, embedding *MemoEmbedding) error {
-	if err := s.ensurePostgresEmbeddingSupport(); err != nil {
-		return err
-	}
 	if embedding == nil {
 		return errors.New("embedding cannot be nil")
 	}
 	if len(embedding.Embedding) == 0 {
 		return errors.New("embedding vector cannot be empty")
 	}
+	if embedding.Dimension == 0 {
+		embedding.Dimension = int32(len(embedding.Embedding))
+	}
+	if len(embedding.Chunks) == 0 {
+		embedding.Chunks = []*MemoEmbeddingChunk{{Embedding: embedding.Embedding}}
+	}
+	vectors := make([][]float64, 0, len(embedding.Chunks))
+	for i, chunk := range embedding.Chunks {
+		if len(chunk.Embedding) == 0 {
+			return errors.Errorf("embedding vector of chunk %d cannot be empty", i)
+		}
+		if chunk.StartOffset < 0 || chunk.EndOffset < 0 || (chunk.EndOffset > 0 && chunk.EndOffset < chunk.StartOffset) {
+			return errors.Errorf("invalid offsets of chunk %d", i)
+		}
+		chunk.MemoID = embedding.MemoID
+		chunk.ChunkIndex = int32(i)
+		chunk.Dimension = int32(len(chunk.Embedding))
+		vectors = append(vectors, chunk.Embedding)
+	}
 
-	const stmt = `
-INSERT INTO memo_embedding (memo_id, model, dimension, embedding, content_hash, updated_ts)
-VALUES ($1, $2, $3, $4, $5, CAST(EXTRACT(EPOCH FROM NOW()) AS BIGINT))
-ON CONFLICT (memo_id)
-DO UPDATE SET
-  model = EXCLUDED.model,
-  dimension = EXCLUDED.dimension,
-  embedding = EXCLUDED.embedding,
-  content_hash = EXCLUDED.content_hash,
-  updated_ts = EXCLUDED.updated_ts
-`
-	if _, err := s.driver.GetDB().ExecContext(
-		ctx,
-		stmt,
-		embedding.MemoID,
-		embedding.Model,
-		embedding.Dimension,
-		pq.Array(embedding.Embedding),
-		embedding.ContentHash,
-	); err != nil {
+	if err := s.driver.UpsertMemoEmbedding(ctx, embedding); err != nil {
 		return errors.Wrap(err, "failed to upsert memo embedding")
 	}
+	s.updateVectorIndex(func(index VectorIndex) {
+		index.Upsert(embedding.MemoID, vectors)
+	})
 	return nil
 }
 
 func (s *Store) DeleteMemoEmbeddingByMemoID(ctx context.Context, memoID int32) error {
-	if err := s.ensurePostgresEmbeddingSupport(); err != nil {
-		return err
-	}
-
-	const stmt = "DELETE FROM memo_embedding WHERE memo_id = $1"
-	if _, err := s.driver.GetDB().ExecContext(ctx, stmt, memoID); err != nil {
+	if err := s.driver.DeleteMemoEmbedding(ctx, &DeleteMemoEmbedding{MemoID: memoID}); err != nil {
 		return errors.Wrap(err, "failed to delete memo embedding")
 	}
+	s.updateVectorIndex(func(index VectorIndex) {
+		index.Delete(memoID)
+	})
 	return nil
 }
 
+func (s *Store) ListMemoEmbeddings(ctx context.Context, find *FindMemoEmbedding) ([]*MemoEmbedding, error) {
+	return s.driver.ListMemoEmbeddings(ctx, find)
+}
+
+func (s *Store) ListMemoEmbeddingChunks(ctx context.Context, find *FindMemoEmbeddingChunk) ([]*MemoEmbeddingChunk, error) {
+	return s.driver.ListMemoEmbeddingChunks(ctx, find)
+}
+
 func (s *Store) ListMemoEmbeddingsByMemoIDs(ctx context.Context, memoIDList []int32) (map[int32][]float64, error) {
-	if err := s.ensurePostgresEmbeddingSupport(); err != nil {
-		return nil, err
-	}
 	result := make(map[int32][]float64, len(memoIDList))
-	if len(memoIDList) == 0 {
-		return result, nil
+	for start := 0; start < len(memoIDList); start += memoEmbeddingListBatchSize {
+		end := min(start+memoEmbeddingListBatchSize, len(memoIDList))
+		list, err := s.driver.ListMemoEmbeddings(ctx, &FindMemoEmbedding{MemoIDList: memoIDList[start:end]})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to query memo embeddings")
+		}
+		for _, embedding := range list {
+			result[embedding.MemoID] = embedding.Embedding
+		}
 	}
+	return result, nil
+}
 
-	queryIDs := make([]int64, 0, len(memoIDList))
-	for _, memoID := range memoIDList {
-		queryIDs = append(queryIDs, int64(memoID))
+// SearchMemoEmbeddings returns the memos whose best chunk is most similar to the query vector,
+// ordered by descending cosine similarity.
+func (s *Store) SearchMemoEmbeddings(ctx context.Context, search *SearchMemoEmbedding) ([]*VectorMatch, error) {
+	if search == nil || len(search.Vector) == 0 {
+		return nil, errors.New("query vector cannot be empty")
+	}
+	if search.MemoIDList != nil && len(search.MemoIDList) == 0 {
+		return []*VectorMatch{}, nil
+	}
+
+	if searcher, ok := s.driver.(MemoEmbeddingSearcher); ok {
+		matches, ok, err := searcher.SearchMemoEmbeddings(ctx, search)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to search memo embeddings")
+		}
+		if ok {
+			return matches, nil
+		}
 	}
 
-	const stmt = "SELECT memo_id, embedding FROM memo_embedding WHERE memo_id = ANY($1::bigint[])"
-	rows, err := s.driver.GetDB().QueryContext(ctx, stmt, pq.Array(queryIDs))
+	candidates := search.MemoIDList
+	if search.MemoFind != nil {
+		memoIDList, err := s.listMemoEmbeddingCandidates(ctx, search.MemoFind, search.MemoIDList)
+		if err != nil {
+			return nil, err
+		}
+		candidates = memoIDList
+	}
+	index, err := s.getVectorIndex(ctx)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to query memo embeddings")
+		return nil, err
 	}
-	defer rows.Close()
+	return index.Search(search.Vector, candidates, search.Limit), nil
+}
 
-	for rows.Next() {
-		var memoID int32
-		var vector []float64
-		if err := rows.Scan(&memoID, pq.Array(&vector)); err != nil {
-			return nil, errors.Wrap(err, "failed to scan memo embedding row")
+// listMemoEmbeddingCandidates returns the ids of memos matching the condition, page by page.
+func (s *Store) listMemoEmbeddingCandidates(ctx context.Context, base *FindMemo, memoIDList []int32) ([]int32, error) {
+	candidates := make([]int32, 0, memoEmbeddingCandidateBatchSize)
+	offset := 0
+	for {
+		limit := memoEmbeddingCandidateBatchSize
+		find := *base
+		find.ExcludeContent = true
+		if memoIDList != nil {
+			find.IDList = memoIDList
+		}
+		find.Limit = &limit
+		find.Offset = &offset
+
+		memos, err := s.driver.ListMemos(ctx, &find)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list memo candidates")
+		}
+		for _, memo := range memos {
+			candidates = append(candidates, memo.ID)
 		}
-		result[memoID] = vector
+		if len(memos) < memoEmbeddingCandidateBatchSize {
+			break
+		}
+		offset += len(memos)
 	}
-	if err := rows.Err(); err != nil {
-		return nil, errors.Wrap(err, "failed to iterate memo embedding rows")
+	return candidates, nil
+}
+
+// EncodeEmbedding serializes a vector as little-endian float64 values for drivers without a native array type.
+func EncodeEmbedding(vector []float64) []byte {
+	buf := make([]byte, 8*len(vector))
+	for i, value := range vector {
+		binary.LittleEndian.PutUint64(buf[i*8:], math.Float64bits(value))
 	}
+	return buf
+}
 
-	return result, nil
+// DecodeEmbedding is the inverse of EncodeEmbedding.
+func DecodeEmbedding(data []byte) ([]float64, error) {
+	if len(data)%8 != 0 {
+		return nil, errors.Errorf("invalid embedding length %d", len(data))
+	}
+	vector := make([]float64, len(data)/8)
+	for i := range vector {
+		vector[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[i*8:]))
+	}
+	return vector, nil
 }
@@ -0,0 +1,72 @@
+package store
+
+import (
+	"context"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// MemoRevision is a prior version of a memo, recorded when the memo is updated.
+type MemoRevision struct {
+	ID     int32
+	MemoID int32
+
+	Content    string
+	Visibility Visibility
+	Payload    *storepb.MemoPayload
+	// CreatedTs is the time the version was saved, the update time of the memo before it was updated.
+	CreatedTs int64
+}
+
+type FindMemoRevision struct {
+	ID     *int32
+	MemoID *int32
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+type DeleteMemoRevision struct {
+	ID     *int32
+	MemoID *int32
+	// MaxID only deletes the revisions with an id up to MaxID.
+	MaxID *int32
+}
+
+func (s *Store) CreateMemoRevision(ctx context.Context, create *MemoRevision) (*MemoRevision, error) {
+	return s.driver.CreateMemoRevision(ctx, create)
+}
+
+// ListMemoRevisions lists the revisions, newest first.
+func (s *Store) ListMemoRevisions(ctx context.Context, find *FindMemoRevision) ([]*MemoRevision, error) {
+	return s.driver.ListMemoRevisions(ctx, find)
+}
+
+func (s *Store) GetMemoRevision(ctx context.Context, find *FindMemoRevision) (*MemoRevision, error) {
+	list, err := s.ListMemoRevisions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteMemoRevision(ctx context.Context, delete *DeleteMemoRevision) error {
+	return s.driver.DeleteMemoRevision(ctx, delete)
+}
+
+// PruneMemoRevisions deletes the oldest revisions of the memo, keeping the given number.
+func (s *Store) PruneMemoRevisions(ctx context.Context, memoID int32, keep int) error {
+	limit := 1
+	newestPruned, err := s.GetMemoRevision(ctx, &FindMemoRevision{MemoID: &memoID, Limit: &limit, Offset: &keep})
+	if err != nil {
+		return err
+	}
+	if newestPruned == nil {
+		return nil
+	}
+	return s.DeleteMemoRevision(ctx, &DeleteMemoRevision{MemoID: &memoID, MaxID: &newestPruned.ID})
+}
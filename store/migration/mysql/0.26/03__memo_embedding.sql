@@ -0,0 +1,10 @@
+CREATE TABLE `memo_embedding` (
+  `memo_id` INT NOT NULL PRIMARY KEY,
+  `model` VARCHAR(256) NOT NULL,
+  `dimension` INT NOT NULL,
+  `embedding` LONGBLOB NOT NULL,
+  `content_hash` VARCHAR(256) NOT NULL,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX `idx_memo_embedding_updated_ts` ON `memo_embedding` (`updated_ts`);
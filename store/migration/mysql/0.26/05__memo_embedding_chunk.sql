@@ -0,0 +1,13 @@
+CREATE TABLE `memo_embedding_chunk` (
+  `memo_id` INT NOT NULL,
+  `chunk_index` INT NOT NULL,
+  `start_offset` INT NOT NULL DEFAULT 0,
+  `end_offset` INT NOT NULL DEFAULT 0,
+  `dimension` INT NOT NULL,
+  `embedding` LONGBLOB NOT NULL,
+  PRIMARY KEY (`memo_id`, `chunk_index`)
+);
+
+-- Existing memo embeddings become a single chunk spanning the whole memo.
+INSERT INTO `memo_embedding_chunk` (`memo_id`, `chunk_index`, `start_offset`, `end_offset`, `dimension`, `embedding`)
+SELECT `memo_id`, 0, 0, 0, `dimension`, `embedding` FROM `memo_embedding`;
@@ -0,0 +1,17 @@
+CREATE TABLE `job` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `type` VARCHAR(256) NOT NULL,
+  `key` VARCHAR(256) NOT NULL DEFAULT '',
+  `status` VARCHAR(32) NOT NULL DEFAULT 'PENDING',
+  `attempts` INT NOT NULL DEFAULT 0,
+  `max_attempts` INT NOT NULL DEFAULT 1,
+  `run_after_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `last_error` TEXT NOT NULL,
+  `payload` TEXT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX `idx_job_status_run_after_ts` ON `job` (`status`, `run_after_ts`);
+
+CREATE INDEX `idx_job_type_key` ON `job` (`type`, `key`);
@@ -0,0 +1,17 @@
+CREATE TABLE `webhook_delivery` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `webhook_id` VARCHAR(256) NOT NULL,
+  `url` TEXT NOT NULL,
+  `activity_type` VARCHAR(256) NOT NULL DEFAULT '',
+  `request_body` MEDIUMTEXT NOT NULL,
+  `status` VARCHAR(32) NOT NULL DEFAULT 'PENDING',
+  `attempts` INT NOT NULL DEFAULT 0,
+  `response_status_code` INT NOT NULL DEFAULT 0,
+  `response_body` TEXT NOT NULL,
+  `error` TEXT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX `idx_webhook_delivery_creator_id_webhook_id` ON `webhook_delivery` (`creator_id`, `webhook_id`);
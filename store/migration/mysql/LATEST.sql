@@ -41,6 +41,29 @@ CREATE TABLE `memo` (
   `pinned` BOOLEAN NOT NULL DEFAULT FALSE,
   `payload` JSON NOT NULL
 );
+CREATE FULLTEXT INDEX `idx_memo_content_fulltext` ON `memo` (`content`);
+
+-- memo_embedding
+CREATE TABLE `memo_embedding` (
+  `memo_id` INT NOT NULL PRIMARY KEY,
+  `model` VARCHAR(256) NOT NULL,
+  `dimension` INT NOT NULL,
+  `embedding` LONGBLOB NOT NULL,
+  `content_hash` VARCHAR(256) NOT NULL,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX `idx_memo_embedding_updated_ts` ON `memo_embedding` (`updated_ts`);
+
+-- memo_embedding_chunk
+CREATE TABLE `memo_embedding_chunk` (
+  `memo_id` INT NOT NULL,
+  `chunk_index` INT NOT NULL,
+  `start_offset` INT NOT NULL DEFAULT 0,
+  `end_offset` INT NOT NULL DEFAULT 0,
+  `dimension` INT NOT NULL,
+  `embedding` LONGBLOB NOT NULL,
+  PRIMARY KEY (`memo_id`, `chunk_index`)
+);
 
 -- memo_relation
 CREATE TABLE `memo_relation` (
@@ -105,3 +128,53 @@ CREATE TABLE `reaction` (
   `reaction_type` VARCHAR(256) NOT NULL,
   UNIQUE(`creator_id`,`content_id`,`reaction_type`)  
 );
+
+-- job
+CREATE TABLE `job` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `type` VARCHAR(256) NOT NULL,
+  `key` VARCHAR(256) NOT NULL DEFAULT '',
+  `status` VARCHAR(32) NOT NULL DEFAULT 'PENDING',
+  `attempts` INT NOT NULL DEFAULT 0,
+  `max_attempts` INT NOT NULL DEFAULT 1,
+  `run_after_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `last_error` TEXT NOT NULL,
+  `payload` TEXT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX `idx_job_status_run_after_ts` ON `job` (`status`, `run_after_ts`);
+
+CREATE INDEX `idx_job_type_key` ON `job` (`type`, `key`);
+
+-- webhook_delivery
+CREATE TABLE `webhook_delivery` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `webhook_id` VARCHAR(256) NOT NULL,
+  `url` TEXT NOT NULL,
+  `activity_type` VARCHAR(256) NOT NULL DEFAULT '',
+  `request_body` MEDIUMTEXT NOT NULL,
+  `status` VARCHAR(32) NOT NULL DEFAULT 'PENDING',
+  `attempts` INT NOT NULL DEFAULT 0,
+  `response_status_code` INT NOT NULL DEFAULT 0,
+  `response_body` TEXT NOT NULL,
+  `error` TEXT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX `idx_webhook_delivery_creator_id_webhook_id` ON `webhook_delivery` (`creator_id`, `webhook_id`);
+
+-- memo_revision
+CREATE TABLE `memo_revision` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `content` TEXT NOT NULL,
+  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
+  `payload` JSON NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX `idx_memo_revision_memo_id` ON `memo_revision` (`memo_id`);
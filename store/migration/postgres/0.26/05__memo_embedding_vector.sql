@@ -0,0 +1,14 @@
+-- Store embeddings as pgvector values when the extension is available.
+-- Without pgvector the array column stays the source of truth and ranking happens in process.
+DO $$
+BEGIN
+  IF EXISTS (SELECT 1 FROM pg_available_extensions WHERE name = 'vector') THEN
+    CREATE EXTENSION IF NOT EXISTS vector;
+    EXECUTE 'ALTER TABLE memo_embedding ADD COLUMN IF NOT EXISTS embedding_vector vector';
+    EXECUTE 'UPDATE memo_embedding SET embedding_vector = embedding::vector';
+  END IF;
+EXCEPTION
+  WHEN insufficient_privilege THEN
+    RAISE NOTICE 'pgvector extension cannot be created, semantic search ranks in process';
+END
+$$;
@@ -0,0 +1,3 @@
+ALTER TABLE memo ADD COLUMN content_tsv TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', content)) STORED;
+
+CREATE INDEX memo_content_tsv_idx ON memo USING GIN (content_tsv);
@@ -0,0 +1,22 @@
+CREATE TABLE memo_embedding_chunk (
+  memo_id INTEGER NOT NULL REFERENCES memo (id) ON DELETE CASCADE,
+  chunk_index INTEGER NOT NULL,
+  start_offset INTEGER NOT NULL DEFAULT 0,
+  end_offset INTEGER NOT NULL DEFAULT 0,
+  dimension INTEGER NOT NULL,
+  embedding DOUBLE PRECISION[] NOT NULL,
+  PRIMARY KEY (memo_id, chunk_index)
+);
+
+-- Existing memo embeddings become a single chunk spanning the whole memo.
+INSERT INTO memo_embedding_chunk (memo_id, chunk_index, start_offset, end_offset, dimension, embedding)
+SELECT memo_id, 0, 0, 0, dimension, embedding FROM memo_embedding;
+
+DO $$
+BEGIN
+  IF EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'vector') THEN
+    EXECUTE 'ALTER TABLE memo_embedding_chunk ADD COLUMN IF NOT EXISTS embedding_vector vector';
+    EXECUTE 'UPDATE memo_embedding_chunk SET embedding_vector = embedding::vector';
+  END IF;
+END
+$$;
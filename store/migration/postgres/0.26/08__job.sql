@@ -0,0 +1,17 @@
+CREATE TABLE job (
+  id SERIAL PRIMARY KEY,
+  type TEXT NOT NULL,
+  key TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL DEFAULT 'PENDING',
+  attempts INTEGER NOT NULL DEFAULT 0,
+  max_attempts INTEGER NOT NULL DEFAULT 1,
+  run_after_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  last_error TEXT NOT NULL DEFAULT '',
+  payload TEXT NOT NULL DEFAULT '{}',
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+CREATE INDEX job_status_run_after_ts_idx ON job (status, run_after_ts);
+
+CREATE INDEX job_type_key_idx ON job (type, key);
@@ -0,0 +1,17 @@
+CREATE TABLE webhook_delivery (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  webhook_id TEXT NOT NULL,
+  url TEXT NOT NULL,
+  activity_type TEXT NOT NULL DEFAULT '',
+  request_body TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL DEFAULT 'PENDING',
+  attempts INTEGER NOT NULL DEFAULT 0,
+  response_status_code INTEGER NOT NULL DEFAULT 0,
+  response_body TEXT NOT NULL DEFAULT '',
+  error TEXT NOT NULL DEFAULT '',
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+CREATE INDEX webhook_delivery_creator_id_webhook_id_idx ON webhook_delivery (creator_id, webhook_id);
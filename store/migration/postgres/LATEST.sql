@@ -51,8 +51,10 @@ CREATE TABLE memo (
   content TEXT NOT NULL,
   visibility TEXT NOT NULL DEFAULT 'PRIVATE',
   pinned BOOLEAN NOT NULL DEFAULT FALSE,
-  payload JSONB NOT NULL DEFAULT '{}'
+  payload JSONB NOT NULL DEFAULT '{}',
+  content_tsv TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', content)) STORED
 );
+CREATE INDEX memo_content_tsv_idx ON memo USING GIN (content_tsv);
 
 -- memo_embedding
 CREATE TABLE memo_embedding (
@@ -65,6 +67,29 @@ CREATE TABLE memo_embedding (
 );
 CREATE INDEX memo_embedding_updated_ts_idx ON memo_embedding (updated_ts);
 
+-- memo_embedding_chunk
+CREATE TABLE memo_embedding_chunk (
+  memo_id INTEGER NOT NULL REFERENCES memo (id) ON DELETE CASCADE,
+  chunk_index INTEGER NOT NULL,
+  start_offset INTEGER NOT NULL DEFAULT 0,
+  end_offset INTEGER NOT NULL DEFAULT 0,
+  dimension INTEGER NOT NULL,
+  embedding DOUBLE PRECISION[] NOT NULL,
+  PRIMARY KEY (memo_id, chunk_index)
+);
+DO $$
+BEGIN
+  IF EXISTS (SELECT 1 FROM pg_available_extensions WHERE name = 'vector') THEN
+    CREATE EXTENSION IF NOT EXISTS vector;
+    EXECUTE 'ALTER TABLE memo_embedding ADD COLUMN IF NOT EXISTS embedding_vector vector';
+    EXECUTE 'ALTER TABLE memo_embedding_chunk ADD COLUMN IF NOT EXISTS embedding_vector vector';
+  END IF;
+EXCEPTION
+  WHEN insufficient_privilege THEN
+    RAISE NOTICE 'pgvector extension cannot be created, semantic search ranks in process';
+END
+$$;
+
 -- memo_relation
 CREATE TABLE memo_relation (
   memo_id INTEGER NOT NULL,
@@ -128,3 +153,53 @@ CREATE TABLE reaction (
   reaction_type TEXT NOT NULL,
   UNIQUE(creator_id, content_id, reaction_type)
 );
+
+-- job
+CREATE TABLE job (
+  id SERIAL PRIMARY KEY,
+  type TEXT NOT NULL,
+  key TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL DEFAULT 'PENDING',
+  attempts INTEGER NOT NULL DEFAULT 0,
+  max_attempts INTEGER NOT NULL DEFAULT 1,
+  run_after_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  last_error TEXT NOT NULL DEFAULT '',
+  payload TEXT NOT NULL DEFAULT '{}',
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+CREATE INDEX job_status_run_after_ts_idx ON job (status, run_after_ts);
+
+CREATE INDEX job_type_key_idx ON job (type, key);
+
+-- webhook_delivery
+CREATE TABLE webhook_delivery (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  webhook_id TEXT NOT NULL,
+  url TEXT NOT NULL,
+  activity_type TEXT NOT NULL DEFAULT '',
+  request_body TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL DEFAULT 'PENDING',
+  attempts INTEGER NOT NULL DEFAULT 0,
+  response_status_code INTEGER NOT NULL DEFAULT 0,
+  response_body TEXT NOT NULL DEFAULT '',
+  error TEXT NOT NULL DEFAULT '',
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+CREATE INDEX webhook_delivery_creator_id_webhook_id_idx ON webhook_delivery (creator_id, webhook_id);
+
+-- memo_revision
+CREATE TABLE memo_revision (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  content TEXT NOT NULL,
+  visibility TEXT NOT NULL DEFAULT 'PRIVATE',
+  payload JSONB NOT NULL DEFAULT '{}',
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+CREATE INDEX memo_revision_memo_id_idx ON memo_revision (memo_id);
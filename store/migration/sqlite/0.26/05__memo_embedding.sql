@@ -0,0 +1,10 @@
+CREATE TABLE memo_embedding (
+  memo_id INTEGER PRIMARY KEY,
+  model TEXT NOT NULL,
+  dimension INTEGER NOT NULL,
+  embedding BLOB NOT NULL,
+  content_hash TEXT NOT NULL,
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now'))
+);
+
+CREATE INDEX idx_memo_embedding_updated_ts ON memo_embedding (updated_ts);
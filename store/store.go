@@ -1,6 +1,7 @@
 package store
 
 import (
+	"sync"
 	"time"
 
 	"github.com/usememos/memos/internal/profile"
@@ -19,6 +20,11 @@ type Store struct {
 	instanceSettingCache *cache.Cache // cache for instance settings
 	userCache            *cache.Cache // cache for users
 	userSettingCache     *cache.Cache // cache for user settings
+
+	// In-process nearest-neighbour index over memo embeddings, loaded lazily.
+	vectorIndexMu     sync.Mutex
+	vectorIndex       VectorIndex
+	vectorIndexLoaded bool
 }
 
 // New creates a new instance of Store.
@@ -38,6 +44,7 @@ func New(driver Driver, profile *profile.Profile) *Store {
 		instanceSettingCache: cache.New(cacheConfig),
 		userCache:            cache.New(cacheConfig),
 		userSettingCache:     cache.New(cacheConfig),
+		vectorIndex:          NewMemoryVectorIndex(),
 	}
 
 	return store
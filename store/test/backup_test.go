@@ -0,0 +1,93 @@
+package test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestStoreWriteBackup(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{UID: "backup", CreatorID: user.ID, Content: "backed up", Visibility: store.Private})
+	require.NoError(t, err)
+	_, err = ts.CreateAttachment(ctx, &store.Attachment{
+		UID:       "blob",
+		CreatorID: user.ID,
+		Filename:  "blob.txt",
+		Blob:      []byte("stored in the database"),
+		Type:      "text/plain",
+		MemoID:    &memo.ID,
+	})
+	require.NoError(t, err)
+	localPath := filepath.Join(t.TempDir(), "local.txt")
+	require.NoError(t, os.WriteFile(localPath, []byte("stored on disk"), 0o600))
+	for uid, reference := range map[string]string{"local": localPath, "missing": "assets/missing.txt"} {
+		_, err = ts.CreateAttachment(ctx, &store.Attachment{
+			UID:         uid,
+			CreatorID:   user.ID,
+			Filename:    uid + ".txt",
+			Type:        "text/plain",
+			StorageType: storepb.AttachmentStorageType_LOCAL,
+			Reference:   reference,
+		})
+		require.NoError(t, err)
+	}
+
+	buffer := &bytes.Buffer{}
+	manifest, err := ts.WriteBackup(ctx, buffer)
+	require.NoError(t, err)
+	require.Equal(t, ts.DriverName(), manifest.Driver)
+	require.NotEmpty(t, manifest.SchemaVersion)
+	require.Equal(t, []string{"assets/missing.txt"}, manifest.MissingFiles)
+	require.Len(t, manifest.Files, 1)
+	require.Equal(t, localPath, manifest.Files[0].Reference)
+
+	archive, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	require.NoError(t, err)
+	entries := map[string][]byte{}
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		require.NoError(t, err)
+		entries[file.Name], err = io.ReadAll(reader)
+		require.NoError(t, err)
+		reader.Close()
+	}
+	require.Equal(t, []byte("stored on disk"), entries[manifest.Files[0].Path])
+	written := &store.BackupManifest{}
+	require.NoError(t, json.Unmarshal(entries[store.BackupManifestName], written))
+	require.Equal(t, manifest.Database, written.Database)
+	require.NotEmpty(t, entries[manifest.Database])
+
+	if ts.DriverName() != "sqlite" {
+		require.Contains(t, string(entries[manifest.Database]), "backed up")
+		return
+	}
+	// The snapshot of a SQLite database is a database file of its own.
+	snapshotPath := filepath.Join(t.TempDir(), manifest.Database)
+	require.NoError(t, os.WriteFile(snapshotPath, entries[manifest.Database], 0o600))
+	snapshot := NewTestingStoreWithDSN(ctx, t, "sqlite", snapshotPath)
+	defer snapshot.Close()
+	memos, err := snapshot.ListMemos(ctx, &store.FindMemo{})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, "backed up", memos[0].Content)
+	attachment, err := snapshot.GetAttachment(ctx, &store.FindAttachment{UID: stringPtr("blob"), GetBlob: true})
+	require.NoError(t, err)
+	require.Equal(t, []byte("stored in the database"), attachment.Blob)
+}
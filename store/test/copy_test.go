@@ -0,0 +1,176 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestStoreCopyTo(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	source := NewTestingStore(ctx, t)
+	defer source.Close()
+
+	user, err := createTestingHostUser(ctx, source)
+	require.NoError(t, err)
+	removed, err := createTestingUserWithRole(ctx, source, "removed", store.RoleUser)
+	require.NoError(t, err)
+	archived := store.Archived
+	updatedTs := int64(1700000000)
+	_, err = source.UpdateUser(ctx, &store.UpdateUser{ID: removed.ID, RowStatus: &archived, UpdatedTs: &updatedTs})
+	require.NoError(t, err)
+	_, err = source.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSetting_GENERAL,
+		Value:  &storepb.UserSetting_General{General: &storepb.GeneralUserSetting{Locale: "fr"}},
+	})
+	require.NoError(t, err)
+	_, err = source.CreateIdentityProvider(ctx, &storepb.IdentityProvider{
+		Name: "GitHub",
+		Type: storepb.IdentityProvider_OAUTH2,
+		Config: &storepb.IdentityProviderConfig{
+			Config: &storepb.IdentityProviderConfig_Oauth2Config{Oauth2Config: &storepb.OAuth2Config{ClientId: "client"}},
+		},
+	})
+	require.NoError(t, err)
+
+	// A deleted memo leaves a gap in the ids, which the copy keeps.
+	memos := []*store.Memo{}
+	for _, uid := range []string{"first", "deleted", "pinned", "archived"} {
+		memo, err := source.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    uid + " memo #copy",
+			Visibility: store.Protected,
+			CreatedTs:  1600000000,
+			UpdatedTs:  1600000100,
+			Payload:    &storepb.MemoPayload{Tags: []string{"copy"}},
+		})
+		require.NoError(t, err)
+		memos = append(memos, memo)
+	}
+	require.NoError(t, source.DeleteMemo(ctx, &store.DeleteMemo{ID: memos[1].ID}))
+	pinned := true
+	require.NoError(t, source.UpdateMemo(ctx, &store.UpdateMemo{ID: memos[2].ID, Pinned: &pinned}))
+	require.NoError(t, source.UpdateMemo(ctx, &store.UpdateMemo{ID: memos[3].ID, RowStatus: &archived}))
+	_, err = source.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: memos[2].ID, RelatedMemoID: memos[0].ID, Type: store.MemoRelationReference})
+	require.NoError(t, err)
+	require.NoError(t, source.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+		MemoID:      memos[0].ID,
+		Model:       "text-embedding-3-small",
+		Dimension:   3,
+		Embedding:   []float64{0.1, 0.2, 0.3},
+		ContentHash: "hash",
+		Chunks:      []*store.MemoEmbeddingChunk{{ChunkIndex: 0, EndOffset: 5, Dimension: 3, Embedding: []float64{0.3, 0.2, 0.1}}},
+	}))
+	_, err = source.CreateAttachment(ctx, &store.Attachment{
+		UID:       "attachment",
+		CreatorID: user.ID,
+		Filename:  "notes.txt",
+		Blob:      []byte("stored in the database"),
+		Type:      "text/plain",
+		Size:      22,
+		MemoID:    &memos[0].ID,
+	})
+	require.NoError(t, err)
+	_, err = source.CreateActivity(ctx, &store.Activity{
+		CreatorID: user.ID,
+		Type:      store.ActivityTypeMemoComment,
+		Level:     store.ActivityLevelInfo,
+		Payload:   &storepb.ActivityPayload{},
+	})
+	require.NoError(t, err)
+	_, err = source.CreateInbox(ctx, &store.Inbox{SenderID: user.ID, ReceiverID: removed.ID, Status: store.ARCHIVED, Message: &storepb.InboxMessage{}})
+	require.NoError(t, err)
+	_, err = source.UpsertReaction(ctx, &store.Reaction{CreatorID: user.ID, ContentID: "memos/first", ReactionType: "👍"})
+	require.NoError(t, err)
+	job, err := source.CreateJob(ctx, &store.Job{Type: store.JobTypeWebhook, Status: store.JobPending, MaxAttempts: 3})
+	require.NoError(t, err)
+	attempts, lastError, dead := int32(3), "timeout", store.JobDead
+	_, err = source.UpdateJob(ctx, &store.UpdateJob{ID: job.ID, Status: &dead, Attempts: &attempts, LastError: &lastError})
+	require.NoError(t, err)
+	_, err = source.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+		CreatorID: user.ID,
+		WebhookID: "hook",
+		URL:       "https://example.com/hook",
+		Status:    store.WebhookDeliveryPending,
+	})
+	require.NoError(t, err)
+
+	target := NewTestingStore(ctx, t)
+	defer target.Close()
+	counts, err := source.CopyTo(ctx, target)
+	require.NoError(t, err)
+	for _, count := range counts {
+		require.Equal(t, count.Source, count.Target, count.Table)
+	}
+
+	t.Run("Rows keep their ids, timestamps and state", func(t *testing.T) {
+		sourceUsers, err := source.ListUsers(ctx, &store.FindUser{})
+		require.NoError(t, err)
+		targetUsers, err := target.ListUsers(ctx, &store.FindUser{})
+		require.NoError(t, err)
+		require.Equal(t, sourceUsers, targetUsers)
+
+		sourceMemos, err := source.ListMemos(ctx, &store.FindMemo{})
+		require.NoError(t, err)
+		targetMemos, err := target.ListMemos(ctx, &store.FindMemo{})
+		require.NoError(t, err)
+		require.Len(t, targetMemos, 3)
+		for i, memo := range targetMemos {
+			require.Equal(t, sourceMemos[i].ID, memo.ID)
+			require.Equal(t, sourceMemos[i].UID, memo.UID)
+			require.Equal(t, sourceMemos[i].Content, memo.Content)
+			require.Equal(t, sourceMemos[i].CreatedTs, memo.CreatedTs)
+			require.Equal(t, sourceMemos[i].UpdatedTs, memo.UpdatedTs)
+			require.Equal(t, sourceMemos[i].RowStatus, memo.RowStatus)
+			require.Equal(t, sourceMemos[i].Pinned, memo.Pinned)
+			require.Equal(t, sourceMemos[i].Payload.GetTags(), memo.Payload.GetTags())
+		}
+
+		relations, err := target.ListMemoRelations(ctx, &store.FindMemoRelation{})
+		require.NoError(t, err)
+		require.Len(t, relations, 1)
+		require.Equal(t, memos[2].ID, relations[0].MemoID)
+
+		attachment, err := target.GetAttachment(ctx, &store.FindAttachment{UID: stringPtr("attachment"), GetBlob: true})
+		require.NoError(t, err)
+		require.Equal(t, []byte("stored in the database"), attachment.Blob)
+		require.Equal(t, memos[0].ID, *attachment.MemoID)
+
+		chunks, err := target.ListMemoEmbeddingChunks(ctx, &store.FindMemoEmbeddingChunk{MemoID: &memos[0].ID})
+		require.NoError(t, err)
+		require.Len(t, chunks, 1)
+		require.Equal(t, []float64{0.3, 0.2, 0.1}, chunks[0].Embedding)
+
+		jobs, err := target.ListJobs(ctx, &store.FindJob{ID: &job.ID})
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		require.Equal(t, store.JobDead, jobs[0].Status)
+		require.Equal(t, int32(3), jobs[0].Attempts)
+		require.Equal(t, "timeout", jobs[0].LastError)
+	})
+
+	t.Run("New rows get ids after the copied ones", func(t *testing.T) {
+		memo, err := target.CreateMemo(ctx, &store.Memo{UID: "after-copy", CreatorID: user.ID, Content: "new", Visibility: store.Private})
+		require.NoError(t, err)
+		require.Greater(t, memo.ID, memos[3].ID)
+		newUser, err := createTestingUserWithRole(ctx, target, "new", store.RoleUser)
+		require.NoError(t, err)
+		require.Greater(t, newUser.ID, removed.ID)
+	})
+
+	t.Run("The target must be empty", func(t *testing.T) {
+		_, err := source.CopyTo(ctx, target)
+		require.ErrorContains(t, err, "not empty")
+	})
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
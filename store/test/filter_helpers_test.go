@@ -74,6 +74,22 @@ func (b *MemoBuilder) Tags(tags ...string) *MemoBuilder {
 	return b
 }
 
+func (b *MemoBuilder) Mentions(mentions ...string) *MemoBuilder {
+	if b.memo.Payload == nil {
+		b.memo.Payload = &storepb.MemoPayload{}
+	}
+	b.memo.Payload.Mentions = mentions
+	return b
+}
+
+func (b *MemoBuilder) Payload(fn func(*storepb.MemoPayload)) *MemoBuilder {
+	if b.memo.Payload == nil {
+		b.memo.Payload = &storepb.MemoPayload{}
+	}
+	fn(b.memo.Payload)
+	return b
+}
+
 func (b *MemoBuilder) Property(fn func(*storepb.MemoPayload_Property)) *MemoBuilder {
 	if b.memo.Payload == nil {
 		b.memo.Payload = &storepb.MemoPayload{}
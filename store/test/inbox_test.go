@@ -207,6 +207,40 @@ func TestInboxListByMessageType(t *testing.T) {
 	ts.Close()
 }
 
+func TestInboxListByMessageTypeList(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	for _, messageType := range []storepb.InboxMessage_Type{
+		storepb.InboxMessage_MEMO_COMMENT,
+		storepb.InboxMessage_DIGEST,
+		storepb.InboxMessage_TYPE_UNSPECIFIED,
+	} {
+		_, err = ts.CreateInbox(ctx, &store.Inbox{
+			SenderID:   user.ID,
+			ReceiverID: user.ID,
+			Status:     store.UNREAD,
+			Message:    &storepb.InboxMessage{Type: messageType},
+		})
+		require.NoError(t, err)
+	}
+
+	inboxes, err := ts.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID:      &user.ID,
+		MessageTypeList: []storepb.InboxMessage_Type{storepb.InboxMessage_MEMO_COMMENT, storepb.InboxMessage_DIGEST},
+	})
+	require.NoError(t, err)
+	require.Len(t, inboxes, 2)
+	for _, inbox := range inboxes {
+		require.NotEqual(t, storepb.InboxMessage_TYPE_UNSPECIFIED, inbox.Message.Type)
+	}
+
+	ts.Close()
+}
+
 func TestInboxListPagination(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -220,6 +220,44 @@ func TestInstanceSettingStorageSetting(t *testing.T) {
 	ts.Close()
 }
 
+func TestInstanceSettingEmailSetting(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	// Get default email setting (empty until configured)
+	emailSetting, err := ts.GetInstanceEmailSetting(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, emailSetting)
+	require.Empty(t, emailSetting.SmtpHost)
+
+	// Set email setting
+	_, err = ts.UpsertInstanceSetting(ctx, &storepb.InstanceSetting{
+		Key: storepb.InstanceSettingKey_EMAIL,
+		Value: &storepb.InstanceSetting_EmailSetting{
+			EmailSetting: &storepb.InstanceEmailSetting{
+				SmtpHost:              "smtp.example.com",
+				SmtpPort:              587,
+				SmtpPasswordEncrypted: "enc:v1:secret",
+				FromEmail:             "memos@example.com",
+				UseTls:                true,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// Verify
+	emailSetting, err = ts.GetInstanceEmailSetting(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "smtp.example.com", emailSetting.SmtpHost)
+	require.Equal(t, int32(587), emailSetting.SmtpPort)
+	require.Equal(t, "enc:v1:secret", emailSetting.SmtpPasswordEncrypted)
+	require.Equal(t, "memos@example.com", emailSetting.FromEmail)
+	require.True(t, emailSetting.UseTls)
+
+	ts.Close()
+}
+
 func TestInstanceSettingListAll(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
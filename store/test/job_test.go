@@ -0,0 +1,98 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestJobStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+
+	now := time.Now().Unix()
+	job, err := ts.CreateJob(ctx, &store.Job{
+		Type:        store.JobTypeMemoEmbedding,
+		Key:         "memos/1",
+		Status:      store.JobPending,
+		MaxAttempts: 3,
+		Payload: &storepb.JobPayload{
+			Payload: &storepb.JobPayload_MemoEmbedding_{MemoEmbedding: &storepb.JobPayload_MemoEmbedding{MemoId: 1}},
+		},
+	})
+	require.NoError(t, err)
+	require.NotZero(t, job.ID)
+	require.Zero(t, job.Attempts)
+	require.GreaterOrEqual(t, job.RunAfterTs, now-1)
+	later, err := ts.CreateJob(ctx, &store.Job{
+		Type:        store.JobTypeWebhook,
+		Status:      store.JobPending,
+		MaxAttempts: 5,
+		RunAfterTs:  now + 3600,
+	})
+	require.NoError(t, err)
+
+	jobType := store.JobTypeMemoEmbedding
+	key := "memos/1"
+	jobs, err := ts.ListJobs(ctx, &store.FindJob{Type: &jobType, Key: &key})
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, int32(1), jobs[0].Payload.GetMemoEmbedding().GetMemoId())
+
+	// Only due jobs are found by run time.
+	runBefore := now + 60
+	jobs, err = ts.ListJobs(ctx, &store.FindJob{
+		StatusList:      []store.JobStatus{store.JobPending},
+		RunBeforeTs:     &runBefore,
+		OrderByRunAfter: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, job.ID, jobs[0].ID)
+	jobs, err = ts.ListJobs(ctx, &store.FindJob{})
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	require.Equal(t, later.ID, jobs[0].ID)
+
+	// Guarded updates only apply in the expected status.
+	running := store.JobRunning
+	pending := store.JobPending
+	attempts := int32(1)
+	updated, err := ts.UpdateJob(ctx, &store.UpdateJob{ID: job.ID, ExpectedStatus: &pending, Status: &running, Attempts: &attempts})
+	require.NoError(t, err)
+	require.NotNil(t, updated)
+	require.Equal(t, store.JobRunning, updated.Status)
+	require.Equal(t, int32(1), updated.Attempts)
+	updated, err = ts.UpdateJob(ctx, &store.UpdateJob{ID: job.ID, ExpectedStatus: &pending, Status: &running})
+	require.NoError(t, err)
+	require.Nil(t, updated)
+
+	lastError := "boom"
+	dead := store.JobDead
+	updated, err = ts.UpdateJob(ctx, &store.UpdateJob{
+		ID:        job.ID,
+		Status:    &dead,
+		LastError: &lastError,
+		Payload: &storepb.JobPayload{
+			Payload: &storepb.JobPayload_MemoEmbedding_{MemoEmbedding: &storepb.JobPayload_MemoEmbedding{MemoId: 2}},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.JobDead, updated.Status)
+	require.Equal(t, "boom", updated.LastError)
+	require.Equal(t, int32(2), updated.Payload.GetMemoEmbedding().GetMemoId())
+
+	updatedBefore := now + 60
+	require.NoError(t, ts.DeleteJob(ctx, &store.DeleteJob{StatusList: []store.JobStatus{store.JobDead}, UpdatedBeforeTs: &updatedBefore}))
+	jobs, err = ts.ListJobs(ctx, &store.FindJob{})
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, later.ID, jobs[0].ID)
+}
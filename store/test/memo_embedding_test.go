@@ -2,6 +2,7 @@ package test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -9,40 +10,8 @@ import (
 	"github.com/usememos/memos/store"
 )
 
-func TestMemoEmbeddingStore_UnsupportedDriver(t *testing.T) {
+func TestMemoEmbeddingStore_CRUD(t *testing.T) {
 	t.Parallel()
-	if getDriverFromEnv() == "postgres" {
-		t.Skip("this case only validates non-postgres behavior")
-	}
-
-	ctx := context.Background()
-	ts := NewTestingStore(ctx, t)
-	defer ts.Close()
-
-	err := ts.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
-		MemoID:      1,
-		Model:       "test-model",
-		Dimension:   2,
-		Embedding:   []float64{0.1, 0.2},
-		ContentHash: "hash-a",
-	})
-	require.ErrorContains(t, err, "only supported for postgres driver")
-
-	_, err = ts.GetMemoEmbeddingContentHash(ctx, 1)
-	require.ErrorContains(t, err, "only supported for postgres driver")
-
-	_, err = ts.ListMemoEmbeddingsByMemoIDs(ctx, []int32{1})
-	require.ErrorContains(t, err, "only supported for postgres driver")
-
-	err = ts.DeleteMemoEmbeddingByMemoID(ctx, 1)
-	require.ErrorContains(t, err, "only supported for postgres driver")
-}
-
-func TestMemoEmbeddingStore_PostgresCRUD(t *testing.T) {
-	t.Parallel()
-	if getDriverFromEnv() != "postgres" {
-		t.Skip("postgres only")
-	}
 
 	ctx := context.Background()
 	ts := NewTestingStore(ctx, t)
@@ -115,11 +84,8 @@ func TestMemoEmbeddingStore_PostgresCRUD(t *testing.T) {
 	require.Len(t, embeddingMap, 0)
 }
 
-func TestMemoEmbeddingStore_PostgresValidation(t *testing.T) {
+func TestMemoEmbeddingStore_Validation(t *testing.T) {
 	t.Parallel()
-	if getDriverFromEnv() != "postgres" {
-		t.Skip("postgres only")
-	}
 
 	ctx := context.Background()
 	ts := NewTestingStore(ctx, t)
@@ -137,3 +103,275 @@ func TestMemoEmbeddingStore_PostgresValidation(t *testing.T) {
 	})
 	require.ErrorContains(t, err, "embedding vector cannot be empty")
 }
+
+func TestMemoEmbeddingStore_Search(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	vectors := [][]float64{
+		{1, 0, 0},
+		{0.8, 0.6, 0},
+		{0, 0, 1},
+	}
+	memoIDs := make([]int32, 0, len(vectors))
+	for i, vector := range vectors {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("memo-embedding-search-%d", i),
+			CreatorID:  user.ID,
+			Content:    fmt.Sprintf("memo %d", i),
+			Visibility: store.Private,
+		})
+		require.NoError(t, err)
+		memoIDs = append(memoIDs, memo.ID)
+
+		err = ts.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+			MemoID:      memo.ID,
+			Model:       "test-model",
+			Embedding:   vector,
+			ContentHash: "hash",
+		})
+		require.NoError(t, err)
+		if i == 0 {
+			// Load the index so later upserts exercise the incremental update path.
+			_, err = ts.SearchMemoEmbeddings(ctx, &store.SearchMemoEmbedding{Vector: vector})
+			require.NoError(t, err)
+		}
+	}
+
+	matches, err := ts.SearchMemoEmbeddings(ctx, &store.SearchMemoEmbedding{Vector: []float64{1, 0, 0}})
+	require.NoError(t, err)
+	require.Len(t, matches, 3)
+	require.Equal(t, []int32{memoIDs[0], memoIDs[1], memoIDs[2]}, []int32{matches[0].MemoID, matches[1].MemoID, matches[2].MemoID})
+	require.InDelta(t, 1.0, matches[0].Score, 1e-6)
+	require.InDelta(t, 0.8, matches[1].Score, 1e-6)
+
+	// Candidate list and limit.
+	matches, err = ts.SearchMemoEmbeddings(ctx, &store.SearchMemoEmbedding{
+		Vector:     []float64{0, 0, 1},
+		MemoIDList: []int32{memoIDs[0], memoIDs[2]},
+		Limit:      1,
+	})
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.Equal(t, memoIDs[2], matches[0].MemoID)
+
+	// Dimension mismatch yields no match.
+	matches, err = ts.SearchMemoEmbeddings(ctx, &store.SearchMemoEmbedding{Vector: []float64{1, 0}})
+	require.NoError(t, err)
+	require.Empty(t, matches)
+
+	// Deleting the memo removes it from the index.
+	err = ts.DeleteMemo(ctx, &store.DeleteMemo{ID: memoIDs[0]})
+	require.NoError(t, err)
+	matches, err = ts.SearchMemoEmbeddings(ctx, &store.SearchMemoEmbedding{Vector: []float64{1, 0, 0}})
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+	require.Equal(t, memoIDs[1], matches[0].MemoID)
+	embeddingMap, err := ts.ListMemoEmbeddingsByMemoIDs(ctx, []int32{memoIDs[0]})
+	require.NoError(t, err)
+	require.Empty(t, embeddingMap)
+}
+
+func TestMemoEmbeddingStore_SearchWithMemoFind(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	visibilities := []store.Visibility{store.Private, store.Public, store.Public}
+	vectors := [][]float64{
+		{1, 0},
+		{0.6, 0.8},
+		{0, 1},
+	}
+	memoIDs := make([]int32, 0, len(vectors))
+	for i, vector := range vectors {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("memo-embedding-find-%d", i),
+			CreatorID:  user.ID,
+			Content:    fmt.Sprintf("memo %d", i),
+			Visibility: visibilities[i],
+		})
+		require.NoError(t, err)
+		memoIDs = append(memoIDs, memo.ID)
+
+		err = ts.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+			MemoID:      memo.ID,
+			Model:       "test-model",
+			Embedding:   vector,
+			ContentHash: "hash",
+		})
+		require.NoError(t, err)
+	}
+
+	// The private memo is the closest match but is excluded by the condition.
+	matches, err := ts.SearchMemoEmbeddings(ctx, &store.SearchMemoEmbedding{
+		Vector: []float64{1, 0},
+		MemoFind: &store.FindMemo{
+			VisibilityList: []store.Visibility{store.Public},
+		},
+		Limit: 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.Equal(t, memoIDs[1], matches[0].MemoID)
+	require.InDelta(t, 0.6, matches[0].Score, 1e-6)
+
+	matches, err = ts.SearchMemoEmbeddings(ctx, &store.SearchMemoEmbedding{
+		Vector: []float64{1, 0},
+		MemoFind: &store.FindMemo{
+			Filters: []string{`visibility == "PUBLIC"`},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+	require.Equal(t, []int32{memoIDs[1], memoIDs[2]}, []int32{matches[0].MemoID, matches[1].MemoID})
+}
+
+func TestMemoEmbeddingStore_Chunks(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	chunkedMemo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-embedding-chunked",
+		CreatorID:  user.ID,
+		Content:    "# Travel\nPacking list.\n# Cooking\nPasta recipe.",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	plainMemo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-embedding-plain",
+		CreatorID:  user.ID,
+		Content:    "A short memo.",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	err = ts.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+		MemoID:      chunkedMemo.ID,
+		Model:       "test-model",
+		Embedding:   []float64{0.5, 0.5},
+		ContentHash: "hash-chunked",
+		Chunks: []*store.MemoEmbeddingChunk{
+			{StartOffset: 0, EndOffset: 22, Embedding: []float64{1, 0}},
+			{StartOffset: 23, EndOffset: 47, Embedding: []float64{0, 1}},
+		},
+	})
+	require.NoError(t, err)
+	err = ts.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+		MemoID:      plainMemo.ID,
+		Model:       "test-model",
+		Embedding:   []float64{0.6, 0.8},
+		ContentHash: "hash-plain",
+	})
+	require.NoError(t, err)
+
+	chunks, err := ts.ListMemoEmbeddingChunks(ctx, &store.FindMemoEmbeddingChunk{MemoIDList: []int32{chunkedMemo.ID, plainMemo.ID}})
+	require.NoError(t, err)
+	require.Len(t, chunks, 3)
+	require.Equal(t, chunkedMemo.ID, chunks[0].MemoID)
+	require.Equal(t, int32(1), chunks[1].ChunkIndex)
+	require.Equal(t, int32(23), chunks[1].StartOffset)
+	require.Equal(t, int32(47), chunks[1].EndOffset)
+	require.Equal(t, []float64{0, 1}, chunks[1].Embedding)
+	// An embedding without chunks is stored as a single chunk spanning the whole memo.
+	require.Equal(t, plainMemo.ID, chunks[2].MemoID)
+	require.Zero(t, chunks[2].EndOffset)
+	require.Equal(t, []float64{0.6, 0.8}, chunks[2].Embedding)
+
+	// Memos are ranked by their best chunk rather than by the memo embedding.
+	matches, err := ts.SearchMemoEmbeddings(ctx, &store.SearchMemoEmbedding{Vector: []float64{0, 1}})
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+	require.Equal(t, chunkedMemo.ID, matches[0].MemoID)
+	require.Equal(t, int32(1), matches[0].ChunkIndex)
+	require.InDelta(t, 1.0, matches[0].Score, 1e-6)
+	require.Equal(t, plainMemo.ID, matches[1].MemoID)
+	require.Zero(t, matches[1].ChunkIndex)
+
+	// Re-embedding replaces the previous chunks.
+	err = ts.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+		MemoID:      chunkedMemo.ID,
+		Model:       "test-model",
+		Embedding:   []float64{1, 0},
+		ContentHash: "hash-chunked-2",
+	})
+	require.NoError(t, err)
+	chunks, err = ts.ListMemoEmbeddingChunks(ctx, &store.FindMemoEmbeddingChunk{MemoID: &chunkedMemo.ID})
+	require.NoError(t, err)
+	require.Len(t, chunks, 1)
+	matches, err = ts.SearchMemoEmbeddings(ctx, &store.SearchMemoEmbedding{Vector: []float64{0, 1}, Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, plainMemo.ID, matches[0].MemoID)
+
+	err = ts.DeleteMemoEmbeddingByMemoID(ctx, chunkedMemo.ID)
+	require.NoError(t, err)
+	chunks, err = ts.ListMemoEmbeddingChunks(ctx, &store.FindMemoEmbeddingChunk{MemoID: &chunkedMemo.ID})
+	require.NoError(t, err)
+	require.Empty(t, chunks)
+}
+
+func TestMemoEmbeddingStore_ChunkVectorIndex(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+
+	if ts.DriverName() != "postgres" {
+		t.Skip("skipping pgvector index test for non-postgres driver")
+	}
+	var vectorColumnCount int
+	err := ts.GetDriver().GetDB().QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM information_schema.columns
+		WHERE table_name IN ('memo_embedding', 'memo_embedding_chunk') AND column_name = 'embedding_vector'`).Scan(&vectorColumnCount)
+	require.NoError(t, err)
+	if vectorColumnCount != 2 {
+		t.Skip("skipping pgvector index test without the pgvector extension")
+	}
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-embedding-chunk-index",
+		CreatorID:  user.ID,
+		Content:    "# Travel\nPacking list.\n# Cooking\nPasta recipe.",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	err = ts.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+		MemoID:      memo.ID,
+		Model:       "test-model",
+		Embedding:   []float64{0.5, 0.5, 0},
+		ContentHash: "hash-chunk-index",
+		Chunks: []*store.MemoEmbeddingChunk{
+			{StartOffset: 0, EndOffset: 22, Embedding: []float64{1, 0, 0}},
+			{StartOffset: 23, EndOffset: 47, Embedding: []float64{0, 1, 0}},
+		},
+	})
+	require.NoError(t, err)
+
+	matches, err := ts.SearchMemoEmbeddings(ctx, &store.SearchMemoEmbedding{Vector: []float64{0, 1, 0}, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.Equal(t, memo.ID, matches[0].MemoID)
+	require.Equal(t, int32(1), matches[0].ChunkIndex)
+
+	// Search ranks chunks, so the chunk table needs its own index for the dimension.
+	var indexCount int
+	err = ts.GetDriver().GetDB().QueryRowContext(ctx, "SELECT COUNT(*) FROM pg_indexes WHERE indexname = 'memo_embedding_chunk_vector_3_idx'").Scan(&indexCount)
+	require.NoError(t, err)
+	require.Equal(t, 1, indexCount)
+}
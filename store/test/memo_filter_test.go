@@ -94,6 +94,91 @@ func TestMemoFilterContentCaseSensitivity(t *testing.T) {
 	}
 }
 
+func TestMemoFilterContentMatches(t *testing.T) {
+	t.Parallel()
+	tc := NewMemoFilterTestContext(t)
+	defer tc.Close()
+
+	tc.CreateMemo(NewMemoBuilder("memo-upgrade", tc.User.ID).Content("Kubernetes upgrade checklist for the staging cluster"))
+	tc.CreateMemo(NewMemoBuilder("memo-cluster", tc.User.ID).Content("Cluster capacity planning"))
+	tc.CreateMemo(NewMemoBuilder("memo-grocery", tc.User.ID).Content("Grocery shopping list"))
+
+	// Test: single word, case-insensitive
+	memos := tc.ListWithFilter(`content.matches("CLUSTER")`)
+	require.Len(t, memos, 2)
+
+	// Test: all words are required
+	memos = tc.ListWithFilter(`content.matches("cluster kubernetes")`)
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-upgrade", memos[0].UID)
+
+	// Test: phrase
+	memos = tc.ListWithFilter(`content.matches("\"staging cluster\"")`)
+	require.Len(t, memos, 1)
+	memos = tc.ListWithFilter(`content.matches("\"cluster staging\"")`)
+	require.Len(t, memos, 0)
+
+	// Test: prefix
+	memos = tc.ListWithFilter(`content.matches("kuber*")`)
+	require.Len(t, memos, 1)
+	memos = tc.ListWithFilter(`content.matches("kuber")`)
+	require.Len(t, memos, 0)
+
+	// Test: combined with other conditions
+	memos = tc.ListWithFilter(`!content.matches("kubernetes") && content.contains("Cluster")`)
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-cluster", memos[0].UID)
+}
+
+func TestMemoFilterContentMatchesFollowsUpdates(t *testing.T) {
+	t.Parallel()
+	tc := NewMemoFilterTestContext(t)
+	defer tc.Close()
+
+	memo := tc.CreateMemo(NewMemoBuilder("memo-draft", tc.User.ID).Content("Draft about databases"))
+	require.Len(t, tc.ListWithFilter(`content.matches("databases")`), 1)
+
+	content := "Final notes about networking"
+	require.NoError(t, tc.Store.UpdateMemo(tc.Ctx, &store.UpdateMemo{ID: memo.ID, Content: &content}))
+	require.Len(t, tc.ListWithFilter(`content.matches("databases")`), 0)
+	require.Len(t, tc.ListWithFilter(`content.matches("networking")`), 1)
+
+	require.NoError(t, tc.Store.DeleteMemo(tc.Ctx, &store.DeleteMemo{ID: memo.ID}))
+	require.Len(t, tc.ListWithFilter(`content.matches("networking")`), 0)
+}
+
+func TestMemoFilterContentMatchesOrderByRelevance(t *testing.T) {
+	t.Parallel()
+	tc := NewMemoFilterTestContext(t)
+	defer tc.Close()
+
+	best := tc.CreateMemo(NewMemoBuilder("memo-best", tc.User.ID).Content("Kubernetes kubernetes kubernetes"))
+	other := tc.CreateMemo(NewMemoBuilder("memo-other", tc.User.ID).Content("Kubernetes is mentioned once in this longer memo about many other topics"))
+
+	// Without relevance the newest memo comes first.
+	memos := tc.ListWithFilter(`content.matches("kubernetes")`)
+	require.Len(t, memos, 2)
+	require.Equal(t, other.ID, memos[0].ID)
+
+	memos, err := tc.Store.ListMemos(tc.Ctx, &store.FindMemo{
+		Filters:          []string{`content.matches("kubernetes")`},
+		OrderByRelevance: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, memos, 2)
+	require.Equal(t, best.ID, memos[0].ID)
+	require.Equal(t, other.ID, memos[1].ID)
+
+	// Without a full-text condition relevance ordering is a no-op.
+	memos, err = tc.Store.ListMemos(tc.Ctx, &store.FindMemo{
+		Filters:          []string{`content.contains("kubernetes")`},
+		OrderByRelevance: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, memos, 2)
+	require.Equal(t, other.ID, memos[0].ID)
+}
+
 // =============================================================================
 // Visibility Field Tests
 // Schema: visibility (string, ==, !=)
@@ -273,6 +358,29 @@ func TestMemoFilterElementInTags(t *testing.T) {
 	require.Len(t, memos, 0)
 }
 
+func TestMemoFilterElementInMentions(t *testing.T) {
+	t.Parallel()
+	tc := NewMemoFilterTestContext(t)
+	defer tc.Close()
+
+	tc.CreateMemo(NewMemoBuilder("memo-mention", tc.User.ID).Content("Thanks @alice").Mentions("alice"))
+	tc.CreateMemo(NewMemoBuilder("memo-mentions", tc.User.ID).Content("@alice @bob").Mentions("alice", "bob"))
+	tc.CreateMemo(NewMemoBuilder("memo-no-mentions", tc.User.ID).Content("No mentions"))
+
+	// Test: "alice" in mentions
+	memos := tc.ListWithFilter(`"alice" in mentions`)
+	require.Len(t, memos, 2)
+
+	// Test: "bob" in mentions
+	memos = tc.ListWithFilter(`"bob" in mentions`)
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-mentions", memos[0].UID)
+
+	// Test: size(mentions) == 0
+	memos = tc.ListWithFilter(`size(mentions) == 0`)
+	require.Len(t, memos, 1)
+}
+
 func TestMemoFilterHierarchicalTags(t *testing.T) {
 	t.Parallel()
 	tc := NewMemoFilterTestContext(t)
@@ -379,6 +487,27 @@ func TestMemoFilterHasIncompleteTasks(t *testing.T) {
 	require.True(t, memos[0].Payload.Property.HasIncompleteTasks)
 }
 
+func TestMemoFilterContentHash(t *testing.T) {
+	t.Parallel()
+	tc := NewMemoFilterTestContext(t)
+	defer tc.Close()
+
+	tc.CreateMemo(NewMemoBuilder("memo-hash-a", tc.User.ID).
+		Content("Same content").
+		Property(func(p *storepb.MemoPayload_Property) { p.ContentHash = "hash-a" }))
+	tc.CreateMemo(NewMemoBuilder("memo-hash-b", tc.User.ID).
+		Content("Other content").
+		Property(func(p *storepb.MemoPayload_Property) { p.ContentHash = "hash-b" }))
+	tc.CreateMemo(NewMemoBuilder("memo-no-hash", tc.User.ID).Content("No hash"))
+
+	memos := tc.ListWithFilter(`content_hash == "hash-a"`)
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-hash-a", memos[0].UID)
+
+	memos = tc.ListWithFilter(`content_hash == "missing"`)
+	require.Len(t, memos, 0)
+}
+
 func TestMemoFilterCombinedJSONBool(t *testing.T) {
 	t.Parallel()
 	tc := NewMemoFilterTestContext(t)
@@ -499,6 +628,35 @@ func TestMemoFilterUpdatedTs(t *testing.T) {
 	require.Len(t, memos, 0)
 }
 
+func TestMemoFilterRemindAndPublishTs(t *testing.T) {
+	t.Parallel()
+	tc := NewMemoFilterTestContext(t)
+	defer tc.Close()
+
+	now := time.Now().Unix()
+	tc.CreateMemo(NewMemoBuilder("memo-due", tc.User.ID).
+		Content("Due reminder").
+		Payload(func(p *storepb.MemoPayload) { p.RemindTs = now - 60 }))
+	tc.CreateMemo(NewMemoBuilder("memo-scheduled", tc.User.ID).
+		Content("Scheduled memo").
+		Payload(func(p *storepb.MemoPayload) {
+			p.RemindTs = now + 3600
+			p.PublishTs = now + 3600
+		}))
+	tc.CreateMemo(NewMemoBuilder("memo-plain", tc.User.ID).Content("No schedule"))
+
+	memos := tc.ListWithFilter(`remind_ts <= now()`)
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-due", memos[0].UID)
+
+	memos = tc.ListWithFilter(`remind_ts > 0`)
+	require.Len(t, memos, 2)
+
+	memos = tc.ListWithFilter(`publish_ts > now()`)
+	require.Len(t, memos, 1)
+	require.Equal(t, "memo-scheduled", memos[0].UID)
+}
+
 func TestMemoFilterAllComparisonOperators(t *testing.T) {
 	t.Parallel()
 	tc := NewMemoFilterTestContext(t)
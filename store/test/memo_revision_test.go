@@ -0,0 +1,61 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoRevisionStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{UID: "revised", CreatorID: user.ID, Content: "current", Visibility: store.Public})
+	require.NoError(t, err)
+
+	contents := []string{"first", "second", "third"}
+	for i, content := range contents {
+		revision, err := ts.CreateMemoRevision(ctx, &store.MemoRevision{
+			MemoID:     memo.ID,
+			Content:    content,
+			Visibility: store.Private,
+			Payload:    &storepb.MemoPayload{Tags: []string{content}},
+			CreatedTs:  int64(1700000000 + i),
+		})
+		require.NoError(t, err)
+		require.NotZero(t, revision.ID)
+		require.Equal(t, int64(1700000000+i), revision.CreatedTs)
+	}
+
+	revisions, err := ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, revisions, 3)
+	require.Equal(t, "third", revisions[0].Content)
+	require.Equal(t, store.Private, revisions[0].Visibility)
+	require.Equal(t, []string{"third"}, revisions[0].Payload.Tags)
+
+	require.NoError(t, ts.PruneMemoRevisions(ctx, memo.ID, 2))
+	revisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	require.Equal(t, "second", revisions[1].Content)
+	// Pruning within the limit keeps all the revisions.
+	require.NoError(t, ts.PruneMemoRevisions(ctx, memo.ID, 2))
+	revisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+
+	// The revisions are deleted with the memo.
+	require.NoError(t, ts.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID}))
+	revisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Empty(t, revisions)
+}
@@ -417,3 +417,43 @@ func TestMemoWithPayload(t *testing.T) {
 
 	ts.Close()
 }
+
+func TestMemoUpdateWithExpectedPayload(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "guarded-memo",
+		CreatorID:  user.ID,
+		Content:    "content #work",
+		Visibility: store.Private,
+		Payload:    &storepb.MemoPayload{Tags: []string{"work"}, RemindTs: 100},
+	})
+	require.NoError(t, err)
+
+	// The payload is updated while it is the expected one.
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:              memo.ID,
+		Payload:         &storepb.MemoPayload{Tags: []string{"work"}},
+		ExpectedPayload: &storepb.MemoPayload{Tags: []string{"work"}, RemindTs: 100},
+	})
+	require.NoError(t, err)
+
+	// A stale expected payload leaves the memo untouched.
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:              memo.ID,
+		Payload:         &storepb.MemoPayload{},
+		ExpectedPayload: &storepb.MemoPayload{Tags: []string{"work"}, RemindTs: 100},
+	})
+	require.ErrorIs(t, err, store.ErrMemoPayloadChanged)
+
+	found, err := ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"work"}, found.Payload.Tags)
+	require.Zero(t, found.Payload.RemindTs)
+
+	ts.Close()
+}
@@ -318,6 +318,37 @@ func TestUserSettingShortcuts(t *testing.T) {
 	ts.Close()
 }
 
+func TestUserSettingDigest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSetting_DIGEST,
+		Value: &storepb.UserSetting_Digest{Digest: &storepb.DigestUserSetting{
+			Frequency:  storepb.DigestUserSetting_WEEKLY,
+			Channels:   []storepb.DigestUserSetting_Channel{storepb.DigestUserSetting_EMAIL, storepb.DigestUserSetting_INBOX},
+			ShortcutId: "shortcut-1",
+		}},
+	})
+	require.NoError(t, err)
+
+	// Digest settings of all users are listed by key.
+	settings, err := ts.ListUserSettings(ctx, &store.FindUserSetting{Key: storepb.UserSetting_DIGEST})
+	require.NoError(t, err)
+	require.Len(t, settings, 1)
+	digest := settings[0].GetDigest()
+	require.Equal(t, user.ID, settings[0].UserId)
+	require.Equal(t, storepb.DigestUserSetting_WEEKLY, digest.Frequency)
+	require.Equal(t, []storepb.DigestUserSetting_Channel{storepb.DigestUserSetting_EMAIL, storepb.DigestUserSetting_INBOX}, digest.Channels)
+	require.Equal(t, "shortcut-1", digest.ShortcutId)
+
+	ts.Close()
+}
+
 func TestUserSettingGetUserByPATHash(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -0,0 +1,76 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestWebhookDeliveryStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	create := func(webhookID string) *store.WebhookDelivery {
+		delivery, err := ts.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+			CreatorID:    user.ID,
+			WebhookID:    webhookID,
+			URL:          "https://example.com/hook",
+			ActivityType: "memos.memo.created",
+			RequestBody:  `{"activityType":"memos.memo.created"}`,
+			Status:       store.WebhookDeliveryPending,
+		})
+		require.NoError(t, err)
+		return delivery
+	}
+	first := create("hook-a")
+	require.NotZero(t, first.ID)
+	require.Zero(t, first.Attempts)
+	require.NotZero(t, first.CreatedTs)
+	second := create("hook-a")
+	create("hook-b")
+
+	// Deliveries are listed per webhook, newest first.
+	webhookID := "hook-a"
+	deliveries, err := ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{CreatorID: &user.ID, WebhookID: &webhookID})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	require.Equal(t, second.ID, deliveries[0].ID)
+	require.Equal(t, `{"activityType":"memos.memo.created"}`, deliveries[1].RequestBody)
+
+	succeeded, attempts, statusCode, responseBody := store.WebhookDeliverySucceeded, int32(2), int32(200), `{"code":0}`
+	updated, err := ts.UpdateWebhookDelivery(ctx, &store.UpdateWebhookDelivery{
+		ID:                 first.ID,
+		Status:             &succeeded,
+		Attempts:           &attempts,
+		ResponseStatusCode: &statusCode,
+		ResponseBody:       &responseBody,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.WebhookDeliverySucceeded, updated.Status)
+	require.Equal(t, int32(2), updated.Attempts)
+	require.Equal(t, int32(200), updated.ResponseStatusCode)
+	require.Equal(t, `{"code":0}`, updated.ResponseBody)
+	require.Equal(t, "https://example.com/hook", updated.URL)
+
+	// Deleting by creation time keeps newer deliveries.
+	createdBefore := time.Now().Add(-time.Hour).Unix()
+	require.NoError(t, ts.DeleteWebhookDelivery(ctx, &store.DeleteWebhookDelivery{WebhookID: &webhookID, CreatedBeforeTs: &createdBefore}))
+	deliveries, err = ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{WebhookID: &webhookID})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+
+	require.NoError(t, ts.DeleteWebhookDelivery(ctx, &store.DeleteWebhookDelivery{CreatorID: &user.ID, WebhookID: &webhookID}))
+	deliveries, err = ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, "hook-b", deliveries[0].WebhookID)
+}
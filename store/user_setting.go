@@ -431,6 +431,12 @@ func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 			return nil, err
 		}
 		userSetting.Value = &storepb.UserSetting_Webhooks{Webhooks: webhooksUserSetting}
+	case storepb.UserSetting_DIGEST:
+		digestUserSetting := &storepb.DigestUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), digestUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Digest{Digest: digestUserSetting}
 	default:
 		return nil, nil
 	}
@@ -479,6 +485,13 @@ func convertUserSettingToRaw(userSetting *storepb.UserSetting) (*UserSetting, er
 			return nil, err
 		}
 		raw.Value = string(value)
+	case storepb.UserSetting_DIGEST:
+		digestUserSetting := userSetting.GetDigest()
+		value, err := protojson.Marshal(digestUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
 	default:
 		return nil, errors.Errorf("unsupported user setting key: %v", userSetting.Key)
 	}
@@ -0,0 +1,202 @@
+package store
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// VectorMatch is a single nearest-neighbour result.
+type VectorMatch struct {
+	MemoID int32
+	// ChunkIndex is the index of the best matching chunk of the memo.
+	ChunkIndex int32
+	Score      float64
+}
+
+// VectorIndex is an in-process nearest-neighbour index over memo chunk embeddings.
+// The store keeps it in sync with the memo_embedding_chunk table so that semantic search
+// works the same way on every driver.
+type VectorIndex interface {
+	// Upsert adds or replaces the chunk vectors of a memo, indexed by chunk index.
+	Upsert(memoID int32, vectors [][]float64)
+	// Delete removes the vectors of a memo.
+	Delete(memoID int32)
+	// Search returns the memos whose best chunk is most similar to query, ordered by descending cosine similarity.
+	// When candidates is non-nil only those memos are considered. A limit of zero means no limit.
+	Search(query []float64, candidates []int32, limit int) []*VectorMatch
+	// Len returns the number of indexed memos.
+	Len() int
+}
+
+// NewMemoryVectorIndex creates a brute-force vector index kept in memory.
+// Vectors are normalized on insert and stored as float32 to halve the memory footprint.
+func NewMemoryVectorIndex() VectorIndex {
+	return &memoryVectorIndex{
+		vectors: make(map[int32][][]float32),
+	}
+}
+
+type memoryVectorIndex struct {
+	mu sync.RWMutex
+	// vectors holds the normalized chunk vectors of each memo; a zero vector is kept as nil to preserve chunk indexes.
+	vectors map[int32][][]float32
+}
+
+func (idx *memoryVectorIndex) Upsert(memoID int32, vectors [][]float64) {
+	normalized := make([][]float32, len(vectors))
+	indexed := false
+	for i, vector := range vectors {
+		normalized[i] = normalizeVector(vector)
+		indexed = indexed || normalized[i] != nil
+	}
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	if !indexed {
+		delete(idx.vectors, memoID)
+		return
+	}
+	idx.vectors[memoID] = normalized
+}
+
+func (idx *memoryVectorIndex) Delete(memoID int32) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	delete(idx.vectors, memoID)
+}
+
+func (idx *memoryVectorIndex) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.vectors)
+}
+
+func (idx *memoryVectorIndex) Search(query []float64, candidates []int32, limit int) []*VectorMatch {
+	normalizedQuery := normalizeVector(query)
+	if normalizedQuery == nil {
+		return []*VectorMatch{}
+	}
+
+	idx.mu.RLock()
+	matches := make([]*VectorMatch, 0)
+	score := func(memoID int32, vectors [][]float32) {
+		var best *VectorMatch
+		for chunkIndex, vector := range vectors {
+			if len(vector) != len(normalizedQuery) {
+				continue
+			}
+			var dotProduct float64
+			for i := range vector {
+				dotProduct += float64(vector[i]) * float64(normalizedQuery[i])
+			}
+			if best == nil || dotProduct > best.Score {
+				best = &VectorMatch{MemoID: memoID, ChunkIndex: int32(chunkIndex), Score: dotProduct}
+			}
+		}
+		if best != nil {
+			matches = append(matches, best)
+		}
+	}
+	if candidates == nil {
+		for memoID, vectors := range idx.vectors {
+			score(memoID, vectors)
+		}
+	} else {
+		for _, memoID := range candidates {
+			if vectors, ok := idx.vectors[memoID]; ok {
+				score(memoID, vectors)
+			}
+		}
+	}
+	idx.mu.RUnlock()
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score == matches[j].Score {
+			return matches[i].MemoID > matches[j].MemoID
+		}
+		return matches[i].Score > matches[j].Score
+	})
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+func normalizeVector(vector []float64) []float32 {
+	var norm float64
+	for _, value := range vector {
+		norm += value * value
+	}
+	if len(vector) == 0 || norm == 0 {
+		return nil
+	}
+	norm = math.Sqrt(norm)
+	normalized := make([]float32, len(vector))
+	for i, value := range vector {
+		normalized[i] = float32(value / norm)
+	}
+	return normalized
+}
+
+// CosineSimilarity returns the cosine similarity of two vectors.
+// The second return value is false when the vectors are empty, of different length or zero.
+func CosineSimilarity(a, b []float64) (float64, bool) {
+	if len(a) == 0 || len(b) == 0 || len(a) != len(b) {
+		return 0, false
+	}
+
+	var dotProduct float64
+	var normA float64
+	var normB float64
+	for i := range a {
+		dotProduct += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0, false
+	}
+	return dotProduct / (math.Sqrt(normA) * math.Sqrt(normB)), true
+}
+
+// getVectorIndex returns the vector index, loading it from the database on first use.
+func (s *Store) getVectorIndex(ctx context.Context) (VectorIndex, error) {
+	s.vectorIndexMu.Lock()
+	defer s.vectorIndexMu.Unlock()
+	if s.vectorIndexLoaded {
+		return s.vectorIndex, nil
+	}
+
+	list, err := s.driver.ListMemoEmbeddingChunks(ctx, &FindMemoEmbeddingChunk{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load memo embedding chunks")
+	}
+	// Chunks are listed by memo and chunk index.
+	for start := 0; start < len(list); {
+		end := start
+		vectors := [][]float64{}
+		for ; end < len(list) && list[end].MemoID == list[start].MemoID; end++ {
+			for len(vectors) < int(list[end].ChunkIndex) {
+				vectors = append(vectors, nil)
+			}
+			vectors = append(vectors, list[end].Embedding)
+		}
+		s.vectorIndex.Upsert(list[start].MemoID, vectors)
+		start = end
+	}
+	s.vectorIndexLoaded = true
+	return s.vectorIndex, nil
+}
+
+// updateVectorIndex applies a change to the vector index if it has already been loaded.
+// An index that has not been loaded yet picks the change up from the database on first use.
+func (s *Store) updateVectorIndex(update func(VectorIndex)) {
+	s.vectorIndexMu.Lock()
+	defer s.vectorIndexMu.Unlock()
+	if s.vectorIndexLoaded {
+		update(s.vectorIndex)
+	}
+}
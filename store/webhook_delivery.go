@@ -0,0 +1,92 @@
+package store
+
+import (
+	"context"
+)
+
+// WebhookDeliveryStatus is the status of a webhook delivery.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending is a delivery waiting for an attempt or a retry.
+	WebhookDeliveryPending WebhookDeliveryStatus = "PENDING"
+	// WebhookDeliverySucceeded is a delivery accepted by the receiver.
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	// WebhookDeliveryFailed is a delivery that failed on all attempts.
+	WebhookDeliveryFailed WebhookDeliveryStatus = "FAILED"
+)
+
+func (s WebhookDeliveryStatus) String() string {
+	return string(s)
+}
+
+// WebhookDelivery is a request sent to a user webhook, updated on every attempt.
+type WebhookDelivery struct {
+	ID        int32
+	CreatorID int32
+	// WebhookID is the id of the webhook in the webhooks user setting of the creator.
+	WebhookID    string
+	URL          string
+	ActivityType string
+	RequestBody  string
+	Status       WebhookDeliveryStatus
+	Attempts     int32
+	// ResponseStatusCode is the HTTP status code of the last attempt, 0 when no response was received.
+	ResponseStatusCode int32
+	ResponseBody       string
+	Error              string
+	CreatedTs          int64
+	UpdatedTs          int64
+}
+
+type FindWebhookDelivery struct {
+	ID        *int32
+	CreatorID *int32
+	WebhookID *string
+	Limit     *int
+	Offset    *int
+}
+
+type UpdateWebhookDelivery struct {
+	ID                 int32
+	Status             *WebhookDeliveryStatus
+	Attempts           *int32
+	ResponseStatusCode *int32
+	ResponseBody       *string
+	Error              *string
+}
+
+type DeleteWebhookDelivery struct {
+	CreatorID *int32
+	WebhookID *string
+	// CreatedBeforeTs only deletes deliveries created before the timestamp.
+	CreatedBeforeTs *int64
+}
+
+func (s *Store) CreateWebhookDelivery(ctx context.Context, create *WebhookDelivery) (*WebhookDelivery, error) {
+	return s.driver.CreateWebhookDelivery(ctx, create)
+}
+
+// ListWebhookDeliveries lists the deliveries, newest first.
+func (s *Store) ListWebhookDeliveries(ctx context.Context, find *FindWebhookDelivery) ([]*WebhookDelivery, error) {
+	return s.driver.ListWebhookDeliveries(ctx, find)
+}
+
+func (s *Store) GetWebhookDelivery(ctx context.Context, find *FindWebhookDelivery) (*WebhookDelivery, error) {
+	list, err := s.ListWebhookDeliveries(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateWebhookDelivery(ctx context.Context, update *UpdateWebhookDelivery) (*WebhookDelivery, error) {
+	return s.driver.UpdateWebhookDelivery(ctx, update)
+}
+
+func (s *Store) DeleteWebhookDelivery(ctx context.Context, delete *DeleteWebhookDelivery) error {
+	return s.driver.DeleteWebhookDelivery(ctx, delete)
+}
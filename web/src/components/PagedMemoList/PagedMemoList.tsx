@@ -34,9 +34,6 @@ interface Props {
 
 const resolveSemanticSearchErrorMessage = (message: string, t: ReturnType<typeof useTranslate>): string => {
   const normalized = message.toLowerCase();
-  if (normalized.includes("semantic search only supports postgres driver")) {
-    return t("memo.semantic-search-error-postgres-only");
-  }
   if (normalized.includes("semantic search is not configured")) {
     return t("memo.semantic-search-error-not-configured");
   }
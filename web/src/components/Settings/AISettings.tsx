@@ -4,6 +4,7 @@ import { toast } from "react-hot-toast";
 import { Button } from "@/components/ui/button";
 import { Input } from "@/components/ui/input";
 import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from "@/components/ui/select";
+import { Switch } from "@/components/ui/switch";
 import { Textarea } from "@/components/ui/textarea";
 import { useInstance } from "@/contexts/InstanceContext";
 import { handleError } from "@/lib/error";
@@ -96,12 +97,15 @@ const AISettings = () => {
       return true;
     }
     return (
+      aiSetting.embeddingProvider !== originalSetting.embeddingProvider ||
       aiSetting.openaiBaseUrl !== originalSetting.openaiBaseUrl ||
       aiSetting.openaiEmbeddingModel !== originalSetting.openaiEmbeddingModel ||
       !isSameStringArray(normalizedModelList, normalizedOriginalModelList) ||
       aiSetting.openaiEmbeddingMaxRetry !== originalSetting.openaiEmbeddingMaxRetry ||
       aiSetting.openaiEmbeddingRetryBackoffMs !== originalSetting.openaiEmbeddingRetryBackoffMs ||
-      aiSetting.semanticEmbeddingConcurrency !== originalSetting.semanticEmbeddingConcurrency
+      aiSetting.semanticEmbeddingConcurrency !== originalSetting.semanticEmbeddingConcurrency ||
+      aiSetting.memoEnrichmentEnabled !== originalSetting.memoEnrichmentEnabled ||
+      aiSetting.openaiCompletionModel !== originalSetting.openaiCompletionModel
     );
   }, [aiSetting, originalSetting]);
 
@@ -203,6 +207,25 @@ const AISettings = () => {
   return (
     <SettingSection>
       <SettingGroup title={t("setting.ai-section.title")}>
+        <SettingRow label={t("setting.ai-section.provider")} description={t("setting.ai-section.provider-description")}>
+          <Select
+            value={aiSetting.embeddingProvider || undefined}
+            onValueChange={(value) =>
+              updatePartialSetting({
+                embeddingProvider: value,
+              })
+            }
+          >
+            <SelectTrigger className="w-full sm:w-80">
+              <SelectValue placeholder={t("setting.ai-section.provider-default")} />
+            </SelectTrigger>
+            <SelectContent>
+              <SelectItem value="openai">{t("setting.ai-section.provider-openai")}</SelectItem>
+              <SelectItem value="local">{t("setting.ai-section.provider-local")}</SelectItem>
+            </SelectContent>
+          </Select>
+        </SettingRow>
+
         <SettingRow label={t("setting.ai-section.base-url")}>
           <Input
             className="w-full sm:w-80"
@@ -362,6 +385,24 @@ const AISettings = () => {
         </SettingRow>
       </SettingGroup>
 
+      <SettingGroup title={t("setting.ai-section.enrichment-title")}>
+        <SettingRow label={t("setting.ai-section.enrichment")} description={t("setting.ai-section.enrichment-description")}>
+          <Switch
+            checked={aiSetting.memoEnrichmentEnabled}
+            onCheckedChange={(checked) => updatePartialSetting({ memoEnrichmentEnabled: checked })}
+          />
+        </SettingRow>
+
+        <SettingRow label={t("setting.ai-section.completion-model")} description={t("setting.ai-section.completion-model-description")}>
+          <Input
+            className="w-full sm:w-80"
+            placeholder="gpt-4o-mini"
+            value={aiSetting.openaiCompletionModel}
+            onChange={(event) => updatePartialSetting({ openaiCompletionModel: event.target.value })}
+          />
+        </SettingRow>
+      </SettingGroup>
+
       <div className="w-full flex justify-end">
         <Button disabled={!allowSave} onClick={handleSaveAISetting}>
           {t("common.save")}
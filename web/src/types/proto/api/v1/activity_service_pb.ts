@@ -16,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/activity_service.proto.
  */
 export const file_api_v1_activity_service: GenFile = /*@__PURE__*/
-  fileDesc("Ch1hcGkvdjEvYWN0aXZpdHlfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxIsEDCghBY3Rpdml0eRIUCgRuYW1lGAEgASgJQgbgQQPgQQgSFAoHY3JlYXRvchgCIAEoCUID4EEDEi4KBHR5cGUYAyABKA4yGy5tZW1vcy5hcGkudjEuQWN0aXZpdHkuVHlwZUID4EEDEjAKBWxldmVsGAQgASgOMhwubWVtb3MuYXBpLnYxLkFjdGl2aXR5LkxldmVsQgPgQQMSNAoLY3JlYXRlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSMwoHcGF5bG9hZBgGIAEoCzIdLm1lbW9zLmFwaS52MS5BY3Rpdml0eVBheWxvYWRCA+BBAyIuCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIQCgxNRU1PX0NPTU1FTlQQASI9CgVMZXZlbBIVChFMRVZFTF9VTlNQRUNJRklFRBAAEggKBElORk8QARIICgRXQVJOEAISCQoFRVJST1IQAzpN6kFKChVtZW1vcy5hcGkudjEvQWN0aXZpdHkSFWFjdGl2aXRpZXMve2FjdGl2aXR5fRoEbmFtZSoKYWN0aXZpdGllczIIYWN0aXZpdHkiXgoPQWN0aXZpdHlQYXlsb2FkEkAKDG1lbW9fY29tbWVudBgBIAEoCzIoLm1lbW9zLmFwaS52MS5BY3Rpdml0eU1lbW9Db21tZW50UGF5bG9hZEgAQgkKB3BheWxvYWQiQAoaQWN0aXZpdHlNZW1vQ29tbWVudFBheWxvYWQSDAoEbWVtbxgBIAEoCRIUCgxyZWxhdGVkX21lbW8YAiABKAkiPgoVTGlzdEFjdGl2aXRpZXNSZXF1ZXN0EhEKCXBhZ2Vfc2l6ZRgBIAEoBRISCgpwYWdlX3Rva2VuGAIgASgJIl0KFkxpc3RBY3Rpdml0aWVzUmVzcG9uc2USKgoKYWN0aXZpdGllcxgBIAMoCzIWLm1lbW9zLmFwaS52MS5BY3Rpdml0eRIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiQQoSR2V0QWN0aXZpdHlSZXF1ZXN0EisKBG5hbWUYASABKAlCHeBBAvpBFwoVbWVtb3MuYXBpLnYxL0FjdGl2aXR5Mv8BCg9BY3Rpdml0eVNlcnZpY2USdwoOTGlzdEFjdGl2aXRpZXMSIy5tZW1vcy5hcGkudjEuTGlzdEFjdGl2aXRpZXNSZXF1ZXN0GiQubWVtb3MuYXBpLnYxLkxpc3RBY3Rpdml0aWVzUmVzcG9uc2UiGoLT5JMCFBISL2FwaS92MS9hY3Rpdml0aWVzEnMKC0dldEFjdGl2aXR5EiAubWVtb3MuYXBpLnYxLkdldEFjdGl2aXR5UmVxdWVzdBoWLm1lbW9zLmFwaS52MS5BY3Rpdml0eSIq2kEEbmFtZYLT5JMCHRIbL2FwaS92MS97bmFtZT1hY3Rpdml0aWVzLyp9QqwBChBjb20ubWVtb3MuYXBpLnYxQhRBY3Rpdml0eVNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_timestamp]);
+  fileDesc("Ch1hcGkvdjEvYWN0aXZpdHlfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxIuYDCghBY3Rpdml0eRIUCgRuYW1lGAEgASgJQgbgQQPgQQgSFAoHY3JlYXRvchgCIAEoCUID4EEDEi4KBHR5cGUYAyABKA4yGy5tZW1vcy5hcGkudjEuQWN0aXZpdHkuVHlwZUID4EEDEjAKBWxldmVsGAQgASgOMhwubWVtb3MuYXBpLnYxLkFjdGl2aXR5LkxldmVsQgPgQQMSNAoLY3JlYXRlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSMwoHcGF5bG9hZBgGIAEoCzIdLm1lbW9zLmFwaS52MS5BY3Rpdml0eVBheWxvYWRCA+BBAyJTCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABIQCgxNRU1PX0NPTU1FTlQQARIQCgxNRU1PX01FTlRJT04QAhIRCg1NRU1PX1JFTUlOREVSEAMiPQoFTGV2ZWwSFQoRTEVWRUxfVU5TUEVDSUZJRUQQABIICgRJTkZPEAESCAoEV0FSThACEgkKBUVSUk9SEAM6TepBSgoVbWVtb3MuYXBpLnYxL0FjdGl2aXR5EhVhY3Rpdml0aWVzL3thY3Rpdml0eX0aBG5hbWUqCmFjdGl2aXRpZXMyCGFjdGl2aXR5IuQBCg9BY3Rpdml0eVBheWxvYWQSQAoMbWVtb19jb21tZW50GAEgASgLMigubWVtb3MuYXBpLnYxLkFjdGl2aXR5TWVtb0NvbW1lbnRQYXlsb2FkSAASQAoMbWVtb19tZW50aW9uGAIgASgLMigubWVtb3MuYXBpLnYxLkFjdGl2aXR5TWVtb01lbnRpb25QYXlsb2FkSAASQgoNbWVtb19yZW1pbmRlchgDIAEoCzIpLm1lbW9zLmFwaS52MS5BY3Rpdml0eU1lbW9SZW1pbmRlclBheWxvYWRIAEIJCgdwYXlsb2FkIkAKGkFjdGl2aXR5TWVtb0NvbW1lbnRQYXlsb2FkEgwKBG1lbW8YASABKAkSFAoMcmVsYXRlZF9tZW1vGAIgASgJIioKGkFjdGl2aXR5TWVtb01lbnRpb25QYXlsb2FkEgwKBG1lbW8YASABKAkiKwobQWN0aXZpdHlNZW1vUmVtaW5kZXJQYXlsb2FkEgwKBG1lbW8YASABKAkiPgoVTGlzdEFjdGl2aXRpZXNSZXF1ZXN0EhEKCXBhZ2Vfc2l6ZRgBIAEoBRISCgpwYWdlX3Rva2VuGAIgASgJIl0KFkxpc3RBY3Rpdml0aWVzUmVzcG9uc2USKgoKYWN0aXZpdGllcxgBIAMoCzIWLm1lbW9zLmFwaS52MS5BY3Rpdml0eRIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiQQoSR2V0QWN0aXZpdHlSZXF1ZXN0EisKBG5hbWUYASABKAlCHeBBAvpBFwoVbWVtb3MuYXBpLnYxL0FjdGl2aXR5Mv8BCg9BY3Rpdml0eVNlcnZpY2USdwoOTGlzdEFjdGl2aXRpZXMSIy5tZW1vcy5hcGkudjEuTGlzdEFjdGl2aXRpZXNSZXF1ZXN0GiQubWVtb3MuYXBpLnYxLkxpc3RBY3Rpdml0aWVzUmVzcG9uc2UiGoLT5JMCFBISL2FwaS92MS9hY3Rpdml0aWVzEnMKC0dldEFjdGl2aXR5EiAubWVtb3MuYXBpLnYxLkdldEFjdGl2aXR5UmVxdWVzdBoWLm1lbW9zLmFwaS52MS5BY3Rpdml0eSIq2kEEbmFtZYLT5JMCHRIbL2FwaS92MS97bmFtZT1hY3Rpdml0aWVzLyp9QqwBChBjb20ubWVtb3MuYXBpLnYxQhRBY3Rpdml0eVNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message memos.api.v1.Activity
@@ -93,6 +93,20 @@ export enum Activity_Type {
    * @generated from enum value: MEMO_COMMENT = 1;
    */
   MEMO_COMMENT = 1,
+
+  /**
+   * Memo mention activity.
+   *
+   * @generated from enum value: MEMO_MENTION = 2;
+   */
+  MEMO_MENTION = 2,
+
+  /**
+   * Memo reminder activity.
+   *
+   * @generated from enum value: MEMO_REMINDER = 3;
+   */
+  MEMO_REMINDER = 3,
 }
 
 /**
@@ -157,6 +171,22 @@ export type ActivityPayload = Message<"memos.api.v1.ActivityPayload"> & {
      */
     value: ActivityMemoCommentPayload;
     case: "memoComment";
+  } | {
+    /**
+     * Memo mention activity payload.
+     *
+     * @generated from field: memos.api.v1.ActivityMemoMentionPayload memo_mention = 2;
+     */
+    value: ActivityMemoMentionPayload;
+    case: "memoMention";
+  } | {
+    /**
+     * Memo reminder activity payload.
+     *
+     * @generated from field: memos.api.v1.ActivityMemoReminderPayload memo_reminder = 3;
+     */
+    value: ActivityMemoReminderPayload;
+    case: "memoReminder";
   } | { case: undefined; value?: undefined };
 };
 
@@ -197,6 +227,50 @@ export type ActivityMemoCommentPayload = Message<"memos.api.v1.ActivityMemoComme
 export const ActivityMemoCommentPayloadSchema: GenMessage<ActivityMemoCommentPayload> = /*@__PURE__*/
   messageDesc(file_api_v1_activity_service, 2);
 
+/**
+ * ActivityMemoMentionPayload represents the payload of a memo mention activity.
+ *
+ * @generated from message memos.api.v1.ActivityMemoMentionPayload
+ */
+export type ActivityMemoMentionPayload = Message<"memos.api.v1.ActivityMemoMentionPayload"> & {
+  /**
+   * The name of the memo mentioning the user.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string memo = 1;
+   */
+  memo: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ActivityMemoMentionPayload.
+ * Use `create(ActivityMemoMentionPayloadSchema)` to create a new message.
+ */
+export const ActivityMemoMentionPayloadSchema: GenMessage<ActivityMemoMentionPayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_activity_service, 3);
+
+/**
+ * ActivityMemoReminderPayload represents the payload of a memo reminder activity.
+ *
+ * @generated from message memos.api.v1.ActivityMemoReminderPayload
+ */
+export type ActivityMemoReminderPayload = Message<"memos.api.v1.ActivityMemoReminderPayload"> & {
+  /**
+   * The name of the memo the user is reminded about.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string memo = 1;
+   */
+  memo: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ActivityMemoReminderPayload.
+ * Use `create(ActivityMemoReminderPayloadSchema)` to create a new message.
+ */
+export const ActivityMemoReminderPayloadSchema: GenMessage<ActivityMemoReminderPayload> = /*@__PURE__*/
+  messageDesc(file_api_v1_activity_service, 4);
+
 /**
  * @generated from message memos.api.v1.ListActivitiesRequest
  */
@@ -225,7 +299,7 @@ export type ListActivitiesRequest = Message<"memos.api.v1.ListActivitiesRequest"
  * Use `create(ListActivitiesRequestSchema)` to create a new message.
  */
 export const ListActivitiesRequestSchema: GenMessage<ListActivitiesRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_activity_service, 3);
+  messageDesc(file_api_v1_activity_service, 5);
 
 /**
  * @generated from message memos.api.v1.ListActivitiesResponse
@@ -253,7 +327,7 @@ export type ListActivitiesResponse = Message<"memos.api.v1.ListActivitiesRespons
  * Use `create(ListActivitiesResponseSchema)` to create a new message.
  */
 export const ListActivitiesResponseSchema: GenMessage<ListActivitiesResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_activity_service, 4);
+  messageDesc(file_api_v1_activity_service, 6);
 
 /**
  * @generated from message memos.api.v1.GetActivityRequest
@@ -273,7 +347,7 @@ export type GetActivityRequest = Message<"memos.api.v1.GetActivityRequest"> & {
  * Use `create(GetActivityRequestSchema)` to create a new message.
  */
 export const GetActivityRequestSchema: GenMessage<GetActivityRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_activity_service, 5);
+  messageDesc(file_api_v1_activity_service, 7);
 
 /**
  * @generated from service memos.api.v1.ActivityService
@@ -0,0 +1,181 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file api/v1/event_service.proto (package memos.api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Memo, Reaction } from "./memo_service_pb";
+import { file_api_v1_memo_service } from "./memo_service_pb";
+import type { UserNotification } from "./user_service_pb";
+import { file_api_v1_user_service } from "./user_service_pb";
+import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/event_service.proto.
+ */
+export const file_api_v1_event_service: GenFile = /*@__PURE__*/
+  fileDesc("ChphcGkvdjEvZXZlbnRfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxIkMKE1N0cmVhbUV2ZW50c1JlcXVlc3QSLAoFdHlwZXMYASADKA4yGC5tZW1vcy5hcGkudjEuRXZlbnQuVHlwZUID4EEBItsDCgVFdmVudBIrCgR0eXBlGAEgASgOMhgubWVtb3MuYXBpLnYxLkV2ZW50LlR5cGVCA+BBAxI0CgtjcmVhdGVfdGltZRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxIlCgRtZW1vGAMgASgLMhIubWVtb3MuYXBpLnYxLk1lbW9CA+BBAxIoCgdjb21tZW50GAQgASgLMhIubWVtb3MuYXBpLnYxLk1lbW9CA+BBAxItCghyZWFjdGlvbhgFIAEoCzIWLm1lbW9zLmFwaS52MS5SZWFjdGlvbkID4EEDEjkKDG5vdGlmaWNhdGlvbhgGIAEoCzIeLm1lbW9zLmFwaS52MS5Vc2VyTm90aWZpY2F0aW9uQgPgQQMiswEKBFR5cGUSFAoQVFlQRV9VTlNQRUNJRklFRBAAEhAKDE1FTU9fQ1JFQVRFRBABEhAKDE1FTU9fVVBEQVRFRBACEhAKDE1FTU9fREVMRVRFRBADEhgKFE1FTU9fQ09NTUVOVF9DUkVBVEVEEAQSFQoRUkVBQ1RJT05fVVBTRVJURUQQBRIUChBSRUFDVElPTl9ERUxFVEVEEAYSGAoUTk9USUZJQ0FUSU9OX0NSRUFURUQQBzJaCgxFdmVudFNlcnZpY2USSgoMU3RyZWFtRXZlbnRzEiEubWVtb3MuYXBpLnYxLlN0cmVhbUV2ZW50c1JlcXVlc3QaEy5tZW1vcy5hcGkudjEuRXZlbnQiADABQqkBChBjb20ubWVtb3MuYXBpLnYxQhFFdmVudFNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_api_v1_memo_service, file_api_v1_user_service, file_google_api_field_behavior, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message memos.api.v1.StreamEventsRequest
+ */
+export type StreamEventsRequest = Message<"memos.api.v1.StreamEventsRequest"> & {
+  /**
+   * Optional. The types of the events to stream. All events are streamed when empty.
+   *
+   * @generated from field: repeated memos.api.v1.Event.Type types = 1;
+   */
+  types: Event_Type[];
+};
+
+/**
+ * Describes the message memos.api.v1.StreamEventsRequest.
+ * Use `create(StreamEventsRequestSchema)` to create a new message.
+ */
+export const StreamEventsRequestSchema: GenMessage<StreamEventsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_event_service, 0);
+
+/**
+ * @generated from message memos.api.v1.Event
+ */
+export type Event = Message<"memos.api.v1.Event"> & {
+  /**
+   * The type of the event.
+   *
+   * @generated from field: memos.api.v1.Event.Type type = 1;
+   */
+  type: Event_Type;
+
+  /**
+   * The time the event happened.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 2;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * The memo the event is about. For comments and reactions, the memo commented or reacted to.
+   *
+   * @generated from field: memos.api.v1.Memo memo = 3;
+   */
+  memo?: Memo;
+
+  /**
+   * The created comment, for MEMO_COMMENT_CREATED events.
+   *
+   * @generated from field: memos.api.v1.Memo comment = 4;
+   */
+  comment?: Memo;
+
+  /**
+   * The reaction, for REACTION_UPSERTED and REACTION_DELETED events.
+   *
+   * @generated from field: memos.api.v1.Reaction reaction = 5;
+   */
+  reaction?: Reaction;
+
+  /**
+   * The created notification, for NOTIFICATION_CREATED events.
+   *
+   * @generated from field: memos.api.v1.UserNotification notification = 6;
+   */
+  notification?: UserNotification;
+};
+
+/**
+ * Describes the message memos.api.v1.Event.
+ * Use `create(EventSchema)` to create a new message.
+ */
+export const EventSchema: GenMessage<Event> = /*@__PURE__*/
+  messageDesc(file_api_v1_event_service, 1);
+
+/**
+ * Event types.
+ *
+ * @generated from enum memos.api.v1.Event.Type
+ */
+export enum Event_Type {
+  /**
+   * Unspecified type.
+   *
+   * @generated from enum value: TYPE_UNSPECIFIED = 0;
+   */
+  TYPE_UNSPECIFIED = 0,
+
+  /**
+   * A memo was created or published.
+   *
+   * @generated from enum value: MEMO_CREATED = 1;
+   */
+  MEMO_CREATED = 1,
+
+  /**
+   * A memo was updated, archived or restored.
+   *
+   * @generated from enum value: MEMO_UPDATED = 2;
+   */
+  MEMO_UPDATED = 2,
+
+  /**
+   * A memo was deleted, or made private by its creator. Only the name of a memo made private is sent.
+   *
+   * @generated from enum value: MEMO_DELETED = 3;
+   */
+  MEMO_DELETED = 3,
+
+  /**
+   * A comment was created on a memo.
+   *
+   * @generated from enum value: MEMO_COMMENT_CREATED = 4;
+   */
+  MEMO_COMMENT_CREATED = 4,
+
+  /**
+   * A reaction was added to a memo.
+   *
+   * @generated from enum value: REACTION_UPSERTED = 5;
+   */
+  REACTION_UPSERTED = 5,
+
+  /**
+   * A reaction was removed from a memo.
+   *
+   * @generated from enum value: REACTION_DELETED = 6;
+   */
+  REACTION_DELETED = 6,
+
+  /**
+   * A notification was created for the current user.
+   *
+   * @generated from enum value: NOTIFICATION_CREATED = 7;
+   */
+  NOTIFICATION_CREATED = 7,
+}
+
+/**
+ * Describes the enum memos.api.v1.Event.Type.
+ */
+export const Event_TypeSchema: GenEnum<Event_Type> = /*@__PURE__*/
+  enumDesc(file_api_v1_event_service, 1, 0);
+
+/**
+ * @generated from service memos.api.v1.EventService
+ */
+export const EventService: GenService<{
+  /**
+   * StreamEvents streams the changes visible to the current user as they happen.
+   * It is only served over Connect, as the gateway does not support streaming.
+   *
+   * @generated from rpc memos.api.v1.EventService.StreamEvents
+   */
+  streamEvents: {
+    methodKind: "server_streaming";
+    input: typeof StreamEventsRequestSchema;
+    output: typeof EventSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_event_service, 0);
+
@@ -10,15 +10,15 @@ import { file_google_api_annotations } from "../../google/api/annotations_pb";
 import { file_google_api_client } from "../../google/api/client_pb";
 import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
 import { file_google_api_resource } from "../../google/api/resource_pb";
-import type { FieldMask } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_field_mask } from "@bufbuild/protobuf/wkt";
+import type { EmptySchema, FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file api/v1/instance_service.proto.
  */
 export const file_api_v1_instance_service: GenFile = /*@__PURE__*/
-  fileDesc("Ch1hcGkvdjEvaW5zdGFuY2Vfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxImkKD0luc3RhbmNlUHJvZmlsZRIPCgd2ZXJzaW9uGAIgASgJEgwKBGRlbW8YAyABKAgSFAoMaW5zdGFuY2VfdXJsGAYgASgJEiEKBWFkbWluGAcgASgLMhIubWVtb3MuYXBpLnYxLlVzZXIiGwoZR2V0SW5zdGFuY2VQcm9maWxlUmVxdWVzdCK+EAoPSW5zdGFuY2VTZXR0aW5nEhEKBG5hbWUYASABKAlCA+BBCBJHCg9nZW5lcmFsX3NldHRpbmcYAiABKAsyLC5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLkdlbmVyYWxTZXR0aW5nSAASRwoPc3RvcmFnZV9zZXR0aW5nGAMgASgLMiwubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5TdG9yYWdlU2V0dGluZ0gAElAKFG1lbW9fcmVsYXRlZF9zZXR0aW5nGAQgASgLMjAubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5NZW1vUmVsYXRlZFNldHRpbmdIABI9CgphaV9zZXR0aW5nGAUgASgLMicubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5BSVNldHRpbmdIABqHAwoOR2VuZXJhbFNldHRpbmcSIgoaZGlzYWxsb3dfdXNlcl9yZWdpc3RyYXRpb24YAiABKAgSHgoWZGlzYWxsb3dfcGFzc3dvcmRfYXV0aBgDIAEoCBIZChFhZGRpdGlvbmFsX3NjcmlwdBgEIAEoCRIYChBhZGRpdGlvbmFsX3N0eWxlGAUgASgJElIKDmN1c3RvbV9wcm9maWxlGAYgASgLMjoubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5HZW5lcmFsU2V0dGluZy5DdXN0b21Qcm9maWxlEh0KFXdlZWtfc3RhcnRfZGF5X29mZnNldBgHIAEoBRIgChhkaXNhbGxvd19jaGFuZ2VfdXNlcm5hbWUYCCABKAgSIAoYZGlzYWxsb3dfY2hhbmdlX25pY2tuYW1lGAkgASgIGkUKDUN1c3RvbVByb2ZpbGUSDQoFdGl0bGUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSEAoIbG9nb191cmwYAyABKAkaugMKDlN0b3JhZ2VTZXR0aW5nEk4KDHN0b3JhZ2VfdHlwZRgBIAEoDjI4Lm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuU3RvcmFnZVNldHRpbmcuU3RvcmFnZVR5cGUSGQoRZmlsZXBhdGhfdGVtcGxhdGUYAiABKAkSHAoUdXBsb2FkX3NpemVfbGltaXRfbWIYAyABKAMSSAoJczNfY29uZmlnGAQgASgLMjUubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5TdG9yYWdlU2V0dGluZy5TM0NvbmZpZxqGAQoIUzNDb25maWcSFQoNYWNjZXNzX2tleV9pZBgBIAEoCRIZChFhY2Nlc3Nfa2V5X3NlY3JldBgCIAEoCRIQCghlbmRwb2ludBgDIAEoCRIOCgZyZWdpb24YBCABKAkSDgoGYnVja2V0GAUgASgJEhYKDnVzZV9wYXRoX3N0eWxlGAYgASgIIkwKC1N0b3JhZ2VUeXBlEhwKGFNUT1JBR0VfVFlQRV9VTlNQRUNJRklFRBAAEgwKCERBVEFCQVNFEAESCQoFTE9DQUwQAhIGCgJTMxADGq0BChJNZW1vUmVsYXRlZFNldHRpbmcSIgoaZGlzYWxsb3dfcHVibGljX3Zpc2liaWxpdHkYASABKAgSIAoYZGlzcGxheV93aXRoX3VwZGF0ZV90aW1lGAIgASgIEhwKFGNvbnRlbnRfbGVuZ3RoX2xpbWl0GAMgASgFEiAKGGVuYWJsZV9kb3VibGVfY2xpY2tfZWRpdBgEIAEoCBIRCglyZWFjdGlvbnMYByADKAkawQQKCUFJU2V0dGluZxIXCg9vcGVuYWlfYmFzZV91cmwYASABKAkSHgoWb3BlbmFpX2VtYmVkZGluZ19tb2RlbBgCIAEoCRIWCg5vcGVuYWlfYXBpX2tleRgDIAEoCRIaChJvcGVuYWlfYXBpX2tleV9zZXQYBCABKAgSHAoUY2xlYXJfb3BlbmFpX2FwaV9rZXkYBSABKAgSIgoab3BlbmFpX2VtYmVkZGluZ19tYXhfcmV0cnkYBiABKAUSKQohb3BlbmFpX2VtYmVkZGluZ19yZXRyeV9iYWNrb2ZmX21zGAcgASgFEiYKHnNlbWFudGljX2VtYmVkZGluZ19jb25jdXJyZW5jeRgIIAEoBRIfChdvcGVuYWlfZW1iZWRkaW5nX21vZGVscxgJIAMoCRIgChhzZW1hbnRpY19yZWluZGV4X3J1bm5pbmcYCiABKAgSHgoWc2VtYW50aWNfcmVpbmRleF90b3RhbBgLIAEoBRIiChpzZW1hbnRpY19yZWluZGV4X3Byb2Nlc3NlZBgMIAEoBRIfChdzZW1hbnRpY19yZWluZGV4X2ZhaWxlZBgNIAEoBRIjChtzZW1hbnRpY19yZWluZGV4X3N0YXJ0ZWRfdHMYDiABKAMSIwobc2VtYW50aWNfcmVpbmRleF91cGRhdGVkX3RzGA8gASgDEh4KFnNlbWFudGljX3JlaW5kZXhfbW9kZWwYECABKAkSIAoYdHJpZ2dlcl9zZW1hbnRpY19yZWluZGV4GBEgASgIIk4KA0tleRITCg9LRVlfVU5TUEVDSUZJRUQQABILCgdHRU5FUkFMEAESCwoHU1RPUkFHRRACEhAKDE1FTU9fUkVMQVRFRBADEgYKAkFJEAQ6YepBXgocbWVtb3MuYXBpLnYxL0luc3RhbmNlU2V0dGluZxIbaW5zdGFuY2Uvc2V0dGluZ3Mve3NldHRpbmd9KhBpbnN0YW5jZVNldHRpbmdzMg9pbnN0YW5jZVNldHRpbmdCBwoFdmFsdWUiTwoZR2V0SW5zdGFuY2VTZXR0aW5nUmVxdWVzdBIyCgRuYW1lGAEgASgJQiTgQQL6QR4KHG1lbW9zLmFwaS52MS9JbnN0YW5jZVNldHRpbmciiQEKHFVwZGF0ZUluc3RhbmNlU2V0dGluZ1JlcXVlc3QSMwoHc2V0dGluZxgBIAEoCzIdLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmdCA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBATLbAwoPSW5zdGFuY2VTZXJ2aWNlEn4KEkdldEluc3RhbmNlUHJvZmlsZRInLm1lbW9zLmFwaS52MS5HZXRJbnN0YW5jZVByb2ZpbGVSZXF1ZXN0Gh0ubWVtb3MuYXBpLnYxLkluc3RhbmNlUHJvZmlsZSIggtPkkwIaEhgvYXBpL3YxL2luc3RhbmNlL3Byb2ZpbGUSjwEKEkdldEluc3RhbmNlU2V0dGluZxInLm1lbW9zLmFwaS52MS5HZXRJbnN0YW5jZVNldHRpbmdSZXF1ZXN0Gh0ubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZyIx2kEEbmFtZYLT5JMCJBIiL2FwaS92MS97bmFtZT1pbnN0YW5jZS9zZXR0aW5ncy8qfRK1AQoVVXBkYXRlSW5zdGFuY2VTZXR0aW5nEioubWVtb3MuYXBpLnYxLlVwZGF0ZUluc3RhbmNlU2V0dGluZ1JlcXVlc3QaHS5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nIlHaQRNzZXR0aW5nLHVwZGF0ZV9tYXNrgtPkkwI1OgdzZXR0aW5nMiovYXBpL3YxL3tzZXR0aW5nLm5hbWU9aW5zdGFuY2Uvc2V0dGluZ3MvKn1CrAEKEGNvbS5tZW1vcy5hcGkudjFCFEluc3RhbmNlU2VydmljZVByb3RvUAFaMGdpdGh1Yi5jb20vdXNlbWVtb3MvbWVtb3MvcHJvdG8vZ2VuL2FwaS92MTthcGl2MaICA01BWKoCDE1lbW9zLkFwaS5WMcoCDE1lbW9zXEFwaVxWMeICGE1lbW9zXEFwaVxWMVxHUEJNZXRhZGF0YeoCDk1lbW9zOjpBcGk6OlYxYgZwcm90bzM", [file_api_v1_user_service, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_field_mask]);
+  fileDesc("Ch1hcGkvdjEvaW5zdGFuY2Vfc2VydmljZS5wcm90bxIMbWVtb3MuYXBpLnYxImkKD0luc3RhbmNlUHJvZmlsZRIPCgd2ZXJzaW9uGAIgASgJEgwKBGRlbW8YAyABKAgSFAoMaW5zdGFuY2VfdXJsGAYgASgJEiEKBWFkbWluGAcgASgLMhIubWVtb3MuYXBpLnYxLlVzZXIiGwoZR2V0SW5zdGFuY2VQcm9maWxlUmVxdWVzdCLVFQoPSW5zdGFuY2VTZXR0aW5nEhEKBG5hbWUYASABKAlCA+BBCBJHCg9nZW5lcmFsX3NldHRpbmcYAiABKAsyLC5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLkdlbmVyYWxTZXR0aW5nSAASRwoPc3RvcmFnZV9zZXR0aW5nGAMgASgLMiwubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5TdG9yYWdlU2V0dGluZ0gAElAKFG1lbW9fcmVsYXRlZF9zZXR0aW5nGAQgASgLMjAubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5NZW1vUmVsYXRlZFNldHRpbmdIABI9CgphaV9zZXR0aW5nGAUgASgLMicubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5BSVNldHRpbmdIABJDCg1lbWFpbF9zZXR0aW5nGAYgASgLMioubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5FbWFpbFNldHRpbmdIABJFCg5iYWNrdXBfc2V0dGluZxgHIAEoCzIrLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmcuQmFja3VwU2V0dGluZ0gAGocDCg5HZW5lcmFsU2V0dGluZxIiChpkaXNhbGxvd191c2VyX3JlZ2lzdHJhdGlvbhgCIAEoCBIeChZkaXNhbGxvd19wYXNzd29yZF9hdXRoGAMgASgIEhkKEWFkZGl0aW9uYWxfc2NyaXB0GAQgASgJEhgKEGFkZGl0aW9uYWxfc3R5bGUYBSABKAkSUgoOY3VzdG9tX3Byb2ZpbGUYBiABKAsyOi5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLkdlbmVyYWxTZXR0aW5nLkN1c3RvbVByb2ZpbGUSHQoVd2Vla19zdGFydF9kYXlfb2Zmc2V0GAcgASgFEiAKGGRpc2FsbG93X2NoYW5nZV91c2VybmFtZRgIIAEoCBIgChhkaXNhbGxvd19jaGFuZ2Vfbmlja25hbWUYCSABKAgaRQoNQ3VzdG9tUHJvZmlsZRINCgV0aXRsZRgBIAEoCRITCgtkZXNjcmlwdGlvbhgCIAEoCRIQCghsb2dvX3VybBgDIAEoCRq6AwoOU3RvcmFnZVNldHRpbmcSTgoMc3RvcmFnZV90eXBlGAEgASgOMjgubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZy5TdG9yYWdlU2V0dGluZy5TdG9yYWdlVHlwZRIZChFmaWxlcGF0aF90ZW1wbGF0ZRgCIAEoCRIcChR1cGxvYWRfc2l6ZV9saW1pdF9tYhgDIAEoAxJICglzM19jb25maWcYBCABKAsyNS5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nLlN0b3JhZ2VTZXR0aW5nLlMzQ29uZmlnGoYBCghTM0NvbmZpZxIVCg1hY2Nlc3Nfa2V5X2lkGAEgASgJEhkKEWFjY2Vzc19rZXlfc2VjcmV0GAIgASgJEhAKCGVuZHBvaW50GAMgASgJEg4KBnJlZ2lvbhgEIAEoCRIOCgZidWNrZXQYBSABKAkSFgoOdXNlX3BhdGhfc3R5bGUYBiABKAgiTAoLU3RvcmFnZVR5cGUSHAoYU1RPUkFHRV9UWVBFX1VOU1BFQ0lGSUVEEAASDAoIREFUQUJBU0UQARIJCgVMT0NBTBACEgYKAlMzEAMa6gEKEk1lbW9SZWxhdGVkU2V0dGluZxIiChpkaXNhbGxvd19wdWJsaWNfdmlzaWJpbGl0eRgBIAEoCBIgChhkaXNwbGF5X3dpdGhfdXBkYXRlX3RpbWUYAiABKAgSHAoUY29udGVudF9sZW5ndGhfbGltaXQYAyABKAUSIAoYZW5hYmxlX2RvdWJsZV9jbGlja19lZGl0GAQgASgIEhEKCXJlYWN0aW9ucxgHIAMoCRIeChZkaXNhYmxlX21lbW9fcmV2aXNpb25zGAggASgIEhsKE21lbW9fcmV2aXNpb25fbGltaXQYCSABKAUanwUKCUFJU2V0dGluZxIXCg9vcGVuYWlfYmFzZV91cmwYASABKAkSHgoWb3BlbmFpX2VtYmVkZGluZ19tb2RlbBgCIAEoCRIWCg5vcGVuYWlfYXBpX2tleRgDIAEoCRIaChJvcGVuYWlfYXBpX2tleV9zZXQYBCABKAgSHAoUY2xlYXJfb3BlbmFpX2FwaV9rZXkYBSABKAgSIgoab3BlbmFpX2VtYmVkZGluZ19tYXhfcmV0cnkYBiABKAUSKQohb3BlbmFpX2VtYmVkZGluZ19yZXRyeV9iYWNrb2ZmX21zGAcgASgFEiYKHnNlbWFudGljX2VtYmVkZGluZ19jb25jdXJyZW5jeRgIIAEoBRIfChdvcGVuYWlfZW1iZWRkaW5nX21vZGVscxgJIAMoCRIgChhzZW1hbnRpY19yZWluZGV4X3J1bm5pbmcYCiABKAgSHgoWc2VtYW50aWNfcmVpbmRleF90b3RhbBgLIAEoBRIiChpzZW1hbnRpY19yZWluZGV4X3Byb2Nlc3NlZBgMIAEoBRIfChdzZW1hbnRpY19yZWluZGV4X2ZhaWxlZBgNIAEoBRIjChtzZW1hbnRpY19yZWluZGV4X3N0YXJ0ZWRfdHMYDiABKAMSIwobc2VtYW50aWNfcmVpbmRleF91cGRhdGVkX3RzGA8gASgDEh4KFnNlbWFudGljX3JlaW5kZXhfbW9kZWwYECABKAkSIAoYdHJpZ2dlcl9zZW1hbnRpY19yZWluZGV4GBEgASgIEhoKEmVtYmVkZGluZ19wcm92aWRlchgSIAEoCRIfChdvcGVuYWlfY29tcGxldGlvbl9tb2RlbBgTIAEoCRIfChdtZW1vX2VucmljaG1lbnRfZW5hYmxlZBgUIAEoCBrjAQoMRW1haWxTZXR0aW5nEhEKCXNtdHBfaG9zdBgBIAEoCRIRCglzbXRwX3BvcnQYAiABKAUSFQoNc210cF91c2VybmFtZRgDIAEoCRIVCg1zbXRwX3Bhc3N3b3JkGAQgASgJEhkKEXNtdHBfcGFzc3dvcmRfc2V0GAUgASgIEhsKE2NsZWFyX3NtdHBfcGFzc3dvcmQYBiABKAgSEgoKZnJvbV9lbWFpbBgHIAEoCRIRCglmcm9tX25hbWUYCCABKAkSDwoHdXNlX3RscxgJIAEoCBIPCgd1c2Vfc3NsGAogASgIGnEKDUJhY2t1cFNldHRpbmcSEAoIc2NoZWR1bGUYASABKAkSNQoLZGVzdGluYXRpb24YAiABKA4yIC5tZW1vcy5hcGkudjEuQmFja3VwLkRlc3RpbmF0aW9uEhcKD3JldGVudGlvbl9jb3VudBgDIAEoBSJlCgNLZXkSEwoPS0VZX1VOU1BFQ0lGSUVEEAASCwoHR0VORVJBTBABEgsKB1NUT1JBR0UQAhIQCgxNRU1PX1JFTEFURUQQAxIGCgJBSRAEEgkKBUVNQUlMEAUSCgoGQkFDS1VQEAY6YepBXgocbWVtb3MuYXBpLnYxL0luc3RhbmNlU2V0dGluZxIbaW5zdGFuY2Uvc2V0dGluZ3Mve3NldHRpbmd9KhBpbnN0YW5jZVNldHRpbmdzMg9pbnN0YW5jZVNldHRpbmdCBwoFdmFsdWUiTwoZR2V0SW5zdGFuY2VTZXR0aW5nUmVxdWVzdBIyCgRuYW1lGAEgASgJQiTgQQL6QR4KHG1lbW9zLmFwaS52MS9JbnN0YW5jZVNldHRpbmciiQEKHFVwZGF0ZUluc3RhbmNlU2V0dGluZ1JlcXVlc3QSMwoHc2V0dGluZxgBIAEoCzIdLm1lbW9zLmFwaS52MS5JbnN0YW5jZVNldHRpbmdCA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBASIuChRTZW5kVGVzdEVtYWlsUmVxdWVzdBIWCglyZWNpcGllbnQYASABKAlCA+BBASKwAgoGQmFja3VwEhQKBG5hbWUYASABKAlCBuBBA+BBCBI6CgtkZXN0aW5hdGlvbhgCIAEoDjIgLm1lbW9zLmFwaS52MS5CYWNrdXAuRGVzdGluYXRpb25CA+BBAxIVCghsb2NhdGlvbhgDIAEoCUID4EEDEhEKBHNpemUYBCABKANCA+BBAxI0CgtjcmVhdGVfdGltZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxIXCgpmaWxlX2NvdW50GAYgASgFQgPgQQMSHAoPZGVsZXRlZF9iYWNrdXBzGAcgAygJQgPgQQMiPQoLRGVzdGluYXRpb24SGwoXREVTVElOQVRJT05fVU5TUEVDSUZJRUQQABIJCgVMT0NBTBABEgYKAlMzEAIiUQoTQ3JlYXRlQmFja3VwUmVxdWVzdBI6CgtkZXN0aW5hdGlvbhgBIAEoDjIgLm1lbW9zLmFwaS52MS5CYWNrdXAuRGVzdGluYXRpb25CA+BBATLRBQoPSW5zdGFuY2VTZXJ2aWNlEn4KEkdldEluc3RhbmNlUHJvZmlsZRInLm1lbW9zLmFwaS52MS5HZXRJbnN0YW5jZVByb2ZpbGVSZXF1ZXN0Gh0ubWVtb3MuYXBpLnYxLkluc3RhbmNlUHJvZmlsZSIggtPkkwIaEhgvYXBpL3YxL2luc3RhbmNlL3Byb2ZpbGUSjwEKEkdldEluc3RhbmNlU2V0dGluZxInLm1lbW9zLmFwaS52MS5HZXRJbnN0YW5jZVNldHRpbmdSZXF1ZXN0Gh0ubWVtb3MuYXBpLnYxLkluc3RhbmNlU2V0dGluZyIx2kEEbmFtZYLT5JMCJBIiL2FwaS92MS97bmFtZT1pbnN0YW5jZS9zZXR0aW5ncy8qfRK1AQoVVXBkYXRlSW5zdGFuY2VTZXR0aW5nEioubWVtb3MuYXBpLnYxLlVwZGF0ZUluc3RhbmNlU2V0dGluZ1JlcXVlc3QaHS5tZW1vcy5hcGkudjEuSW5zdGFuY2VTZXR0aW5nIlHaQRNzZXR0aW5nLHVwZGF0ZV9tYXNrgtPkkwI1OgdzZXR0aW5nMiovYXBpL3YxL3tzZXR0aW5nLm5hbWU9aW5zdGFuY2Uvc2V0dGluZ3MvKn0ShQEKDVNlbmRUZXN0RW1haWwSIi5tZW1vcy5hcGkudjEuU2VuZFRlc3RFbWFpbFJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiOILT5JMCMjoBKiItL2FwaS92MS9pbnN0YW5jZS9zZXR0aW5ncy9FTUFJTDpzZW5kVGVzdEVtYWlsEmwKDENyZWF0ZUJhY2t1cBIhLm1lbW9zLmFwaS52MS5DcmVhdGVCYWNrdXBSZXF1ZXN0GhQubWVtb3MuYXBpLnYxLkJhY2t1cCIjgtPkkwIdOgEqIhgvYXBpL3YxL2luc3RhbmNlL2JhY2t1cHNCrAEKEGNvbS5tZW1vcy5hcGkudjFCFEluc3RhbmNlU2VydmljZVByb3RvUAFaMGdpdGh1Yi5jb20vdXNlbWVtb3MvbWVtb3MvcHJvdG8vZ2VuL2FwaS92MTthcGl2MaICA01BWKoCDE1lbW9zLkFwaS5WMcoCDE1lbW9zXEFwaVxWMeICGE1lbW9zXEFwaVxWMVxHUEJNZXRhZGF0YeoCDk1lbW9zOjpBcGk6OlYxYgZwcm90bzM", [file_api_v1_user_service, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
 
 /**
  * Instance profile message containing basic instance information.
@@ -119,6 +119,18 @@ export type InstanceSetting = Message<"memos.api.v1.InstanceSetting"> & {
      */
     value: InstanceSetting_AISetting;
     case: "aiSetting";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.InstanceSetting.EmailSetting email_setting = 6;
+     */
+    value: InstanceSetting_EmailSetting;
+    case: "emailSetting";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.InstanceSetting.BackupSetting backup_setting = 7;
+     */
+    value: InstanceSetting_BackupSetting;
+    case: "backupSetting";
   } | { case: undefined; value?: undefined };
 };
 
@@ -397,6 +409,21 @@ export type InstanceSetting_MemoRelatedSetting = Message<"memos.api.v1.InstanceS
    * @generated from field: repeated string reactions = 7;
    */
   reactions: string[];
+
+  /**
+   * disable_memo_revisions stops recording the prior versions of memos on update.
+   *
+   * @generated from field: bool disable_memo_revisions = 8;
+   */
+  disableMemoRevisions: boolean;
+
+  /**
+   * memo_revision_limit is the number of revisions kept per memo, the oldest ones are deleted beyond it.
+   * Defaults to 50.
+   *
+   * @generated from field: int32 memo_revision_limit = 9;
+   */
+  memoRevisionLimit: number;
 };
 
 /**
@@ -535,6 +562,30 @@ export type InstanceSetting_AISetting = Message<"memos.api.v1.InstanceSetting.AI
    * @generated from field: bool trigger_semantic_reindex = 17;
    */
   triggerSemanticReindex: boolean;
+
+  /**
+   * embedding_provider is the name of the embedding provider: "openai" calls an OpenAI-compatible API,
+   * "local" embeds in process without network access.
+   * Empty means using backend environment value or "openai".
+   *
+   * @generated from field: string embedding_provider = 18;
+   */
+  embeddingProvider: string;
+
+  /**
+   * openai_completion_model is the chat completion model used to enrich memos.
+   * Empty means using backend environment value or "gpt-4o-mini".
+   *
+   * @generated from field: string openai_completion_model = 19;
+   */
+  openaiCompletionModel: string;
+
+  /**
+   * memo_enrichment_enabled enables generating summaries, tag suggestions and categories on memo save.
+   *
+   * @generated from field: bool memo_enrichment_enabled = 20;
+   */
+  memoEnrichmentEnabled: boolean;
 };
 
 /**
@@ -544,6 +595,128 @@ export type InstanceSetting_AISetting = Message<"memos.api.v1.InstanceSetting.AI
 export const InstanceSetting_AISettingSchema: GenMessage<InstanceSetting_AISetting> = /*@__PURE__*/
   messageDesc(file_api_v1_instance_service, 2, 3);
 
+/**
+ * Email configuration settings for SMTP.
+ *
+ * @generated from message memos.api.v1.InstanceSetting.EmailSetting
+ */
+export type InstanceSetting_EmailSetting = Message<"memos.api.v1.InstanceSetting.EmailSetting"> & {
+  /**
+   * smtp_host is the host of the SMTP server. Emails are disabled when empty.
+   *
+   * @generated from field: string smtp_host = 1;
+   */
+  smtpHost: string;
+
+  /**
+   * smtp_port is the port of the SMTP server.
+   *
+   * @generated from field: int32 smtp_port = 2;
+   */
+  smtpPort: number;
+
+  /**
+   * smtp_username is the username of the SMTP server.
+   *
+   * @generated from field: string smtp_username = 3;
+   */
+  smtpUsername: string;
+
+  /**
+   * smtp_password is write-only plain text password from frontend.
+   * It is encrypted before being persisted by backend.
+   *
+   * @generated from field: string smtp_password = 4;
+   */
+  smtpPassword: string;
+
+  /**
+   * smtp_password_set indicates if backend already has a stored password.
+   *
+   * @generated from field: bool smtp_password_set = 5;
+   */
+  smtpPasswordSet: boolean;
+
+  /**
+   * clear_smtp_password clears stored password when true.
+   *
+   * @generated from field: bool clear_smtp_password = 6;
+   */
+  clearSmtpPassword: boolean;
+
+  /**
+   * from_email is the sender email address.
+   *
+   * @generated from field: string from_email = 7;
+   */
+  fromEmail: string;
+
+  /**
+   * from_name is the sender display name.
+   *
+   * @generated from field: string from_name = 8;
+   */
+  fromName: string;
+
+  /**
+   * use_tls upgrades the connection with STARTTLS.
+   *
+   * @generated from field: bool use_tls = 9;
+   */
+  useTls: boolean;
+
+  /**
+   * use_ssl connects with implicit TLS.
+   *
+   * @generated from field: bool use_ssl = 10;
+   */
+  useSsl: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceSetting.EmailSetting.
+ * Use `create(InstanceSetting_EmailSettingSchema)` to create a new message.
+ */
+export const InstanceSetting_EmailSettingSchema: GenMessage<InstanceSetting_EmailSetting> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 2, 4);
+
+/**
+ * Backup configuration settings.
+ *
+ * @generated from message memos.api.v1.InstanceSetting.BackupSetting
+ */
+export type InstanceSetting_BackupSetting = Message<"memos.api.v1.InstanceSetting.BackupSetting"> & {
+  /**
+   * schedule is the cron expression of the scheduled backups, in the server time zone.
+   * Scheduled backups are disabled when empty.
+   *
+   * @generated from field: string schedule = 1;
+   */
+  schedule: string;
+
+  /**
+   * destination is where the backups are written. Defaults to LOCAL.
+   *
+   * @generated from field: memos.api.v1.Backup.Destination destination = 2;
+   */
+  destination: Backup_Destination;
+
+  /**
+   * retention_count is the number of backups kept in the destination, the older ones are deleted after a backup.
+   * Value <= 0 keeps all the backups.
+   *
+   * @generated from field: int32 retention_count = 3;
+   */
+  retentionCount: number;
+};
+
+/**
+ * Describes the message memos.api.v1.InstanceSetting.BackupSetting.
+ * Use `create(InstanceSetting_BackupSettingSchema)` to create a new message.
+ */
+export const InstanceSetting_BackupSettingSchema: GenMessage<InstanceSetting_BackupSetting> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 2, 5);
+
 /**
  * Enumeration of instance setting keys.
  *
@@ -582,6 +755,20 @@ export enum InstanceSetting_Key {
    * @generated from enum value: AI = 4;
    */
   AI = 4,
+
+  /**
+   * EMAIL is the key for email settings.
+   *
+   * @generated from enum value: EMAIL = 5;
+   */
+  EMAIL = 5,
+
+  /**
+   * BACKUP is the key for backup settings.
+   *
+   * @generated from enum value: BACKUP = 6;
+   */
+  BACKUP = 6,
 }
 
 /**
@@ -640,6 +827,144 @@ export type UpdateInstanceSettingRequest = Message<"memos.api.v1.UpdateInstanceS
 export const UpdateInstanceSettingRequestSchema: GenMessage<UpdateInstanceSettingRequest> = /*@__PURE__*/
   messageDesc(file_api_v1_instance_service, 4);
 
+/**
+ * Request message for SendTestEmail method.
+ *
+ * @generated from message memos.api.v1.SendTestEmailRequest
+ */
+export type SendTestEmailRequest = Message<"memos.api.v1.SendTestEmailRequest"> & {
+  /**
+   * The recipient of the test email. Defaults to the email of the current user.
+   *
+   * @generated from field: string recipient = 1;
+   */
+  recipient: string;
+};
+
+/**
+ * Describes the message memos.api.v1.SendTestEmailRequest.
+ * Use `create(SendTestEmailRequestSchema)` to create a new message.
+ */
+export const SendTestEmailRequestSchema: GenMessage<SendTestEmailRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 5);
+
+/**
+ * A backup archive of the instance.
+ *
+ * @generated from message memos.api.v1.Backup
+ */
+export type Backup = Message<"memos.api.v1.Backup"> & {
+  /**
+   * The name of the backup.
+   * Format: instance/backups/{filename}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The destination the backup was written to.
+   *
+   * @generated from field: memos.api.v1.Backup.Destination destination = 2;
+   */
+  destination: Backup_Destination;
+
+  /**
+   * The location of the backup: the file path for LOCAL, the object key for S3.
+   *
+   * @generated from field: string location = 3;
+   */
+  location: string;
+
+  /**
+   * The size of the backup in bytes.
+   *
+   * @generated from field: int64 size = 4;
+   */
+  size: bigint;
+
+  /**
+   * The time the snapshot was taken.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 5;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * The number of local attachment files bundled in the backup.
+   *
+   * @generated from field: int32 file_count = 6;
+   */
+  fileCount: number;
+
+  /**
+   * The names of the older backups deleted by the retention.
+   *
+   * @generated from field: repeated string deleted_backups = 7;
+   */
+  deletedBackups: string[];
+};
+
+/**
+ * Describes the message memos.api.v1.Backup.
+ * Use `create(BackupSchema)` to create a new message.
+ */
+export const BackupSchema: GenMessage<Backup> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 6);
+
+/**
+ * Where a backup is written.
+ *
+ * @generated from enum memos.api.v1.Backup.Destination
+ */
+export enum Backup_Destination {
+  /**
+   * @generated from enum value: DESTINATION_UNSPECIFIED = 0;
+   */
+  DESTINATION_UNSPECIFIED = 0,
+
+  /**
+   * LOCAL writes the backup to the backups folder of the data directory.
+   *
+   * @generated from enum value: LOCAL = 1;
+   */
+  LOCAL = 1,
+
+  /**
+   * S3 uploads the backup to the bucket of the S3 storage config.
+   *
+   * @generated from enum value: S3 = 2;
+   */
+  S3 = 2,
+}
+
+/**
+ * Describes the enum memos.api.v1.Backup.Destination.
+ */
+export const Backup_DestinationSchema: GenEnum<Backup_Destination> = /*@__PURE__*/
+  enumDesc(file_api_v1_instance_service, 6, 0);
+
+/**
+ * Request message for CreateBackup method.
+ *
+ * @generated from message memos.api.v1.CreateBackupRequest
+ */
+export type CreateBackupRequest = Message<"memos.api.v1.CreateBackupRequest"> & {
+  /**
+   * The destination of the backup. Defaults to the destination of the backup setting.
+   *
+   * @generated from field: memos.api.v1.Backup.Destination destination = 1;
+   */
+  destination: Backup_Destination;
+};
+
+/**
+ * Describes the message memos.api.v1.CreateBackupRequest.
+ * Use `create(CreateBackupRequestSchema)` to create a new message.
+ */
+export const CreateBackupRequestSchema: GenMessage<CreateBackupRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_instance_service, 7);
+
 /**
  * @generated from service memos.api.v1.InstanceService
  */
@@ -674,6 +999,26 @@ export const InstanceService: GenService<{
     input: typeof UpdateInstanceSettingRequestSchema;
     output: typeof InstanceSettingSchema;
   },
+  /**
+   * Sends a test email with the email setting. Requires admin.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.SendTestEmail
+   */
+  sendTestEmail: {
+    methodKind: "unary";
+    input: typeof SendTestEmailRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * Creates a consistent backup of the database and the local attachments. Requires admin.
+   *
+   * @generated from rpc memos.api.v1.InstanceService.CreateBackup
+   */
+  createBackup: {
+    methodKind: "unary";
+    input: typeof CreateBackupRequestSchema;
+    output: typeof BackupSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_instance_service, 0);
 
@@ -0,0 +1,307 @@
+// @generated by protoc-gen-es v2.11.0 with parameter "target=ts"
+// @generated from file api/v1/job_service.proto (package memos.api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_google_api_annotations } from "../../google/api/annotations_pb";
+import { file_google_api_client } from "../../google/api/client_pb";
+import { file_google_api_field_behavior } from "../../google/api/field_behavior_pb";
+import { file_google_api_resource } from "../../google/api/resource_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/job_service.proto.
+ */
+export const file_api_v1_job_service: GenFile = /*@__PURE__*/
+  fileDesc("ChhhcGkvdjEvam9iX3NlcnZpY2UucHJvdG8SDG1lbW9zLmFwaS52MSKCBAoDSm9iEhQKBG5hbWUYASABKAlCBuBBA+BBCBIRCgR0eXBlGAIgASgJQgPgQQMSEAoDa2V5GAMgASgJQgPgQQMSKwoFc3RhdGUYBCABKA4yFy5tZW1vcy5hcGkudjEuSm9iLlN0YXRlQgPgQQMSFQoIYXR0ZW1wdHMYBSABKAVCA+BBAxIZCgxtYXhfYXR0ZW1wdHMYBiABKAVCA+BBAxIXCgpsYXN0X2Vycm9yGAcgASgJQgPgQQMSFAoHcGF5bG9hZBgIIAEoCUID4EEDEjQKC2NyZWF0ZV90aW1lGAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjQKC3VwZGF0ZV90aW1lGAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjEKCHJ1bl90aW1lGAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDIl8KBVN0YXRlEhUKEVNUQVRFX1VOU1BFQ0lGSUVEEAASCwoHUEVORElORxABEgsKB1JVTk5JTkcQAhINCglTVUNDRUVERUQQAxIICgRERUFEEAQSDAoIQ0FOQ0VMRUQQBToy6kEvChBtZW1vcy5hcGkudjEvSm9iEgpqb2JzL3tqb2J9GgRuYW1lKgRqb2JzMgNqb2IiggEKD0xpc3RKb2JzUmVxdWVzdBIWCglwYWdlX3NpemUYASABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAIgASgJQgPgQQESEQoEdHlwZRgDIAEoCUID4EEBEisKBXN0YXRlGAQgASgOMhcubWVtb3MuYXBpLnYxLkpvYi5TdGF0ZUID4EEBIkwKEExpc3RKb2JzUmVzcG9uc2USHwoEam9icxgBIAMoCzIRLm1lbW9zLmFwaS52MS5Kb2ISFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIjkKD1JldHJ5Sm9iUmVxdWVzdBImCgRuYW1lGAEgASgJQhjgQQL6QRIKEG1lbW9zLmFwaS52MS9Kb2IiOgoQQ2FuY2VsSm9iUmVxdWVzdBImCgRuYW1lGAEgASgJQhjgQQL6QRIKEG1lbW9zLmFwaS52MS9Kb2IyygIKCkpvYlNlcnZpY2USXwoITGlzdEpvYnMSHS5tZW1vcy5hcGkudjEuTGlzdEpvYnNSZXF1ZXN0Gh4ubWVtb3MuYXBpLnYxLkxpc3RKb2JzUmVzcG9uc2UiFILT5JMCDhIML2FwaS92MS9qb2JzEmsKCFJldHJ5Sm9iEh0ubWVtb3MuYXBpLnYxLlJldHJ5Sm9iUmVxdWVzdBoRLm1lbW9zLmFwaS52MS5Kb2IiLdpBBG5hbWWC0+STAiA6ASoiGy9hcGkvdjEve25hbWU9am9icy8qfTpyZXRyeRJuCglDYW5jZWxKb2ISHi5tZW1vcy5hcGkudjEuQ2FuY2VsSm9iUmVxdWVzdBoRLm1lbW9zLmFwaS52MS5Kb2IiLtpBBG5hbWWC0+STAiE6ASoiHC9hcGkvdjEve25hbWU9am9icy8qfTpjYW5jZWxCpwEKEGNvbS5tZW1vcy5hcGkudjFCD0pvYlNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message memos.api.v1.Job
+ */
+export type Job = Message<"memos.api.v1.Job"> & {
+  /**
+   * The name of the job.
+   * Format: jobs/{id}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The type of the job, e.g. "MEMO_EMBEDDING" or "WEBHOOK".
+   *
+   * @generated from field: string type = 2;
+   */
+  type: string;
+
+  /**
+   * The key of the job, e.g. the memo the job refreshes.
+   *
+   * @generated from field: string key = 3;
+   */
+  key: string;
+
+  /**
+   * The state of the job.
+   *
+   * @generated from field: memos.api.v1.Job.State state = 4;
+   */
+  state: Job_State;
+
+  /**
+   * The number of started attempts.
+   *
+   * @generated from field: int32 attempts = 5;
+   */
+  attempts: number;
+
+  /**
+   * The number of attempts after which a failing job is dead.
+   *
+   * @generated from field: int32 max_attempts = 6;
+   */
+  maxAttempts: number;
+
+  /**
+   * The error of the last failed attempt.
+   *
+   * @generated from field: string last_error = 7;
+   */
+  lastError: string;
+
+  /**
+   * The payload of the job in JSON.
+   *
+   * @generated from field: string payload = 8;
+   */
+  payload: string;
+
+  /**
+   * The time the job was enqueued.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 9;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * The time the job was last updated.
+   *
+   * @generated from field: google.protobuf.Timestamp update_time = 10;
+   */
+  updateTime?: Timestamp;
+
+  /**
+   * The earliest time a pending job runs.
+   *
+   * @generated from field: google.protobuf.Timestamp run_time = 11;
+   */
+  runTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.Job.
+ * Use `create(JobSchema)` to create a new message.
+ */
+export const JobSchema: GenMessage<Job> = /*@__PURE__*/
+  messageDesc(file_api_v1_job_service, 0);
+
+/**
+ * @generated from enum memos.api.v1.Job.State
+ */
+export enum Job_State {
+  /**
+   * @generated from enum value: STATE_UNSPECIFIED = 0;
+   */
+  STATE_UNSPECIFIED = 0,
+
+  /**
+   * The job waits to run.
+   *
+   * @generated from enum value: PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * The job is running.
+   *
+   * @generated from enum value: RUNNING = 2;
+   */
+  RUNNING = 2,
+
+  /**
+   * The job succeeded.
+   *
+   * @generated from enum value: SUCCEEDED = 3;
+   */
+  SUCCEEDED = 3,
+
+  /**
+   * The job failed on all attempts.
+   *
+   * @generated from enum value: DEAD = 4;
+   */
+  DEAD = 4,
+
+  /**
+   * The job was canceled.
+   *
+   * @generated from enum value: CANCELED = 5;
+   */
+  CANCELED = 5,
+}
+
+/**
+ * Describes the enum memos.api.v1.Job.State.
+ */
+export const Job_StateSchema: GenEnum<Job_State> = /*@__PURE__*/
+  enumDesc(file_api_v1_job_service, 0, 0);
+
+/**
+ * @generated from message memos.api.v1.ListJobsRequest
+ */
+export type ListJobsRequest = Message<"memos.api.v1.ListJobsRequest"> & {
+  /**
+   * The maximum number of jobs to return.
+   * If unspecified, at most 10 jobs will be returned.
+   * The maximum value is 1000; values above 1000 will be coerced to 1000.
+   *
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  /**
+   * A page token, received from a previous `ListJobs` call.
+   * Provide this to retrieve the subsequent page.
+   *
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+
+  /**
+   * Only list jobs of the type.
+   *
+   * @generated from field: string type = 3;
+   */
+  type: string;
+
+  /**
+   * Only list jobs in the state.
+   *
+   * @generated from field: memos.api.v1.Job.State state = 4;
+   */
+  state: Job_State;
+};
+
+/**
+ * Describes the message memos.api.v1.ListJobsRequest.
+ * Use `create(ListJobsRequestSchema)` to create a new message.
+ */
+export const ListJobsRequestSchema: GenMessage<ListJobsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_job_service, 1);
+
+/**
+ * @generated from message memos.api.v1.ListJobsResponse
+ */
+export type ListJobsResponse = Message<"memos.api.v1.ListJobsResponse"> & {
+  /**
+   * The jobs.
+   *
+   * @generated from field: repeated memos.api.v1.Job jobs = 1;
+   */
+  jobs: Job[];
+
+  /**
+   * A token to retrieve the next page of results.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListJobsResponse.
+ * Use `create(ListJobsResponseSchema)` to create a new message.
+ */
+export const ListJobsResponseSchema: GenMessage<ListJobsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_job_service, 2);
+
+/**
+ * @generated from message memos.api.v1.RetryJobRequest
+ */
+export type RetryJobRequest = Message<"memos.api.v1.RetryJobRequest"> & {
+  /**
+   * The name of the job.
+   * Format: jobs/{id}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.RetryJobRequest.
+ * Use `create(RetryJobRequestSchema)` to create a new message.
+ */
+export const RetryJobRequestSchema: GenMessage<RetryJobRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_job_service, 3);
+
+/**
+ * @generated from message memos.api.v1.CancelJobRequest
+ */
+export type CancelJobRequest = Message<"memos.api.v1.CancelJobRequest"> & {
+  /**
+   * The name of the job.
+   * Format: jobs/{id}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.CancelJobRequest.
+ * Use `create(CancelJobRequestSchema)` to create a new message.
+ */
+export const CancelJobRequestSchema: GenMessage<CancelJobRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_job_service, 4);
+
+/**
+ * @generated from service memos.api.v1.JobService
+ */
+export const JobService: GenService<{
+  /**
+   * ListJobs returns the background jobs, newest first. Only admins can list jobs.
+   *
+   * @generated from rpc memos.api.v1.JobService.ListJobs
+   */
+  listJobs: {
+    methodKind: "unary";
+    input: typeof ListJobsRequestSchema;
+    output: typeof ListJobsResponseSchema;
+  },
+  /**
+   * RetryJob schedules a dead or canceled job to run again.
+   *
+   * @generated from rpc memos.api.v1.JobService.RetryJob
+   */
+  retryJob: {
+    methodKind: "unary";
+    input: typeof RetryJobRequestSchema;
+    output: typeof JobSchema;
+  },
+  /**
+   * CancelJob cancels a pending or running job.
+   *
+   * @generated from rpc memos.api.v1.JobService.CancelJob
+   */
+  cancelJob: {
+    methodKind: "unary";
+    input: typeof CancelJobRequestSchema;
+    output: typeof JobSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_job_service, 0);
+
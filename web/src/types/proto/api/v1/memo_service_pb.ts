@@ -20,7 +20,135 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/memo_service.proto.
  */
 export const file_api_v1_memo_service: GenFile = /*@__PURE__*/
-  fileDesc("ChlhcGkvdjEvbWVtb19zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEipwIKCFJlYWN0aW9uEhQKBG5hbWUYASABKAlCBuBBA+BBCBIqCgdjcmVhdG9yGAIgASgJQhngQQP6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEi0KCmNvbnRlbnRfaWQYAyABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SGgoNcmVhY3Rpb25fdHlwZRgEIAEoCUID4EECEjQKC2NyZWF0ZV90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDOljqQVUKFW1lbW9zLmFwaS52MS9SZWFjdGlvbhIhbWVtb3Mve21lbW99L3JlYWN0aW9ucy97cmVhY3Rpb259GgRuYW1lKglyZWFjdGlvbnMyCHJlYWN0aW9uIv4GCgRNZW1vEhEKBG5hbWUYASABKAlCA+BBCBInCgVzdGF0ZRgCIAEoDjITLm1lbW9zLmFwaS52MS5TdGF0ZUID4EECEioKB2NyZWF0b3IYAyABKAlCGeBBA/pBEwoRbWVtb3MuYXBpLnYxL1VzZXISNAoLY3JlYXRlX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQESNAoLdXBkYXRlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQESNQoMZGlzcGxheV90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEBEhQKB2NvbnRlbnQYByABKAlCA+BBAhIxCgp2aXNpYmlsaXR5GAkgASgOMhgubWVtb3MuYXBpLnYxLlZpc2liaWxpdHlCA+BBAhIRCgR0YWdzGAogAygJQgPgQQMSEwoGcGlubmVkGAsgASgIQgPgQQESMgoLYXR0YWNobWVudHMYDCADKAsyGC5tZW1vcy5hcGkudjEuQXR0YWNobWVudEID4EEBEjIKCXJlbGF0aW9ucxgNIAMoCzIaLm1lbW9zLmFwaS52MS5NZW1vUmVsYXRpb25CA+BBARIuCglyZWFjdGlvbnMYDiADKAsyFi5tZW1vcy5hcGkudjEuUmVhY3Rpb25CA+BBAxIyCghwcm9wZXJ0eRgPIAEoCzIbLm1lbW9zLmFwaS52MS5NZW1vLlByb3BlcnR5QgPgQQMSLgoGcGFyZW50GBAgASgJQhngQQP6QRMKEW1lbW9zLmFwaS52MS9NZW1vSACIAQESFAoHc25pcHBldBgRIAEoCUID4EEDEjIKCGxvY2F0aW9uGBIgASgLMhYubWVtb3MuYXBpLnYxLkxvY2F0aW9uQgPgQQFIAYgBARpjCghQcm9wZXJ0eRIQCghoYXNfbGluaxgBIAEoCBIVCg1oYXNfdGFza19saXN0GAIgASgIEhAKCGhhc19jb2RlGAMgASgIEhwKFGhhc19pbmNvbXBsZXRlX3Rhc2tzGAQgASgIOjfqQTQKEW1lbW9zLmFwaS52MS9NZW1vEgxtZW1vcy97bWVtb30aBG5hbWUqBW1lbW9zMgRtZW1vQgkKB19wYXJlbnRCCwoJX2xvY2F0aW9uIlMKCExvY2F0aW9uEhgKC3BsYWNlaG9sZGVyGAEgASgJQgPgQQESFQoIbGF0aXR1ZGUYAiABKAFCA+BBARIWCglsb25naXR1ZGUYAyABKAFCA+BBASJQChFDcmVhdGVNZW1vUmVxdWVzdBIlCgRtZW1vGAEgASgLMhIubWVtb3MuYXBpLnYxLk1lbW9CA+BBAhIUCgdtZW1vX2lkGAIgASgJQgPgQQEiswEKEExpc3RNZW1vc1JlcXVlc3QSFgoJcGFnZV9zaXplGAEgASgFQgPgQQESFwoKcGFnZV90b2tlbhgCIAEoCUID4EEBEicKBXN0YXRlGAMgASgOMhMubWVtb3MuYXBpLnYxLlN0YXRlQgPgQQESFQoIb3JkZXJfYnkYBCABKAlCA+BBARITCgZmaWx0ZXIYBSABKAlCA+BBARIZCgxzaG93X2RlbGV0ZWQYBiABKAhCA+BBASJPChFMaXN0TWVtb3NSZXNwb25zZRIhCgVtZW1vcxgBIAMoCzISLm1lbW9zLmFwaS52MS5NZW1vEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSKfAQoaU2VhcmNoTWVtb3NTZW1hbnRpY1JlcXVlc3QSEgoFcXVlcnkYASABKAlCA+BBAhIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQESJwoFc3RhdGUYBCABKA4yEy5tZW1vcy5hcGkudjEuU3RhdGVCA+BBARITCgZmaWx0ZXIYBSABKAlCA+BBASI5Cg5HZXRNZW1vUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vInAKEVVwZGF0ZU1lbW9SZXF1ZXN0EiUKBG1lbW8YASABKAsyEi5tZW1vcy5hcGkudjEuTWVtb0ID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EECIlAKEURlbGV0ZU1lbW9SZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SEgoFZm9yY2UYAiABKAhCA+BBASJ4ChlTZXRNZW1vQXR0YWNobWVudHNSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SMgoLYXR0YWNobWVudHMYAiADKAsyGC5tZW1vcy5hcGkudjEuQXR0YWNobWVudEID4EECInYKGkxpc3RNZW1vQXR0YWNobWVudHNSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SFgoJcGFnZV9zaXplGAIgASgFQgPgQQESFwoKcGFnZV90b2tlbhgDIAEoCUID4EEBImUKG0xpc3RNZW1vQXR0YWNobWVudHNSZXNwb25zZRItCgthdHRhY2htZW50cxgBIAMoCzIYLm1lbW9zLmFwaS52MS5BdHRhY2htZW50EhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSKzAgoMTWVtb1JlbGF0aW9uEjIKBG1lbW8YASABKAsyHy5tZW1vcy5hcGkudjEuTWVtb1JlbGF0aW9uLk1lbW9CA+BBAhI6CgxyZWxhdGVkX21lbW8YAiABKAsyHy5tZW1vcy5hcGkudjEuTWVtb1JlbGF0aW9uLk1lbW9CA+BBAhIyCgR0eXBlGAMgASgOMh8ubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbi5UeXBlQgPgQQIaRQoETWVtbxInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhQKB3NuaXBwZXQYAiABKAlCA+BBAyI4CgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABINCglSRUZFUkVOQ0UQARILCgdDT01NRU5UEAIidgoXU2V0TWVtb1JlbGF0aW9uc1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIyCglyZWxhdGlvbnMYAiADKAsyGi5tZW1vcy5hcGkudjEuTWVtb1JlbGF0aW9uQgPgQQIidAoYTGlzdE1lbW9SZWxhdGlvbnNSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SFgoJcGFnZV9zaXplGAIgASgFQgPgQQESFwoKcGFnZV90b2tlbhgDIAEoCUID4EEBImMKGUxpc3RNZW1vUmVsYXRpb25zUmVzcG9uc2USLQoJcmVsYXRpb25zGAEgAygLMhoubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbhIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkihgEKGENyZWF0ZU1lbW9Db21tZW50UmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEigKB2NvbW1lbnQYAiABKAsyEi5tZW1vcy5hcGkudjEuTWVtb0ID4EECEhcKCmNvbW1lbnRfaWQYAyABKAlCA+BBASKKAQoXTGlzdE1lbW9Db21tZW50c1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQESFQoIb3JkZXJfYnkYBCABKAlCA+BBASJqChhMaXN0TWVtb0NvbW1lbnRzUmVzcG9uc2USIQoFbWVtb3MYASADKAsyEi5tZW1vcy5hcGkudjEuTWVtbxIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkSEgoKdG90YWxfc2l6ZRgDIAEoBSJ0ChhMaXN0TWVtb1JlYWN0aW9uc1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQEicwoZTGlzdE1lbW9SZWFjdGlvbnNSZXNwb25zZRIpCglyZWFjdGlvbnMYASADKAsyFi5tZW1vcy5hcGkudjEuUmVhY3Rpb24SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhIKCnRvdGFsX3NpemUYAyABKAUicwoZVXBzZXJ0TWVtb1JlYWN0aW9uUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEi0KCHJlYWN0aW9uGAIgASgLMhYubWVtb3MuYXBpLnYxLlJlYWN0aW9uQgPgQQIiSAoZRGVsZXRlTWVtb1JlYWN0aW9uUmVxdWVzdBIrCgRuYW1lGAEgASgJQh3gQQL6QRcKFW1lbW9zLmFwaS52MS9SZWFjdGlvbipQCgpWaXNpYmlsaXR5EhoKFlZJU0lCSUxJVFlfVU5TUEVDSUZJRUQQABILCgdQUklWQVRFEAESDQoJUFJPVEVDVEVEEAISCgoGUFVCTElDEAMy5w8KC01lbW9TZXJ2aWNlEmUKCkNyZWF0ZU1lbW8SHy5tZW1vcy5hcGkudjEuQ3JlYXRlTWVtb1JlcXVlc3QaEi5tZW1vcy5hcGkudjEuTWVtbyIi2kEEbWVtb4LT5JMCFToEbWVtbyINL2FwaS92MS9tZW1vcxJmCglMaXN0TWVtb3MSHi5tZW1vcy5hcGkudjEuTGlzdE1lbW9zUmVxdWVzdBofLm1lbW9zLmFwaS52MS5MaXN0TWVtb3NSZXNwb25zZSIY2kEAgtPkkwIPEg0vYXBpL3YxL21lbW9zEpEBChNTZWFyY2hNZW1vc1NlbWFudGljEigubWVtb3MuYXBpLnYxLlNlYXJjaE1lbW9zU2VtYW50aWNSZXF1ZXN0Gh8ubWVtb3MuYXBpLnYxLkxpc3RNZW1vc1Jlc3BvbnNlIi/aQQVxdWVyeYLT5JMCIToBKiIcL2FwaS92MS9tZW1vczpzZWFyY2hTZW1hbnRpYxJiCgdHZXRNZW1vEhwubWVtb3MuYXBpLnYxLkdldE1lbW9SZXF1ZXN0GhIubWVtb3MuYXBpLnYxLk1lbW8iJdpBBG5hbWWC0+STAhgSFi9hcGkvdjEve25hbWU9bWVtb3MvKn0SfwoKVXBkYXRlTWVtbxIfLm1lbW9zLmFwaS52MS5VcGRhdGVNZW1vUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5NZW1vIjzaQRBtZW1vLHVwZGF0ZV9tYXNrgtPkkwIjOgRtZW1vMhsvYXBpL3YxL3ttZW1vLm5hbWU9bWVtb3MvKn0SbAoKRGVsZXRlTWVtbxIfLm1lbW9zLmFwaS52MS5EZWxldGVNZW1vUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIl2kEEbmFtZYLT5JMCGCoWL2FwaS92MS97bmFtZT1tZW1vcy8qfRKLAQoSU2V0TWVtb0F0dGFjaG1lbnRzEicubWVtb3MuYXBpLnYxLlNldE1lbW9BdHRhY2htZW50c1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiNNpBBG5hbWWC0+STAic6ASoyIi9hcGkvdjEve25hbWU9bWVtb3MvKn0vYXR0YWNobWVudHMSnQEKE0xpc3RNZW1vQXR0YWNobWVudHMSKC5tZW1vcy5hcGkudjEuTGlzdE1lbW9BdHRhY2htZW50c1JlcXVlc3QaKS5tZW1vcy5hcGkudjEuTGlzdE1lbW9BdHRhY2htZW50c1Jlc3BvbnNlIjHaQQRuYW1lgtPkkwIkEiIvYXBpL3YxL3tuYW1lPW1lbW9zLyp9L2F0dGFjaG1lbnRzEoUBChBTZXRNZW1vUmVsYXRpb25zEiUubWVtb3MuYXBpLnYxLlNldE1lbW9SZWxhdGlvbnNSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjLaQQRuYW1lgtPkkwIlOgEqMiAvYXBpL3YxL3tuYW1lPW1lbW9zLyp9L3JlbGF0aW9ucxKVAQoRTGlzdE1lbW9SZWxhdGlvbnMSJi5tZW1vcy5hcGkudjEuTGlzdE1lbW9SZWxhdGlvbnNSZXF1ZXN0GicubWVtb3MuYXBpLnYxLkxpc3RNZW1vUmVsYXRpb25zUmVzcG9uc2UiL9pBBG5hbWWC0+STAiISIC9hcGkvdjEve25hbWU9bWVtb3MvKn0vcmVsYXRpb25zEpABChFDcmVhdGVNZW1vQ29tbWVudBImLm1lbW9zLmFwaS52MS5DcmVhdGVNZW1vQ29tbWVudFJlcXVlc3QaEi5tZW1vcy5hcGkudjEuTWVtbyI/2kEMbmFtZSxjb21tZW50gtPkkwIqOgdjb21tZW50Ih8vYXBpL3YxL3tuYW1lPW1lbW9zLyp9L2NvbW1lbnRzEpEBChBMaXN0TWVtb0NvbW1lbnRzEiUubWVtb3MuYXBpLnYxLkxpc3RNZW1vQ29tbWVudHNSZXF1ZXN0GiYubWVtb3MuYXBpLnYxLkxpc3RNZW1vQ29tbWVudHNSZXNwb25zZSIu2kEEbmFtZYLT5JMCIRIfL2FwaS92MS97bmFtZT1tZW1vcy8qfS9jb21tZW50cxKVAQoRTGlzdE1lbW9SZWFjdGlvbnMSJi5tZW1vcy5hcGkudjEuTGlzdE1lbW9SZWFjdGlvbnNSZXF1ZXN0GicubWVtb3MuYXBpLnYxLkxpc3RNZW1vUmVhY3Rpb25zUmVzcG9uc2UiL9pBBG5hbWWC0+STAiISIC9hcGkvdjEve25hbWU9bWVtb3MvKn0vcmVhY3Rpb25zEokBChJVcHNlcnRNZW1vUmVhY3Rpb24SJy5tZW1vcy5hcGkudjEuVXBzZXJ0TWVtb1JlYWN0aW9uUmVxdWVzdBoWLm1lbW9zLmFwaS52MS5SZWFjdGlvbiIy2kEEbmFtZYLT5JMCJToBKiIgL2FwaS92MS97bmFtZT1tZW1vcy8qfS9yZWFjdGlvbnMSiAEKEkRlbGV0ZU1lbW9SZWFjdGlvbhInLm1lbW9zLmFwaS52MS5EZWxldGVNZW1vUmVhY3Rpb25SZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjHaQQRuYW1lgtPkkwIkKiIvYXBpL3YxL3tuYW1lPW1lbW9zLyovcmVhY3Rpb25zLyp9QqgBChBjb20ubWVtb3MuYXBpLnYxQhBNZW1vU2VydmljZVByb3RvUAFaMGdpdGh1Yi5jb20vdXNlbWVtb3MvbWVtb3MvcHJvdG8vZ2VuL2FwaS92MTthcGl2MaICA01BWKoCDE1lbW9zLkFwaS5WMcoCDE1lbW9zXEFwaVxWMeICGE1lbW9zXEFwaVxWMVxHUEJNZXRhZGF0YeoCDk1lbW9zOjpBcGk6OlYxYgZwcm90bzM", [file_api_v1_attachment_service, file_api_v1_common, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
+  fileDesc("ChlhcGkvdjEvbWVtb19zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEitQEKBFRhc2sSFAoEbmFtZRgBIAEoCUIG4EED4EEIEhEKBHRleHQYAiABKAlCA+BBAxIUCgdjaGVja2VkGAMgASgIQgPgQQMSEQoEbGluZRgEIAEoBUID4EEDEhUKCGR1ZV9kYXRlGAUgASgJQgPgQQM6ROpBQQoRbWVtb3MuYXBpLnYxL1Rhc2sSGW1lbW9zL3ttZW1vfS90YXNrcy97dGFza30aBG5hbWUqBXRhc2tzMgR0YXNrImcKEExpc3RUYXNrc1JlcXVlc3QSEwoGZmlsdGVyGAEgASgJQgPgQQESGQoHY2hlY2tlZBgCIAEoCEID4EEBSACIAQESFwoKZHVlX2JlZm9yZRgDIAEoCUID4EEBQgoKCF9jaGVja2VkIjYKEUxpc3RUYXNrc1Jlc3BvbnNlEiEKBXRhc2tzGAEgAygLMhIubWVtb3MuYXBpLnYxLlRhc2siYwoRVG9nZ2xlVGFza1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVGFzaxIZCgdjaGVja2VkGAIgASgIQgPgQQFIAIgBAUIKCghfY2hlY2tlZCKnAgoIUmVhY3Rpb24SFAoEbmFtZRgBIAEoCUIG4EED4EEIEioKB2NyZWF0b3IYAiABKAlCGeBBA/pBEwoRbWVtb3MuYXBpLnYxL1VzZXISLQoKY29udGVudF9pZBgDIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIaCg1yZWFjdGlvbl90eXBlGAQgASgJQgPgQQISNAoLY3JlYXRlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQM6WOpBVQoVbWVtb3MuYXBpLnYxL1JlYWN0aW9uEiFtZW1vcy97bWVtb30vcmVhY3Rpb25zL3tyZWFjdGlvbn0aBG5hbWUqCXJlYWN0aW9uczIIcmVhY3Rpb24i1goKBE1lbW8SEQoEbmFtZRgBIAEoCUID4EEIEicKBXN0YXRlGAIgASgOMhMubWVtb3MuYXBpLnYxLlN0YXRlQgPgQQISKgoHY3JlYXRvchgDIAEoCUIZ4EED+kETChFtZW1vcy5hcGkudjEvVXNlchI0CgtjcmVhdGVfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBARI0Cgt1cGRhdGVfdGltZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBARI1CgxkaXNwbGF5X3RpbWUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQESFAoHY29udGVudBgHIAEoCUID4EECEjEKCnZpc2liaWxpdHkYCSABKA4yGC5tZW1vcy5hcGkudjEuVmlzaWJpbGl0eUID4EECEhEKBHRhZ3MYCiADKAlCA+BBAxITCgZwaW5uZWQYCyABKAhCA+BBARIyCgthdHRhY2htZW50cxgMIAMoCzIYLm1lbW9zLmFwaS52MS5BdHRhY2htZW50QgPgQQESMgoJcmVsYXRpb25zGA0gAygLMhoubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbkID4EEBEi4KCXJlYWN0aW9ucxgOIAMoCzIWLm1lbW9zLmFwaS52MS5SZWFjdGlvbkID4EEDEjIKCHByb3BlcnR5GA8gASgLMhsubWVtb3MuYXBpLnYxLk1lbW8uUHJvcGVydHlCA+BBAxIuCgZwYXJlbnQYECABKAlCGeBBA/pBEwoRbWVtb3MuYXBpLnYxL01lbW9IAIgBARIUCgdzbmlwcGV0GBEgASgJQgPgQQMSMgoIbG9jYXRpb24YEiABKAsyFi5tZW1vcy5hcGkudjEuTG9jYXRpb25CA+BBAUgBiAEBEjYKE3Bvc3NpYmxlX2R1cGxpY2F0ZXMYEyADKAlCGeBBA/pBEwoRbWVtb3MuYXBpLnYxL01lbW8SNgoKZW5yaWNobWVudBgUIAEoCzIdLm1lbW9zLmFwaS52MS5NZW1vLkVucmljaG1lbnRCA+BBAxIVCghtZW50aW9ucxgVIAMoCUID4EEDEjkKC3JlbWluZF90aW1lGBYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEBSAKIAQESOgoMcHVibGlzaF90aW1lGBcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEBSAOIAQESOQoScHVibGlzaF92aXNpYmlsaXR5GBggASgOMhgubWVtb3MuYXBpLnYxLlZpc2liaWxpdHlCA+BBARpjCghQcm9wZXJ0eRIQCghoYXNfbGluaxgBIAEoCBIVCg1oYXNfdGFza19saXN0GAIgASgIEhAKCGhhc19jb2RlGAMgASgIEhwKFGhhc19pbmNvbXBsZXRlX3Rhc2tzGAQgASgIGnwKCkVucmljaG1lbnQSDwoHc3VtbWFyeRgBIAEoCRIWCg5zdWdnZXN0ZWRfdGFncxgCIAMoCRIQCghjYXRlZ29yeRgDIAEoCRIYChBzdW1tYXJ5X2FjY2VwdGVkGAQgASgIEhkKEWNhdGVnb3J5X2FjY2VwdGVkGAUgASgIOjfqQTQKEW1lbW9zLmFwaS52MS9NZW1vEgxtZW1vcy97bWVtb30aBG5hbWUqBW1lbW9zMgRtZW1vQgkKB19wYXJlbnRCCwoJX2xvY2F0aW9uQg4KDF9yZW1pbmRfdGltZUIPCg1fcHVibGlzaF90aW1lIlMKCExvY2F0aW9uEhgKC3BsYWNlaG9sZGVyGAEgASgJQgPgQQESFQoIbGF0aXR1ZGUYAiABKAFCA+BBARIWCglsb25naXR1ZGUYAyABKAFCA+BBASJQChFDcmVhdGVNZW1vUmVxdWVzdBIlCgRtZW1vGAEgASgLMhIubWVtb3MuYXBpLnYxLk1lbW9CA+BBAhIUCgdtZW1vX2lkGAIgASgJQgPgQQEiswEKEExpc3RNZW1vc1JlcXVlc3QSFgoJcGFnZV9zaXplGAEgASgFQgPgQQESFwoKcGFnZV90b2tlbhgCIAEoCUID4EEBEicKBXN0YXRlGAMgASgOMhMubWVtb3MuYXBpLnYxLlN0YXRlQgPgQQESFQoIb3JkZXJfYnkYBCABKAlCA+BBARITCgZmaWx0ZXIYBSABKAlCA+BBARIZCgxzaG93X2RlbGV0ZWQYBiABKAhCA+BBASJPChFMaXN0TWVtb3NSZXNwb25zZRIhCgVtZW1vcxgBIAMoCzISLm1lbW9zLmFwaS52MS5NZW1vEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSKfAQoaU2VhcmNoTWVtb3NTZW1hbnRpY1JlcXVlc3QSEgoFcXVlcnkYASABKAlCA+BBAhIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQESJwoFc3RhdGUYBCABKA4yEy5tZW1vcy5hcGkudjEuU3RhdGVCA+BBARITCgZmaWx0ZXIYBSABKAlCA+BBASKWAgoSU2VhcmNoTWVtb3NSZXF1ZXN0EhIKBXF1ZXJ5GAEgASgJQgPgQQISOAoEbW9kZRgCIAEoDjIlLm1lbW9zLmFwaS52MS5TZWFyY2hNZW1vc1JlcXVlc3QuTW9kZUID4EEBEhYKCXBhZ2Vfc2l6ZRgDIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YBCABKAlCA+BBARInCgVzdGF0ZRgFIAEoDjITLm1lbW9zLmFwaS52MS5TdGF0ZUID4EEBEhMKBmZpbHRlchgGIAEoCUID4EEBIkMKBE1vZGUSFAoQTU9ERV9VTlNQRUNJRklFRBAAEgsKB0tFWVdPUkQQARIMCghTRU1BTlRJQxACEgoKBkhZQlJJRBADIroCChNTZWFyY2hNZW1vc1Jlc3BvbnNlEjkKB3Jlc3VsdHMYASADKAsyKC5tZW1vcy5hcGkudjEuU2VhcmNoTWVtb3NSZXNwb25zZS5SZXN1bHQSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJGs4BCgZSZXN1bHQSIAoEbWVtbxgBIAEoCzISLm1lbW9zLmFwaS52MS5NZW1vEg0KBXNjb3JlGAIgASgBEhQKDGtleXdvcmRfcmFuaxgDIAEoBRIVCg1rZXl3b3JkX3Njb3JlGAQgASgBEhUKDXNlbWFudGljX3JhbmsYBSABKAUSFgoOc2VtYW50aWNfc2NvcmUYBiABKAESFQoNbWF0Y2hlZF90ZXJtcxgHIAMoCRIPCgdzbmlwcGV0GAggASgJEg8KB3Bhc3NhZ2UYCSABKAkiOQoOR2V0TWVtb1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbyJwChFVcGRhdGVNZW1vUmVxdWVzdBIlCgRtZW1vGAEgASgLMhIubWVtb3MuYXBpLnYxLk1lbW9CA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAiJQChFEZWxldGVNZW1vUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhIKBWZvcmNlGAIgASgIQgPgQQEieAoZU2V0TWVtb0F0dGFjaG1lbnRzUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEjIKC2F0dGFjaG1lbnRzGAIgAygLMhgubWVtb3MuYXBpLnYxLkF0dGFjaG1lbnRCA+BBAiJ2ChpMaXN0TWVtb0F0dGFjaG1lbnRzUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASJlChtMaXN0TWVtb0F0dGFjaG1lbnRzUmVzcG9uc2USLQoLYXR0YWNobWVudHMYASADKAsyGC5tZW1vcy5hcGkudjEuQXR0YWNobWVudBIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiswIKDE1lbW9SZWxhdGlvbhIyCgRtZW1vGAEgASgLMh8ubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbi5NZW1vQgPgQQISOgoMcmVsYXRlZF9tZW1vGAIgASgLMh8ubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbi5NZW1vQgPgQQISMgoEdHlwZRgDIAEoDjIfLm1lbW9zLmFwaS52MS5NZW1vUmVsYXRpb24uVHlwZUID4EECGkUKBE1lbW8SJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIUCgdzbmlwcGV0GAIgASgJQgPgQQMiOAoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASDQoJUkVGRVJFTkNFEAESCwoHQ09NTUVOVBACInYKF1NldE1lbW9SZWxhdGlvbnNSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SMgoJcmVsYXRpb25zGAIgAygLMhoubWVtb3MuYXBpLnYxLk1lbW9SZWxhdGlvbkID4EECInQKGExpc3RNZW1vUmVsYXRpb25zUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASJjChlMaXN0TWVtb1JlbGF0aW9uc1Jlc3BvbnNlEi0KCXJlbGF0aW9ucxgBIAMoCzIaLm1lbW9zLmFwaS52MS5NZW1vUmVsYXRpb24SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIlYKF0xpc3RSZWxhdGVkTWVtb3NSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SEgoFbGltaXQYAiABKAVCA+BBASKfAgoYTGlzdFJlbGF0ZWRNZW1vc1Jlc3BvbnNlEkkKDXJlbGF0ZWRfbWVtb3MYASADKAsyMi5tZW1vcy5hcGkudjEuTGlzdFJlbGF0ZWRNZW1vc1Jlc3BvbnNlLlJlbGF0ZWRNZW1vGrcBCgtSZWxhdGVkTWVtbxIgCgRtZW1vGAEgASgLMhIubWVtb3MuYXBpLnYxLk1lbW8SDQoFc2NvcmUYAiABKAESFgoOc2VtYW50aWNfc2NvcmUYAyABKAESEwoLc2hhcmVkX3RhZ3MYBCADKAkSEgoKcmVmZXJlbmNlZBgFIAEoCBI2ChJzdWdnZXN0ZWRfcmVsYXRpb24YBiABKAsyGi5tZW1vcy5hcGkudjEuTWVtb1JlbGF0aW9uIk4KIExpc3REdXBsaWNhdGVNZW1vQ2x1c3RlcnNSZXF1ZXN0EioKB2NyZWF0b3IYASABKAlCGeBBAfpBEwoRbWVtb3MuYXBpLnYxL1VzZXIi2QEKIUxpc3REdXBsaWNhdGVNZW1vQ2x1c3RlcnNSZXNwb25zZRJWCghjbHVzdGVycxgBIAMoCzJELm1lbW9zLmFwaS52MS5MaXN0RHVwbGljYXRlTWVtb0NsdXN0ZXJzUmVzcG9uc2UuRHVwbGljYXRlTWVtb0NsdXN0ZXIaXAoURHVwbGljYXRlTWVtb0NsdXN0ZXISIQoFbWVtb3MYASADKAsyEi5tZW1vcy5hcGkudjEuTWVtbxINCgVleGFjdBgCIAEoCBISCgpzaW1pbGFyaXR5GAMgASgBIm0KEU1lcmdlTWVtb3NSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SLwoMbWVyZ2VkX21lbW9zGAIgAygJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vIoYBChtBY2NlcHRNZW1vRW5yaWNobWVudFJlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIUCgdzdW1tYXJ5GAIgASgIQgPgQQESEQoEdGFncxgDIAMoCUID4EEBEhUKCGNhdGVnb3J5GAQgASgIQgPgQQEihgEKG1JlamVjdE1lbW9FbnJpY2htZW50UmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhQKB3N1bW1hcnkYAiABKAhCA+BBARIRCgR0YWdzGAMgAygJQgPgQQESFQoIY2F0ZWdvcnkYBCABKAhCA+BBASKGAQoYQ3JlYXRlTWVtb0NvbW1lbnRSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SKAoHY29tbWVudBgCIAEoCzISLm1lbW9zLmFwaS52MS5NZW1vQgPgQQISFwoKY29tbWVudF9pZBgDIAEoCUID4EEBIooBChdMaXN0TWVtb0NvbW1lbnRzUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBARIVCghvcmRlcl9ieRgEIAEoCUID4EEBImoKGExpc3RNZW1vQ29tbWVudHNSZXNwb25zZRIhCgVtZW1vcxgBIAMoCzISLm1lbW9zLmFwaS52MS5NZW1vEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgFInQKGExpc3RNZW1vUmVhY3Rpb25zUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9NZW1vEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASJzChlMaXN0TWVtb1JlYWN0aW9uc1Jlc3BvbnNlEikKCXJlYWN0aW9ucxgBIAMoCzIWLm1lbW9zLmFwaS52MS5SZWFjdGlvbhIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkSEgoKdG90YWxfc2l6ZRgDIAEoBSJzChlVcHNlcnRNZW1vUmVhY3Rpb25SZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL01lbW8SLQoIcmVhY3Rpb24YAiABKAsyFi5tZW1vcy5hcGkudjEuUmVhY3Rpb25CA+BBAiJIChlEZWxldGVNZW1vUmVhY3Rpb25SZXF1ZXN0EisKBG5hbWUYASABKAlCHeBBAvpBFwoVbWVtb3MuYXBpLnYxL1JlYWN0aW9uIt0CCgxNZW1vUmV2aXNpb24SFAoEbmFtZRgBIAEoCUIG4EED4EEIEhQKB2NvbnRlbnQYAiABKAlCA+BBAxIxCgp2aXNpYmlsaXR5GAMgASgOMhgubWVtb3MuYXBpLnYxLlZpc2liaWxpdHlCA+BBAxIyCghsb2NhdGlvbhgEIAEoCzIWLm1lbW9zLmFwaS52MS5Mb2NhdGlvbkID4EEDSACIAQESNAoLY3JlYXRlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSEQoEZGlmZhgGIAEoCUID4EEDOmTqQWEKGW1lbW9zLmFwaS52MS9NZW1vUmV2aXNpb24SIW1lbW9zL3ttZW1vfS9yZXZpc2lvbnMve3JldmlzaW9ufRoEbmFtZSoNbWVtb1JldmlzaW9uczIMbWVtb1JldmlzaW9uQgsKCV9sb2NhdGlvbiJ0ChhMaXN0TWVtb1JldmlzaW9uc1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvTWVtbxIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQEiYwoZTGlzdE1lbW9SZXZpc2lvbnNSZXNwb25zZRItCglyZXZpc2lvbnMYASADKAsyGi5tZW1vcy5hcGkudjEuTWVtb1JldmlzaW9uEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSJJChZHZXRNZW1vUmV2aXNpb25SZXF1ZXN0Ei8KBG5hbWUYASABKAlCIeBBAvpBGwoZbWVtb3MuYXBpLnYxL01lbW9SZXZpc2lvbiJNChpSZXN0b3JlTWVtb1JldmlzaW9uUmVxdWVzdBIvCgRuYW1lGAEgASgJQiHgQQL6QRsKGW1lbW9zLmFwaS52MS9NZW1vUmV2aXNpb24qUAoKVmlzaWJpbGl0eRIaChZWSVNJQklMSVRZX1VOU1BFQ0lGSUVEEAASCwoHUFJJVkFURRABEg0KCVBST1RFQ1RFRBACEgoKBlBVQkxJQxADMtwbCgtNZW1vU2VydmljZRJlCgpDcmVhdGVNZW1vEh8ubWVtb3MuYXBpLnYxLkNyZWF0ZU1lbW9SZXF1ZXN0GhIubWVtb3MuYXBpLnYxLk1lbW8iItpBBG1lbW+C0+STAhU6BG1lbW8iDS9hcGkvdjEvbWVtb3MSZgoJTGlzdE1lbW9zEh4ubWVtb3MuYXBpLnYxLkxpc3RNZW1vc1JlcXVlc3QaHy5tZW1vcy5hcGkudjEuTGlzdE1lbW9zUmVzcG9uc2UiGNpBAILT5JMCDxINL2FwaS92MS9tZW1vcxKRAQoTU2VhcmNoTWVtb3NTZW1hbnRpYxIoLm1lbW9zLmFwaS52MS5TZWFyY2hNZW1vc1NlbWFudGljUmVxdWVzdBofLm1lbW9zLmFwaS52MS5MaXN0TWVtb3NSZXNwb25zZSIv2kEFcXVlcnmC0+STAiE6ASoiHC9hcGkvdjEvbWVtb3M6c2VhcmNoU2VtYW50aWMSewoLU2VhcmNoTWVtb3MSIC5tZW1vcy5hcGkudjEuU2VhcmNoTWVtb3NSZXF1ZXN0GiEubWVtb3MuYXBpLnYxLlNlYXJjaE1lbW9zUmVzcG9uc2UiJ9pBBXF1ZXJ5gtPkkwIZOgEqIhQvYXBpL3YxL21lbW9zOnNlYXJjaBJiCgdHZXRNZW1vEhwubWVtb3MuYXBpLnYxLkdldE1lbW9SZXF1ZXN0GhIubWVtb3MuYXBpLnYxLk1lbW8iJdpBBG5hbWWC0+STAhgSFi9hcGkvdjEve25hbWU9bWVtb3MvKn0SfwoKVXBkYXRlTWVtbxIfLm1lbW9zLmFwaS52MS5VcGRhdGVNZW1vUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5NZW1vIjzaQRBtZW1vLHVwZGF0ZV9tYXNrgtPkkwIjOgRtZW1vMhsvYXBpL3YxL3ttZW1vLm5hbWU9bWVtb3MvKn0SbAoKRGVsZXRlTWVtbxIfLm1lbW9zLmFwaS52MS5EZWxldGVNZW1vUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIl2kEEbmFtZYLT5JMCGCoWL2FwaS92MS97bmFtZT1tZW1vcy8qfRKLAQoSU2V0TWVtb0F0dGFjaG1lbnRzEicubWVtb3MuYXBpLnYxLlNldE1lbW9BdHRhY2htZW50c1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiNNpBBG5hbWWC0+STAic6ASoyIi9hcGkvdjEve25hbWU9bWVtb3MvKn0vYXR0YWNobWVudHMSnQEKE0xpc3RNZW1vQXR0YWNobWVudHMSKC5tZW1vcy5hcGkudjEuTGlzdE1lbW9BdHRhY2htZW50c1JlcXVlc3QaKS5tZW1vcy5hcGkudjEuTGlzdE1lbW9BdHRhY2htZW50c1Jlc3BvbnNlIjHaQQRuYW1lgtPkkwIkEiIvYXBpL3YxL3tuYW1lPW1lbW9zLyp9L2F0dGFjaG1lbnRzEoUBChBTZXRNZW1vUmVsYXRpb25zEiUubWVtb3MuYXBpLnYxLlNldE1lbW9SZWxhdGlvbnNSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjLaQQRuYW1lgtPkkwIlOgEqMiAvYXBpL3YxL3tuYW1lPW1lbW9zLyp9L3JlbGF0aW9ucxKVAQoRTGlzdE1lbW9SZWxhdGlvbnMSJi5tZW1vcy5hcGkudjEuTGlzdE1lbW9SZWxhdGlvbnNSZXF1ZXN0GicubWVtb3MuYXBpLnYxLkxpc3RNZW1vUmVsYXRpb25zUmVzcG9uc2UiL9pBBG5hbWWC0+STAiISIC9hcGkvdjEve25hbWU9bWVtb3MvKn0vcmVsYXRpb25zEpABChBMaXN0UmVsYXRlZE1lbW9zEiUubWVtb3MuYXBpLnYxLkxpc3RSZWxhdGVkTWVtb3NSZXF1ZXN0GiYubWVtb3MuYXBpLnYxLkxpc3RSZWxhdGVkTWVtb3NSZXNwb25zZSIt2kEEbmFtZYLT5JMCIBIeL2FwaS92MS97bmFtZT1tZW1vcy8qfS9yZWxhdGVkEqEBChlMaXN0RHVwbGljYXRlTWVtb0NsdXN0ZXJzEi4ubWVtb3MuYXBpLnYxLkxpc3REdXBsaWNhdGVNZW1vQ2x1c3RlcnNSZXF1ZXN0Gi8ubWVtb3MuYXBpLnYxLkxpc3REdXBsaWNhdGVNZW1vQ2x1c3RlcnNSZXNwb25zZSIj2kEAgtPkkwIaEhgvYXBpL3YxL21lbW9zOmR1cGxpY2F0ZXMSfgoKTWVyZ2VNZW1vcxIfLm1lbW9zLmFwaS52MS5NZXJnZU1lbW9zUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5NZW1vIjvaQRFuYW1lLG1lcmdlZF9tZW1vc4LT5JMCIToBKiIcL2FwaS92MS97bmFtZT1tZW1vcy8qfTptZXJnZRKRAQoUQWNjZXB0TWVtb0VucmljaG1lbnQSKS5tZW1vcy5hcGkudjEuQWNjZXB0TWVtb0VucmljaG1lbnRSZXF1ZXN0GhIubWVtb3MuYXBpLnYxLk1lbW8iOtpBBG5hbWWC0+STAi06ASoiKC9hcGkvdjEve25hbWU9bWVtb3MvKn0vZW5yaWNobWVudDphY2NlcHQSkQEKFFJlamVjdE1lbW9FbnJpY2htZW50EikubWVtb3MuYXBpLnYxLlJlamVjdE1lbW9FbnJpY2htZW50UmVxdWVzdBoSLm1lbW9zLmFwaS52MS5NZW1vIjraQQRuYW1lgtPkkwItOgEqIigvYXBpL3YxL3tuYW1lPW1lbW9zLyp9L2VucmljaG1lbnQ6cmVqZWN0EpABChFDcmVhdGVNZW1vQ29tbWVudBImLm1lbW9zLmFwaS52MS5DcmVhdGVNZW1vQ29tbWVudFJlcXVlc3QaEi5tZW1vcy5hcGkudjEuTWVtbyI/2kEMbmFtZSxjb21tZW50gtPkkwIqOgdjb21tZW50Ih8vYXBpL3YxL3tuYW1lPW1lbW9zLyp9L2NvbW1lbnRzEpEBChBMaXN0TWVtb0NvbW1lbnRzEiUubWVtb3MuYXBpLnYxLkxpc3RNZW1vQ29tbWVudHNSZXF1ZXN0GiYubWVtb3MuYXBpLnYxLkxpc3RNZW1vQ29tbWVudHNSZXNwb25zZSIu2kEEbmFtZYLT5JMCIRIfL2FwaS92MS97bmFtZT1tZW1vcy8qfS9jb21tZW50cxKVAQoRTGlzdE1lbW9SZWFjdGlvbnMSJi5tZW1vcy5hcGkudjEuTGlzdE1lbW9SZWFjdGlvbnNSZXF1ZXN0GicubWVtb3MuYXBpLnYxLkxpc3RNZW1vUmVhY3Rpb25zUmVzcG9uc2UiL9pBBG5hbWWC0+STAiISIC9hcGkvdjEve25hbWU9bWVtb3MvKn0vcmVhY3Rpb25zEokBChJVcHNlcnRNZW1vUmVhY3Rpb24SJy5tZW1vcy5hcGkudjEuVXBzZXJ0TWVtb1JlYWN0aW9uUmVxdWVzdBoWLm1lbW9zLmFwaS52MS5SZWFjdGlvbiIy2kEEbmFtZYLT5JMCJToBKiIgL2FwaS92MS97bmFtZT1tZW1vcy8qfS9yZWFjdGlvbnMSiAEKEkRlbGV0ZU1lbW9SZWFjdGlvbhInLm1lbW9zLmFwaS52MS5EZWxldGVNZW1vUmVhY3Rpb25SZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjHaQQRuYW1lgtPkkwIkKiIvYXBpL3YxL3tuYW1lPW1lbW9zLyovcmVhY3Rpb25zLyp9EmYKCUxpc3RUYXNrcxIeLm1lbW9zLmFwaS52MS5MaXN0VGFza3NSZXF1ZXN0Gh8ubWVtb3MuYXBpLnYxLkxpc3RUYXNrc1Jlc3BvbnNlIhjaQQCC0+STAg8SDS9hcGkvdjEvdGFza3MSegoKVG9nZ2xlVGFzaxIfLm1lbW9zLmFwaS52MS5Ub2dnbGVUYXNrUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5UYXNrIjfaQQRuYW1lgtPkkwIqOgEqIiUvYXBpL3YxL3tuYW1lPW1lbW9zLyovdGFza3MvKn06dG9nZ2xlEpUBChFMaXN0TWVtb1JldmlzaW9ucxImLm1lbW9zLmFwaS52MS5MaXN0TWVtb1JldmlzaW9uc1JlcXVlc3QaJy5tZW1vcy5hcGkudjEuTGlzdE1lbW9SZXZpc2lvbnNSZXNwb25zZSIv2kEEbmFtZYLT5JMCIhIgL2FwaS92MS97bmFtZT1tZW1vcy8qfS9yZXZpc2lvbnMShgEKD0dldE1lbW9SZXZpc2lvbhIkLm1lbW9zLmFwaS52MS5HZXRNZW1vUmV2aXNpb25SZXF1ZXN0GhoubWVtb3MuYXBpLnYxLk1lbW9SZXZpc2lvbiIx2kEEbmFtZYLT5JMCJBIiL2FwaS92MS97bmFtZT1tZW1vcy8qL3JldmlzaW9ucy8qfRKRAQoTUmVzdG9yZU1lbW9SZXZpc2lvbhIoLm1lbW9zLmFwaS52MS5SZXN0b3JlTWVtb1JldmlzaW9uUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5NZW1vIjzaQQRuYW1lgtPkkwIvOgEqIiovYXBpL3YxL3tuYW1lPW1lbW9zLyovcmV2aXNpb25zLyp9OnJlc3RvcmVCqAEKEGNvbS5tZW1vcy5hcGkudjFCEE1lbW9TZXJ2aWNlUHJvdG9QAVowZ2l0aHViLmNvbS91c2VtZW1vcy9tZW1vcy9wcm90by9nZW4vYXBpL3YxO2FwaXYxogIDTUFYqgIMTWVtb3MuQXBpLlYxygIMTWVtb3NcQXBpXFYx4gIYTWVtb3NcQXBpXFYxXEdQQk1ldGFkYXRh6gIOTWVtb3M6OkFwaTo6VjFiBnByb3RvMw", [file_api_v1_attachment_service, file_api_v1_common, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message memos.api.v1.Task
+ */
+export type Task = Message<"memos.api.v1.Task"> & {
+  /**
+   * The resource name of the task.
+   * Format: memos/{memo}/tasks/{task}, task is the 0-based index of the checklist item in the memo.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The text of the checklist item without its due date.
+   *
+   * @generated from field: string text = 2;
+   */
+  text: string;
+
+  /**
+   * Whether the checklist item is checked.
+   *
+   * @generated from field: bool checked = 3;
+   */
+  checked: boolean;
+
+  /**
+   * The 1-based line number of the checklist item in the memo content.
+   *
+   * @generated from field: int32 line = 4;
+   */
+  line: number;
+
+  /**
+   * The due date in YYYY-MM-DD format, written as "📅 2026-11-01" or "due:2026-11-01" in the content.
+   *
+   * @generated from field: string due_date = 5;
+   */
+  dueDate: string;
+};
+
+/**
+ * Describes the message memos.api.v1.Task.
+ * Use `create(TaskSchema)` to create a new message.
+ */
+export const TaskSchema: GenMessage<Task> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 0);
+
+/**
+ * @generated from message memos.api.v1.ListTasksRequest
+ */
+export type ListTasksRequest = Message<"memos.api.v1.ListTasksRequest"> & {
+  /**
+   * Optional. The CEL filter of the memos to list the tasks of.
+   * Refer to `ListMemosRequest.filter` for the supported fields.
+   *
+   * @generated from field: string filter = 1;
+   */
+  filter: string;
+
+  /**
+   * Optional. Only list the tasks with the checked state.
+   *
+   * @generated from field: optional bool checked = 2;
+   */
+  checked?: boolean;
+
+  /**
+   * Optional. Only list the tasks due on or before the date, in YYYY-MM-DD format.
+   *
+   * @generated from field: string due_before = 3;
+   */
+  dueBefore: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListTasksRequest.
+ * Use `create(ListTasksRequestSchema)` to create a new message.
+ */
+export const ListTasksRequestSchema: GenMessage<ListTasksRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 1);
+
+/**
+ * @generated from message memos.api.v1.ListTasksResponse
+ */
+export type ListTasksResponse = Message<"memos.api.v1.ListTasksResponse"> & {
+  /**
+   * The tasks, grouped by memo in display time order and in content order within a memo.
+   *
+   * @generated from field: repeated memos.api.v1.Task tasks = 1;
+   */
+  tasks: Task[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListTasksResponse.
+ * Use `create(ListTasksResponseSchema)` to create a new message.
+ */
+export const ListTasksResponseSchema: GenMessage<ListTasksResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 2);
+
+/**
+ * @generated from message memos.api.v1.ToggleTaskRequest
+ */
+export type ToggleTaskRequest = Message<"memos.api.v1.ToggleTaskRequest"> & {
+  /**
+   * Required. The resource name of the task.
+   * Format: memos/{memo}/tasks/{task}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Optional. The checked state to set. The checkbox is flipped when unset.
+   *
+   * @generated from field: optional bool checked = 2;
+   */
+  checked?: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.ToggleTaskRequest.
+ * Use `create(ToggleTaskRequestSchema)` to create a new message.
+ */
+export const ToggleTaskRequestSchema: GenMessage<ToggleTaskRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 3);
 
 /**
  * @generated from message memos.api.v1.Reaction
@@ -71,7 +199,7 @@ export type Reaction = Message<"memos.api.v1.Reaction"> & {
  * Use `create(ReactionSchema)` to create a new message.
  */
 export const ReactionSchema: GenMessage<Reaction> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 0);
+  messageDesc(file_api_v1_memo_service, 4);
 
 /**
  * @generated from message memos.api.v1.Memo
@@ -200,6 +328,54 @@ export type Memo = Message<"memos.api.v1.Memo"> & {
    * @generated from field: optional memos.api.v1.Location location = 18;
    */
   location?: Location;
+
+  /**
+   * Output only. The memos of the same creator whose content is nearly identical to this memo.
+   * Only set in the responses of CreateMemo and UpdateMemo.
+   * Format: memos/{memo}
+   *
+   * @generated from field: repeated string possible_duplicates = 19;
+   */
+  possibleDuplicates: string[];
+
+  /**
+   * Output only. The summary, tag suggestions and category generated from the content.
+   *
+   * @generated from field: memos.api.v1.Memo.Enrichment enrichment = 20;
+   */
+  enrichment?: Memo_Enrichment;
+
+  /**
+   * Output only. The usernames mentioned in the content, without the leading "@".
+   *
+   * @generated from field: repeated string mentions = 21;
+   */
+  mentions: string[];
+
+  /**
+   * Optional. The time to remind the creator about the memo.
+   * It is cleared once the reminder is sent.
+   *
+   * @generated from field: optional google.protobuf.Timestamp remind_time = 22;
+   */
+  remindTime?: Timestamp;
+
+  /**
+   * Optional. The time to publish the memo.
+   * The memo stays private until then and is published with publish_visibility.
+   * It is cleared once the memo is published.
+   *
+   * @generated from field: optional google.protobuf.Timestamp publish_time = 23;
+   */
+  publishTime?: Timestamp;
+
+  /**
+   * Optional. The visibility of the memo once it is published.
+   * Defaults to the visibility of the memo when publish_time is set on creation.
+   *
+   * @generated from field: memos.api.v1.Visibility publish_visibility = 24;
+   */
+  publishVisibility: Visibility;
 };
 
 /**
@@ -207,7 +383,7 @@ export type Memo = Message<"memos.api.v1.Memo"> & {
  * Use `create(MemoSchema)` to create a new message.
  */
 export const MemoSchema: GenMessage<Memo> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 1);
+  messageDesc(file_api_v1_memo_service, 5);
 
 /**
  * Computed properties of a memo.
@@ -241,7 +417,56 @@ export type Memo_Property = Message<"memos.api.v1.Memo.Property"> & {
  * Use `create(Memo_PropertySchema)` to create a new message.
  */
 export const Memo_PropertySchema: GenMessage<Memo_Property> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 1, 0);
+  messageDesc(file_api_v1_memo_service, 5, 0);
+
+/**
+ * Suggestions generated by the language model. They stay suggestions until accepted.
+ *
+ * @generated from message memos.api.v1.Memo.Enrichment
+ */
+export type Memo_Enrichment = Message<"memos.api.v1.Memo.Enrichment"> & {
+  /**
+   * A one or two sentence summary of the content.
+   *
+   * @generated from field: string summary = 1;
+   */
+  summary: string;
+
+  /**
+   * Tags suggested for the memo, without the leading "#".
+   *
+   * @generated from field: repeated string suggested_tags = 2;
+   */
+  suggestedTags: string[];
+
+  /**
+   * A single lowercase category, filterable with `category == "..."`.
+   *
+   * @generated from field: string category = 3;
+   */
+  category: string;
+
+  /**
+   * Whether the summary was accepted.
+   *
+   * @generated from field: bool summary_accepted = 4;
+   */
+  summaryAccepted: boolean;
+
+  /**
+   * Whether the category was accepted.
+   *
+   * @generated from field: bool category_accepted = 5;
+   */
+  categoryAccepted: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.Memo.Enrichment.
+ * Use `create(Memo_EnrichmentSchema)` to create a new message.
+ */
+export const Memo_EnrichmentSchema: GenMessage<Memo_Enrichment> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 5, 1);
 
 /**
  * @generated from message memos.api.v1.Location
@@ -274,7 +499,7 @@ export type Location = Message<"memos.api.v1.Location"> & {
  * Use `create(LocationSchema)` to create a new message.
  */
 export const LocationSchema: GenMessage<Location> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 2);
+  messageDesc(file_api_v1_memo_service, 6);
 
 /**
  * @generated from message memos.api.v1.CreateMemoRequest
@@ -301,7 +526,7 @@ export type CreateMemoRequest = Message<"memos.api.v1.CreateMemoRequest"> & {
  * Use `create(CreateMemoRequestSchema)` to create a new message.
  */
 export const CreateMemoRequestSchema: GenMessage<CreateMemoRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 3);
+  messageDesc(file_api_v1_memo_service, 7);
 
 /**
  * @generated from message memos.api.v1.ListMemosRequest
@@ -338,7 +563,8 @@ export type ListMemosRequest = Message<"memos.api.v1.ListMemosRequest"> & {
    * Default to "display_time desc".
    * Supports comma-separated list of fields following AIP-132.
    * Example: "pinned desc, display_time desc" or "create_time asc"
-   * Supported fields: pinned, display_time, create_time, update_time, name
+   * Supported fields: pinned, display_time, create_time, update_time, name, relevance
+   * `relevance` ranks by how well memos match the `content.matches()` filter, best match first.
    *
    * @generated from field: string order_by = 4;
    */
@@ -366,7 +592,7 @@ export type ListMemosRequest = Message<"memos.api.v1.ListMemosRequest"> & {
  * Use `create(ListMemosRequestSchema)` to create a new message.
  */
 export const ListMemosRequestSchema: GenMessage<ListMemosRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 4);
+  messageDesc(file_api_v1_memo_service, 8);
 
 /**
  * @generated from message memos.api.v1.ListMemosResponse
@@ -393,7 +619,7 @@ export type ListMemosResponse = Message<"memos.api.v1.ListMemosResponse"> & {
  * Use `create(ListMemosResponseSchema)` to create a new message.
  */
 export const ListMemosResponseSchema: GenMessage<ListMemosResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 5);
+  messageDesc(file_api_v1_memo_service, 9);
 
 /**
  * @generated from message memos.api.v1.SearchMemosSemanticRequest
@@ -443,156 +669,122 @@ export type SearchMemosSemanticRequest = Message<"memos.api.v1.SearchMemosSemant
  * Use `create(SearchMemosSemanticRequestSchema)` to create a new message.
  */
 export const SearchMemosSemanticRequestSchema: GenMessage<SearchMemosSemanticRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 6);
+  messageDesc(file_api_v1_memo_service, 10);
 
 /**
- * @generated from message memos.api.v1.GetMemoRequest
+ * @generated from message memos.api.v1.SearchMemosRequest
  */
-export type GetMemoRequest = Message<"memos.api.v1.GetMemoRequest"> & {
+export type SearchMemosRequest = Message<"memos.api.v1.SearchMemosRequest"> & {
   /**
-   * Required. The resource name of the memo.
-   * Format: memos/{memo}
+   * Required. The search query.
    *
-   * @generated from field: string name = 1;
+   * @generated from field: string query = 1;
    */
-  name: string;
-};
-
-/**
- * Describes the message memos.api.v1.GetMemoRequest.
- * Use `create(GetMemoRequestSchema)` to create a new message.
- */
-export const GetMemoRequestSchema: GenMessage<GetMemoRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 7);
+  query: string;
 
-/**
- * @generated from message memos.api.v1.UpdateMemoRequest
- */
-export type UpdateMemoRequest = Message<"memos.api.v1.UpdateMemoRequest"> & {
   /**
-   * Required. The memo to update.
-   * The `name` field is required.
+   * Optional. The search mode.
+   * Default to `HYBRID`.
    *
-   * @generated from field: memos.api.v1.Memo memo = 1;
+   * @generated from field: memos.api.v1.SearchMemosRequest.Mode mode = 2;
    */
-  memo?: Memo;
+  mode: SearchMemosRequest_Mode;
 
   /**
-   * Required. The list of fields to update.
+   * Optional. The maximum number of results to return.
+   * If unspecified, at most 10 results will be returned.
    *
-   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   * @generated from field: int32 page_size = 3;
    */
-  updateMask?: FieldMask;
-};
+  pageSize: number;
 
-/**
- * Describes the message memos.api.v1.UpdateMemoRequest.
- * Use `create(UpdateMemoRequestSchema)` to create a new message.
- */
-export const UpdateMemoRequestSchema: GenMessage<UpdateMemoRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 8);
+  /**
+   * Optional. A page token, received from a previous `SearchMemos` call.
+   *
+   * @generated from field: string page_token = 4;
+   */
+  pageToken: string;
 
-/**
- * @generated from message memos.api.v1.DeleteMemoRequest
- */
-export type DeleteMemoRequest = Message<"memos.api.v1.DeleteMemoRequest"> & {
   /**
-   * Required. The resource name of the memo to delete.
-   * Format: memos/{memo}
+   * Optional. The state of the memos to search.
+   * Default to `NORMAL`. Set to `ARCHIVED` to search archived memos.
    *
-   * @generated from field: string name = 1;
+   * @generated from field: memos.api.v1.State state = 5;
    */
-  name: string;
+  state: State;
 
   /**
-   * Optional. If set to true, the memo will be deleted even if it has associated data.
+   * Optional. Additional CEL filter to narrow down the candidates.
+   * Refer to `Shortcut.filter`.
    *
-   * @generated from field: bool force = 2;
+   * @generated from field: string filter = 6;
    */
-  force: boolean;
+  filter: string;
 };
 
 /**
- * Describes the message memos.api.v1.DeleteMemoRequest.
- * Use `create(DeleteMemoRequestSchema)` to create a new message.
+ * Describes the message memos.api.v1.SearchMemosRequest.
+ * Use `create(SearchMemosRequestSchema)` to create a new message.
  */
-export const DeleteMemoRequestSchema: GenMessage<DeleteMemoRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 9);
+export const SearchMemosRequestSchema: GenMessage<SearchMemosRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 11);
 
 /**
- * @generated from message memos.api.v1.SetMemoAttachmentsRequest
+ * Search modes.
+ *
+ * @generated from enum memos.api.v1.SearchMemosRequest.Mode
  */
-export type SetMemoAttachmentsRequest = Message<"memos.api.v1.SetMemoAttachmentsRequest"> & {
-  /**
-   * Required. The resource name of the memo.
-   * Format: memos/{memo}
-   *
-   * @generated from field: string name = 1;
-   */
-  name: string;
-
+export enum SearchMemosRequest_Mode {
   /**
-   * Required. The attachments to set for the memo.
+   * Unspecified mode, treated as `HYBRID`.
    *
-   * @generated from field: repeated memos.api.v1.Attachment attachments = 2;
+   * @generated from enum value: MODE_UNSPECIFIED = 0;
    */
-  attachments: Attachment[];
-};
-
-/**
- * Describes the message memos.api.v1.SetMemoAttachmentsRequest.
- * Use `create(SetMemoAttachmentsRequestSchema)` to create a new message.
- */
-export const SetMemoAttachmentsRequestSchema: GenMessage<SetMemoAttachmentsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 10);
+  MODE_UNSPECIFIED = 0,
 
-/**
- * @generated from message memos.api.v1.ListMemoAttachmentsRequest
- */
-export type ListMemoAttachmentsRequest = Message<"memos.api.v1.ListMemoAttachmentsRequest"> & {
   /**
-   * Required. The resource name of the memo.
-   * Format: memos/{memo}
+   * Match the query terms against memo content.
    *
-   * @generated from field: string name = 1;
+   * @generated from enum value: KEYWORD = 1;
    */
-  name: string;
+  KEYWORD = 1,
 
   /**
-   * Optional. The maximum number of attachments to return.
+   * Rank memos by embedding similarity to the query.
    *
-   * @generated from field: int32 page_size = 2;
+   * @generated from enum value: SEMANTIC = 2;
    */
-  pageSize: number;
+  SEMANTIC = 2,
 
   /**
-   * Optional. A page token for pagination.
+   * Fuse the keyword and semantic rankings with reciprocal rank fusion.
+   * Falls back to keyword search when semantic search is not configured.
    *
-   * @generated from field: string page_token = 3;
+   * @generated from enum value: HYBRID = 3;
    */
-  pageToken: string;
-};
+  HYBRID = 3,
+}
 
 /**
- * Describes the message memos.api.v1.ListMemoAttachmentsRequest.
- * Use `create(ListMemoAttachmentsRequestSchema)` to create a new message.
+ * Describes the enum memos.api.v1.SearchMemosRequest.Mode.
  */
-export const ListMemoAttachmentsRequestSchema: GenMessage<ListMemoAttachmentsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 11);
+export const SearchMemosRequest_ModeSchema: GenEnum<SearchMemosRequest_Mode> = /*@__PURE__*/
+  enumDesc(file_api_v1_memo_service, 11, 0);
 
 /**
- * @generated from message memos.api.v1.ListMemoAttachmentsResponse
+ * @generated from message memos.api.v1.SearchMemosResponse
  */
-export type ListMemoAttachmentsResponse = Message<"memos.api.v1.ListMemoAttachmentsResponse"> & {
+export type SearchMemosResponse = Message<"memos.api.v1.SearchMemosResponse"> & {
   /**
-   * The list of attachments.
+   * The search results, best match first.
    *
-   * @generated from field: repeated memos.api.v1.Attachment attachments = 1;
+   * @generated from field: repeated memos.api.v1.SearchMemosResponse.Result results = 1;
    */
-  attachments: Attachment[];
+  results: SearchMemosResponse_Result[];
 
   /**
-   * A token for the next page of results.
+   * A token that can be sent as `page_token` to retrieve the next page.
+   * If this field is omitted, there are no subsequent pages.
    *
    * @generated from field: string next_page_token = 2;
    */
@@ -600,12 +792,250 @@ export type ListMemoAttachmentsResponse = Message<"memos.api.v1.ListMemoAttachme
 };
 
 /**
- * Describes the message memos.api.v1.ListMemoAttachmentsResponse.
- * Use `create(ListMemoAttachmentsResponseSchema)` to create a new message.
+ * Describes the message memos.api.v1.SearchMemosResponse.
+ * Use `create(SearchMemosResponseSchema)` to create a new message.
  */
-export const ListMemoAttachmentsResponseSchema: GenMessage<ListMemoAttachmentsResponse> = /*@__PURE__*/
+export const SearchMemosResponseSchema: GenMessage<SearchMemosResponse> = /*@__PURE__*/
   messageDesc(file_api_v1_memo_service, 12);
 
+/**
+ * @generated from message memos.api.v1.SearchMemosResponse.Result
+ */
+export type SearchMemosResponse_Result = Message<"memos.api.v1.SearchMemosResponse.Result"> & {
+  /**
+   * The matched memo.
+   *
+   * @generated from field: memos.api.v1.Memo memo = 1;
+   */
+  memo?: Memo;
+
+  /**
+   * The score used to order the results.
+   * In hybrid mode this is the reciprocal rank fusion score.
+   *
+   * @generated from field: double score = 2;
+   */
+  score: number;
+
+  /**
+   * The 1-based position of the memo in the keyword ranking, 0 if it did not match.
+   *
+   * @generated from field: int32 keyword_rank = 3;
+   */
+  keywordRank: number;
+
+  /**
+   * The BM25 score of the memo for the query terms.
+   *
+   * @generated from field: double keyword_score = 4;
+   */
+  keywordScore: number;
+
+  /**
+   * The 1-based position of the memo in the semantic ranking, 0 if it did not match.
+   *
+   * @generated from field: int32 semantic_rank = 5;
+   */
+  semanticRank: number;
+
+  /**
+   * The cosine similarity between the best matching passage of the memo and the query.
+   *
+   * @generated from field: double semantic_score = 6;
+   */
+  semanticScore: number;
+
+  /**
+   * The query terms found in the memo content.
+   *
+   * @generated from field: repeated string matched_terms = 7;
+   */
+  matchedTerms: string[];
+
+  /**
+   * A plain text excerpt of the memo around the first matched term,
+   * or of the best matching passage when no term matched.
+   *
+   * @generated from field: string snippet = 8;
+   */
+  snippet: string;
+
+  /**
+   * The raw content of the passage that matched the query semantically, empty if it did not match.
+   *
+   * @generated from field: string passage = 9;
+   */
+  passage: string;
+};
+
+/**
+ * Describes the message memos.api.v1.SearchMemosResponse.Result.
+ * Use `create(SearchMemosResponse_ResultSchema)` to create a new message.
+ */
+export const SearchMemosResponse_ResultSchema: GenMessage<SearchMemosResponse_Result> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 12, 0);
+
+/**
+ * @generated from message memos.api.v1.GetMemoRequest
+ */
+export type GetMemoRequest = Message<"memos.api.v1.GetMemoRequest"> & {
+  /**
+   * Required. The resource name of the memo.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetMemoRequest.
+ * Use `create(GetMemoRequestSchema)` to create a new message.
+ */
+export const GetMemoRequestSchema: GenMessage<GetMemoRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 13);
+
+/**
+ * @generated from message memos.api.v1.UpdateMemoRequest
+ */
+export type UpdateMemoRequest = Message<"memos.api.v1.UpdateMemoRequest"> & {
+  /**
+   * Required. The memo to update.
+   * The `name` field is required.
+   *
+   * @generated from field: memos.api.v1.Memo memo = 1;
+   */
+  memo?: Memo;
+
+  /**
+   * Required. The list of fields to update.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message memos.api.v1.UpdateMemoRequest.
+ * Use `create(UpdateMemoRequestSchema)` to create a new message.
+ */
+export const UpdateMemoRequestSchema: GenMessage<UpdateMemoRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 14);
+
+/**
+ * @generated from message memos.api.v1.DeleteMemoRequest
+ */
+export type DeleteMemoRequest = Message<"memos.api.v1.DeleteMemoRequest"> & {
+  /**
+   * Required. The resource name of the memo to delete.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Optional. If set to true, the memo will be deleted even if it has associated data.
+   *
+   * @generated from field: bool force = 2;
+   */
+  force: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.DeleteMemoRequest.
+ * Use `create(DeleteMemoRequestSchema)` to create a new message.
+ */
+export const DeleteMemoRequestSchema: GenMessage<DeleteMemoRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 15);
+
+/**
+ * @generated from message memos.api.v1.SetMemoAttachmentsRequest
+ */
+export type SetMemoAttachmentsRequest = Message<"memos.api.v1.SetMemoAttachmentsRequest"> & {
+  /**
+   * Required. The resource name of the memo.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Required. The attachments to set for the memo.
+   *
+   * @generated from field: repeated memos.api.v1.Attachment attachments = 2;
+   */
+  attachments: Attachment[];
+};
+
+/**
+ * Describes the message memos.api.v1.SetMemoAttachmentsRequest.
+ * Use `create(SetMemoAttachmentsRequestSchema)` to create a new message.
+ */
+export const SetMemoAttachmentsRequestSchema: GenMessage<SetMemoAttachmentsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 16);
+
+/**
+ * @generated from message memos.api.v1.ListMemoAttachmentsRequest
+ */
+export type ListMemoAttachmentsRequest = Message<"memos.api.v1.ListMemoAttachmentsRequest"> & {
+  /**
+   * Required. The resource name of the memo.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Optional. The maximum number of attachments to return.
+   *
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * Optional. A page token for pagination.
+   *
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoAttachmentsRequest.
+ * Use `create(ListMemoAttachmentsRequestSchema)` to create a new message.
+ */
+export const ListMemoAttachmentsRequestSchema: GenMessage<ListMemoAttachmentsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 17);
+
+/**
+ * @generated from message memos.api.v1.ListMemoAttachmentsResponse
+ */
+export type ListMemoAttachmentsResponse = Message<"memos.api.v1.ListMemoAttachmentsResponse"> & {
+  /**
+   * The list of attachments.
+   *
+   * @generated from field: repeated memos.api.v1.Attachment attachments = 1;
+   */
+  attachments: Attachment[];
+
+  /**
+   * A token for the next page of results.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoAttachmentsResponse.
+ * Use `create(ListMemoAttachmentsResponseSchema)` to create a new message.
+ */
+export const ListMemoAttachmentsResponseSchema: GenMessage<ListMemoAttachmentsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 18);
+
 /**
  * @generated from message memos.api.v1.MemoRelation
  */
@@ -635,7 +1065,7 @@ export type MemoRelation = Message<"memos.api.v1.MemoRelation"> & {
  * Use `create(MemoRelationSchema)` to create a new message.
  */
 export const MemoRelationSchema: GenMessage<MemoRelation> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 13);
+  messageDesc(file_api_v1_memo_service, 19);
 
 /**
  * Memo reference in relations.
@@ -652,54 +1082,317 @@ export type MemoRelation_Memo = Message<"memos.api.v1.MemoRelation.Memo"> & {
   name: string;
 
   /**
-   * Output only. The snippet of the memo content. Plain text only.
+   * Output only. The snippet of the memo content. Plain text only.
+   *
+   * @generated from field: string snippet = 2;
+   */
+  snippet: string;
+};
+
+/**
+ * Describes the message memos.api.v1.MemoRelation.Memo.
+ * Use `create(MemoRelation_MemoSchema)` to create a new message.
+ */
+export const MemoRelation_MemoSchema: GenMessage<MemoRelation_Memo> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 19, 0);
+
+/**
+ * The type of the relation.
+ *
+ * @generated from enum memos.api.v1.MemoRelation.Type
+ */
+export enum MemoRelation_Type {
+  /**
+   * @generated from enum value: TYPE_UNSPECIFIED = 0;
+   */
+  TYPE_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: REFERENCE = 1;
+   */
+  REFERENCE = 1,
+
+  /**
+   * @generated from enum value: COMMENT = 2;
+   */
+  COMMENT = 2,
+}
+
+/**
+ * Describes the enum memos.api.v1.MemoRelation.Type.
+ */
+export const MemoRelation_TypeSchema: GenEnum<MemoRelation_Type> = /*@__PURE__*/
+  enumDesc(file_api_v1_memo_service, 19, 0);
+
+/**
+ * @generated from message memos.api.v1.SetMemoRelationsRequest
+ */
+export type SetMemoRelationsRequest = Message<"memos.api.v1.SetMemoRelationsRequest"> & {
+  /**
+   * Required. The resource name of the memo.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Required. The relations to set for the memo.
+   *
+   * @generated from field: repeated memos.api.v1.MemoRelation relations = 2;
+   */
+  relations: MemoRelation[];
+};
+
+/**
+ * Describes the message memos.api.v1.SetMemoRelationsRequest.
+ * Use `create(SetMemoRelationsRequestSchema)` to create a new message.
+ */
+export const SetMemoRelationsRequestSchema: GenMessage<SetMemoRelationsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 20);
+
+/**
+ * @generated from message memos.api.v1.ListMemoRelationsRequest
+ */
+export type ListMemoRelationsRequest = Message<"memos.api.v1.ListMemoRelationsRequest"> & {
+  /**
+   * Required. The resource name of the memo.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Optional. The maximum number of relations to return.
+   *
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * Optional. A page token for pagination.
+   *
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoRelationsRequest.
+ * Use `create(ListMemoRelationsRequestSchema)` to create a new message.
+ */
+export const ListMemoRelationsRequestSchema: GenMessage<ListMemoRelationsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 21);
+
+/**
+ * @generated from message memos.api.v1.ListMemoRelationsResponse
+ */
+export type ListMemoRelationsResponse = Message<"memos.api.v1.ListMemoRelationsResponse"> & {
+  /**
+   * The list of relations.
+   *
+   * @generated from field: repeated memos.api.v1.MemoRelation relations = 1;
+   */
+  relations: MemoRelation[];
+
+  /**
+   * A token for the next page of results.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoRelationsResponse.
+ * Use `create(ListMemoRelationsResponseSchema)` to create a new message.
+ */
+export const ListMemoRelationsResponseSchema: GenMessage<ListMemoRelationsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 22);
+
+/**
+ * @generated from message memos.api.v1.ListRelatedMemosRequest
+ */
+export type ListRelatedMemosRequest = Message<"memos.api.v1.ListRelatedMemosRequest"> & {
+  /**
+   * Required. The resource name of the memo.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Optional. The maximum number of related memos to return.
+   * The default is 10 and the maximum is 50.
+   *
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message memos.api.v1.ListRelatedMemosRequest.
+ * Use `create(ListRelatedMemosRequestSchema)` to create a new message.
+ */
+export const ListRelatedMemosRequestSchema: GenMessage<ListRelatedMemosRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 23);
+
+/**
+ * @generated from message memos.api.v1.ListRelatedMemosResponse
+ */
+export type ListRelatedMemosResponse = Message<"memos.api.v1.ListRelatedMemosResponse"> & {
+  /**
+   * The related memos, most related first.
+   *
+   * @generated from field: repeated memos.api.v1.ListRelatedMemosResponse.RelatedMemo related_memos = 1;
+   */
+  relatedMemos: ListRelatedMemosResponse_RelatedMemo[];
+};
+
+/**
+ * Describes the message memos.api.v1.ListRelatedMemosResponse.
+ * Use `create(ListRelatedMemosResponseSchema)` to create a new message.
+ */
+export const ListRelatedMemosResponseSchema: GenMessage<ListRelatedMemosResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 24);
+
+/**
+ * @generated from message memos.api.v1.ListRelatedMemosResponse.RelatedMemo
+ */
+export type ListRelatedMemosResponse_RelatedMemo = Message<"memos.api.v1.ListRelatedMemosResponse.RelatedMemo"> & {
+  /**
+   * The related memo.
+   *
+   * @generated from field: memos.api.v1.Memo memo = 1;
+   */
+  memo?: Memo;
+
+  /**
+   * The combined relatedness score used to order the results.
+   *
+   * @generated from field: double score = 2;
+   */
+  score: number;
+
+  /**
+   * The cosine similarity between the embeddings of the two memos, 0 if either has no embedding.
+   *
+   * @generated from field: double semantic_score = 3;
+   */
+  semanticScore: number;
+
+  /**
+   * The tags both memos have in common.
+   *
+   * @generated from field: repeated string shared_tags = 4;
+   */
+  sharedTags: string[];
+
+  /**
+   * Whether a REFERENCE relation already links the two memos, in either direction.
+   *
+   * @generated from field: bool referenced = 5;
+   */
+  referenced: boolean;
+
+  /**
+   * A REFERENCE relation from the requested memo to the related memo that the caller can accept
+   * by adding it to the relations sent to SetMemoRelations.
+   * Only set when the memos are not linked yet and the caller can edit the requested memo.
+   *
+   * @generated from field: memos.api.v1.MemoRelation suggested_relation = 6;
+   */
+  suggestedRelation?: MemoRelation;
+};
+
+/**
+ * Describes the message memos.api.v1.ListRelatedMemosResponse.RelatedMemo.
+ * Use `create(ListRelatedMemosResponse_RelatedMemoSchema)` to create a new message.
+ */
+export const ListRelatedMemosResponse_RelatedMemoSchema: GenMessage<ListRelatedMemosResponse_RelatedMemo> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 24, 0);
+
+/**
+ * @generated from message memos.api.v1.ListDuplicateMemoClustersRequest
+ */
+export type ListDuplicateMemoClustersRequest = Message<"memos.api.v1.ListDuplicateMemoClustersRequest"> & {
+  /**
+   * Optional. The creator whose memos are scanned.
+   * Format: users/{user}
+   * Defaults to the current user. Admins may scan another user, or every user by setting it to "users/-".
+   *
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListDuplicateMemoClustersRequest.
+ * Use `create(ListDuplicateMemoClustersRequestSchema)` to create a new message.
+ */
+export const ListDuplicateMemoClustersRequestSchema: GenMessage<ListDuplicateMemoClustersRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 25);
+
+/**
+ * @generated from message memos.api.v1.ListDuplicateMemoClustersResponse
+ */
+export type ListDuplicateMemoClustersResponse = Message<"memos.api.v1.ListDuplicateMemoClustersResponse"> & {
+  /**
+   * The clusters of near-duplicate memos, largest first.
    *
-   * @generated from field: string snippet = 2;
+   * @generated from field: repeated memos.api.v1.ListDuplicateMemoClustersResponse.DuplicateMemoCluster clusters = 1;
    */
-  snippet: string;
+  clusters: ListDuplicateMemoClustersResponse_DuplicateMemoCluster[];
 };
 
 /**
- * Describes the message memos.api.v1.MemoRelation.Memo.
- * Use `create(MemoRelation_MemoSchema)` to create a new message.
+ * Describes the message memos.api.v1.ListDuplicateMemoClustersResponse.
+ * Use `create(ListDuplicateMemoClustersResponseSchema)` to create a new message.
  */
-export const MemoRelation_MemoSchema: GenMessage<MemoRelation_Memo> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 13, 0);
+export const ListDuplicateMemoClustersResponseSchema: GenMessage<ListDuplicateMemoClustersResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 26);
 
 /**
- * The type of the relation.
- *
- * @generated from enum memos.api.v1.MemoRelation.Type
+ * @generated from message memos.api.v1.ListDuplicateMemoClustersResponse.DuplicateMemoCluster
  */
-export enum MemoRelation_Type {
+export type ListDuplicateMemoClustersResponse_DuplicateMemoCluster = Message<"memos.api.v1.ListDuplicateMemoClustersResponse.DuplicateMemoCluster"> & {
   /**
-   * @generated from enum value: TYPE_UNSPECIFIED = 0;
+   * The memos of the cluster, most recently updated first.
+   *
+   * @generated from field: repeated memos.api.v1.Memo memos = 1;
    */
-  TYPE_UNSPECIFIED = 0,
+  memos: Memo[];
 
   /**
-   * @generated from enum value: REFERENCE = 1;
+   * Whether all memos of the cluster have the same normalized content.
+   *
+   * @generated from field: bool exact = 2;
    */
-  REFERENCE = 1,
+  exact: boolean;
 
   /**
-   * @generated from enum value: COMMENT = 2;
+   * The lowest similarity between two memos linked in the cluster, 1 for exact duplicates.
+   *
+   * @generated from field: double similarity = 3;
    */
-  COMMENT = 2,
-}
+  similarity: number;
+};
 
 /**
- * Describes the enum memos.api.v1.MemoRelation.Type.
+ * Describes the message memos.api.v1.ListDuplicateMemoClustersResponse.DuplicateMemoCluster.
+ * Use `create(ListDuplicateMemoClustersResponse_DuplicateMemoClusterSchema)` to create a new message.
  */
-export const MemoRelation_TypeSchema: GenEnum<MemoRelation_Type> = /*@__PURE__*/
-  enumDesc(file_api_v1_memo_service, 13, 0);
+export const ListDuplicateMemoClustersResponse_DuplicateMemoClusterSchema: GenMessage<ListDuplicateMemoClustersResponse_DuplicateMemoCluster> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 26, 0);
 
 /**
- * @generated from message memos.api.v1.SetMemoRelationsRequest
+ * @generated from message memos.api.v1.MergeMemosRequest
  */
-export type SetMemoRelationsRequest = Message<"memos.api.v1.SetMemoRelationsRequest"> & {
+export type MergeMemosRequest = Message<"memos.api.v1.MergeMemosRequest"> & {
   /**
-   * Required. The resource name of the memo.
+   * Required. The resource name of the surviving memo.
    * Format: memos/{memo}
    *
    * @generated from field: string name = 1;
@@ -707,24 +1400,26 @@ export type SetMemoRelationsRequest = Message<"memos.api.v1.SetMemoRelationsRequ
   name: string;
 
   /**
-   * Required. The relations to set for the memo.
+   * Required. The memos merged into the surviving memo, in the order their content is appended.
+   * They are deleted once merged.
+   * Format: memos/{memo}
    *
-   * @generated from field: repeated memos.api.v1.MemoRelation relations = 2;
+   * @generated from field: repeated string merged_memos = 2;
    */
-  relations: MemoRelation[];
+  mergedMemos: string[];
 };
 
 /**
- * Describes the message memos.api.v1.SetMemoRelationsRequest.
- * Use `create(SetMemoRelationsRequestSchema)` to create a new message.
+ * Describes the message memos.api.v1.MergeMemosRequest.
+ * Use `create(MergeMemosRequestSchema)` to create a new message.
  */
-export const SetMemoRelationsRequestSchema: GenMessage<SetMemoRelationsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 14);
+export const MergeMemosRequestSchema: GenMessage<MergeMemosRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 27);
 
 /**
- * @generated from message memos.api.v1.ListMemoRelationsRequest
+ * @generated from message memos.api.v1.AcceptMemoEnrichmentRequest
  */
-export type ListMemoRelationsRequest = Message<"memos.api.v1.ListMemoRelationsRequest"> & {
+export type AcceptMemoEnrichmentRequest = Message<"memos.api.v1.AcceptMemoEnrichmentRequest"> & {
   /**
    * Required. The resource name of the memo.
    * Format: memos/{memo}
@@ -734,52 +1429,74 @@ export type ListMemoRelationsRequest = Message<"memos.api.v1.ListMemoRelationsRe
   name: string;
 
   /**
-   * Optional. The maximum number of relations to return.
+   * Optional. Whether to accept the summary.
    *
-   * @generated from field: int32 page_size = 2;
+   * @generated from field: bool summary = 2;
    */
-  pageSize: number;
+  summary: boolean;
 
   /**
-   * Optional. A page token for pagination.
+   * Optional. The suggested tags to accept. They are appended to the content as hashtags.
    *
-   * @generated from field: string page_token = 3;
+   * @generated from field: repeated string tags = 3;
    */
-  pageToken: string;
+  tags: string[];
+
+  /**
+   * Optional. Whether to accept the category.
+   *
+   * @generated from field: bool category = 4;
+   */
+  category: boolean;
 };
 
 /**
- * Describes the message memos.api.v1.ListMemoRelationsRequest.
- * Use `create(ListMemoRelationsRequestSchema)` to create a new message.
+ * Describes the message memos.api.v1.AcceptMemoEnrichmentRequest.
+ * Use `create(AcceptMemoEnrichmentRequestSchema)` to create a new message.
  */
-export const ListMemoRelationsRequestSchema: GenMessage<ListMemoRelationsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 15);
+export const AcceptMemoEnrichmentRequestSchema: GenMessage<AcceptMemoEnrichmentRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 28);
 
 /**
- * @generated from message memos.api.v1.ListMemoRelationsResponse
+ * @generated from message memos.api.v1.RejectMemoEnrichmentRequest
  */
-export type ListMemoRelationsResponse = Message<"memos.api.v1.ListMemoRelationsResponse"> & {
+export type RejectMemoEnrichmentRequest = Message<"memos.api.v1.RejectMemoEnrichmentRequest"> & {
   /**
-   * The list of relations.
+   * Required. The resource name of the memo.
+   * Format: memos/{memo}
    *
-   * @generated from field: repeated memos.api.v1.MemoRelation relations = 1;
+   * @generated from field: string name = 1;
    */
-  relations: MemoRelation[];
+  name: string;
 
   /**
-   * A token for the next page of results.
+   * Optional. Whether to reject the summary.
    *
-   * @generated from field: string next_page_token = 2;
+   * @generated from field: bool summary = 2;
    */
-  nextPageToken: string;
+  summary: boolean;
+
+  /**
+   * Optional. The suggested tags to reject. Rejected tags are not suggested again.
+   *
+   * @generated from field: repeated string tags = 3;
+   */
+  tags: string[];
+
+  /**
+   * Optional. Whether to reject the category.
+   *
+   * @generated from field: bool category = 4;
+   */
+  category: boolean;
 };
 
 /**
- * Describes the message memos.api.v1.ListMemoRelationsResponse.
- * Use `create(ListMemoRelationsResponseSchema)` to create a new message.
+ * Describes the message memos.api.v1.RejectMemoEnrichmentRequest.
+ * Use `create(RejectMemoEnrichmentRequestSchema)` to create a new message.
  */
-export const ListMemoRelationsResponseSchema: GenMessage<ListMemoRelationsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 16);
+export const RejectMemoEnrichmentRequestSchema: GenMessage<RejectMemoEnrichmentRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 29);
 
 /**
  * @generated from message memos.api.v1.CreateMemoCommentRequest
@@ -813,7 +1530,7 @@ export type CreateMemoCommentRequest = Message<"memos.api.v1.CreateMemoCommentRe
  * Use `create(CreateMemoCommentRequestSchema)` to create a new message.
  */
 export const CreateMemoCommentRequestSchema: GenMessage<CreateMemoCommentRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 17);
+  messageDesc(file_api_v1_memo_service, 30);
 
 /**
  * @generated from message memos.api.v1.ListMemoCommentsRequest
@@ -854,7 +1571,7 @@ export type ListMemoCommentsRequest = Message<"memos.api.v1.ListMemoCommentsRequ
  * Use `create(ListMemoCommentsRequestSchema)` to create a new message.
  */
 export const ListMemoCommentsRequestSchema: GenMessage<ListMemoCommentsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 18);
+  messageDesc(file_api_v1_memo_service, 31);
 
 /**
  * @generated from message memos.api.v1.ListMemoCommentsResponse
@@ -887,7 +1604,7 @@ export type ListMemoCommentsResponse = Message<"memos.api.v1.ListMemoCommentsRes
  * Use `create(ListMemoCommentsResponseSchema)` to create a new message.
  */
 export const ListMemoCommentsResponseSchema: GenMessage<ListMemoCommentsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 19);
+  messageDesc(file_api_v1_memo_service, 32);
 
 /**
  * @generated from message memos.api.v1.ListMemoReactionsRequest
@@ -921,7 +1638,7 @@ export type ListMemoReactionsRequest = Message<"memos.api.v1.ListMemoReactionsRe
  * Use `create(ListMemoReactionsRequestSchema)` to create a new message.
  */
 export const ListMemoReactionsRequestSchema: GenMessage<ListMemoReactionsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 20);
+  messageDesc(file_api_v1_memo_service, 33);
 
 /**
  * @generated from message memos.api.v1.ListMemoReactionsResponse
@@ -954,7 +1671,7 @@ export type ListMemoReactionsResponse = Message<"memos.api.v1.ListMemoReactionsR
  * Use `create(ListMemoReactionsResponseSchema)` to create a new message.
  */
 export const ListMemoReactionsResponseSchema: GenMessage<ListMemoReactionsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 21);
+  messageDesc(file_api_v1_memo_service, 34);
 
 /**
  * @generated from message memos.api.v1.UpsertMemoReactionRequest
@@ -981,7 +1698,7 @@ export type UpsertMemoReactionRequest = Message<"memos.api.v1.UpsertMemoReaction
  * Use `create(UpsertMemoReactionRequestSchema)` to create a new message.
  */
 export const UpsertMemoReactionRequestSchema: GenMessage<UpsertMemoReactionRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 22);
+  messageDesc(file_api_v1_memo_service, 35);
 
 /**
  * @generated from message memos.api.v1.DeleteMemoReactionRequest
@@ -1001,7 +1718,168 @@ export type DeleteMemoReactionRequest = Message<"memos.api.v1.DeleteMemoReaction
  * Use `create(DeleteMemoReactionRequestSchema)` to create a new message.
  */
 export const DeleteMemoReactionRequestSchema: GenMessage<DeleteMemoReactionRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_memo_service, 23);
+  messageDesc(file_api_v1_memo_service, 36);
+
+/**
+ * MemoRevision is a prior version of a memo, recorded when the memo is updated.
+ *
+ * @generated from message memos.api.v1.MemoRevision
+ */
+export type MemoRevision = Message<"memos.api.v1.MemoRevision"> & {
+  /**
+   * The resource name of the revision.
+   * Format: memos/{memo}/revisions/{revision}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The content of the memo in this version.
+   *
+   * @generated from field: string content = 2;
+   */
+  content: string;
+
+  /**
+   * The visibility of the memo in this version.
+   *
+   * @generated from field: memos.api.v1.Visibility visibility = 3;
+   */
+  visibility: Visibility;
+
+  /**
+   * The location of the memo in this version.
+   *
+   * @generated from field: optional memos.api.v1.Location location = 4;
+   */
+  location?: Location;
+
+  /**
+   * The time this version was saved.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 5;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * The unified diff of the content of this version against the current content of the memo.
+   * Only set in the response of GetMemoRevision.
+   *
+   * @generated from field: string diff = 6;
+   */
+  diff: string;
+};
+
+/**
+ * Describes the message memos.api.v1.MemoRevision.
+ * Use `create(MemoRevisionSchema)` to create a new message.
+ */
+export const MemoRevisionSchema: GenMessage<MemoRevision> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 37);
+
+/**
+ * @generated from message memos.api.v1.ListMemoRevisionsRequest
+ */
+export type ListMemoRevisionsRequest = Message<"memos.api.v1.ListMemoRevisionsRequest"> & {
+  /**
+   * Required. The resource name of the memo.
+   * Format: memos/{memo}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Optional. The maximum number of revisions to return.
+   * If unspecified, at most 10 revisions will be returned.
+   * The maximum value is 1000; values above 1000 will be coerced to 1000.
+   *
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * Optional. A page token, received from a previous `ListMemoRevisions` call.
+   *
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoRevisionsRequest.
+ * Use `create(ListMemoRevisionsRequestSchema)` to create a new message.
+ */
+export const ListMemoRevisionsRequestSchema: GenMessage<ListMemoRevisionsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 38);
+
+/**
+ * @generated from message memos.api.v1.ListMemoRevisionsResponse
+ */
+export type ListMemoRevisionsResponse = Message<"memos.api.v1.ListMemoRevisionsResponse"> & {
+  /**
+   * The list of revisions, newest first. Their content is set but not their diff.
+   *
+   * @generated from field: repeated memos.api.v1.MemoRevision revisions = 1;
+   */
+  revisions: MemoRevision[];
+
+  /**
+   * A token that can be sent as `page_token` to retrieve the next page.
+   * If this field is omitted, there are no subsequent pages.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListMemoRevisionsResponse.
+ * Use `create(ListMemoRevisionsResponseSchema)` to create a new message.
+ */
+export const ListMemoRevisionsResponseSchema: GenMessage<ListMemoRevisionsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 39);
+
+/**
+ * @generated from message memos.api.v1.GetMemoRevisionRequest
+ */
+export type GetMemoRevisionRequest = Message<"memos.api.v1.GetMemoRevisionRequest"> & {
+  /**
+   * Required. The resource name of the revision.
+   * Format: memos/{memo}/revisions/{revision}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.GetMemoRevisionRequest.
+ * Use `create(GetMemoRevisionRequestSchema)` to create a new message.
+ */
+export const GetMemoRevisionRequestSchema: GenMessage<GetMemoRevisionRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 40);
+
+/**
+ * @generated from message memos.api.v1.RestoreMemoRevisionRequest
+ */
+export type RestoreMemoRevisionRequest = Message<"memos.api.v1.RestoreMemoRevisionRequest"> & {
+  /**
+   * Required. The resource name of the revision to restore.
+   * Format: memos/{memo}/revisions/{revision}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.RestoreMemoRevisionRequest.
+ * Use `create(RestoreMemoRevisionRequestSchema)` to create a new message.
+ */
+export const RestoreMemoRevisionRequestSchema: GenMessage<RestoreMemoRevisionRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_memo_service, 41);
 
 /**
  * @generated from enum memos.api.v1.Visibility
@@ -1068,6 +1946,16 @@ export const MemoService: GenService<{
     input: typeof SearchMemosSemanticRequestSchema;
     output: typeof ListMemosResponseSchema;
   },
+  /**
+   * SearchMemos searches memos by keyword, semantic similarity or both.
+   *
+   * @generated from rpc memos.api.v1.MemoService.SearchMemos
+   */
+  searchMemos: {
+    methodKind: "unary";
+    input: typeof SearchMemosRequestSchema;
+    output: typeof SearchMemosResponseSchema;
+  },
   /**
    * GetMemo gets a memo.
    *
@@ -1138,6 +2026,57 @@ export const MemoService: GenService<{
     input: typeof ListMemoRelationsRequestSchema;
     output: typeof ListMemoRelationsResponseSchema;
   },
+  /**
+   * ListRelatedMemos lists memos related to a memo by content similarity, shared tags and references.
+   *
+   * @generated from rpc memos.api.v1.MemoService.ListRelatedMemos
+   */
+  listRelatedMemos: {
+    methodKind: "unary";
+    input: typeof ListRelatedMemosRequestSchema;
+    output: typeof ListRelatedMemosResponseSchema;
+  },
+  /**
+   * ListDuplicateMemoClusters scans memos for near-duplicates and groups them into clusters.
+   * Only the 2000 most recently updated memos are scanned.
+   *
+   * @generated from rpc memos.api.v1.MemoService.ListDuplicateMemoClusters
+   */
+  listDuplicateMemoClusters: {
+    methodKind: "unary";
+    input: typeof ListDuplicateMemoClustersRequestSchema;
+    output: typeof ListDuplicateMemoClustersResponseSchema;
+  },
+  /**
+   * MergeMemos merges memos into a surviving memo and deletes them.
+   *
+   * @generated from rpc memos.api.v1.MemoService.MergeMemos
+   */
+  mergeMemos: {
+    methodKind: "unary";
+    input: typeof MergeMemosRequestSchema;
+    output: typeof MemoSchema;
+  },
+  /**
+   * AcceptMemoEnrichment accepts suggestions of the memo enrichment.
+   *
+   * @generated from rpc memos.api.v1.MemoService.AcceptMemoEnrichment
+   */
+  acceptMemoEnrichment: {
+    methodKind: "unary";
+    input: typeof AcceptMemoEnrichmentRequestSchema;
+    output: typeof MemoSchema;
+  },
+  /**
+   * RejectMemoEnrichment rejects suggestions of the memo enrichment.
+   *
+   * @generated from rpc memos.api.v1.MemoService.RejectMemoEnrichment
+   */
+  rejectMemoEnrichment: {
+    methodKind: "unary";
+    input: typeof RejectMemoEnrichmentRequestSchema;
+    output: typeof MemoSchema;
+  },
   /**
    * CreateMemoComment creates a comment for a memo.
    *
@@ -1188,6 +2127,56 @@ export const MemoService: GenService<{
     input: typeof DeleteMemoReactionRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * ListTasks lists the checklist items of the memos of the current user.
+   *
+   * @generated from rpc memos.api.v1.MemoService.ListTasks
+   */
+  listTasks: {
+    methodKind: "unary";
+    input: typeof ListTasksRequestSchema;
+    output: typeof ListTasksResponseSchema;
+  },
+  /**
+   * ToggleTask checks or unchecks a checklist item by rewriting its checkbox in the memo content.
+   *
+   * @generated from rpc memos.api.v1.MemoService.ToggleTask
+   */
+  toggleTask: {
+    methodKind: "unary";
+    input: typeof ToggleTaskRequestSchema;
+    output: typeof TaskSchema;
+  },
+  /**
+   * ListMemoRevisions lists the prior versions of a memo, newest first.
+   *
+   * @generated from rpc memos.api.v1.MemoService.ListMemoRevisions
+   */
+  listMemoRevisions: {
+    methodKind: "unary";
+    input: typeof ListMemoRevisionsRequestSchema;
+    output: typeof ListMemoRevisionsResponseSchema;
+  },
+  /**
+   * GetMemoRevision gets a prior version of a memo, with a diff against the current version.
+   *
+   * @generated from rpc memos.api.v1.MemoService.GetMemoRevision
+   */
+  getMemoRevision: {
+    methodKind: "unary";
+    input: typeof GetMemoRevisionRequestSchema;
+    output: typeof MemoRevisionSchema;
+  },
+  /**
+   * RestoreMemoRevision restores a memo to a prior version. The replaced version is recorded as a revision.
+   *
+   * @generated from rpc memos.api.v1.MemoService.RestoreMemoRevision
+   */
+  restoreMemoRevision: {
+    methodKind: "unary";
+    input: typeof RestoreMemoRevisionRequestSchema;
+    output: typeof MemoSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_memo_service, 0);
 
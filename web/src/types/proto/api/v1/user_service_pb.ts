@@ -18,7 +18,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/user_service.proto.
  */
 export const file_api_v1_user_service: GenFile = /*@__PURE__*/
-  fileDesc("ChlhcGkvdjEvdXNlcl9zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEi1gMKBFVzZXISEQoEbmFtZRgBIAEoCUID4EEIEioKBHJvbGUYAiABKA4yFy5tZW1vcy5hcGkudjEuVXNlci5Sb2xlQgPgQQISFQoIdXNlcm5hbWUYAyABKAlCA+BBAhISCgVlbWFpbBgEIAEoCUID4EEBEhkKDGRpc3BsYXlfbmFtZRgFIAEoCUID4EEBEhcKCmF2YXRhcl91cmwYBiABKAlCA+BBARIYCgtkZXNjcmlwdGlvbhgHIAEoCUID4EEBEhUKCHBhc3N3b3JkGAggASgJQgPgQQQSJwoFc3RhdGUYCSABKA4yEy5tZW1vcy5hcGkudjEuU3RhdGVCA+BBAhI0CgtjcmVhdGVfdGltZRgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAyIxCgRSb2xlEhQKEFJPTEVfVU5TUEVDSUZJRUQQABIJCgVBRE1JThACEggKBFVTRVIQAzo36kE0ChFtZW1vcy5hcGkudjEvVXNlchIMdXNlcnMve3VzZXJ9GgRuYW1lKgV1c2VyczIEdXNlciJzChBMaXN0VXNlcnNSZXF1ZXN0EhYKCXBhZ2Vfc2l6ZRgBIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAiABKAlCA+BBARITCgZmaWx0ZXIYAyABKAlCA+BBARIZCgxzaG93X2RlbGV0ZWQYBCABKAhCA+BBASJjChFMaXN0VXNlcnNSZXNwb25zZRIhCgV1c2VycxgBIAMoCzISLm1lbW9zLmFwaS52MS5Vc2VyEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgFIm0KDkdldFVzZXJSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXISMgoJcmVhZF9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EEBIogBChFDcmVhdGVVc2VyUmVxdWVzdBIoCgR1c2VyGAEgASgLMhIubWVtb3MuYXBpLnYxLlVzZXJCBuBBAuBBBBIUCgd1c2VyX2lkGAIgASgJQgPgQQESGgoNdmFsaWRhdGVfb25seRgDIAEoCEID4EEBEhcKCnJlcXVlc3RfaWQYBCABKAlCA+BBASKMAQoRVXBkYXRlVXNlclJlcXVlc3QSJQoEdXNlchgBIAEoCzISLm1lbW9zLmFwaS52MS5Vc2VyQgPgQQISNAoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrQgPgQQISGgoNYWxsb3dfbWlzc2luZxgDIAEoCEID4EEBIlAKEURlbGV0ZVVzZXJSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXISEgoFZm9yY2UYAiABKAhCA+BBASLYAwoJVXNlclN0YXRzEhEKBG5hbWUYASABKAlCA+BBCBI7ChdtZW1vX2Rpc3BsYXlfdGltZXN0YW1wcxgCIAMoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASPgoPbWVtb190eXBlX3N0YXRzGAMgASgLMiUubWVtb3MuYXBpLnYxLlVzZXJTdGF0cy5NZW1vVHlwZVN0YXRzEjgKCXRhZ19jb3VudBgEIAMoCzIlLm1lbW9zLmFwaS52MS5Vc2VyU3RhdHMuVGFnQ291bnRFbnRyeRIUCgxwaW5uZWRfbWVtb3MYBSADKAkSGAoQdG90YWxfbWVtb19jb3VudBgGIAEoBRovCg1UYWdDb3VudEVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoBToCOAEaXwoNTWVtb1R5cGVTdGF0cxISCgpsaW5rX2NvdW50GAEgASgFEhIKCmNvZGVfY291bnQYAiABKAUSEgoKdG9kb19jb3VudBgDIAEoBRISCgp1bmRvX2NvdW50GAQgASgFOj/qQTwKFm1lbW9zLmFwaS52MS9Vc2VyU3RhdHMSDHVzZXJzL3t1c2VyfSoJdXNlclN0YXRzMgl1c2VyU3RhdHMiPgoTR2V0VXNlclN0YXRzUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyIhkKF0xpc3RBbGxVc2VyU3RhdHNSZXF1ZXN0IkIKGExpc3RBbGxVc2VyU3RhdHNSZXNwb25zZRImCgVzdGF0cxgBIAMoCzIXLm1lbW9zLmFwaS52MS5Vc2VyU3RhdHMi4AMKC1VzZXJTZXR0aW5nEhEKBG5hbWUYASABKAlCA+BBCBJDCg9nZW5lcmFsX3NldHRpbmcYAiABKAsyKC5tZW1vcy5hcGkudjEuVXNlclNldHRpbmcuR2VuZXJhbFNldHRpbmdIABJFChB3ZWJob29rc19zZXR0aW5nGAUgASgLMikubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nLldlYmhvb2tzU2V0dGluZ0gAGlcKDkdlbmVyYWxTZXR0aW5nEhMKBmxvY2FsZRgBIAEoCUID4EEBEhwKD21lbW9fdmlzaWJpbGl0eRgDIAEoCUID4EEBEhIKBXRoZW1lGAQgASgJQgPgQQEaPgoPV2ViaG9va3NTZXR0aW5nEisKCHdlYmhvb2tzGAEgAygLMhkubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rIjUKA0tleRITCg9LRVlfVU5TUEVDSUZJRUQQABILCgdHRU5FUkFMEAESDAoIV0VCSE9PS1MQBDpZ6kFWChhtZW1vcy5hcGkudjEvVXNlclNldHRpbmcSH3VzZXJzL3t1c2VyfS9zZXR0aW5ncy97c2V0dGluZ30qDHVzZXJTZXR0aW5nczILdXNlclNldHRpbmdCBwoFdmFsdWUiRwoVR2V0VXNlclNldHRpbmdSZXF1ZXN0Ei4KBG5hbWUYASABKAlCIOBBAvpBGgoYbWVtb3MuYXBpLnYxL1VzZXJTZXR0aW5nIoEBChhVcGRhdGVVc2VyU2V0dGluZ1JlcXVlc3QSLwoHc2V0dGluZxgBIAEoCzIZLm1lbW9zLmFwaS52MS5Vc2VyU2V0dGluZ0ID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EECInUKF0xpc3RVc2VyU2V0dGluZ3NSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlchIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQEidAoYTGlzdFVzZXJTZXR0aW5nc1Jlc3BvbnNlEisKCHNldHRpbmdzGAEgAygLMhkubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgFIvICChNQZXJzb25hbEFjY2Vzc1Rva2VuEhEKBG5hbWUYASABKAlCA+BBCBIYCgtkZXNjcmlwdGlvbhgCIAEoCUID4EEBEjMKCmNyZWF0ZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSMwoKZXhwaXJlc19hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBARI1CgxsYXN0X3VzZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQM6jAHqQYgBCiBtZW1vcy5hcGkudjEvUGVyc29uYWxBY2Nlc3NUb2tlbhI5dXNlcnMve3VzZXJ9L3BlcnNvbmFsQWNjZXNzVG9rZW5zL3twZXJzb25hbF9hY2Nlc3NfdG9rZW59KhRwZXJzb25hbEFjY2Vzc1Rva2VuczITcGVyc29uYWxBY2Nlc3NUb2tlbiJ9Ch9MaXN0UGVyc29uYWxBY2Nlc3NUb2tlbnNSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlchIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQEikgEKIExpc3RQZXJzb25hbEFjY2Vzc1Rva2Vuc1Jlc3BvbnNlEkEKFnBlcnNvbmFsX2FjY2Vzc190b2tlbnMYASADKAsyIS5tZW1vcy5hcGkudjEuUGVyc29uYWxBY2Nlc3NUb2tlbhIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkSEgoKdG90YWxfc2l6ZRgDIAEoBSKFAQogQ3JlYXRlUGVyc29uYWxBY2Nlc3NUb2tlblJlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEhgKC2Rlc2NyaXB0aW9uGAIgASgJQgPgQQESHAoPZXhwaXJlc19pbl9kYXlzGAMgASgFQgPgQQEidAohQ3JlYXRlUGVyc29uYWxBY2Nlc3NUb2tlblJlc3BvbnNlEkAKFXBlcnNvbmFsX2FjY2Vzc190b2tlbhgBIAEoCzIhLm1lbW9zLmFwaS52MS5QZXJzb25hbEFjY2Vzc1Rva2VuEg0KBXRva2VuGAIgASgJIloKIERlbGV0ZVBlcnNvbmFsQWNjZXNzVG9rZW5SZXF1ZXN0EjYKBG5hbWUYASABKAlCKOBBAvpBIgogbWVtb3MuYXBpLnYxL1BlcnNvbmFsQWNjZXNzVG9rZW4iqgEKC1VzZXJXZWJob29rEgwKBG5hbWUYASABKAkSCwoDdXJsGAIgASgJEhQKDGRpc3BsYXlfbmFtZRgDIAEoCRI0CgtjcmVhdGVfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAyIuChdMaXN0VXNlcldlYmhvb2tzUmVxdWVzdBITCgZwYXJlbnQYASABKAlCA+BBAiJHChhMaXN0VXNlcldlYmhvb2tzUmVzcG9uc2USKwoId2ViaG9va3MYASADKAsyGS5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2siYAoYQ3JlYXRlVXNlcldlYmhvb2tSZXF1ZXN0EhMKBnBhcmVudBgBIAEoCUID4EECEi8KB3dlYmhvb2sYAiABKAsyGS5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2tCA+BBAiJ8ChhVcGRhdGVVc2VyV2ViaG9va1JlcXVlc3QSLwoHd2ViaG9vaxgBIAEoCzIZLm1lbW9zLmFwaS52MS5Vc2VyV2ViaG9va0ID4EECEi8KC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzayItChhEZWxldGVVc2VyV2ViaG9va1JlcXVlc3QSEQoEbmFtZRgBIAEoCUID4EECIooEChBVc2VyTm90aWZpY2F0aW9uEhQKBG5hbWUYASABKAlCBuBBA+BBCBIpCgZzZW5kZXIYAiABKAlCGeBBA/pBEwoRbWVtb3MuYXBpLnYxL1VzZXISOgoGc3RhdHVzGAMgASgOMiUubWVtb3MuYXBpLnYxLlVzZXJOb3RpZmljYXRpb24uU3RhdHVzQgPgQQESNAoLY3JlYXRlX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSNgoEdHlwZRgFIAEoDjIjLm1lbW9zLmFwaS52MS5Vc2VyTm90aWZpY2F0aW9uLlR5cGVCA+BBAxIdCgthY3Rpdml0eV9pZBgGIAEoBUID4EEBSACIAQEiOgoGU3RhdHVzEhYKElNUQVRVU19VTlNQRUNJRklFRBAAEgoKBlVOUkVBRBABEgwKCEFSQ0hJVkVEEAIiLgoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASEAoMTUVNT19DT01NRU5UEAE6cOpBbQodbWVtb3MuYXBpLnYxL1VzZXJOb3RpZmljYXRpb24SKXVzZXJzL3t1c2VyfS9ub3RpZmljYXRpb25zL3tub3RpZmljYXRpb259GgRuYW1lKg1ub3RpZmljYXRpb25zMgxub3RpZmljYXRpb25CDgoMX2FjdGl2aXR5X2lkIo8BChxMaXN0VXNlck5vdGlmaWNhdGlvbnNSZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlchIWCglwYWdlX3NpemUYAiABKAVCA+BBARIXCgpwYWdlX3Rva2VuGAMgASgJQgPgQQESEwoGZmlsdGVyGAQgASgJQgPgQQEibwodTGlzdFVzZXJOb3RpZmljYXRpb25zUmVzcG9uc2USNQoNbm90aWZpY2F0aW9ucxgBIAMoCzIeLm1lbW9zLmFwaS52MS5Vc2VyTm90aWZpY2F0aW9uEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSKQAQodVXBkYXRlVXNlck5vdGlmaWNhdGlvblJlcXVlc3QSOQoMbm90aWZpY2F0aW9uGAEgASgLMh4ubWVtb3MuYXBpLnYxLlVzZXJOb3RpZmljYXRpb25CA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAiJUCh1EZWxldGVVc2VyTm90aWZpY2F0aW9uUmVxdWVzdBIzCgRuYW1lGAEgASgJQiXgQQL6QR8KHW1lbW9zLmFwaS52MS9Vc2VyTm90aWZpY2F0aW9uMoMXCgtVc2VyU2VydmljZRJjCglMaXN0VXNlcnMSHi5tZW1vcy5hcGkudjEuTGlzdFVzZXJzUmVxdWVzdBofLm1lbW9zLmFwaS52MS5MaXN0VXNlcnNSZXNwb25zZSIVgtPkkwIPEg0vYXBpL3YxL3VzZXJzEmIKB0dldFVzZXISHC5tZW1vcy5hcGkudjEuR2V0VXNlclJlcXVlc3QaEi5tZW1vcy5hcGkudjEuVXNlciIl2kEEbmFtZYLT5JMCGBIWL2FwaS92MS97bmFtZT11c2Vycy8qfRJlCgpDcmVhdGVVc2VyEh8ubWVtb3MuYXBpLnYxLkNyZWF0ZVVzZXJSZXF1ZXN0GhIubWVtb3MuYXBpLnYxLlVzZXIiItpBBHVzZXKC0+STAhU6BHVzZXIiDS9hcGkvdjEvdXNlcnMSfwoKVXBkYXRlVXNlchIfLm1lbW9zLmFwaS52MS5VcGRhdGVVc2VyUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5Vc2VyIjzaQRB1c2VyLHVwZGF0ZV9tYXNrgtPkkwIjOgR1c2VyMhsvYXBpL3YxL3t1c2VyLm5hbWU9dXNlcnMvKn0SbAoKRGVsZXRlVXNlchIfLm1lbW9zLmFwaS52MS5EZWxldGVVc2VyUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIl2kEEbmFtZYLT5JMCGCoWL2FwaS92MS97bmFtZT11c2Vycy8qfRJ+ChBMaXN0QWxsVXNlclN0YXRzEiUubWVtb3MuYXBpLnYxLkxpc3RBbGxVc2VyU3RhdHNSZXF1ZXN0GiYubWVtb3MuYXBpLnYxLkxpc3RBbGxVc2VyU3RhdHNSZXNwb25zZSIbgtPkkwIVEhMvYXBpL3YxL3VzZXJzOnN0YXRzEnoKDEdldFVzZXJTdGF0cxIhLm1lbW9zLmFwaS52MS5HZXRVc2VyU3RhdHNSZXF1ZXN0GhcubWVtb3MuYXBpLnYxLlVzZXJTdGF0cyIu2kEEbmFtZYLT5JMCIRIfL2FwaS92MS97bmFtZT11c2Vycy8qfTpnZXRTdGF0cxKCAQoOR2V0VXNlclNldHRpbmcSIy5tZW1vcy5hcGkudjEuR2V0VXNlclNldHRpbmdSZXF1ZXN0GhkubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nIjDaQQRuYW1lgtPkkwIjEiEvYXBpL3YxL3tuYW1lPXVzZXJzLyovc2V0dGluZ3MvKn0SqAEKEVVwZGF0ZVVzZXJTZXR0aW5nEiYubWVtb3MuYXBpLnYxLlVwZGF0ZVVzZXJTZXR0aW5nUmVxdWVzdBoZLm1lbW9zLmFwaS52MS5Vc2VyU2V0dGluZyJQ2kETc2V0dGluZyx1cGRhdGVfbWFza4LT5JMCNDoHc2V0dGluZzIpL2FwaS92MS97c2V0dGluZy5uYW1lPXVzZXJzLyovc2V0dGluZ3MvKn0SlQEKEExpc3RVc2VyU2V0dGluZ3MSJS5tZW1vcy5hcGkudjEuTGlzdFVzZXJTZXR0aW5nc1JlcXVlc3QaJi5tZW1vcy5hcGkudjEuTGlzdFVzZXJTZXR0aW5nc1Jlc3BvbnNlIjLaQQZwYXJlbnSC0+STAiMSIS9hcGkvdjEve3BhcmVudD11c2Vycy8qfS9zZXR0aW5ncxK5AQoYTGlzdFBlcnNvbmFsQWNjZXNzVG9rZW5zEi0ubWVtb3MuYXBpLnYxLkxpc3RQZXJzb25hbEFjY2Vzc1Rva2Vuc1JlcXVlc3QaLi5tZW1vcy5hcGkudjEuTGlzdFBlcnNvbmFsQWNjZXNzVG9rZW5zUmVzcG9uc2UiPtpBBnBhcmVudILT5JMCLxItL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3BlcnNvbmFsQWNjZXNzVG9rZW5zErYBChlDcmVhdGVQZXJzb25hbEFjY2Vzc1Rva2VuEi4ubWVtb3MuYXBpLnYxLkNyZWF0ZVBlcnNvbmFsQWNjZXNzVG9rZW5SZXF1ZXN0Gi8ubWVtb3MuYXBpLnYxLkNyZWF0ZVBlcnNvbmFsQWNjZXNzVG9rZW5SZXNwb25zZSI4gtPkkwIyOgEqIi0vYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vcGVyc29uYWxBY2Nlc3NUb2tlbnMSoQEKGURlbGV0ZVBlcnNvbmFsQWNjZXNzVG9rZW4SLi5tZW1vcy5hcGkudjEuRGVsZXRlUGVyc29uYWxBY2Nlc3NUb2tlblJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiPNpBBG5hbWWC0+STAi8qLS9hcGkvdjEve25hbWU9dXNlcnMvKi9wZXJzb25hbEFjY2Vzc1Rva2Vucy8qfRKVAQoQTGlzdFVzZXJXZWJob29rcxIlLm1lbW9zLmFwaS52MS5MaXN0VXNlcldlYmhvb2tzUmVxdWVzdBomLm1lbW9zLmFwaS52MS5MaXN0VXNlcldlYmhvb2tzUmVzcG9uc2UiMtpBBnBhcmVudILT5JMCIxIhL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3dlYmhvb2tzEpsBChFDcmVhdGVVc2VyV2ViaG9vaxImLm1lbW9zLmFwaS52MS5DcmVhdGVVc2VyV2ViaG9va1JlcXVlc3QaGS5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2siQ9pBDnBhcmVudCx3ZWJob29rgtPkkwIsOgd3ZWJob29rIiEvYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vd2ViaG9va3MSqAEKEVVwZGF0ZVVzZXJXZWJob29rEiYubWVtb3MuYXBpLnYxLlVwZGF0ZVVzZXJXZWJob29rUmVxdWVzdBoZLm1lbW9zLmFwaS52MS5Vc2VyV2ViaG9vayJQ2kETd2ViaG9vayx1cGRhdGVfbWFza4LT5JMCNDoHd2ViaG9vazIpL2FwaS92MS97d2ViaG9vay5uYW1lPXVzZXJzLyovd2ViaG9va3MvKn0ShQEKEURlbGV0ZVVzZXJXZWJob29rEiYubWVtb3MuYXBpLnYxLkRlbGV0ZVVzZXJXZWJob29rUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIw2kEEbmFtZYLT5JMCIyohL2FwaS92MS97bmFtZT11c2Vycy8qL3dlYmhvb2tzLyp9EqkBChVMaXN0VXNlck5vdGlmaWNhdGlvbnMSKi5tZW1vcy5hcGkudjEuTGlzdFVzZXJOb3RpZmljYXRpb25zUmVxdWVzdBorLm1lbW9zLmFwaS52MS5MaXN0VXNlck5vdGlmaWNhdGlvbnNSZXNwb25zZSI32kEGcGFyZW50gtPkkwIoEiYvYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vbm90aWZpY2F0aW9ucxLLAQoWVXBkYXRlVXNlck5vdGlmaWNhdGlvbhIrLm1lbW9zLmFwaS52MS5VcGRhdGVVc2VyTm90aWZpY2F0aW9uUmVxdWVzdBoeLm1lbW9zLmFwaS52MS5Vc2VyTm90aWZpY2F0aW9uImTaQRhub3RpZmljYXRpb24sdXBkYXRlX21hc2uC0+STAkM6DG5vdGlmaWNhdGlvbjIzL2FwaS92MS97bm90aWZpY2F0aW9uLm5hbWU9dXNlcnMvKi9ub3RpZmljYXRpb25zLyp9EpQBChZEZWxldGVVc2VyTm90aWZpY2F0aW9uEisubWVtb3MuYXBpLnYxLkRlbGV0ZVVzZXJOb3RpZmljYXRpb25SZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjXaQQRuYW1lgtPkkwIoKiYvYXBpL3YxL3tuYW1lPXVzZXJzLyovbm90aWZpY2F0aW9ucy8qfUKoAQoQY29tLm1lbW9zLmFwaS52MUIQVXNlclNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_api_v1_common, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
+  fileDesc("ChlhcGkvdjEvdXNlcl9zZXJ2aWNlLnByb3RvEgxtZW1vcy5hcGkudjEi1gMKBFVzZXISEQoEbmFtZRgBIAEoCUID4EEIEioKBHJvbGUYAiABKA4yFy5tZW1vcy5hcGkudjEuVXNlci5Sb2xlQgPgQQISFQoIdXNlcm5hbWUYAyABKAlCA+BBAhISCgVlbWFpbBgEIAEoCUID4EEBEhkKDGRpc3BsYXlfbmFtZRgFIAEoCUID4EEBEhcKCmF2YXRhcl91cmwYBiABKAlCA+BBARIYCgtkZXNjcmlwdGlvbhgHIAEoCUID4EEBEhUKCHBhc3N3b3JkGAggASgJQgPgQQQSJwoFc3RhdGUYCSABKA4yEy5tZW1vcy5hcGkudjEuU3RhdGVCA+BBAhI0CgtjcmVhdGVfdGltZRgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI0Cgt1cGRhdGVfdGltZRgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAyIxCgRSb2xlEhQKEFJPTEVfVU5TUEVDSUZJRUQQABIJCgVBRE1JThACEggKBFVTRVIQAzo36kE0ChFtZW1vcy5hcGkudjEvVXNlchIMdXNlcnMve3VzZXJ9GgRuYW1lKgV1c2VyczIEdXNlciJzChBMaXN0VXNlcnNSZXF1ZXN0EhYKCXBhZ2Vfc2l6ZRgBIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAiABKAlCA+BBARITCgZmaWx0ZXIYAyABKAlCA+BBARIZCgxzaG93X2RlbGV0ZWQYBCABKAhCA+BBASJjChFMaXN0VXNlcnNSZXNwb25zZRIhCgV1c2VycxgBIAMoCzISLm1lbW9zLmFwaS52MS5Vc2VyEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgFIm0KDkdldFVzZXJSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXISMgoJcmVhZF9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EEBIogBChFDcmVhdGVVc2VyUmVxdWVzdBIoCgR1c2VyGAEgASgLMhIubWVtb3MuYXBpLnYxLlVzZXJCBuBBAuBBBBIUCgd1c2VyX2lkGAIgASgJQgPgQQESGgoNdmFsaWRhdGVfb25seRgDIAEoCEID4EEBEhcKCnJlcXVlc3RfaWQYBCABKAlCA+BBASKMAQoRVXBkYXRlVXNlclJlcXVlc3QSJQoEdXNlchgBIAEoCzISLm1lbW9zLmFwaS52MS5Vc2VyQgPgQQISNAoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrQgPgQQISGgoNYWxsb3dfbWlzc2luZxgDIAEoCEID4EEBIlAKEURlbGV0ZVVzZXJSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXISEgoFZm9yY2UYAiABKAhCA+BBASLYAwoJVXNlclN0YXRzEhEKBG5hbWUYASABKAlCA+BBCBI7ChdtZW1vX2Rpc3BsYXlfdGltZXN0YW1wcxgCIAMoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASPgoPbWVtb190eXBlX3N0YXRzGAMgASgLMiUubWVtb3MuYXBpLnYxLlVzZXJTdGF0cy5NZW1vVHlwZVN0YXRzEjgKCXRhZ19jb3VudBgEIAMoCzIlLm1lbW9zLmFwaS52MS5Vc2VyU3RhdHMuVGFnQ291bnRFbnRyeRIUCgxwaW5uZWRfbWVtb3MYBSADKAkSGAoQdG90YWxfbWVtb19jb3VudBgGIAEoBRovCg1UYWdDb3VudEVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoBToCOAEaXwoNTWVtb1R5cGVTdGF0cxISCgpsaW5rX2NvdW50GAEgASgFEhIKCmNvZGVfY291bnQYAiABKAUSEgoKdG9kb19jb3VudBgDIAEoBRISCgp1bmRvX2NvdW50GAQgASgFOj/qQTwKFm1lbW9zLmFwaS52MS9Vc2VyU3RhdHMSDHVzZXJzL3t1c2VyfSoJdXNlclN0YXRzMgl1c2VyU3RhdHMiPgoTR2V0VXNlclN0YXRzUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyIhkKF0xpc3RBbGxVc2VyU3RhdHNSZXF1ZXN0IkIKGExpc3RBbGxVc2VyU3RhdHNSZXNwb25zZRImCgVzdGF0cxgBIAMoCzIXLm1lbW9zLmFwaS52MS5Vc2VyU3RhdHMi0AcKC1VzZXJTZXR0aW5nEhEKBG5hbWUYASABKAlCA+BBCBJDCg9nZW5lcmFsX3NldHRpbmcYAiABKAsyKC5tZW1vcy5hcGkudjEuVXNlclNldHRpbmcuR2VuZXJhbFNldHRpbmdIABJFChB3ZWJob29rc19zZXR0aW5nGAUgASgLMikubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nLldlYmhvb2tzU2V0dGluZ0gAEkEKDmRpZ2VzdF9zZXR0aW5nGAYgASgLMicubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nLkRpZ2VzdFNldHRpbmdIABq1AQoOR2VuZXJhbFNldHRpbmcSEwoGbG9jYWxlGAEgASgJQgPgQQESHAoPbWVtb192aXNpYmlsaXR5GAMgASgJQgPgQQESEgoFdGhlbWUYBCABKAlCA+BBARIdChBlbWFpbF9vbl9jb21tZW50GAUgASgIQgPgQQESHQoQZW1haWxfb25fbWVudGlvbhgGIAEoCEID4EEBEh4KEWVtYWlsX29uX3JlbWluZGVyGAcgASgIQgPgQQEaPgoPV2ViaG9va3NTZXR0aW5nEisKCHdlYmhvb2tzGAEgAygLMhkubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rGr8CCg1EaWdlc3RTZXR0aW5nEkkKCWZyZXF1ZW5jeRgBIAEoDjIxLm1lbW9zLmFwaS52MS5Vc2VyU2V0dGluZy5EaWdlc3RTZXR0aW5nLkZyZXF1ZW5jeUID4EEBEkYKCGNoYW5uZWxzGAIgAygOMi8ubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nLkRpZ2VzdFNldHRpbmcuQ2hhbm5lbEID4EEBEhUKCHNob3J0Y3V0GAMgASgJQgPgQQEiPQoJRnJlcXVlbmN5EhkKFUZSRVFVRU5DWV9VTlNQRUNJRklFRBAAEgkKBURBSUxZEAESCgoGV0VFS0xZEAIiRQoHQ2hhbm5lbBIXChNDSEFOTkVMX1VOU1BFQ0lGSUVEEAASCQoFRU1BSUwQARILCgdXRUJIT09LEAISCQoFSU5CT1gQAyJBCgNLZXkSEwoPS0VZX1VOU1BFQ0lGSUVEEAASCwoHR0VORVJBTBABEgwKCFdFQkhPT0tTEAQSCgoGRElHRVNUEAU6WepBVgoYbWVtb3MuYXBpLnYxL1VzZXJTZXR0aW5nEh91c2Vycy97dXNlcn0vc2V0dGluZ3Mve3NldHRpbmd9Kgx1c2VyU2V0dGluZ3MyC3VzZXJTZXR0aW5nQgcKBXZhbHVlIkcKFUdldFVzZXJTZXR0aW5nUmVxdWVzdBIuCgRuYW1lGAEgASgJQiDgQQL6QRoKGG1lbW9zLmFwaS52MS9Vc2VyU2V0dGluZyKBAQoYVXBkYXRlVXNlclNldHRpbmdSZXF1ZXN0Ei8KB3NldHRpbmcYASABKAsyGS5tZW1vcy5hcGkudjEuVXNlclNldHRpbmdCA+BBAhI0Cgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2tCA+BBAiJ1ChdMaXN0VXNlclNldHRpbmdzUmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXISFgoJcGFnZV9zaXplGAIgASgFQgPgQQESFwoKcGFnZV90b2tlbhgDIAEoCUID4EEBInQKGExpc3RVc2VyU2V0dGluZ3NSZXNwb25zZRIrCghzZXR0aW5ncxgBIAMoCzIZLm1lbW9zLmFwaS52MS5Vc2VyU2V0dGluZxIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkSEgoKdG90YWxfc2l6ZRgDIAEoBSLyAgoTUGVyc29uYWxBY2Nlc3NUb2tlbhIRCgRuYW1lGAEgASgJQgPgQQgSGAoLZGVzY3JpcHRpb24YAiABKAlCA+BBARIzCgpjcmVhdGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjMKCmV4cGlyZXNfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQESNQoMbGFzdF91c2VkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDOowB6kGIAQogbWVtb3MuYXBpLnYxL1BlcnNvbmFsQWNjZXNzVG9rZW4SOXVzZXJzL3t1c2VyfS9wZXJzb25hbEFjY2Vzc1Rva2Vucy97cGVyc29uYWxfYWNjZXNzX3Rva2VufSoUcGVyc29uYWxBY2Nlc3NUb2tlbnMyE3BlcnNvbmFsQWNjZXNzVG9rZW4ifQofTGlzdFBlcnNvbmFsQWNjZXNzVG9rZW5zUmVxdWVzdBIpCgZwYXJlbnQYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXISFgoJcGFnZV9zaXplGAIgASgFQgPgQQESFwoKcGFnZV90b2tlbhgDIAEoCUID4EEBIpIBCiBMaXN0UGVyc29uYWxBY2Nlc3NUb2tlbnNSZXNwb25zZRJBChZwZXJzb25hbF9hY2Nlc3NfdG9rZW5zGAEgAygLMiEubWVtb3MuYXBpLnYxLlBlcnNvbmFsQWNjZXNzVG9rZW4SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhIKCnRvdGFsX3NpemUYAyABKAUihQEKIENyZWF0ZVBlcnNvbmFsQWNjZXNzVG9rZW5SZXF1ZXN0EikKBnBhcmVudBgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlchIYCgtkZXNjcmlwdGlvbhgCIAEoCUID4EEBEhwKD2V4cGlyZXNfaW5fZGF5cxgDIAEoBUID4EEBInQKIUNyZWF0ZVBlcnNvbmFsQWNjZXNzVG9rZW5SZXNwb25zZRJAChVwZXJzb25hbF9hY2Nlc3NfdG9rZW4YASABKAsyIS5tZW1vcy5hcGkudjEuUGVyc29uYWxBY2Nlc3NUb2tlbhINCgV0b2tlbhgCIAEoCSJaCiBEZWxldGVQZXJzb25hbEFjY2Vzc1Rva2VuUmVxdWVzdBI2CgRuYW1lGAEgASgJQijgQQL6QSIKIG1lbW9zLmFwaS52MS9QZXJzb25hbEFjY2Vzc1Rva2VuIrsDCgtVc2VyV2ViaG9vaxIMCgRuYW1lGAEgASgJEgsKA3VybBgCIAEoCRIUCgxkaXNwbGF5X25hbWUYAyABKAkSNAoLY3JlYXRlX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSNAoLdXBkYXRlX3RpbWUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgPgQQMSDgoGc2VjcmV0GAYgASgJEhMKC2V2ZW50X3R5cGVzGAcgAygJEg4KBmZpbHRlchgIIAEoCRIwCgZmb3JtYXQYCSABKA4yIC5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2suRm9ybWF0EhAKCHRlbXBsYXRlGAogASgJEhsKE2NoZWNrX3Jlc3BvbnNlX2NvZGUYCyABKAgieQoGRm9ybWF0EhYKEkZPUk1BVF9VTlNQRUNJRklFRBAAEgkKBU1FTU9TEAESCQoFU0xBQ0sQAhILCgdESVNDT1JEEAMSDAoIVEVMRUdSQU0QBBIKCgZGRUlTSFUQBRIMCghESU5HVEFMSxAGEgwKCFRFTVBMQVRFEAciLgoXTGlzdFVzZXJXZWJob29rc1JlcXVlc3QSEwoGcGFyZW50GAEgASgJQgPgQQIiRwoYTGlzdFVzZXJXZWJob29rc1Jlc3BvbnNlEisKCHdlYmhvb2tzGAEgAygLMhkubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rImAKGENyZWF0ZVVzZXJXZWJob29rUmVxdWVzdBITCgZwYXJlbnQYASABKAlCA+BBAhIvCgd3ZWJob29rGAIgASgLMhkubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rQgPgQQIifAoYVXBkYXRlVXNlcldlYmhvb2tSZXF1ZXN0Ei8KB3dlYmhvb2sYASABKAsyGS5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2tCA+BBAhIvCgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2siLQoYRGVsZXRlVXNlcldlYmhvb2tSZXF1ZXN0EhEKBG5hbWUYASABKAlCA+BBAiLHAwoTVXNlcldlYmhvb2tEZWxpdmVyeRIMCgRuYW1lGAEgASgJEhAKA3VybBgCIAEoCUID4EEDEhoKDWFjdGl2aXR5X3R5cGUYAyABKAlCA+BBAxI7CgVzdGF0ZRgEIAEoDjInLm1lbW9zLmFwaS52MS5Vc2VyV2ViaG9va0RlbGl2ZXJ5LlN0YXRlQgPgQQMSFQoIYXR0ZW1wdHMYBSABKAVCA+BBAxIZCgxyZXF1ZXN0X2JvZHkYBiABKAlCA+BBAxIhChRyZXNwb25zZV9zdGF0dXNfY29kZRgHIAEoBUID4EEDEhoKDXJlc3BvbnNlX2JvZHkYCCABKAlCA+BBAxISCgVlcnJvchgJIAEoCUID4EEDEjQKC2NyZWF0ZV90aW1lGAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDEjQKC3VwZGF0ZV90aW1lGAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEID4EEDIkYKBVN0YXRlEhUKEVNUQVRFX1VOU1BFQ0lGSUVEEAASCwoHUEVORElORxABEg0KCVNVQ0NFRURFRBACEgoKBkZBSUxFRBADImgKIExpc3RVc2VyV2ViaG9va0RlbGl2ZXJpZXNSZXF1ZXN0EhMKBnBhcmVudBgBIAEoCUID4EECEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBASJzCiFMaXN0VXNlcldlYmhvb2tEZWxpdmVyaWVzUmVzcG9uc2USNQoKZGVsaXZlcmllcxgBIAMoCzIhLm1lbW9zLmFwaS52MS5Vc2VyV2ViaG9va0RlbGl2ZXJ5EhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSI4CiNSZWRlbGl2ZXJVc2VyV2ViaG9va0RlbGl2ZXJ5UmVxdWVzdBIRCgRuYW1lGAEgASgJQgPgQQIi/QUKEFVzZXJOb3RpZmljYXRpb24SFAoEbmFtZRgBIAEoCUIG4EED4EEIEikKBnNlbmRlchgCIAEoCUIZ4EED+kETChFtZW1vcy5hcGkudjEvVXNlchI6CgZzdGF0dXMYAyABKA4yJS5tZW1vcy5hcGkudjEuVXNlck5vdGlmaWNhdGlvbi5TdGF0dXNCA+BBARI0CgtjcmVhdGVfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCA+BBAxI2CgR0eXBlGAUgASgOMiMubWVtb3MuYXBpLnYxLlVzZXJOb3RpZmljYXRpb24uVHlwZUID4EEDEh0KC2FjdGl2aXR5X2lkGAYgASgFQgPgQQFIAIgBARI6CgZkaWdlc3QYByABKAsyJS5tZW1vcy5hcGkudjEuVXNlck5vdGlmaWNhdGlvbi5EaWdlc3RCA+BBAxqDAQoGRGlnZXN0Eg0KBXRpdGxlGAEgASgJEgwKBGh0bWwYAiABKAkSLgoKc3RhcnRfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIjoKBlN0YXR1cxIWChJTVEFUVVNfVU5TUEVDSUZJRUQQABIKCgZVTlJFQUQQARIMCghBUkNISVZFRBACIl8KBFR5cGUSFAoQVFlQRV9VTlNQRUNJRklFRBAAEhAKDE1FTU9fQ09NTUVOVBABEgoKBkRJR0VTVBACEhAKDE1FTU9fTUVOVElPThADEhEKDU1FTU9fUkVNSU5ERVIQBDpw6kFtCh1tZW1vcy5hcGkudjEvVXNlck5vdGlmaWNhdGlvbhIpdXNlcnMve3VzZXJ9L25vdGlmaWNhdGlvbnMve25vdGlmaWNhdGlvbn0aBG5hbWUqDW5vdGlmaWNhdGlvbnMyDG5vdGlmaWNhdGlvbkIOCgxfYWN0aXZpdHlfaWQijwEKHExpc3RVc2VyTm90aWZpY2F0aW9uc1JlcXVlc3QSKQoGcGFyZW50GAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEhYKCXBhZ2Vfc2l6ZRgCIAEoBUID4EEBEhcKCnBhZ2VfdG9rZW4YAyABKAlCA+BBARITCgZmaWx0ZXIYBCABKAlCA+BBASJvCh1MaXN0VXNlck5vdGlmaWNhdGlvbnNSZXNwb25zZRI1Cg1ub3RpZmljYXRpb25zGAEgAygLMh4ubWVtb3MuYXBpLnYxLlVzZXJOb3RpZmljYXRpb24SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIpABCh1VcGRhdGVVc2VyTm90aWZpY2F0aW9uUmVxdWVzdBI5Cgxub3RpZmljYXRpb24YASABKAsyHi5tZW1vcy5hcGkudjEuVXNlck5vdGlmaWNhdGlvbkID4EECEjQKC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFza0ID4EECIlQKHURlbGV0ZVVzZXJOb3RpZmljYXRpb25SZXF1ZXN0EjMKBG5hbWUYASABKAlCJeBBAvpBHwodbWVtb3MuYXBpLnYxL1VzZXJOb3RpZmljYXRpb24iQAoVRXhwb3J0VXNlckRhdGFSZXF1ZXN0EicKBG5hbWUYASABKAlCGeBBAvpBEwoRbWVtb3MuYXBpLnYxL1VzZXIiKQoWRXhwb3J0VXNlckRhdGFSZXNwb25zZRIPCgdjb250ZW50GAEgASgMIlYKFUltcG9ydFVzZXJEYXRhUmVxdWVzdBInCgRuYW1lGAEgASgJQhngQQL6QRMKEW1lbW9zLmFwaS52MS9Vc2VyEhQKB2NvbnRlbnQYAiABKAxCA+BBAiJyChZJbXBvcnRVc2VyRGF0YVJlc3BvbnNlEhoKEmNyZWF0ZWRfbWVtb19jb3VudBgBIAEoBRIaChJ1cGRhdGVkX21lbW9fY291bnQYAiABKAUSIAoYY3JlYXRlZF9hdHRhY2htZW50X2NvdW50GAMgASgFIpgCChJJbXBvcnROb3Rlc1JlcXVlc3QSJwoEbmFtZRgBIAEoCUIZ4EEC+kETChFtZW1vcy5hcGkudjEvVXNlchI8CgZmb3JtYXQYAiABKA4yJy5tZW1vcy5hcGkudjEuSW1wb3J0Tm90ZXNSZXF1ZXN0LkZvcm1hdEID4EECEhUKCGZpbGVuYW1lGAMgASgJQgPgQQISFAoHY29udGVudBgEIAEoDEID4EECEhQKB2RyeV9ydW4YBSABKAhCA+BBASJYCgZGb3JtYXQSFgoSRk9STUFUX1VOU1BFQ0lGSUVEEAASDAoIT0JTSURJQU4QARIJCgVGTE9NTxACEg8KC0dPT0dMRV9LRUVQEAMSDAoIRVZFUk5PVEUQBCJ7ChNJbXBvcnROb3Rlc1Jlc3BvbnNlEhIKCm1lbW9fY291bnQYASABKAUSGAoQYXR0YWNobWVudF9jb3VudBgCIAEoBRIWCg5yZWxhdGlvbl9jb3VudBgDIAEoBRIMCgR0YWdzGAQgAygJEhAKCHdhcm5pbmdzGAUgAygJMssdCgtVc2VyU2VydmljZRJjCglMaXN0VXNlcnMSHi5tZW1vcy5hcGkudjEuTGlzdFVzZXJzUmVxdWVzdBofLm1lbW9zLmFwaS52MS5MaXN0VXNlcnNSZXNwb25zZSIVgtPkkwIPEg0vYXBpL3YxL3VzZXJzEmIKB0dldFVzZXISHC5tZW1vcy5hcGkudjEuR2V0VXNlclJlcXVlc3QaEi5tZW1vcy5hcGkudjEuVXNlciIl2kEEbmFtZYLT5JMCGBIWL2FwaS92MS97bmFtZT11c2Vycy8qfRJlCgpDcmVhdGVVc2VyEh8ubWVtb3MuYXBpLnYxLkNyZWF0ZVVzZXJSZXF1ZXN0GhIubWVtb3MuYXBpLnYxLlVzZXIiItpBBHVzZXKC0+STAhU6BHVzZXIiDS9hcGkvdjEvdXNlcnMSfwoKVXBkYXRlVXNlchIfLm1lbW9zLmFwaS52MS5VcGRhdGVVc2VyUmVxdWVzdBoSLm1lbW9zLmFwaS52MS5Vc2VyIjzaQRB1c2VyLHVwZGF0ZV9tYXNrgtPkkwIjOgR1c2VyMhsvYXBpL3YxL3t1c2VyLm5hbWU9dXNlcnMvKn0SbAoKRGVsZXRlVXNlchIfLm1lbW9zLmFwaS52MS5EZWxldGVVc2VyUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIl2kEEbmFtZYLT5JMCGCoWL2FwaS92MS97bmFtZT11c2Vycy8qfRJ+ChBMaXN0QWxsVXNlclN0YXRzEiUubWVtb3MuYXBpLnYxLkxpc3RBbGxVc2VyU3RhdHNSZXF1ZXN0GiYubWVtb3MuYXBpLnYxLkxpc3RBbGxVc2VyU3RhdHNSZXNwb25zZSIbgtPkkwIVEhMvYXBpL3YxL3VzZXJzOnN0YXRzEnoKDEdldFVzZXJTdGF0cxIhLm1lbW9zLmFwaS52MS5HZXRVc2VyU3RhdHNSZXF1ZXN0GhcubWVtb3MuYXBpLnYxLlVzZXJTdGF0cyIu2kEEbmFtZYLT5JMCIRIfL2FwaS92MS97bmFtZT11c2Vycy8qfTpnZXRTdGF0cxKCAQoOR2V0VXNlclNldHRpbmcSIy5tZW1vcy5hcGkudjEuR2V0VXNlclNldHRpbmdSZXF1ZXN0GhkubWVtb3MuYXBpLnYxLlVzZXJTZXR0aW5nIjDaQQRuYW1lgtPkkwIjEiEvYXBpL3YxL3tuYW1lPXVzZXJzLyovc2V0dGluZ3MvKn0SqAEKEVVwZGF0ZVVzZXJTZXR0aW5nEiYubWVtb3MuYXBpLnYxLlVwZGF0ZVVzZXJTZXR0aW5nUmVxdWVzdBoZLm1lbW9zLmFwaS52MS5Vc2VyU2V0dGluZyJQ2kETc2V0dGluZyx1cGRhdGVfbWFza4LT5JMCNDoHc2V0dGluZzIpL2FwaS92MS97c2V0dGluZy5uYW1lPXVzZXJzLyovc2V0dGluZ3MvKn0SlQEKEExpc3RVc2VyU2V0dGluZ3MSJS5tZW1vcy5hcGkudjEuTGlzdFVzZXJTZXR0aW5nc1JlcXVlc3QaJi5tZW1vcy5hcGkudjEuTGlzdFVzZXJTZXR0aW5nc1Jlc3BvbnNlIjLaQQZwYXJlbnSC0+STAiMSIS9hcGkvdjEve3BhcmVudD11c2Vycy8qfS9zZXR0aW5ncxK5AQoYTGlzdFBlcnNvbmFsQWNjZXNzVG9rZW5zEi0ubWVtb3MuYXBpLnYxLkxpc3RQZXJzb25hbEFjY2Vzc1Rva2Vuc1JlcXVlc3QaLi5tZW1vcy5hcGkudjEuTGlzdFBlcnNvbmFsQWNjZXNzVG9rZW5zUmVzcG9uc2UiPtpBBnBhcmVudILT5JMCLxItL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3BlcnNvbmFsQWNjZXNzVG9rZW5zErYBChlDcmVhdGVQZXJzb25hbEFjY2Vzc1Rva2VuEi4ubWVtb3MuYXBpLnYxLkNyZWF0ZVBlcnNvbmFsQWNjZXNzVG9rZW5SZXF1ZXN0Gi8ubWVtb3MuYXBpLnYxLkNyZWF0ZVBlcnNvbmFsQWNjZXNzVG9rZW5SZXNwb25zZSI4gtPkkwIyOgEqIi0vYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vcGVyc29uYWxBY2Nlc3NUb2tlbnMSoQEKGURlbGV0ZVBlcnNvbmFsQWNjZXNzVG9rZW4SLi5tZW1vcy5hcGkudjEuRGVsZXRlUGVyc29uYWxBY2Nlc3NUb2tlblJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiPNpBBG5hbWWC0+STAi8qLS9hcGkvdjEve25hbWU9dXNlcnMvKi9wZXJzb25hbEFjY2Vzc1Rva2Vucy8qfRKVAQoQTGlzdFVzZXJXZWJob29rcxIlLm1lbW9zLmFwaS52MS5MaXN0VXNlcldlYmhvb2tzUmVxdWVzdBomLm1lbW9zLmFwaS52MS5MaXN0VXNlcldlYmhvb2tzUmVzcG9uc2UiMtpBBnBhcmVudILT5JMCIxIhL2FwaS92MS97cGFyZW50PXVzZXJzLyp9L3dlYmhvb2tzEpsBChFDcmVhdGVVc2VyV2ViaG9vaxImLm1lbW9zLmFwaS52MS5DcmVhdGVVc2VyV2ViaG9va1JlcXVlc3QaGS5tZW1vcy5hcGkudjEuVXNlcldlYmhvb2siQ9pBDnBhcmVudCx3ZWJob29rgtPkkwIsOgd3ZWJob29rIiEvYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vd2ViaG9va3MSqAEKEVVwZGF0ZVVzZXJXZWJob29rEiYubWVtb3MuYXBpLnYxLlVwZGF0ZVVzZXJXZWJob29rUmVxdWVzdBoZLm1lbW9zLmFwaS52MS5Vc2VyV2ViaG9vayJQ2kETd2ViaG9vayx1cGRhdGVfbWFza4LT5JMCNDoHd2ViaG9vazIpL2FwaS92MS97d2ViaG9vay5uYW1lPXVzZXJzLyovd2ViaG9va3MvKn0ShQEKEURlbGV0ZVVzZXJXZWJob29rEiYubWVtb3MuYXBpLnYxLkRlbGV0ZVVzZXJXZWJob29rUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIw2kEEbmFtZYLT5JMCIyohL2FwaS92MS97bmFtZT11c2Vycy8qL3dlYmhvb2tzLyp9Er0BChlMaXN0VXNlcldlYmhvb2tEZWxpdmVyaWVzEi4ubWVtb3MuYXBpLnYxLkxpc3RVc2VyV2ViaG9va0RlbGl2ZXJpZXNSZXF1ZXN0Gi8ubWVtb3MuYXBpLnYxLkxpc3RVc2VyV2ViaG9va0RlbGl2ZXJpZXNSZXNwb25zZSI/2kEGcGFyZW50gtPkkwIwEi4vYXBpL3YxL3twYXJlbnQ9dXNlcnMvKi93ZWJob29rcy8qfS9kZWxpdmVyaWVzEsABChxSZWRlbGl2ZXJVc2VyV2ViaG9va0RlbGl2ZXJ5EjEubWVtb3MuYXBpLnYxLlJlZGVsaXZlclVzZXJXZWJob29rRGVsaXZlcnlSZXF1ZXN0GiEubWVtb3MuYXBpLnYxLlVzZXJXZWJob29rRGVsaXZlcnkiStpBBG5hbWWC0+STAj06ASoiOC9hcGkvdjEve25hbWU9dXNlcnMvKi93ZWJob29rcy8qL2RlbGl2ZXJpZXMvKn06cmVkZWxpdmVyEqkBChVMaXN0VXNlck5vdGlmaWNhdGlvbnMSKi5tZW1vcy5hcGkudjEuTGlzdFVzZXJOb3RpZmljYXRpb25zUmVxdWVzdBorLm1lbW9zLmFwaS52MS5MaXN0VXNlck5vdGlmaWNhdGlvbnNSZXNwb25zZSI32kEGcGFyZW50gtPkkwIoEiYvYXBpL3YxL3twYXJlbnQ9dXNlcnMvKn0vbm90aWZpY2F0aW9ucxLLAQoWVXBkYXRlVXNlck5vdGlmaWNhdGlvbhIrLm1lbW9zLmFwaS52MS5VcGRhdGVVc2VyTm90aWZpY2F0aW9uUmVxdWVzdBoeLm1lbW9zLmFwaS52MS5Vc2VyTm90aWZpY2F0aW9uImTaQRhub3RpZmljYXRpb24sdXBkYXRlX21hc2uC0+STAkM6DG5vdGlmaWNhdGlvbjIzL2FwaS92MS97bm90aWZpY2F0aW9uLm5hbWU9dXNlcnMvKi9ub3RpZmljYXRpb25zLyp9EpQBChZEZWxldGVVc2VyTm90aWZpY2F0aW9uEisubWVtb3MuYXBpLnYxLkRlbGV0ZVVzZXJOb3RpZmljYXRpb25SZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IjXaQQRuYW1lgtPkkwIoKiYvYXBpL3YxL3tuYW1lPXVzZXJzLyovbm90aWZpY2F0aW9ucy8qfRKNAQoORXhwb3J0VXNlckRhdGESIy5tZW1vcy5hcGkudjEuRXhwb3J0VXNlckRhdGFSZXF1ZXN0GiQubWVtb3MuYXBpLnYxLkV4cG9ydFVzZXJEYXRhUmVzcG9uc2UiMNpBBG5hbWWC0+STAiMSIS9hcGkvdjEve25hbWU9dXNlcnMvKn06ZXhwb3J0RGF0YRKYAQoOSW1wb3J0VXNlckRhdGESIy5tZW1vcy5hcGkudjEuSW1wb3J0VXNlckRhdGFSZXF1ZXN0GiQubWVtb3MuYXBpLnYxLkltcG9ydFVzZXJEYXRhUmVzcG9uc2UiO9pBDG5hbWUsY29udGVudILT5JMCJjoBKiIhL2FwaS92MS97bmFtZT11c2Vycy8qfTppbXBvcnREYXRhEpcBCgtJbXBvcnROb3RlcxIgLm1lbW9zLmFwaS52MS5JbXBvcnROb3Rlc1JlcXVlc3QaIS5tZW1vcy5hcGkudjEuSW1wb3J0Tm90ZXNSZXNwb25zZSJD2kETbmFtZSxmb3JtYXQsY29udGVudILT5JMCJzoBKiIiL2FwaS92MS97bmFtZT11c2Vycy8qfTppbXBvcnROb3Rlc0KoAQoQY29tLm1lbW9zLmFwaS52MUIQVXNlclNlcnZpY2VQcm90b1ABWjBnaXRodWIuY29tL3VzZW1lbW9zL21lbW9zL3Byb3RvL2dlbi9hcGkvdjE7YXBpdjGiAgNNQViqAgxNZW1vcy5BcGkuVjHKAgxNZW1vc1xBcGlcVjHiAhhNZW1vc1xBcGlcVjFcR1BCTWV0YWRhdGHqAg5NZW1vczo6QXBpOjpWMWIGcHJvdG8z", [file_api_v1_common, file_google_api_annotations, file_google_api_client, file_google_api_field_behavior, file_google_api_resource, file_google_protobuf_empty, file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message memos.api.v1.User
@@ -532,6 +532,12 @@ export type UserSetting = Message<"memos.api.v1.UserSetting"> & {
      */
     value: UserSetting_WebhooksSetting;
     case: "webhooksSetting";
+  } | {
+    /**
+     * @generated from field: memos.api.v1.UserSetting.DigestSetting digest_setting = 6;
+     */
+    value: UserSetting_DigestSetting;
+    case: "digestSetting";
   } | { case: undefined; value?: undefined };
 };
 
@@ -570,6 +576,27 @@ export type UserSetting_GeneralSetting = Message<"memos.api.v1.UserSetting.Gener
    * @generated from field: string theme = 4;
    */
   theme: string;
+
+  /**
+   * Whether to email the user about new comments on their memos.
+   *
+   * @generated from field: bool email_on_comment = 5;
+   */
+  emailOnComment: boolean;
+
+  /**
+   * Whether to email the user when they are mentioned.
+   *
+   * @generated from field: bool email_on_mention = 6;
+   */
+  emailOnMention: boolean;
+
+  /**
+   * Whether to email the user about memo reminders.
+   *
+   * @generated from field: bool email_on_reminder = 7;
+   */
+  emailOnReminder: boolean;
 };
 
 /**
@@ -600,6 +627,104 @@ export type UserSetting_WebhooksSetting = Message<"memos.api.v1.UserSetting.Webh
 export const UserSetting_WebhooksSettingSchema: GenMessage<UserSetting_WebhooksSetting> = /*@__PURE__*/
   messageDesc(file_api_v1_user_service, 11, 1);
 
+/**
+ * Periodic digest subscription.
+ * Daily digests are sent every day and weekly digests every Monday, at 08:00 in the server time zone.
+ *
+ * @generated from message memos.api.v1.UserSetting.DigestSetting
+ */
+export type UserSetting_DigestSetting = Message<"memos.api.v1.UserSetting.DigestSetting"> & {
+  /**
+   * @generated from field: memos.api.v1.UserSetting.DigestSetting.Frequency frequency = 1;
+   */
+  frequency: UserSetting_DigestSetting_Frequency;
+
+  /**
+   * @generated from field: repeated memos.api.v1.UserSetting.DigestSetting.Channel channels = 2;
+   */
+  channels: UserSetting_DigestSetting_Channel[];
+
+  /**
+   * The shortcut whose matching memos are included in the digest.
+   * Format: users/{user}/shortcuts/{shortcut}
+   *
+   * @generated from field: string shortcut = 3;
+   */
+  shortcut: string;
+};
+
+/**
+ * Describes the message memos.api.v1.UserSetting.DigestSetting.
+ * Use `create(UserSetting_DigestSettingSchema)` to create a new message.
+ */
+export const UserSetting_DigestSettingSchema: GenMessage<UserSetting_DigestSetting> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 11, 2);
+
+/**
+ * @generated from enum memos.api.v1.UserSetting.DigestSetting.Frequency
+ */
+export enum UserSetting_DigestSetting_Frequency {
+  /**
+   * The digest is disabled.
+   *
+   * @generated from enum value: FREQUENCY_UNSPECIFIED = 0;
+   */
+  FREQUENCY_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: DAILY = 1;
+   */
+  DAILY = 1,
+
+  /**
+   * @generated from enum value: WEEKLY = 2;
+   */
+  WEEKLY = 2,
+}
+
+/**
+ * Describes the enum memos.api.v1.UserSetting.DigestSetting.Frequency.
+ */
+export const UserSetting_DigestSetting_FrequencySchema: GenEnum<UserSetting_DigestSetting_Frequency> = /*@__PURE__*/
+  enumDesc(file_api_v1_user_service, 11, 2, 0);
+
+/**
+ * @generated from enum memos.api.v1.UserSetting.DigestSetting.Channel
+ */
+export enum UserSetting_DigestSetting_Channel {
+  /**
+   * @generated from enum value: CHANNEL_UNSPECIFIED = 0;
+   */
+  CHANNEL_UNSPECIFIED = 0,
+
+  /**
+   * Sent by email. Requires the instance email setting.
+   *
+   * @generated from enum value: EMAIL = 1;
+   */
+  EMAIL = 1,
+
+  /**
+   * Sent to the user webhooks subscribed to "memos.digest.created".
+   *
+   * @generated from enum value: WEBHOOK = 2;
+   */
+  WEBHOOK = 2,
+
+  /**
+   * Created as a notification.
+   *
+   * @generated from enum value: INBOX = 3;
+   */
+  INBOX = 3,
+}
+
+/**
+ * Describes the enum memos.api.v1.UserSetting.DigestSetting.Channel.
+ */
+export const UserSetting_DigestSetting_ChannelSchema: GenEnum<UserSetting_DigestSetting_Channel> = /*@__PURE__*/
+  enumDesc(file_api_v1_user_service, 11, 2, 1);
+
 /**
  * Enumeration of user setting keys.
  *
@@ -624,6 +749,13 @@ export enum UserSetting_Key {
    * @generated from enum value: WEBHOOKS = 4;
    */
   WEBHOOKS = 4,
+
+  /**
+   * DIGEST is the key for the periodic digest subscription.
+   *
+   * @generated from enum value: DIGEST = 5;
+   */
+  DIGEST = 5,
 }
 
 /**
@@ -994,6 +1126,53 @@ export type UserWebhook = Message<"memos.api.v1.UserWebhook"> & {
    * @generated from field: google.protobuf.Timestamp update_time = 5;
    */
   updateTime?: Timestamp;
+
+  /**
+   * Optional. The secret used to sign deliveries with HMAC-SHA256.
+   * Generated when empty on creation, and rotated by updating the field with an empty value.
+   * Only returned by the creation and by the update setting it, it is empty otherwise.
+   *
+   * @generated from field: string secret = 6;
+   */
+  secret: string;
+
+  /**
+   * Optional. The activity types the webhook subscribes to, e.g. "memos.memo.created".
+   * Empty subscribes to all activity types.
+   *
+   * @generated from field: repeated string event_types = 7;
+   */
+  eventTypes: string[];
+
+  /**
+   * Optional. The CEL filter the memo of an activity must match, using the memo filter syntax.
+   * Example: `tag in ["release"] && visibility == "PUBLIC"`.
+   *
+   * @generated from field: string filter = 8;
+   */
+  filter: string;
+
+  /**
+   * Optional. The format of the request body. Defaults to the memos JSON payload.
+   *
+   * @generated from field: memos.api.v1.UserWebhook.Format format = 9;
+   */
+  format: UserWebhook_Format;
+
+  /**
+   * Optional. The Go text/template rendering the request body of the TEMPLATE format.
+   * The template is executed with the memos payload and the rendered `Title`, `Snippet` and `Link`.
+   *
+   * @generated from field: string template = 10;
+   */
+  template: string;
+
+  /**
+   * Optional. Whether the response body must be a JSON object with a zero `code`, e.g. `{"code":0}`.
+   *
+   * @generated from field: bool check_response_code = 11;
+   */
+  checkResponseCode: boolean;
 };
 
 /**
@@ -1003,6 +1182,71 @@ export type UserWebhook = Message<"memos.api.v1.UserWebhook"> & {
 export const UserWebhookSchema: GenMessage<UserWebhook> = /*@__PURE__*/
   messageDesc(file_api_v1_user_service, 22);
 
+/**
+ * @generated from enum memos.api.v1.UserWebhook.Format
+ */
+export enum UserWebhook_Format {
+  /**
+   * @generated from enum value: FORMAT_UNSPECIFIED = 0;
+   */
+  FORMAT_UNSPECIFIED = 0,
+
+  /**
+   * The memos JSON payload.
+   *
+   * @generated from enum value: MEMOS = 1;
+   */
+  MEMOS = 1,
+
+  /**
+   * Slack incoming webhook with blocks.
+   *
+   * @generated from enum value: SLACK = 2;
+   */
+  SLACK = 2,
+
+  /**
+   * Discord webhook with an embed.
+   *
+   * @generated from enum value: DISCORD = 3;
+   */
+  DISCORD = 3,
+
+  /**
+   * Telegram Bot API sendMessage. The chat is taken from the `chat_id` query parameter of the URL.
+   *
+   * @generated from enum value: TELEGRAM = 4;
+   */
+  TELEGRAM = 4,
+
+  /**
+   * Feishu/Lark custom bot with an interactive card.
+   *
+   * @generated from enum value: FEISHU = 5;
+   */
+  FEISHU = 5,
+
+  /**
+   * DingTalk custom robot with a markdown message.
+   *
+   * @generated from enum value: DINGTALK = 6;
+   */
+  DINGTALK = 6,
+
+  /**
+   * A user-supplied Go text/template.
+   *
+   * @generated from enum value: TEMPLATE = 7;
+   */
+  TEMPLATE = 7,
+}
+
+/**
+ * Describes the enum memos.api.v1.UserWebhook.Format.
+ */
+export const UserWebhook_FormatSchema: GenEnum<UserWebhook_Format> = /*@__PURE__*/
+  enumDesc(file_api_v1_user_service, 22, 0);
+
 /**
  * @generated from message memos.api.v1.ListUserWebhooksRequest
  */
@@ -1115,6 +1359,218 @@ export type DeleteUserWebhookRequest = Message<"memos.api.v1.DeleteUserWebhookRe
 export const DeleteUserWebhookRequestSchema: GenMessage<DeleteUserWebhookRequest> = /*@__PURE__*/
   messageDesc(file_api_v1_user_service, 27);
 
+/**
+ * UserWebhookDelivery is a request sent to a webhook, retried until it succeeds or fails on all attempts.
+ *
+ * @generated from message memos.api.v1.UserWebhookDelivery
+ */
+export type UserWebhookDelivery = Message<"memos.api.v1.UserWebhookDelivery"> & {
+  /**
+   * The name of the delivery.
+   * Format: users/{user}/webhooks/{webhook}/deliveries/{delivery}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The URL the request is sent to.
+   *
+   * @generated from field: string url = 2;
+   */
+  url: string;
+
+  /**
+   * The type of activity that triggered the delivery.
+   *
+   * @generated from field: string activity_type = 3;
+   */
+  activityType: string;
+
+  /**
+   * The state of the delivery.
+   *
+   * @generated from field: memos.api.v1.UserWebhookDelivery.State state = 4;
+   */
+  state: UserWebhookDelivery_State;
+
+  /**
+   * The number of attempts made.
+   *
+   * @generated from field: int32 attempts = 5;
+   */
+  attempts: number;
+
+  /**
+   * The JSON request body.
+   *
+   * @generated from field: string request_body = 6;
+   */
+  requestBody: string;
+
+  /**
+   * The HTTP status code of the last attempt, 0 when no response was received.
+   *
+   * @generated from field: int32 response_status_code = 7;
+   */
+  responseStatusCode: number;
+
+  /**
+   * The response body of the last attempt, truncated.
+   *
+   * @generated from field: string response_body = 8;
+   */
+  responseBody: string;
+
+  /**
+   * The error of the last failed attempt.
+   *
+   * @generated from field: string error = 9;
+   */
+  error: string;
+
+  /**
+   * The creation time of the delivery.
+   *
+   * @generated from field: google.protobuf.Timestamp create_time = 10;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * The time of the last attempt.
+   *
+   * @generated from field: google.protobuf.Timestamp update_time = 11;
+   */
+  updateTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.UserWebhookDelivery.
+ * Use `create(UserWebhookDeliverySchema)` to create a new message.
+ */
+export const UserWebhookDeliverySchema: GenMessage<UserWebhookDelivery> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 28);
+
+/**
+ * @generated from enum memos.api.v1.UserWebhookDelivery.State
+ */
+export enum UserWebhookDelivery_State {
+  /**
+   * @generated from enum value: STATE_UNSPECIFIED = 0;
+   */
+  STATE_UNSPECIFIED = 0,
+
+  /**
+   * The delivery is waiting for an attempt or a retry.
+   *
+   * @generated from enum value: PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * The receiver accepted the delivery.
+   *
+   * @generated from enum value: SUCCEEDED = 2;
+   */
+  SUCCEEDED = 2,
+
+  /**
+   * All attempts failed.
+   *
+   * @generated from enum value: FAILED = 3;
+   */
+  FAILED = 3,
+}
+
+/**
+ * Describes the enum memos.api.v1.UserWebhookDelivery.State.
+ */
+export const UserWebhookDelivery_StateSchema: GenEnum<UserWebhookDelivery_State> = /*@__PURE__*/
+  enumDesc(file_api_v1_user_service, 28, 0);
+
+/**
+ * @generated from message memos.api.v1.ListUserWebhookDeliveriesRequest
+ */
+export type ListUserWebhookDeliveriesRequest = Message<"memos.api.v1.ListUserWebhookDeliveriesRequest"> & {
+  /**
+   * The parent webhook resource.
+   * Format: users/{user}/webhooks/{webhook}
+   *
+   * @generated from field: string parent = 1;
+   */
+  parent: string;
+
+  /**
+   * Optional. The maximum number of deliveries to return.
+   * If unspecified, at most 10 deliveries will be returned.
+   * The maximum value is 1000; values above 1000 will be coerced to 1000.
+   *
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * Optional. A page token, received from a previous `ListUserWebhookDeliveries` call.
+   *
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListUserWebhookDeliveriesRequest.
+ * Use `create(ListUserWebhookDeliveriesRequestSchema)` to create a new message.
+ */
+export const ListUserWebhookDeliveriesRequestSchema: GenMessage<ListUserWebhookDeliveriesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 29);
+
+/**
+ * @generated from message memos.api.v1.ListUserWebhookDeliveriesResponse
+ */
+export type ListUserWebhookDeliveriesResponse = Message<"memos.api.v1.ListUserWebhookDeliveriesResponse"> & {
+  /**
+   * The list of deliveries.
+   *
+   * @generated from field: repeated memos.api.v1.UserWebhookDelivery deliveries = 1;
+   */
+  deliveries: UserWebhookDelivery[];
+
+  /**
+   * A token that can be sent as `page_token` to retrieve the next page.
+   * If this field is omitted, there are no subsequent pages.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ListUserWebhookDeliveriesResponse.
+ * Use `create(ListUserWebhookDeliveriesResponseSchema)` to create a new message.
+ */
+export const ListUserWebhookDeliveriesResponseSchema: GenMessage<ListUserWebhookDeliveriesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 30);
+
+/**
+ * @generated from message memos.api.v1.RedeliverUserWebhookDeliveryRequest
+ */
+export type RedeliverUserWebhookDeliveryRequest = Message<"memos.api.v1.RedeliverUserWebhookDeliveryRequest"> & {
+  /**
+   * The name of the delivery to redeliver.
+   * Format: users/{user}/webhooks/{webhook}/deliveries/{delivery}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.RedeliverUserWebhookDeliveryRequest.
+ * Use `create(RedeliverUserWebhookDeliveryRequestSchema)` to create a new message.
+ */
+export const RedeliverUserWebhookDeliveryRequestSchema: GenMessage<RedeliverUserWebhookDeliveryRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 31);
+
 /**
  * @generated from message memos.api.v1.UserNotification
  */
@@ -1162,6 +1618,13 @@ export type UserNotification = Message<"memos.api.v1.UserNotification"> & {
    * @generated from field: optional int32 activity_id = 6;
    */
   activityId?: number;
+
+  /**
+   * The digest, for digest notifications.
+   *
+   * @generated from field: memos.api.v1.UserNotification.Digest digest = 7;
+   */
+  digest?: UserNotification_Digest;
 };
 
 /**
@@ -1169,7 +1632,41 @@ export type UserNotification = Message<"memos.api.v1.UserNotification"> & {
  * Use `create(UserNotificationSchema)` to create a new message.
  */
 export const UserNotificationSchema: GenMessage<UserNotification> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 28);
+  messageDesc(file_api_v1_user_service, 32);
+
+/**
+ * @generated from message memos.api.v1.UserNotification.Digest
+ */
+export type UserNotification_Digest = Message<"memos.api.v1.UserNotification.Digest"> & {
+  /**
+   * @generated from field: string title = 1;
+   */
+  title: string;
+
+  /**
+   * The digest rendered as HTML.
+   *
+   * @generated from field: string html = 2;
+   */
+  html: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 3;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 4;
+   */
+  endTime?: Timestamp;
+};
+
+/**
+ * Describes the message memos.api.v1.UserNotification.Digest.
+ * Use `create(UserNotification_DigestSchema)` to create a new message.
+ */
+export const UserNotification_DigestSchema: GenMessage<UserNotification_Digest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 32, 0);
 
 /**
  * @generated from enum memos.api.v1.UserNotification.Status
@@ -1195,7 +1692,7 @@ export enum UserNotification_Status {
  * Describes the enum memos.api.v1.UserNotification.Status.
  */
 export const UserNotification_StatusSchema: GenEnum<UserNotification_Status> = /*@__PURE__*/
-  enumDesc(file_api_v1_user_service, 28, 0);
+  enumDesc(file_api_v1_user_service, 32, 0);
 
 /**
  * @generated from enum memos.api.v1.UserNotification.Type
@@ -1210,13 +1707,28 @@ export enum UserNotification_Type {
    * @generated from enum value: MEMO_COMMENT = 1;
    */
   MEMO_COMMENT = 1,
+
+  /**
+   * @generated from enum value: DIGEST = 2;
+   */
+  DIGEST = 2,
+
+  /**
+   * @generated from enum value: MEMO_MENTION = 3;
+   */
+  MEMO_MENTION = 3,
+
+  /**
+   * @generated from enum value: MEMO_REMINDER = 4;
+   */
+  MEMO_REMINDER = 4,
 }
 
 /**
  * Describes the enum memos.api.v1.UserNotification.Type.
  */
 export const UserNotification_TypeSchema: GenEnum<UserNotification_Type> = /*@__PURE__*/
-  enumDesc(file_api_v1_user_service, 28, 1);
+  enumDesc(file_api_v1_user_service, 32, 1);
 
 /**
  * @generated from message memos.api.v1.ListUserNotificationsRequest
@@ -1251,7 +1763,7 @@ export type ListUserNotificationsRequest = Message<"memos.api.v1.ListUserNotific
  * Use `create(ListUserNotificationsRequestSchema)` to create a new message.
  */
 export const ListUserNotificationsRequestSchema: GenMessage<ListUserNotificationsRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 29);
+  messageDesc(file_api_v1_user_service, 33);
 
 /**
  * @generated from message memos.api.v1.ListUserNotificationsResponse
@@ -1273,7 +1785,7 @@ export type ListUserNotificationsResponse = Message<"memos.api.v1.ListUserNotifi
  * Use `create(ListUserNotificationsResponseSchema)` to create a new message.
  */
 export const ListUserNotificationsResponseSchema: GenMessage<ListUserNotificationsResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 30);
+  messageDesc(file_api_v1_user_service, 34);
 
 /**
  * @generated from message memos.api.v1.UpdateUserNotificationRequest
@@ -1295,7 +1807,7 @@ export type UpdateUserNotificationRequest = Message<"memos.api.v1.UpdateUserNoti
  * Use `create(UpdateUserNotificationRequestSchema)` to create a new message.
  */
 export const UpdateUserNotificationRequestSchema: GenMessage<UpdateUserNotificationRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 31);
+  messageDesc(file_api_v1_user_service, 35);
 
 /**
  * @generated from message memos.api.v1.DeleteUserNotificationRequest
@@ -1314,7 +1826,246 @@ export type DeleteUserNotificationRequest = Message<"memos.api.v1.DeleteUserNoti
  * Use `create(DeleteUserNotificationRequestSchema)` to create a new message.
  */
 export const DeleteUserNotificationRequestSchema: GenMessage<DeleteUserNotificationRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 32);
+  messageDesc(file_api_v1_user_service, 36);
+
+/**
+ * @generated from message memos.api.v1.ExportUserDataRequest
+ */
+export type ExportUserDataRequest = Message<"memos.api.v1.ExportUserDataRequest"> & {
+  /**
+   * The name of the user.
+   * Format: users/{user}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message memos.api.v1.ExportUserDataRequest.
+ * Use `create(ExportUserDataRequestSchema)` to create a new message.
+ */
+export const ExportUserDataRequestSchema: GenMessage<ExportUserDataRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 37);
+
+/**
+ * @generated from message memos.api.v1.ExportUserDataResponse
+ */
+export type ExportUserDataResponse = Message<"memos.api.v1.ExportUserDataResponse"> & {
+  /**
+   * The zip archive, with a Markdown file with YAML front matter per memo
+   * in "memos/" and the attachment blobs in "attachments/".
+   *
+   * @generated from field: bytes content = 1;
+   */
+  content: Uint8Array;
+};
+
+/**
+ * Describes the message memos.api.v1.ExportUserDataResponse.
+ * Use `create(ExportUserDataResponseSchema)` to create a new message.
+ */
+export const ExportUserDataResponseSchema: GenMessage<ExportUserDataResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 38);
+
+/**
+ * @generated from message memos.api.v1.ImportUserDataRequest
+ */
+export type ImportUserDataRequest = Message<"memos.api.v1.ImportUserDataRequest"> & {
+  /**
+   * The name of the user.
+   * Format: users/{user}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The zip archive made by ExportUserData.
+   *
+   * @generated from field: bytes content = 2;
+   */
+  content: Uint8Array;
+};
+
+/**
+ * Describes the message memos.api.v1.ImportUserDataRequest.
+ * Use `create(ImportUserDataRequestSchema)` to create a new message.
+ */
+export const ImportUserDataRequestSchema: GenMessage<ImportUserDataRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 39);
+
+/**
+ * @generated from message memos.api.v1.ImportUserDataResponse
+ */
+export type ImportUserDataResponse = Message<"memos.api.v1.ImportUserDataResponse"> & {
+  /**
+   * The number of memos created.
+   *
+   * @generated from field: int32 created_memo_count = 1;
+   */
+  createdMemoCount: number;
+
+  /**
+   * The number of existing memos updated.
+   *
+   * @generated from field: int32 updated_memo_count = 2;
+   */
+  updatedMemoCount: number;
+
+  /**
+   * The number of attachments created.
+   *
+   * @generated from field: int32 created_attachment_count = 3;
+   */
+  createdAttachmentCount: number;
+};
+
+/**
+ * Describes the message memos.api.v1.ImportUserDataResponse.
+ * Use `create(ImportUserDataResponseSchema)` to create a new message.
+ */
+export const ImportUserDataResponseSchema: GenMessage<ImportUserDataResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 40);
+
+/**
+ * @generated from message memos.api.v1.ImportNotesRequest
+ */
+export type ImportNotesRequest = Message<"memos.api.v1.ImportNotesRequest"> & {
+  /**
+   * The name of the user.
+   * Format: users/{user}
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: memos.api.v1.ImportNotesRequest.Format format = 2;
+   */
+  format: ImportNotesRequest_Format;
+
+  /**
+   * The name of the exported file. Files ending with .zip are extracted.
+   *
+   * @generated from field: string filename = 3;
+   */
+  filename: string;
+
+  /**
+   * The content of the exported file.
+   *
+   * @generated from field: bytes content = 4;
+   */
+  content: Uint8Array;
+
+  /**
+   * Whether to only report what would be imported, without importing it.
+   *
+   * @generated from field: bool dry_run = 5;
+   */
+  dryRun: boolean;
+};
+
+/**
+ * Describes the message memos.api.v1.ImportNotesRequest.
+ * Use `create(ImportNotesRequestSchema)` to create a new message.
+ */
+export const ImportNotesRequestSchema: GenMessage<ImportNotesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 41);
+
+/**
+ * The note tool the notes are exported from.
+ *
+ * @generated from enum memos.api.v1.ImportNotesRequest.Format
+ */
+export enum ImportNotesRequest_Format {
+  /**
+   * @generated from enum value: FORMAT_UNSPECIFIED = 0;
+   */
+  FORMAT_UNSPECIFIED = 0,
+
+  /**
+   * An Obsidian vault, zipped.
+   *
+   * @generated from enum value: OBSIDIAN = 1;
+   */
+  OBSIDIAN = 1,
+
+  /**
+   * The zip archive exported by flomo.
+   *
+   * @generated from enum value: FLOMO = 2;
+   */
+  FLOMO = 2,
+
+  /**
+   * The zip archive of Google Keep exported by Google Takeout.
+   *
+   * @generated from enum value: GOOGLE_KEEP = 3;
+   */
+  GOOGLE_KEEP = 3,
+
+  /**
+   * An ENEX file exported by Evernote, or a zip archive of ENEX files.
+   *
+   * @generated from enum value: EVERNOTE = 4;
+   */
+  EVERNOTE = 4,
+}
+
+/**
+ * Describes the enum memos.api.v1.ImportNotesRequest.Format.
+ */
+export const ImportNotesRequest_FormatSchema: GenEnum<ImportNotesRequest_Format> = /*@__PURE__*/
+  enumDesc(file_api_v1_user_service, 41, 0);
+
+/**
+ * @generated from message memos.api.v1.ImportNotesResponse
+ */
+export type ImportNotesResponse = Message<"memos.api.v1.ImportNotesResponse"> & {
+  /**
+   * The number of memos imported, or to be imported on a dry run.
+   *
+   * @generated from field: int32 memo_count = 1;
+   */
+  memoCount: number;
+
+  /**
+   * The number of attachments imported, or to be imported on a dry run.
+   *
+   * @generated from field: int32 attachment_count = 2;
+   */
+  attachmentCount: number;
+
+  /**
+   * The number of reference relations made from the links between the notes.
+   *
+   * @generated from field: int32 relation_count = 3;
+   */
+  relationCount: number;
+
+  /**
+   * The tags of the imported memos.
+   *
+   * @generated from field: repeated string tags = 4;
+   */
+  tags: string[];
+
+  /**
+   * The problems found in the export, such as links to missing notes.
+   *
+   * @generated from field: repeated string warnings = 5;
+   */
+  warnings: string[];
+};
+
+/**
+ * Describes the message memos.api.v1.ImportNotesResponse.
+ * Use `create(ImportNotesResponseSchema)` to create a new message.
+ */
+export const ImportNotesResponseSchema: GenMessage<ImportNotesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 42);
 
 /**
  * @generated from service memos.api.v1.UserService
@@ -1495,6 +2246,26 @@ export const UserService: GenService<{
     input: typeof DeleteUserWebhookRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * ListUserWebhookDeliveries returns the deliveries of a webhook, newest first.
+   *
+   * @generated from rpc memos.api.v1.UserService.ListUserWebhookDeliveries
+   */
+  listUserWebhookDeliveries: {
+    methodKind: "unary";
+    input: typeof ListUserWebhookDeliveriesRequestSchema;
+    output: typeof ListUserWebhookDeliveriesResponseSchema;
+  },
+  /**
+   * RedeliverUserWebhookDelivery sends the request of a delivery again as a new delivery.
+   *
+   * @generated from rpc memos.api.v1.UserService.RedeliverUserWebhookDelivery
+   */
+  redeliverUserWebhookDelivery: {
+    methodKind: "unary";
+    input: typeof RedeliverUserWebhookDeliveryRequestSchema;
+    output: typeof UserWebhookDeliverySchema;
+  },
   /**
    * ListUserNotifications lists notifications for a user.
    *
@@ -1525,6 +2296,37 @@ export const UserService: GenService<{
     input: typeof DeleteUserNotificationRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * ExportUserData exports the memos and attachments of a user as a zip archive.
+   *
+   * @generated from rpc memos.api.v1.UserService.ExportUserData
+   */
+  exportUserData: {
+    methodKind: "unary";
+    input: typeof ExportUserDataRequestSchema;
+    output: typeof ExportUserDataResponseSchema;
+  },
+  /**
+   * ImportUserData imports a zip archive made by ExportUserData into the memos of a user.
+   * Memos and attachments are matched by uid, so importing the same archive again updates them.
+   *
+   * @generated from rpc memos.api.v1.UserService.ImportUserData
+   */
+  importUserData: {
+    methodKind: "unary";
+    input: typeof ImportUserDataRequestSchema;
+    output: typeof ImportUserDataResponseSchema;
+  },
+  /**
+   * ImportNotes imports the notes exported from another note tool as memos of a user.
+   *
+   * @generated from rpc memos.api.v1.UserService.ImportNotes
+   */
+  importNotes: {
+    methodKind: "unary";
+    input: typeof ImportNotesRequestSchema;
+    output: typeof ImportNotesResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_user_service, 0);
 